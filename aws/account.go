@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// AccountInfo - what tells the account being nuked apart from the others, e.g. in the reports of many sandbox accounts
+type AccountInfo struct {
+	Id    string
+	Alias string
+	Email string
+	// The organizational units from the root of the organization down to the account, e.g. Root/Sandboxes/Team
+	OUPath string
+}
+
+// GetAccountInfo - Looks up the account the credentials belong to. Only the id is guaranteed to be found: the alias,
+// email and OU path are left empty when the account doesn't have them, or when the credentials aren't allowed to
+// look them up, which is the case for the email and OU path outside of the organization's management account.
+func GetAccountInfo() (*AccountInfo, error) {
+	// IAM and Organizations are global services, homed in us-east-1
+	session := newSession("us-east-1")
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	info := AccountInfo{Id: *accountId}
+
+	aliases, err := iam.New(session).ListAccountAliases(&iam.ListAccountAliasesInput{})
+	if err != nil {
+		logging.Logger.Debugf("Unable to look up the alias of account %s: %s", info.Id, err)
+	} else if len(aliases.AccountAliases) > 0 {
+		info.Alias = awsgo.StringValue(aliases.AccountAliases[0])
+	}
+
+	svc := organizations.New(session)
+	account, err := svc.DescribeAccount(&organizations.DescribeAccountInput{AccountId: accountId})
+	if err != nil {
+		logging.Logger.Debugf("Unable to look up account %s in its organization: %s", info.Id, err)
+		return &info, nil
+	}
+	info.Email = awsgo.StringValue(account.Account.Email)
+
+	ouPath, err := getOUPath(svc, accountId)
+	if err != nil {
+		logging.Logger.Debugf("Unable to look up the OU path of account %s: %s", info.Id, err)
+		return &info, nil
+	}
+	info.OUPath = ouPath
+
+	return &info, nil
+}
+
+// getOUPath - Returns the names of the organizational units the account is in, from the root of the organization down
+func getOUPath(svc *organizations.Organizations, accountId *string) (string, error) {
+	var names []string
+	childId := accountId
+	for {
+		parents, err := svc.ListParents(&organizations.ListParentsInput{ChildId: childId})
+		if err != nil {
+			return "", errors.WithStackTrace(err)
+		}
+		if len(parents.Parents) == 0 {
+			break
+		}
+
+		parent := parents.Parents[0]
+		if awsgo.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			names = append([]string{"Root"}, names...)
+			break
+		}
+
+		unit, err := svc.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+			OrganizationalUnitId: parent.Id,
+		})
+		if err != nil {
+			return "", errors.WithStackTrace(err)
+		}
+		names = append([]string{awsgo.StringValue(unit.OrganizationalUnit.Name)}, names...)
+		childId = parent.Id
+	}
+
+	return strings.Join(names, "/"), nil
+}
@@ -4,14 +4,21 @@ import (
 	"time"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+// AMIConfig - what to do along with deregistering AMIs
+type AMIConfig struct {
+	// Delete the EBS snapshots backing each AMI once it's deregistered, so they aren't left behind
+	DeleteSnapshots bool
+}
+
 // Returns a formatted string of AMI Image ids
-func getAllAMIs(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllAMIs(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
 	svc := ec2.New(session)
 
 	params := &ec2.DescribeImagesInput{
@@ -31,7 +38,7 @@ func getAllAMIs(session *session.Session, region string, excludeAfter time.Time)
 			return nil, err
 		}
 
-		if excludeAfter.After(createdTime) {
+		if timeFilter.IncludesResource(image.ImageId, createdTime) {
 			imageIds = append(imageIds, image.ImageId)
 		}
 	}
@@ -39,8 +46,66 @@ func getAllAMIs(session *session.Session, region string, excludeAfter time.Time)
 	return imageIds, nil
 }
 
-// Deletes all AMIs
-func nukeAllAMIs(session *session.Session, imageIds []*string) error {
+// imageSnapshotIds - Returns the ids of the EBS snapshots referenced in the block device mappings of the image
+func imageSnapshotIds(image *ec2.Image) []*string {
+	var snapshotIds []*string
+	for _, mapping := range image.BlockDeviceMappings {
+		if mapping.Ebs == nil || mapping.Ebs.SnapshotId == nil {
+			continue
+		}
+		snapshotIds = append(snapshotIds, mapping.Ebs.SnapshotId)
+	}
+	return snapshotIds
+}
+
+// getBackingSnapshotIds - Returns the ids of the EBS snapshots backing each of the given images, keyed by image id
+func getBackingSnapshotIds(svc *ec2.EC2, imageIds []*string) (map[string][]*string, error) {
+	output, err := svc.DescribeImages(&ec2.DescribeImagesInput{ImageIds: imageIds})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	snapshotIds := map[string][]*string{}
+	for _, image := range output.Images {
+		snapshotIds[*image.ImageId] = imageSnapshotIds(image)
+	}
+	return snapshotIds, nil
+}
+
+// deleteBackingSnapshots - Deletes the EBS snapshots that backed a deregistered AMI. The final snapshots of EBS volumes
+// still within their retention period are left alone, and so are the snapshots still backing other AMIs, which can't
+// be deleted.
+func deleteBackingSnapshots(svc *ec2.EC2, imageId string, snapshotIds []*string) {
+	if len(snapshotIds) == 0 {
+		return
+	}
+
+	output, err := svc.DescribeSnapshots(&ec2.DescribeSnapshotsInput{SnapshotIds: snapshotIds})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] Snapshots backing AMI %s not deleted: %s", imageId, err)
+		return
+	}
+
+	for _, snapshot := range output.Snapshots {
+		if isRetainedFinalSnapshot(snapshot.Tags, time.Now()) {
+			logging.Logger.Infof("Skipping Snapshot %s backing AMI %s: it's the final snapshot of a nuked EBS volume, still within its retention period", *snapshot.SnapshotId, imageId)
+			continue
+		}
+
+		_, err := svc.DeleteSnapshot(&ec2.DeleteSnapshotInput{SnapshotId: snapshot.SnapshotId})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidSnapshot.NotFound" {
+				continue
+			}
+			logging.Logger.Errorf("[Failed] Snapshot %s backing AMI %s not deleted: %s", *snapshot.SnapshotId, imageId, err)
+		} else {
+			logging.Logger.Infof("Deleted Snapshot %s backing AMI %s", *snapshot.SnapshotId, imageId)
+		}
+	}
+}
+
+// Deletes all AMIs, along with the snapshots backing them when asked to
+func nukeAllAMIs(session *session.Session, imageIds []*string, config AMIConfig) error {
 	svc := ec2.New(session)
 
 	if len(imageIds) == 0 {
@@ -48,6 +113,15 @@ func nukeAllAMIs(session *session.Session, imageIds []*string) error {
 		return nil
 	}
 
+	// The block device mappings of an image can't be looked up anymore once it's deregistered
+	var backingSnapshotIds map[string][]*string
+	if config.DeleteSnapshots {
+		var err error
+		if backingSnapshotIds, err = getBackingSnapshotIds(svc, imageIds); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
 	logging.Logger.Infof("Deleting all AMIs in region %s", *session.Config.Region)
 
 	for _, imageID := range imageIds {
@@ -60,6 +134,7 @@ func nukeAllAMIs(session *session.Session, imageIds []*string) error {
 			logging.Logger.Errorf("[Failed] %s", err)
 		} else {
 			logging.Logger.Infof("Deleted AMI: %s", *imageID)
+			deleteBackingSnapshots(svc, *imageID, backingSnapshotIds[*imageID])
 		}
 	}
 
@@ -58,7 +58,7 @@ func createTestAMI(t *testing.T, session *session.Session, name string) (*ec2.Im
 
 	if err != nil {
 		// clean this up since we won't use it again
-		defer nukeAllAMIs(session, []*string{output.ImageId})
+		defer nukeAllAMIs(session, []*string{output.ImageId}, AMIConfig{})
 		return nil, errors.WithStackTrace(err)
 	}
 
@@ -103,17 +103,17 @@ func TestListAMIs(t *testing.T) {
 	}
 
 	// clean up after this test
-	defer nukeAllAMIs(session, []*string{image.ImageId})
+	defer nukeAllAMIs(session, []*string{image.ImageId}, AMIConfig{})
 	defer nukeAllEc2Instances(session, findEC2InstancesByNameTag(t, session, uniqueTestID))
 
-	amis, err := getAllAMIs(session, region, time.Now().Add(1*time.Hour*-1))
+	amis, err := getAllAMIs(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of AMIs")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(amis), *image.ImageId)
 
-	amis, err = getAllAMIs(session, region, time.Now().Add(1*time.Hour))
+	amis, err = getAllAMIs(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of AMIs")
 	}
@@ -162,14 +162,30 @@ func TestNukeAMIs(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	if err := nukeAllAMIs(session, []*string{image.ImageId}); err != nil {
+	if err := nukeAllAMIs(session, []*string{image.ImageId}, AMIConfig{}); err != nil {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	amis, err := getAllAMIs(session, region, time.Now().Add(1*time.Hour))
+	amis, err := getAllAMIs(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of AMIs")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(amis), *image.ImageId)
 }
+
+func TestImageSnapshotIds(t *testing.T) {
+	t.Parallel()
+
+	image := &ec2.Image{
+		BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+			{DeviceName: awsgo.String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{SnapshotId: awsgo.String("snap-root")}},
+			{DeviceName: awsgo.String("/dev/sdb"), VirtualName: awsgo.String("ephemeral0")},
+			{DeviceName: awsgo.String("/dev/sdc"), Ebs: &ec2.EbsBlockDevice{VolumeSize: awsgo.Int64(10)}},
+			{DeviceName: awsgo.String("/dev/sdd"), Ebs: &ec2.EbsBlockDevice{SnapshotId: awsgo.String("snap-data")}},
+		},
+	}
+
+	assert.Equal(t, []string{"snap-root", "snap-data"}, awsgo.StringValueSlice(imageSnapshotIds(image)))
+	assert.Empty(t, imageSnapshotIds(&ec2.Image{}))
+}
@@ -6,9 +6,37 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: AMIs{},
+		weight:   1700,
+		actions: []string{
+			"ec2:DeregisterImage",
+			"ec2:DeleteSnapshot",
+			"ec2:CopyImage",
+			"ec2:ModifyImageAttribute",
+			"ec2:ModifySnapshotAttribute",
+		},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			imageIds, err := getAllAMIs(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return AMIs{Archive: discovery.options.Archive, Config: discovery.options.AMI, ImageIds: awsgo.StringValueSlice(imageIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return AMIs{ImageIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // AMIs - represents all user owned AMIs
 type AMIs struct {
 	ImageIds []string
+	// Where to archive the AMIs to before they are nuked. Nothing is archived when nil.
+	Archive *ArchiveConfig
+	// What to do along with deregistering the AMIs
+	Config AMIConfig
 }
 
 // ResourceName - the simple name of the aws resource
@@ -28,7 +56,15 @@ func (image AMIs) MaxBatchSize() int {
 
 // Nuke - nuke 'em all!!!
 func (image AMIs) Nuke(session *session.Session, identifiers []string) error {
-	if err := nukeAllAMIs(session, awsgo.StringSlice(identifiers)); err != nil {
+	imageIds := awsgo.StringSlice(identifiers)
+	if image.Archive != nil {
+		var err error
+		if imageIds, err = archiveAMIs(session, *image.Archive, imageIds); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	if err := nukeAllAMIs(session, imageIds, image.Config); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// API Gateway only allows deleting one REST API every 30 seconds per account, so throttled deletions are retried
+// after that long
+const apiGatewayDeleteRetryInterval = 30 * time.Second
+
+const apiGatewayDeleteMaxRetries = 10
+
+// Returns a formatted string of API Gateway REST API ids
+func getAllAPIGateways(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := apigateway.New(session)
+
+	var apiIds []*string
+	err := svc.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+		for _, api := range page.Items {
+			if timeFilter.IncludesResource(api.Id, *api.CreatedDate) {
+				apiIds = append(apiIds, api.Id)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return apiIds, nil
+}
+
+// deleteAPIGatewayBasePathMappings deletes the custom domain base path mappings pointing at the given REST APIs, as
+// a REST API can't be deleted while a custom domain is mapped to it
+func deleteAPIGatewayBasePathMappings(svc *apigateway.APIGateway, apiIds []*string) error {
+	var domainNames []*string
+	err := svc.GetDomainNamesPages(&apigateway.GetDomainNamesInput{}, func(page *apigateway.GetDomainNamesOutput, lastPage bool) bool {
+		for _, domainName := range page.Items {
+			domainNames = append(domainNames, domainName.DomainName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, domainName := range domainNames {
+		var mappings []*apigateway.BasePathMapping
+		err := svc.GetBasePathMappingsPages(
+			&apigateway.GetBasePathMappingsInput{DomainName: domainName},
+			func(page *apigateway.GetBasePathMappingsOutput, lastPage bool) bool {
+				for _, mapping := range page.Items {
+					if collections.ListContainsElement(awsgo.StringValueSlice(apiIds), awsgo.StringValue(mapping.RestApiId)) {
+						mappings = append(mappings, mapping)
+					}
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, mapping := range mappings {
+			_, err := svc.DeleteBasePathMapping(&apigateway.DeleteBasePathMappingInput{
+				DomainName: domainName,
+				BasePath:   mapping.BasePath,
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+			logging.Logger.Infof("Deleted base path mapping %s of custom domain %s for API Gateway %s", awsgo.StringValue(mapping.BasePath), *domainName, *mapping.RestApiId)
+		}
+	}
+
+	return nil
+}
+
+// deleteAPIGateway deletes the REST API, along with its stages, retrying when throttled
+func deleteAPIGateway(svc *apigateway.APIGateway, apiId *string) error {
+	for attempt := 0; ; attempt++ {
+		_, err := svc.DeleteRestApi(&apigateway.DeleteRestApiInput{RestApiId: apiId})
+		awsErr, isAwsErr := err.(awserr.Error)
+		if !isAwsErr || awsErr.Code() != apigateway.ErrCodeTooManyRequestsException || attempt == apiGatewayDeleteMaxRetries {
+			return err
+		}
+		logging.Logger.Infof("Throttled deleting API Gateway %s, retrying in %s", *apiId, apiGatewayDeleteRetryInterval)
+		time.Sleep(apiGatewayDeleteRetryInterval)
+	}
+}
+
+// Deletes all API Gateway REST APIs, along with their stages and the custom domain base path mappings pointing at them
+func nukeAllAPIGateways(session *session.Session, apiIds []*string) error {
+	svc := apigateway.New(session)
+
+	if len(apiIds) == 0 {
+		logging.Logger.Infof("No API Gateways to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all API Gateways in region %s", *session.Config.Region)
+
+	if err := deleteAPIGatewayBasePathMappings(svc, apiIds); err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	var deletedApiIds []*string
+	for _, apiId := range apiIds {
+		err := deleteAPIGateway(svc, apiId)
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == apigateway.ErrCodeNotFoundException {
+				logging.Logger.Infof("API Gateway %s has already been deleted", *apiId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedApiIds = append(deletedApiIds, apiId)
+			logging.Logger.Infof("Deleted API Gateway: %s", *apiId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d API Gateway(s) deleted in %s", len(deletedApiIds), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestAPIGateway(t *testing.T, session *session.Session, name string) string {
+	svc := apigateway.New(session)
+
+	result, err := svc.CreateRestApi(&apigateway.CreateRestApiInput{
+		Name: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(result.Id)
+}
+
+func TestListAPIGateways(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	apiId := createTestAPIGateway(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllAPIGateways(session, []*string{awsgo.String(apiId)})
+
+	apiIds, err := getAllAPIGateways(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of API Gateways")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(apiIds), apiId)
+
+	apiIds, err = getAllAPIGateways(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of API Gateways")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(apiIds), apiId)
+}
+
+func TestNukeAPIGateways(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	apiId := createTestAPIGateway(t, session, uniqueTestID)
+
+	if err := nukeAllAPIGateways(session, []*string{awsgo.String(apiId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	apiIds, err := getAllAPIGateways(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of API Gateways")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(apiIds), apiId)
+}
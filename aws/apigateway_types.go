@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: APIGateways{},
+		weight:   2400,
+		actions:  []string{"apigateway:DELETE"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			apiIds, err := getAllAPIGateways(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return APIGateways{Ids: awsgo.StringValueSlice(apiIds)}, nil
+		},
+	})
+}
+
+// APIGateways - represents all API Gateway REST APIs
+type APIGateways struct {
+	Ids []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (apis APIGateways) ResourceName() string {
+	return "apigateway"
+}
+
+// ResourceIdentifiers - The ids of the API Gateway REST APIs
+func (apis APIGateways) ResourceIdentifiers() []string {
+	return apis.Ids
+}
+
+func (apis APIGateways) MaxBatchSize() int {
+	// API Gateway only allows deleting one REST API every 30 seconds, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (apis APIGateways) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAPIGateways(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
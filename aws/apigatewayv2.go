@@ -0,0 +1,135 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of API Gateway V2 API ids. These are the HTTP and WebSocket APIs.
+func getAllAPIGatewaysV2(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := apigatewayv2.New(session)
+
+	var apiIds []*string
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		output, err := svc.GetApis(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, api := range output.Items {
+			if timeFilter.IncludesResource(api.ApiId, *api.CreatedDate) {
+				apiIds = append(apiIds, api.ApiId)
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return apiIds, nil
+}
+
+// getAllAPIGatewayV2DomainNames returns the names of all API Gateway V2 custom domains
+func getAllAPIGatewayV2DomainNames(svc *apigatewayv2.ApiGatewayV2) ([]*string, error) {
+	var domainNames []*string
+	input := &apigatewayv2.GetDomainNamesInput{}
+	for {
+		output, err := svc.GetDomainNames(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, domainName := range output.Items {
+			domainNames = append(domainNames, domainName.DomainName)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return domainNames, nil
+}
+
+// deleteAPIGatewayV2Mappings deletes the custom domain API mappings pointing at the given APIs, as an API can't be
+// deleted while a custom domain is mapped to it
+func deleteAPIGatewayV2Mappings(svc *apigatewayv2.ApiGatewayV2, apiIds []*string) error {
+	domainNames, err := getAllAPIGatewayV2DomainNames(svc)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, domainName := range domainNames {
+		input := &apigatewayv2.GetApiMappingsInput{DomainName: domainName}
+		for {
+			output, err := svc.GetApiMappings(input)
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+
+			for _, mapping := range output.Items {
+				if !collections.ListContainsElement(awsgo.StringValueSlice(apiIds), awsgo.StringValue(mapping.ApiId)) {
+					continue
+				}
+				_, err := svc.DeleteApiMapping(&apigatewayv2.DeleteApiMappingInput{
+					DomainName:   domainName,
+					ApiMappingId: mapping.ApiMappingId,
+				})
+				if err != nil {
+					return errors.WithStackTrace(err)
+				}
+				logging.Logger.Infof("Deleted API mapping %s of custom domain %s for API Gateway V2 %s", *mapping.ApiMappingId, *domainName, *mapping.ApiId)
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+			input.NextToken = output.NextToken
+		}
+	}
+
+	return nil
+}
+
+// Deletes all API Gateway V2 APIs, along with their stages and the custom domain API mappings pointing at them
+func nukeAllAPIGatewaysV2(session *session.Session, apiIds []*string) error {
+	svc := apigatewayv2.New(session)
+
+	if len(apiIds) == 0 {
+		logging.Logger.Infof("No API Gateway V2 APIs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all API Gateway V2 APIs in region %s", *session.Config.Region)
+
+	if err := deleteAPIGatewayV2Mappings(svc, apiIds); err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	var deletedApiIds []*string
+	for _, apiId := range apiIds {
+		_, err := svc.DeleteApi(&apigatewayv2.DeleteApiInput{ApiId: apiId})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == apigatewayv2.ErrCodeNotFoundException {
+				logging.Logger.Infof("API Gateway V2 API %s has already been deleted", *apiId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedApiIds = append(deletedApiIds, apiId)
+			logging.Logger.Infof("Deleted API Gateway V2 API: %s", *apiId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d API Gateway V2 API(s) deleted in %s", len(deletedApiIds), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestAPIGatewayV2 creates an HTTP API with an auto-deployed stage, so that nuking it exercises deleting stages
+func createTestAPIGatewayV2(t *testing.T, session *session.Session, name string) string {
+	svc := apigatewayv2.New(session)
+
+	result, err := svc.CreateApi(&apigatewayv2.CreateApiInput{
+		Name:         awsgo.String(name),
+		ProtocolType: awsgo.String(apigatewayv2.ProtocolTypeHttp),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateStage(&apigatewayv2.CreateStageInput{
+		ApiId:      result.ApiId,
+		StageName:  awsgo.String("test"),
+		AutoDeploy: awsgo.Bool(true),
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(result.ApiId)
+}
+
+func TestListAPIGatewaysV2(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	apiId := createTestAPIGatewayV2(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllAPIGatewaysV2(session, []*string{awsgo.String(apiId)})
+
+	apiIds, err := getAllAPIGatewaysV2(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of API Gateway V2 APIs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(apiIds), apiId)
+
+	apiIds, err = getAllAPIGatewaysV2(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of API Gateway V2 APIs")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(apiIds), apiId)
+}
+
+func TestNukeAPIGatewaysV2(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	apiId := createTestAPIGatewayV2(t, session, uniqueTestID)
+
+	if err := nukeAllAPIGatewaysV2(session, []*string{awsgo.String(apiId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	apiIds, err := getAllAPIGatewaysV2(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of API Gateway V2 APIs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(apiIds), apiId)
+}
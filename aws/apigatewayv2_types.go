@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: APIGatewaysV2{},
+		weight:   2500,
+		actions:  []string{"apigateway:DELETE"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			apiIds, err := getAllAPIGatewaysV2(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return APIGatewaysV2{Ids: awsgo.StringValueSlice(apiIds)}, nil
+		},
+	})
+}
+
+// APIGatewaysV2 - represents all API Gateway V2 HTTP and WebSocket APIs
+type APIGatewaysV2 struct {
+	Ids []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (apis APIGatewaysV2) ResourceName() string {
+	return "apigatewayv2"
+}
+
+// ResourceIdentifiers - The ids of the API Gateway V2 APIs
+func (apis APIGatewaysV2) ResourceIdentifiers() []string {
+	return apis.Ids
+}
+
+func (apis APIGatewaysV2) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (apis APIGatewaysV2) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAPIGatewaysV2(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How many times to poll for an archive copy to complete, every 15 seconds, before giving up. Copying large snapshots
+// can take a while, so wait for up to an hour.
+const archiveCopyMaxAttempts = 240
+
+// The tag the archive copies carry, pointing back to the resource they were copied from
+const archivedFromTagKey = "cloud-nuke-archived-from"
+
+// ArchiveConfig - where and which AMIs and snapshots to copy to before they are nuked
+type ArchiveConfig struct {
+	// The role to assume in the archive account. The copies are made, and owned, by that account.
+	RoleArn string
+	// Only the resources carrying this tag are archived. All resources are archived when the key is empty, and any
+	// value matches when the value is empty.
+	TagKey   string
+	TagValue string
+}
+
+// matches - Checks if a resource with the given tags has to be archived
+func (config ArchiveConfig) matches(tags []*ec2.Tag) bool {
+	if config.TagKey == "" {
+		return true
+	}
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) == config.TagKey && (config.TagValue == "" || awsgo.StringValue(tag.Value) == config.TagValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// newArchiveSession - Returns a session in the archive account, in the same region as the given session
+func newArchiveSession(session *session.Session, config ArchiveConfig) *session.Session {
+	return session.Copy(&awsgo.Config{
+		Credentials: stscreds.NewCredentials(session, config.RoleArn),
+	})
+}
+
+// archiveSnapshots - Copies the snapshots matching the archive config to the archive account. Returns the ids of the
+// snapshots that are safe to nuke, i.e. the ones that were archived or didn't have to be. Snapshots that failed to
+// be archived are left alone.
+func archiveSnapshots(session *session.Session, config ArchiveConfig, snapshotIds []*string) ([]*string, error) {
+	if len(snapshotIds) == 0 {
+		return snapshotIds, nil
+	}
+
+	svc := ec2.New(session)
+	archiveSession := newArchiveSession(session, config)
+	archiveAccountId, err := getAccountId(archiveSession)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	archiveSvc := ec2.New(archiveSession)
+
+	output, err := svc.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: snapshotIds,
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var nukeableSnapshotIds []*string
+	for _, snapshot := range output.Snapshots {
+		if !config.matches(snapshot.Tags) {
+			nukeableSnapshotIds = append(nukeableSnapshotIds, snapshot.SnapshotId)
+			continue
+		}
+
+		logging.Logger.Infof("Archiving Snapshot %s to account %s", *snapshot.SnapshotId, *archiveAccountId)
+		if err := archiveSnapshot(svc, archiveSvc, *session.Config.Region, snapshot, archiveAccountId); err != nil {
+			logging.Logger.Errorf("[Failed] Snapshot %s not archived, so it won't be nuked: %s", *snapshot.SnapshotId, err)
+			continue
+		}
+		nukeableSnapshotIds = append(nukeableSnapshotIds, snapshot.SnapshotId)
+	}
+
+	return nukeableSnapshotIds, nil
+}
+
+// archiveSnapshot - Shares the snapshot with the archive account, and has the archive account copy it
+func archiveSnapshot(svc *ec2.EC2, archiveSvc *ec2.EC2, region string, snapshot *ec2.Snapshot, archiveAccountId *string) error {
+	_, err := svc.ModifySnapshotAttribute(&ec2.ModifySnapshotAttributeInput{
+		SnapshotId: snapshot.SnapshotId,
+		CreateVolumePermission: &ec2.CreateVolumePermissionModifications{
+			Add: []*ec2.CreateVolumePermission{{UserId: archiveAccountId}},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	result, err := archiveSvc.CopySnapshot(&ec2.CopySnapshotInput{
+		SourceRegion:     awsgo.String(region),
+		SourceSnapshotId: snapshot.SnapshotId,
+		Description:      awsgo.String(fmt.Sprintf("Archived by cloud-nuke from %s", *snapshot.SnapshotId)),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: awsgo.String(ec2.ResourceTypeSnapshot),
+				Tags:         archiveTags(snapshot.Tags, *snapshot.SnapshotId),
+			},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	// The original has to stick around until the copy is complete
+	err = archiveSvc.WaitUntilSnapshotCompletedWithContext(
+		awsgo.BackgroundContext(),
+		&ec2.DescribeSnapshotsInput{SnapshotIds: []*string{result.SnapshotId}},
+		request.WithWaiterMaxAttempts(archiveCopyMaxAttempts),
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Archived Snapshot %s as %s", *snapshot.SnapshotId, *result.SnapshotId)
+	return nil
+}
+
+// archiveAMIs - Copies the AMIs matching the archive config to the archive account. Returns the ids of the AMIs that
+// are safe to nuke, i.e. the ones that were archived or didn't have to be. AMIs that failed to be archived are left
+// alone.
+func archiveAMIs(session *session.Session, config ArchiveConfig, imageIds []*string) ([]*string, error) {
+	if len(imageIds) == 0 {
+		return imageIds, nil
+	}
+
+	svc := ec2.New(session)
+	archiveSession := newArchiveSession(session, config)
+	archiveAccountId, err := getAccountId(archiveSession)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	archiveSvc := ec2.New(archiveSession)
+
+	output, err := svc.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: imageIds,
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var nukeableImageIds []*string
+	for _, image := range output.Images {
+		if !config.matches(image.Tags) {
+			nukeableImageIds = append(nukeableImageIds, image.ImageId)
+			continue
+		}
+
+		logging.Logger.Infof("Archiving AMI %s to account %s", *image.ImageId, *archiveAccountId)
+		if err := archiveAMI(svc, archiveSvc, *session.Config.Region, image, archiveAccountId); err != nil {
+			logging.Logger.Errorf("[Failed] AMI %s not archived, so it won't be nuked: %s", *image.ImageId, err)
+			continue
+		}
+		nukeableImageIds = append(nukeableImageIds, image.ImageId)
+	}
+
+	return nukeableImageIds, nil
+}
+
+// archiveAMI - Shares the AMI, and the snapshots backing it, with the archive account, and has the archive account
+// copy it
+func archiveAMI(svc *ec2.EC2, archiveSvc *ec2.EC2, region string, image *ec2.Image, archiveAccountId *string) error {
+	_, err := svc.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
+		ImageId: image.ImageId,
+		LaunchPermission: &ec2.LaunchPermissionModifications{
+			Add: []*ec2.LaunchPermission{{UserId: archiveAccountId}},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	// Copying an AMI across accounts requires access to its snapshots as well
+	for _, snapshotId := range imageSnapshotIds(image) {
+		_, err := svc.ModifySnapshotAttribute(&ec2.ModifySnapshotAttributeInput{
+			SnapshotId: snapshotId,
+			CreateVolumePermission: &ec2.CreateVolumePermissionModifications{
+				Add: []*ec2.CreateVolumePermission{{UserId: archiveAccountId}},
+			},
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	result, err := archiveSvc.CopyImage(&ec2.CopyImageInput{
+		Name:          image.Name,
+		SourceImageId: image.ImageId,
+		SourceRegion:  awsgo.String(region),
+		Description:   awsgo.String(fmt.Sprintf("Archived by cloud-nuke from %s", *image.ImageId)),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: awsgo.String(ec2.ResourceTypeImage),
+				Tags:         archiveTags(image.Tags, *image.ImageId),
+			},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	// The original has to stick around until the copy is available
+	err = archiveSvc.WaitUntilImageAvailableWithContext(
+		awsgo.BackgroundContext(),
+		&ec2.DescribeImagesInput{ImageIds: []*string{result.ImageId}},
+		request.WithWaiterMaxAttempts(archiveCopyMaxAttempts),
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Archived AMI %s as %s", *image.ImageId, *result.ImageId)
+	return nil
+}
+
+// archiveTags - Returns the tags of an archive copy: the tags of the original, along with a tag pointing back to it
+func archiveTags(tags []*ec2.Tag, sourceId string) []*ec2.Tag {
+	var archiveTags []*ec2.Tag
+	for _, tag := range tags {
+		// Tags with the aws: prefix are reserved for AWS, and can't be set
+		if strings.HasPrefix(awsgo.StringValue(tag.Key), "aws:") {
+			continue
+		}
+		archiveTags = append(archiveTags, tag)
+	}
+	return append(archiveTags, &ec2.Tag{
+		Key:   awsgo.String(archivedFromTagKey),
+		Value: awsgo.String(sourceId),
+	})
+}
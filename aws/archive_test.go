@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveConfigMatches(t *testing.T) {
+	t.Parallel()
+
+	tags := []*ec2.Tag{
+		{Key: awsgo.String("Name"), Value: awsgo.String("build-cache")},
+		{Key: awsgo.String("Archive"), Value: awsgo.String("true")},
+	}
+
+	assert.True(t, ArchiveConfig{}.matches(tags))
+	assert.True(t, ArchiveConfig{}.matches(nil))
+	assert.True(t, ArchiveConfig{TagKey: "Archive"}.matches(tags))
+	assert.True(t, ArchiveConfig{TagKey: "Archive", TagValue: "true"}.matches(tags))
+	assert.False(t, ArchiveConfig{TagKey: "Archive", TagValue: "false"}.matches(tags))
+	assert.False(t, ArchiveConfig{TagKey: "Team"}.matches(tags))
+	assert.False(t, ArchiveConfig{TagKey: "Archive"}.matches(nil))
+}
+
+func TestArchiveTags(t *testing.T) {
+	t.Parallel()
+
+	tags := archiveTags([]*ec2.Tag{
+		{Key: awsgo.String("Name"), Value: awsgo.String("build-cache")},
+		{Key: awsgo.String("aws:backup:source-resource"), Value: awsgo.String("vol-1")},
+	}, "snap-1")
+
+	assert.Equal(t, []*ec2.Tag{
+		{Key: awsgo.String("Name"), Value: awsgo.String("build-cache")},
+		{Key: awsgo.String(archivedFromTagKey), Value: awsgo.String("snap-1")},
+	}, tags)
+}
@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"time"
-
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -11,7 +9,7 @@ import (
 )
 
 // Returns a formatted string of ASG Names
-func getAllAutoScalingGroups(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllAutoScalingGroups(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
 	svc := autoscaling.New(session)
 	result, err := svc.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
 	if err != nil {
@@ -20,7 +18,7 @@ func getAllAutoScalingGroups(session *session.Session, region string, excludeAft
 
 	var groupNames []*string
 	for _, group := range result.AutoScalingGroups {
-		if excludeAfter.After(*group.CreatedTime) {
+		if timeFilter.IncludesResource(group.AutoScalingGroupName, *group.CreatedTime) {
 			groupNames = append(groupNames, group.AutoScalingGroupName)
 		}
 	}
@@ -58,14 +58,14 @@ func TestListAutoScalingGroups(t *testing.T) {
 	defer nukeAllAutoScalingGroups(session, []*string{&uniqueTestID})
 	defer nukeAllEc2Instances(session, findEC2InstancesByNameTag(t, session, uniqueTestID))
 
-	groupNames, err := getAllAutoScalingGroups(session, region, time.Now().Add(1*time.Hour*-1))
+	groupNames, err := getAllAutoScalingGroups(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Auto Scaling Groups")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(groupNames), uniqueTestID)
 
-	groupNames, err = getAllAutoScalingGroups(session, region, time.Now().Add(1*time.Hour))
+	groupNames, err = getAllAutoScalingGroups(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Auto Scaling Groups")
 	}
@@ -107,7 +107,7 @@ func TestNukeAutoScalingGroups(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	groupNames, err := getAllAutoScalingGroups(session, region, time.Now().Add(1*time.Hour))
+	groupNames, err := getAllAutoScalingGroups(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Auto Scaling Groups")
 	}
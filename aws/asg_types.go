@@ -6,6 +6,24 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: ASGroups{},
+		weight:   700,
+		actions:  []string{"autoscaling:DeleteAutoScalingGroup"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			groupNames, err := getAllAutoScalingGroups(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ASGroups{GroupNames: awsgo.StringValueSlice(groupNames)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return ASGroups{GroupNames: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // ASGroups - represents all auto scaling groups
 type ASGroups struct {
 	GroupNames []string
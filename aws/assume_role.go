@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"sync"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// roleSessionName - the name of the sessions of the roles assumed by cloud-nuke, as seen in CloudTrail
+const roleSessionName = "cloud-nuke"
+
+var assumedRoleMutex sync.Mutex
+var assumedRoleCredentials *credentials.Credentials
+
+// AssumeRole - Makes the AWS API calls made from then on with the credentials of the given role, assumed with the
+// credentials cloud-nuke was started with, e.g. to nuke several accounts in turn. An empty role ARN goes back to the
+// credentials cloud-nuke was started with.
+func AssumeRole(roleArn string) error {
+	// The role is assumed with the original credentials, not the ones of the role assumed before
+	setAssumedRoleCredentials(nil)
+	if roleArn == "" {
+		return nil
+	}
+
+	roleCredentials := stscreds.NewCredentials(newSession("us-east-1"), roleArn, func(provider *stscreds.AssumeRoleProvider) {
+		provider.RoleSessionName = roleSessionName
+	})
+	// Assume the role right away, so that a role that can't be assumed fails here rather than in the middle of a run
+	if _, err := roleCredentials.Get(); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	setAssumedRoleCredentials(roleCredentials)
+	return nil
+}
+
+func setAssumedRoleCredentials(roleCredentials *credentials.Credentials) {
+	assumedRoleMutex.Lock()
+	defer assumedRoleMutex.Unlock()
+	assumedRoleCredentials = roleCredentials
+}
+
+func getAssumedRoleCredentials() *credentials.Credentials {
+	assumedRoleMutex.Lock()
+	defer assumedRoleMutex.Unlock()
+	return assumedRoleCredentials
+}
+
+// withAssumedRole - Configures the AWS API calls made with the given config to use the credentials of the assumed
+// role, if any
+func withAssumedRole(config *awsgo.Config) *awsgo.Config {
+	if roleCredentials := getAssumedRoleCredentials(); roleCredentials != nil {
+		config.Credentials = roleCredentials
+	}
+	return config
+}
+
+// newCallerSession - Returns a session in the given region with the credentials cloud-nuke was started with, whatever
+// the role assumed, e.g. for what the whole run shares across the accounts it nukes
+func newCallerSession(region string) *session.Session {
+	return instrumentSession(session.Must(
+		session.NewSessionWithOptions(
+			session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+				Config: *withRetries(&awsgo.Config{
+					Region: awsgo.String(region),
+				}),
+			},
+		),
+	))
+}
+
+// withSessionSettings - Applies the settings shared by all the sessions of a run, i.e. the retries and the assumed
+// role, to the config of a new session
+func withSessionSettings(config *awsgo.Config) *awsgo.Config {
+	return withAssumedRole(withRetries(config))
+}
@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSessionSettings(t *testing.T) {
+	config := withSessionSettings(&awsgo.Config{Region: awsgo.String("us-east-1")})
+	assert.Nil(t, config.Credentials)
+	assert.NotNil(t, config.Retryer)
+
+	roleCredentials := credentials.NewStaticCredentials("id", "secret", "token")
+	setAssumedRoleCredentials(roleCredentials)
+	defer setAssumedRoleCredentials(nil)
+
+	config = withSessionSettings(&awsgo.Config{Region: awsgo.String("us-east-1")})
+	assert.Equal(t, roleCredentials, config.Credentials)
+	assert.NotNil(t, config.Retryer)
+
+	// Going back to the original credentials doesn't need to assume anything
+	assert.NoError(t, AssumeRole(""))
+	assert.Nil(t, getAssumedRoleCredentials())
+}
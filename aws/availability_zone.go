@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"regexp"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// The region an availability zone belongs to is the start of its name, e.g. us-east-1 for us-east-1a, and for local
+// zones such as us-west-2-lax-1a
+var availabilityZoneRegionRegexp = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-\d+`)
+
+// AZScopedResourceTypes - Returns the resource types whose resources live in a single availability zone. They are the
+// only resource types nuked when nuking is limited to some availability zones.
+func AZScopedResourceTypes() []string {
+	return []string{
+		EC2Instances{}.ResourceName(),
+		EBSVolumes{}.ResourceName(),
+	}
+}
+
+// RegionOfAvailabilityZone - Returns the region the availability zone belongs to, or an empty string when the name
+// isn't the one of an availability zone
+func RegionOfAvailabilityZone(availabilityZone string) string {
+	region := availabilityZoneRegionRegexp.FindString(availabilityZone)
+	if region == availabilityZone {
+		return ""
+	}
+	return region
+}
+
+// availabilityZoneFilter - Returns an EC2 filter matching the resources in the given availability zones
+func availabilityZoneFilter(availabilityZones []string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   awsgo.String("availability-zone"),
+		Values: awsgo.StringSlice(availabilityZones),
+	}
+}
@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionOfAvailabilityZone(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-east-1", RegionOfAvailabilityZone("us-east-1a"))
+	assert.Equal(t, "ap-southeast-2", RegionOfAvailabilityZone("ap-southeast-2c"))
+	assert.Equal(t, "us-gov-west-1", RegionOfAvailabilityZone("us-gov-west-1b"))
+	assert.Equal(t, "us-west-2", RegionOfAvailabilityZone("us-west-2-lax-1a"))
+	assert.Equal(t, "", RegionOfAvailabilityZone("us-east-1"))
+	assert.Equal(t, "", RegionOfAvailabilityZone("use1-az1"))
+}
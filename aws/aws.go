@@ -1,16 +1,19 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"sort"
-	"strings"
+	"sync"
 	"time"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/progress"
 	"github.com/gruntwork-io/gruntwork-cli/collections"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
@@ -38,16 +41,16 @@ var OptInNotRequiredRegions = [...]string{
 }
 
 func newSession(region string) *session.Session {
-	return session.Must(
+	return instrumentSession(session.Must(
 		session.NewSessionWithOptions(
 			session.Options{
 				SharedConfigState: session.SharedConfigEnable,
-				Config: awsgo.Config{
+				Config: *withSessionSettings(&awsgo.Config{
 					Region: awsgo.String(region),
-				},
+				}),
 			},
 		),
-	)
+	))
 }
 
 // Try a describe regions command with the most likely enabled regions
@@ -86,6 +89,17 @@ func GetEnabledRegions() ([]string, error) {
 	return regionNames, nil
 }
 
+// Returns the id of the account the session's credentials belong to
+func getAccountId(session *session.Session) (*string, error) {
+	svc := sts.New(session)
+
+	result, err := svc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return result.Account, nil
+}
+
 func getRandomRegion() (string, error) {
 	allRegions, err := GetEnabledRegions()
 	if err != nil {
@@ -117,196 +131,150 @@ func split(identifiers []string, limit int) [][]string {
 	return chunks
 }
 
-// GetAllResources - Lists all aws resources
-func GetAllResources(regions []string, excludedRegions []string, excludeAfter time.Time, resourceTypes []string) (*AwsAccountResources, error) {
+// GetAllResources - Lists all aws resources. The options change how some of them are nuked. Once the context is
+// cancelled, no more AWS API calls are made and an InterruptedError is returned.
+func GetAllResources(ctx context.Context, regions []string, excludedRegions []string, timeFilter TimeFilter, resourceTypes []string, excludeResourceTypes []string, options ResourceOptions) (*AwsAccountResources, error) {
 	account := AwsAccountResources{
 		Resources: make(map[string]AwsRegionResource),
 	}
 
+	// The QuickSight subscription belongs to the whole account, so it is only nuked in one of the regions it's found in
+	quickSightSubscriptionClaim := &accountWideClaim{}
+	// So is the organization, whichever region its resources are looked for in
+	organizationClaim := &accountWideClaim{}
+
+	var discoveredRegions []string
 	for _, region := range regions {
 		// Ignore all cli excluded regions
 		if collections.ListContainsElement(excludedRegions, region) {
 			logging.Logger.Infoln("Skipping region: " + region)
 			continue
 		}
-		logging.Logger.Infoln("Checking region: " + region)
-
-		session, err := session.NewSession(&awsgo.Config{
-			Region: awsgo.String(region)},
-		)
-
-		if err != nil {
-			return nil, errors.WithStackTrace(err)
-		}
-
-		resourcesInRegion := AwsRegionResource{}
-
-		// The order in which resources are nuked is important
-		// because of dependencies between resources
+		discoveredRegions = append(discoveredRegions, region)
+	}
 
-		// ASG Names
-		asGroups := ASGroups{}
-		if IsNukeable(asGroups.ResourceName(), resourceTypes) {
-			groupNames, err := getAllAutoScalingGroups(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			asGroups.GroupNames = awsgo.StringValueSlice(groupNames)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, asGroups)
+	// Regions are independent from each other, so several of them can be discovered at once
+	concurrency := options.RegionConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	resourcesPerRegion := make([]AwsRegionResource, len(discoveredRegions))
+	var failure regionFailure
+	var waitGroup sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i, region := range discoveredRegions {
+		slots <- struct{}{}
+		// Don't start discovering more regions once one of them failed, or once interrupted
+		if failure.get() != nil || ctx.Err() != nil {
+			<-slots
+			break
 		}
-		// End ASG Names
-
-		// Launch Configuration Names
-		configs := LaunchConfigs{}
-		if IsNukeable(configs.ResourceName(), resourceTypes) {
-			configNames, err := getAllLaunchConfigurations(session, region, excludeAfter)
+		waitGroup.Add(1)
+		go func(i int, region string) {
+			defer waitGroup.Done()
+			defer func() { <-slots }()
+			resourcesInRegion, err := getAllRegionResources(ctx, region, timeFilter, resourceTypes, excludeResourceTypes, options, quickSightSubscriptionClaim, organizationClaim)
 			if err != nil {
-				return nil, errors.WithStackTrace(err)
+				failure.set(err)
+				return
 			}
-			configs.LaunchConfigurationNames = awsgo.StringValueSlice(configNames)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, configs)
-		}
-		// End Launch Configuration Names
+			resourcesPerRegion[i] = resourcesInRegion
+		}(i, region)
+	}
+	waitGroup.Wait()
+	// The calls of the regions still being discovered fail once interrupted, which isn't worth reporting
+	if ctx.Err() != nil {
+		return nil, interrupted(ctx)
+	}
+	if err := failure.get(); err != nil {
+		return nil, err
+	}
 
-		// LoadBalancer Names
-		loadBalancers := LoadBalancers{}
-		if IsNukeable(loadBalancers.ResourceName(), resourceTypes) {
-			elbNames, err := getAllElbInstances(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			loadBalancers.Names = awsgo.StringValueSlice(elbNames)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, loadBalancers)
+	for i, region := range discoveredRegions {
+		if len(resourcesPerRegion[i].Resources) > 0 {
+			account.Resources[region] = resourcesPerRegion[i]
 		}
-		// End LoadBalancer Names
+	}
 
-		// LoadBalancerV2 Arns
-		loadBalancersV2 := LoadBalancersV2{}
-		if IsNukeable(loadBalancersV2.ResourceName(), resourceTypes) {
-			elbv2Arns, err := getAllElbv2Instances(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
+	return &account, nil
+}
 
-			loadBalancersV2.Arns = awsgo.StringValueSlice(elbv2Arns)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, loadBalancersV2)
-		}
-		// End LoadBalancerV2 Arns
+// getAllRegionResources - Returns the resources to nuke in a single region. The QuickSight subscription and the
+// resources of the organization are only returned when they haven't been claimed by another region yet.
+func getAllRegionResources(ctx context.Context, region string, timeFilter TimeFilter, resourceTypes []string, excludeResourceTypes []string, options ResourceOptions, quickSightSubscriptionClaim *accountWideClaim, organizationClaim *accountWideClaim) (AwsRegionResource, error) {
+	logging.Logger.Infoln("Checking region: " + region)
 
-		// EC2 Instances
-		ec2Instances := EC2Instances{}
-		if IsNukeable(ec2Instances.ResourceName(), resourceTypes) {
-			instanceIds, err := getAllEc2Instances(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			ec2Instances.InstanceIds = awsgo.StringValueSlice(instanceIds)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, ec2Instances)
-		}
-		// End EC2 Instances
-
-		// EBS Volumes
-		ebsVolumes := EBSVolumes{}
-		if IsNukeable(ebsVolumes.ResourceName(), resourceTypes) {
-			volumeIds, err := getAllEbsVolumes(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			ebsVolumes.VolumeIds = awsgo.StringValueSlice(volumeIds)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, ebsVolumes)
-		}
-		// End EBS Volumes
+	session, err := session.NewSession(withSessionSettings(&awsgo.Config{
+		Region: awsgo.String(region)},
+	))
 
-		// EIP Addresses
-		eipAddresses := EIPAddresses{}
-		if IsNukeable(eipAddresses.ResourceName(), resourceTypes) {
-			allocationIds, err := getAllEIPAddresses(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			eipAddresses.AllocationIds = awsgo.StringValueSlice(allocationIds)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, eipAddresses)
-		}
-		// End EIP Addresses
+	if err != nil {
+		return AwsRegionResource{}, errors.WithStackTrace(err)
+	}
+	instrumentSession(session)
+	withContext(session, ctx)
+
+	resourcesInRegion := AwsRegionResource{}
+	var skipped []SkippedResource
+
+	// Record the creation times of the resources found in the region, so they can be shown along with them
+	timeFilter = timeFilter.recordingCreationTimes()
+
+	// Record how long discovering each resource type took, for the timing table logged once the run is over
+	clock := newDiscoveryClock()
+
+	discovery := regionDiscovery{
+		session:                     session,
+		region:                      region,
+		timeFilter:                  timeFilter,
+		resourceTypes:               resourceTypes,
+		excludeResourceTypes:        excludeResourceTypes,
+		options:                     options,
+		quickSightSubscriptionClaim: quickSightSubscriptionClaim,
+		organizationClaim:           organizationClaim,
+	}
 
-		// AMIs
-		amis := AMIs{}
-		if IsNukeable(amis.ResourceName(), resourceTypes) {
-			imageIds, err := getAllAMIs(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			amis.ImageIds = awsgo.StringValueSlice(imageIds)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, amis)
+	// The order in which resources are nuked is important because of dependencies between resources, so they are
+	// discovered in that order
+	for _, resourceType := range resourceTypesInNukeOrder() {
+		if !resourceType.isSelected(resourceTypes, excludeResourceTypes) {
+			continue
 		}
-		// End AMIs
 
-		// Snapshots
-		snapshots := Snapshots{}
-		if IsNukeable(snapshots.ResourceName(), resourceTypes) {
-			snapshotIds, err := getAllSnapshots(session, region, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			snapshots.SnapshotIds = awsgo.StringValueSlice(snapshotIds)
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, snapshots)
+		resources, err := resourceType.getAll(discovery)
+		if err != nil {
+			return AwsRegionResource{}, errors.WithStackTrace(err)
 		}
-		// End Snapshots
-
-		// ECS resources
-		ecsServices := ECSServices{}
-		if IsNukeable(ecsServices.ResourceName(), resourceTypes) {
-			clusterArns, err := getAllEcsClusters(session)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			serviceArns, serviceClusterMap, err := getAllEcsServices(session, clusterArns, excludeAfter)
-			if err != nil {
-				return nil, errors.WithStackTrace(err)
-			}
-			ecsServices.Services = awsgo.StringValueSlice(serviceArns)
-			ecsServices.ServiceClusterMap = serviceClusterMap
-			resourcesInRegion.Resources = append(resourcesInRegion.Resources, ecsServices)
+		// Lapped even when the resource type isn't available in the region, so that the time spent finding that out
+		// isn't charged to the next resource type
+		clock.lap(resourceType.resource.ResourceName())
+		// The resource type isn't available in the region
+		if resources == nil {
+			continue
 		}
-		// End ECS resources
-
-		// EKS resources
-		eksClusters := EKSClusters{}
-		if IsNukeable(eksClusters.ResourceName(), resourceTypes) {
-			if eksSupportedRegion(region) {
-				eksClusterNames, err := getAllEksClusters(session, excludeAfter)
-				if err != nil {
-					return nil, errors.WithStackTrace(err)
-				}
 
-				eksClusters.Clusters = awsgo.StringValueSlice(eksClusterNames)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, eksClusters)
-			}
+		resourcesInRegion.Resources = append(resourcesInRegion.Resources, resources)
+		if skipping, isSkipping := resources.(skippingAwsResources); isSkipping {
+			skipped = append(skipped, skipping.SkippedResources()...)
 		}
-		// End EKS resources
+	}
 
-		if len(resourcesInRegion.Resources) > 0 {
-			account.Resources[region] = resourcesInRegion
-		}
+	resourcesInRegion, err = applyDefaultExclusions(session, resourcesInRegion, resourceTypes, defaultExclusions)
+	if err != nil {
+		return AwsRegionResource{}, errors.WithStackTrace(err)
 	}
+	resourcesInRegion.Skipped = skipped
+	resourcesInRegion.CreationTimes = timeFilter.creationTimes
+	resourcesInRegion.DiscoveryTimes = clock.times
 
-	return &account, nil
+	return resourcesInRegion, nil
 }
 
 // ListResourceTypes - Returns list of resources which can be passed to --resource-type
 func ListResourceTypes() []string {
-	resourceTypes := []string{
-		ASGroups{}.ResourceName(),
-		LaunchConfigs{}.ResourceName(),
-		LoadBalancers{}.ResourceName(),
-		LoadBalancersV2{}.ResourceName(),
-		EC2Instances{}.ResourceName(),
-		EBSVolumes{}.ResourceName(),
-		EIPAddresses{}.ResourceName(),
-		AMIs{}.ResourceName(),
-		Snapshots{}.ResourceName(),
-		ECSServices{}.ResourceName(),
-		EKSClusters{}.ResourceName(),
+	var resourceTypes []string
+	for _, resourceType := range registeredResourceTypes {
+		resourceTypes = append(resourceTypes, resourceType.resource.ResourceName())
 	}
 	sort.Strings(resourceTypes)
 	return resourceTypes
@@ -317,8 +285,12 @@ func IsValidResourceType(resourceType string, allResourceTypes []string) bool {
 	return collections.ListContainsElement(allResourceTypes, resourceType)
 }
 
-// IsNukeable - Checks if we should nuke a resource or not
-func IsNukeable(resourceType string, resourceTypes []string) bool {
+// IsNukeable - Checks if we should nuke a resource or not. Resource types in excludeResourceTypes are never nuked,
+// even when they are selected in resourceTypes or with "all".
+func IsNukeable(resourceType string, resourceTypes []string, excludeResourceTypes []string) bool {
+	if collections.ListContainsElement(excludeResourceTypes, resourceType) {
+		return false
+	}
 	if len(resourceTypes) == 0 ||
 		collections.ListContainsElement(resourceTypes, "all") ||
 		collections.ListContainsElement(resourceTypes, resourceType) {
@@ -327,45 +299,119 @@ func IsNukeable(resourceType string, resourceTypes []string) bool {
 	return false
 }
 
-// NukeAllResources - Nukes all aws resources
-func NukeAllResources(account *AwsAccountResources, regions []string) error {
+// IsExplicitlyNukeable - Checks if a resource that is too destructive to be nuked by default has been explicitly
+// selected with --resource-type
+func IsExplicitlyNukeable(resourceType string, resourceTypes []string) bool {
+	return collections.ListContainsElement(resourceTypes, resourceType)
+}
+
+// NukeAllResources - Nukes all aws resources. When wait is set, the batches of the resource types that delete their
+// resources asynchronously are only done once the resources are actually gone, and fail when they don't get there. The
+// resource types running out of time are given up on, and reported in the error returned once all the others are
+// nuked, and so are the resource types failing under a failure policy other than abort-run. Once the context is
+// cancelled, no more AWS API calls are made, the resources left are left alone, and an InterruptedError is returned.
+func NukeAllResources(ctx context.Context, account *AwsAccountResources, regions []string, wait bool, timeouts NukeTimeouts, policies FailurePolicies, reporter *progress.Reporter) error {
+	total := 0
+	for _, region := range regions {
+		for _, resources := range account.Resources[region].Resources {
+			total += len(resources.ResourceIdentifiers())
+		}
+	}
+	reporter.Start(total)
+
+	var timedOutResourceTypes []string
+	var failedResourceTypes []string
 	for _, region := range regions {
-		session, err := session.NewSession(&awsgo.Config{
+		session, err := session.NewSession(withSessionSettings(&awsgo.Config{
 			Region: awsgo.String(region)},
-		)
+		))
 
 		if err != nil {
 			return errors.WithStackTrace(err)
 		}
+		// The session is bound to the context batch by batch, by nukeBefore, so that each batch can be cancelled on its own
+		instrumentSession(session)
 
 		resourcesInRegion := account.Resources[region]
 		for _, resources := range resourcesInRegion.Resources {
+			if ctx.Err() != nil {
+				return interrupted(ctx)
+			}
 			length := len(resources.ResourceIdentifiers())
+			typeSession := withResourceType(session, resources.ResourceName())
 
 			// Split api calls into batches
 			logging.Logger.Infof("Terminating %d resources in batches", length)
 			batches := split(resources.ResourceIdentifiers(), resources.MaxBatchSize())
 
+			var deadline time.Time
+			if timeout := timeouts.For(resources.ResourceName()); timeout > 0 {
+				deadline = time.Now().Add(timeout)
+			}
+
 			for i := 0; i < len(batches); i++ {
 				batch := batches[i]
-				if err := resources.Nuke(session, batch); err != nil {
-					// TODO: Figure out actual error type
-					if strings.Contains(err.Error(), "RequestLimitExceeded") {
-						logging.Logger.Info("Request limit reached. Waiting 1 minute before making new requests")
-						time.Sleep(1 * time.Minute)
-						continue
+				// Throttled calls are retried with backoff by the session, so any error left is final
+				err := nukeBefore(ctx, resources, typeSession, batch, region, deadline, wait)
+				if timeoutErr, isTimeout := err.(ResourceTypeTimeoutError); isTimeout {
+					// Give up on the batch and the ones left, and move on to the next resource type
+					logging.Logger.Errorf("[Failed] %s", timeoutErr)
+					for _, remainingBatch := range batches[i:] {
+						reporter.AdvanceBatch(resources.ResourceName(), region, remainingBatch, true)
 					}
-
-					return errors.WithStackTrace(err)
+					timedOutResourceTypes = append(timedOutResourceTypes, fmt.Sprintf("%s in %s", resources.ResourceName(), region))
+					break
+				}
+				if _, isInterrupted := err.(InterruptedError); isInterrupted {
+					// Which resources of the batch are gone is unknown
+					reporter.AdvanceBatch(resources.ResourceName(), region, batch, true)
+					return err
+				}
+				if err != nil {
+					// Which resources of the batch are gone is unknown
+					reporter.AdvanceBatch(resources.ResourceName(), region, batch, true)
+					policy := policies.For(resources.ResourceName())
+					if policy == FailurePolicyAbortRun {
+						return errors.WithStackTrace(err)
+					}
+					logging.Logger.Errorf("[Failed] %s", err)
+					failedResourceType := fmt.Sprintf("%s in %s", resources.ResourceName(), region)
+					if !collections.ListContainsElement(failedResourceTypes, failedResourceType) {
+						failedResourceTypes = append(failedResourceTypes, failedResourceType)
+					}
+					if policy == FailurePolicyAbortType {
+						// Give up on the batches left, and move on to the next resource type
+						for _, remainingBatch := range batches[i+1:] {
+							reporter.AdvanceBatch(resources.ResourceName(), region, remainingBatch, true)
+						}
+						break
+					}
+				} else {
+					reporter.AdvanceBatch(resources.ResourceName(), region, batch, false)
 				}
 
 				if i != len(batches)-1 {
 					logging.Logger.Info("Sleeping for 10 seconds before processing next batch...")
-					time.Sleep(10 * time.Second)
+					select {
+					case <-time.After(10 * time.Second):
+					case <-ctx.Done():
+						return interrupted(ctx)
+					}
 				}
 			}
 		}
 	}
 
+	if !wait {
+		logAsyncResourceTypes(account)
+	}
+
+	// The resource types that timed out were logged as failed, so only the failures are reported when there are both
+	if len(failedResourceTypes) > 0 {
+		return NukeFailedError{ResourceTypes: failedResourceTypes}
+	}
+	if len(timedOutResourceTypes) > 0 {
+		return NukeTimedOutError{ResourceTypes: timedOutResourceTypes}
+	}
 	return nil
 }
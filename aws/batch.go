@@ -0,0 +1,300 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How often, and how many times, to check whether a job queue or compute environment is done transitioning from one
+// state to the next. Batch rejects the updates and deletions of the ones still transitioning. Gives up after half an
+// hour, as deleting a compute environment terminates its instances.
+var batchPollInterval = 10 * time.Second
+
+const batchTransitionMaxAttempts = 180
+
+// getBatchFirstSeenTime returns the time cloud-nuke first saw the job queue or compute environment, tagging it with
+// the current time if it hasn't been seen before. We need this because neither of them contains an attribute that
+// gives us their creation time.
+func getBatchFirstSeenTime(svc *batch.Batch, resourceArn *string, tags map[string]*string) (*time.Time, error) {
+	if firstSeen, isTagged := tags[firstSeenTagKey]; isTagged {
+		firstSeenTime, err := time.Parse(firstSeenTagLayout, awsgo.StringValue(firstSeen))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		return &firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&batch.TagResourceInput{
+		ResourceArn: resourceArn,
+		Tags:        map[string]*string{firstSeenTagKey: awsgo.String(now.Format(firstSeenTagLayout))},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &now, nil
+}
+
+// isBatchTransitionDone - Checks whether a job queue or compute environment with the given status is done
+// transitioning, and so can be updated or deleted
+func isBatchTransitionDone(status string) bool {
+	// Job queues and compute environments share their statuses
+	return status == batch.JQStatusValid || status == batch.JQStatusInvalid
+}
+
+// waitForBatchTransition - Polls until done returns true, e.g. until a job queue is disabled
+func waitForBatchTransition(identifier string, done func() (bool, error)) error {
+	for attempt := 0; attempt < batchTransitionMaxAttempts; attempt++ {
+		isDone, err := done()
+		if err != nil {
+			return err
+		}
+		if isDone {
+			return nil
+		}
+		time.Sleep(batchPollInterval)
+	}
+	return BatchTransitionTimeoutError{Identifier: identifier}
+}
+
+// Returns the ARNs of all Batch job queues first seen before the cutoff
+func getAllBatchJobQueues(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := batch.New(session)
+
+	var jobQueues []*batch.JobQueueDetail
+	err := svc.DescribeJobQueuesPages(
+		&batch.DescribeJobQueuesInput{},
+		func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
+			jobQueues = append(jobQueues, page.JobQueues...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, jobQueue := range jobQueues {
+		if awsgo.StringValue(jobQueue.Status) == batch.JQStatusDeleting {
+			continue
+		}
+		firstSeenTime, err := getBatchFirstSeenTime(svc, jobQueue.JobQueueArn, jobQueue.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if timeFilter.IncludesFirstSeenResource(jobQueue.JobQueueArn, *firstSeenTime) {
+			arns = append(arns, jobQueue.JobQueueArn)
+		}
+	}
+
+	return arns, nil
+}
+
+// describeBatchJobQueue - Returns the job queue with the given ARN, or nil when it's gone
+func describeBatchJobQueue(svc *batch.Batch, arn *string) (*batch.JobQueueDetail, error) {
+	result, err := svc.DescribeJobQueues(&batch.DescribeJobQueuesInput{JobQueues: []*string{arn}})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	for _, jobQueue := range result.JobQueues {
+		if awsgo.StringValue(jobQueue.Status) != batch.JQStatusDeleted {
+			return jobQueue, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForBatchJobQueueTransition - Waits for the job queue to be done transitioning. Returns whether it still exists.
+func waitForBatchJobQueueTransition(svc *batch.Batch, arn *string) (bool, error) {
+	exists := true
+	err := waitForBatchTransition(*arn, func() (bool, error) {
+		jobQueue, err := describeBatchJobQueue(svc, arn)
+		if err != nil || jobQueue == nil {
+			exists = false
+			return true, err
+		}
+		return isBatchTransitionDone(awsgo.StringValue(jobQueue.Status)), nil
+	})
+	return exists, err
+}
+
+// disableAndDeleteBatchJobQueue - Disables the job queue, which has to be done before deleting it, and deletes it.
+// The jobs left in the queue are terminated along with it.
+func disableAndDeleteBatchJobQueue(svc *batch.Batch, arn *string) error {
+	exists, err := waitForBatchJobQueueTransition(svc, arn)
+	if err != nil || !exists {
+		return err
+	}
+
+	_, err = svc.UpdateJobQueue(&batch.UpdateJobQueueInput{
+		JobQueue: arn,
+		State:    awsgo.String(batch.JQStateDisabled),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	logging.Logger.Infof("...disabled Batch job queue %s", *arn)
+
+	if exists, err = waitForBatchJobQueueTransition(svc, arn); err != nil || !exists {
+		return err
+	}
+
+	_, err = svc.DeleteJobQueue(&batch.DeleteJobQueueInput{JobQueue: arn})
+	return errors.WithStackTrace(err)
+}
+
+// Disables and deletes all the given Batch job queues, and waits for them to be gone, so that the compute
+// environments they use can be deleted next
+func nukeAllBatchJobQueues(session *session.Session, arns []*string) error {
+	svc := batch.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Batch job queues to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Batch job queues in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		if err := disableAndDeleteBatchJobQueue(svc, arn); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+		deletedArns = append(deletedArns, arn)
+	}
+
+	for _, arn := range deletedArns {
+		if _, err := waitForBatchJobQueueTransition(svc, arn); err != nil {
+			return err
+		}
+		logging.Logger.Infof("Deleted Batch job queue: %s", *arn)
+	}
+
+	logging.Logger.Infof("[OK] %d Batch job queue(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
+
+// Returns the ARNs of all Batch compute environments first seen before the cutoff
+func getAllBatchComputeEnvironments(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := batch.New(session)
+
+	var computeEnvironments []*batch.ComputeEnvironmentDetail
+	err := svc.DescribeComputeEnvironmentsPages(
+		&batch.DescribeComputeEnvironmentsInput{},
+		func(page *batch.DescribeComputeEnvironmentsOutput, lastPage bool) bool {
+			computeEnvironments = append(computeEnvironments, page.ComputeEnvironments...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, computeEnvironment := range computeEnvironments {
+		if awsgo.StringValue(computeEnvironment.Status) == batch.CEStatusDeleting {
+			continue
+		}
+		firstSeenTime, err := getBatchFirstSeenTime(svc, computeEnvironment.ComputeEnvironmentArn, computeEnvironment.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if timeFilter.IncludesFirstSeenResource(computeEnvironment.ComputeEnvironmentArn, *firstSeenTime) {
+			arns = append(arns, computeEnvironment.ComputeEnvironmentArn)
+		}
+	}
+
+	return arns, nil
+}
+
+// describeBatchComputeEnvironment - Returns the compute environment with the given ARN, or nil when it's gone
+func describeBatchComputeEnvironment(svc *batch.Batch, arn *string) (*batch.ComputeEnvironmentDetail, error) {
+	result, err := svc.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{ComputeEnvironments: []*string{arn}})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	for _, computeEnvironment := range result.ComputeEnvironments {
+		if awsgo.StringValue(computeEnvironment.Status) != batch.CEStatusDeleted {
+			return computeEnvironment, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForBatchComputeEnvironmentTransition - Waits for the compute environment to be done transitioning. Returns
+// whether it still exists.
+func waitForBatchComputeEnvironmentTransition(svc *batch.Batch, arn *string) (bool, error) {
+	exists := true
+	err := waitForBatchTransition(*arn, func() (bool, error) {
+		computeEnvironment, err := describeBatchComputeEnvironment(svc, arn)
+		if err != nil || computeEnvironment == nil {
+			exists = false
+			return true, err
+		}
+		return isBatchTransitionDone(awsgo.StringValue(computeEnvironment.Status)), nil
+	})
+	return exists, err
+}
+
+// disableAndDeleteBatchComputeEnvironment - Disables the compute environment, which has to be done before deleting
+// it, and deletes it
+func disableAndDeleteBatchComputeEnvironment(svc *batch.Batch, arn *string) error {
+	exists, err := waitForBatchComputeEnvironmentTransition(svc, arn)
+	if err != nil || !exists {
+		return err
+	}
+
+	_, err = svc.UpdateComputeEnvironment(&batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: arn,
+		State:              awsgo.String(batch.CEStateDisabled),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	logging.Logger.Infof("...disabled Batch compute environment %s", *arn)
+
+	if exists, err = waitForBatchComputeEnvironmentTransition(svc, arn); err != nil || !exists {
+		return err
+	}
+
+	_, err = svc.DeleteComputeEnvironment(&batch.DeleteComputeEnvironmentInput{ComputeEnvironment: arn})
+	return errors.WithStackTrace(err)
+}
+
+// Disables and deletes all the given Batch compute environments, and waits for them to be gone, so that the
+// instances they launched no longer hold on to their subnets and security groups
+func nukeAllBatchComputeEnvironments(session *session.Session, arns []*string) error {
+	svc := batch.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Batch compute environments to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Batch compute environments in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		if err := disableAndDeleteBatchComputeEnvironment(svc, arn); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+		deletedArns = append(deletedArns, arn)
+	}
+
+	for _, arn := range deletedArns {
+		if _, err := waitForBatchComputeEnvironmentTransition(svc, arn); err != nil {
+			return err
+		}
+		logging.Logger.Infof("Deleted Batch compute environment: %s", *arn)
+	}
+
+	logging.Logger.Infof("[OK] %d Batch compute environment(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBatchTransitionDone(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isBatchTransitionDone(batch.JQStatusValid))
+	assert.True(t, isBatchTransitionDone(batch.CEStatusInvalid))
+	assert.False(t, isBatchTransitionDone(batch.JQStatusUpdating))
+	assert.False(t, isBatchTransitionDone(batch.CEStatusCreating))
+	assert.False(t, isBatchTransitionDone(batch.JQStatusDeleting))
+}
+
+func TestWaitForBatchTransition(t *testing.T) {
+	batchPollInterval = 0
+
+	attempts := 0
+	err := waitForBatchTransition("arn:aws:batch:us-east-1:123456789012:job-queue/nightly", func() (bool, error) {
+		attempts++
+		return attempts == 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	err = waitForBatchTransition("arn:aws:batch:us-east-1:123456789012:job-queue/nightly", func() (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, BatchTransitionTimeoutError{Identifier: "arn:aws:batch:us-east-1:123456789012:job-queue/nightly"}, err)
+}
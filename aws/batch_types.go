@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before compute environments, which can't be deleted while job queues use them
+	registerResourceType(awsResourceType{
+		resource: BatchJobQueues{},
+		weight:   300,
+		actions:  []string{"batch:TagResource", "batch:UpdateJobQueue", "batch:DeleteJobQueue"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			arns, err := getAllBatchJobQueues(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return BatchJobQueues{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+
+	// Nuked before ASGs and EC2 instances, since deleting a compute environment also terminates its instances
+	registerResourceType(awsResourceType{
+		resource: BatchComputeEnvironments{},
+		weight:   400,
+		actions:  []string{"batch:TagResource", "batch:UpdateComputeEnvironment", "batch:DeleteComputeEnvironment"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			arns, err := getAllBatchComputeEnvironments(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return BatchComputeEnvironments{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+}
+
+// BatchJobQueues - represents all Batch job queues
+type BatchJobQueues struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (jobQueues BatchJobQueues) ResourceName() string {
+	return "batchjobqueue"
+}
+
+// ResourceIdentifiers - The ARNs of the job queues
+func (jobQueues BatchJobQueues) ResourceIdentifiers() []string {
+	return jobQueues.Arns
+}
+
+func (jobQueues BatchJobQueues) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (jobQueues BatchJobQueues) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBatchJobQueues(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// BatchComputeEnvironments - represents all Batch compute environments
+type BatchComputeEnvironments struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (computeEnvironments BatchComputeEnvironments) ResourceName() string {
+	return "batchcomputeenvironment"
+}
+
+// ResourceIdentifiers - The ARNs of the compute environments
+func (computeEnvironments BatchComputeEnvironments) ResourceIdentifiers() []string {
+	return computeEnvironments.Arns
+}
+
+func (computeEnvironments BatchComputeEnvironments) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (computeEnvironments BatchComputeEnvironments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBatchComputeEnvironments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// BatchTransitionTimeoutError - returned when a job queue or compute environment is still transitioning from one
+// state to the next after waiting for it
+type BatchTransitionTimeoutError struct {
+	Identifier string
+}
+
+func (e BatchTransitionTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for %s to be done transitioning", e.Identifier)
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Bedrock. Refer to
+// https://docs.aws.amazon.com/general/latest/gr/bedrock.html
+var bedrockRegions = []string{
+	"ap-northeast-1",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"sa-east-1",
+	"us-east-1",
+	"us-west-2",
+}
+
+// bedrockSupportedRegion returns true if the provided region supports Bedrock
+func bedrockSupportedRegion(region string) bool {
+	return collections.ListContainsElement(bedrockRegions, region)
+}
+
+// Returns a formatted string of Bedrock provisioned model throughput ARNs
+func getAllBedrockProvisionedThroughputs(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := bedrock.New(session)
+
+	var arns []*string
+	err := svc.ListProvisionedModelThroughputsPages(
+		&bedrock.ListProvisionedModelThroughputsInput{},
+		func(page *bedrock.ListProvisionedModelThroughputsOutput, lastPage bool) bool {
+			for _, summary := range page.ProvisionedModelSummaries {
+				if timeFilter.IncludesResource(summary.ProvisionedModelArn, *summary.CreationTime) {
+					arns = append(arns, summary.ProvisionedModelArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return arns, nil
+}
+
+// Deletes all Bedrock provisioned model throughputs
+func nukeAllBedrockProvisionedThroughputs(session *session.Session, arns []*string) error {
+	svc := bedrock.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Bedrock provisioned throughputs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Bedrock provisioned throughputs in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		_, err := svc.DeleteProvisionedModelThroughput(&bedrock.DeleteProvisionedModelThroughputInput{
+			ProvisionedModelId: arn,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == bedrock.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Bedrock provisioned throughput %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Infof("Deleted Bedrock provisioned throughput: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Bedrock provisioned throughput(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of the ARNs of Bedrock model customization (fine-tuning) jobs that are still in progress.
+// Finished jobs can't be deleted and don't incur any cost, so they are left alone.
+func getAllBedrockCustomizationJobs(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := bedrock.New(session)
+
+	var arns []*string
+	err := svc.ListModelCustomizationJobsPages(
+		&bedrock.ListModelCustomizationJobsInput{
+			StatusEquals: awsgo.String(bedrock.FineTuningJobStatusInProgress),
+		},
+		func(page *bedrock.ListModelCustomizationJobsOutput, lastPage bool) bool {
+			for _, summary := range page.ModelCustomizationJobSummaries {
+				if timeFilter.IncludesResource(summary.JobArn, *summary.CreationTime) {
+					arns = append(arns, summary.JobArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return arns, nil
+}
+
+// Stops all in progress Bedrock model customization jobs
+func nukeAllBedrockCustomizationJobs(session *session.Session, arns []*string) error {
+	svc := bedrock.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Bedrock model customization jobs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Stopping all Bedrock model customization jobs in region %s", *session.Config.Region)
+	var stoppedArns []*string
+
+	for _, arn := range arns {
+		_, err := svc.StopModelCustomizationJob(&bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: arn,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == bedrock.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Bedrock model customization job %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			stoppedArns = append(stoppedArns, arn)
+			logging.Logger.Infof("Stopped Bedrock model customization job: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Bedrock model customization job(s) stopped in %s", len(stoppedArns), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of Bedrock custom model ARNs
+func getAllBedrockCustomModels(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := bedrock.New(session)
+
+	var arns []*string
+	err := svc.ListCustomModelsPages(
+		&bedrock.ListCustomModelsInput{},
+		func(page *bedrock.ListCustomModelsOutput, lastPage bool) bool {
+			for _, summary := range page.ModelSummaries {
+				if timeFilter.IncludesResource(summary.ModelArn, *summary.CreationTime) {
+					arns = append(arns, summary.ModelArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return arns, nil
+}
+
+// Deletes all Bedrock custom models
+func nukeAllBedrockCustomModels(session *session.Session, arns []*string) error {
+	svc := bedrock.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Bedrock custom models to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Bedrock custom models in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		_, err := svc.DeleteCustomModel(&bedrock.DeleteCustomModelInput{
+			ModelIdentifier: arn,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == bedrock.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Bedrock custom model %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Infof("Deleted Bedrock custom model: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Bedrock custom model(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,22 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Provisioned throughputs are billed by commitment and custom models require a fine-tuning job that takes hours, so
+// only the region lookup is tested here
+func TestBedrockSupportedRegions(t *testing.T) {
+	unsupportedRegions := []string{
+		"us-west-1",
+		"eu-north-1",
+	}
+	for _, region := range unsupportedRegions {
+		assert.False(t, bedrockSupportedRegion(region))
+	}
+	for _, region := range bedrockRegions {
+		assert.True(t, bedrockSupportedRegion(region))
+	}
+}
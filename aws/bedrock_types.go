@@ -0,0 +1,145 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: BedrockProvisionedThroughputs{},
+		weight:   5700,
+		actions:  []string{"bedrock:DeleteProvisionedModelThroughput"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !bedrockSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			arns, err := getAllBedrockProvisionedThroughputs(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return BedrockProvisionedThroughputs{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+
+	// Jobs are stopped before custom models are deleted, as they may still produce new ones
+	registerResourceType(awsResourceType{
+		resource: BedrockCustomizationJobs{},
+		weight:   5800,
+		actions:  []string{"bedrock:StopModelCustomizationJob"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !bedrockSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			arns, err := getAllBedrockCustomizationJobs(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return BedrockCustomizationJobs{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: BedrockCustomModels{},
+		weight:   5900,
+		actions:  []string{"bedrock:DeleteCustomModel"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !bedrockSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			arns, err := getAllBedrockCustomModels(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return BedrockCustomModels{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+}
+
+// BedrockProvisionedThroughputs - represents all Bedrock provisioned model throughputs
+type BedrockProvisionedThroughputs struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (throughputs BedrockProvisionedThroughputs) ResourceName() string {
+	return "bedrockprovisionedthroughput"
+}
+
+// ResourceIdentifiers - The ARNs of the Bedrock provisioned model throughputs
+func (throughputs BedrockProvisionedThroughputs) ResourceIdentifiers() []string {
+	return throughputs.Arns
+}
+
+func (throughputs BedrockProvisionedThroughputs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (throughputs BedrockProvisionedThroughputs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBedrockProvisionedThroughputs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// BedrockCustomizationJobs - represents all in progress Bedrock model customization jobs
+type BedrockCustomizationJobs struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (jobs BedrockCustomizationJobs) ResourceName() string {
+	return "bedrockcustomizationjob"
+}
+
+// ResourceIdentifiers - The ARNs of the Bedrock model customization jobs
+func (jobs BedrockCustomizationJobs) ResourceIdentifiers() []string {
+	return jobs.Arns
+}
+
+func (jobs BedrockCustomizationJobs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (jobs BedrockCustomizationJobs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBedrockCustomizationJobs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// BedrockCustomModels - represents all Bedrock custom models
+type BedrockCustomModels struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (models BedrockCustomModels) ResourceName() string {
+	return "bedrockcustommodel"
+}
+
+// ResourceIdentifiers - The ARNs of the Bedrock custom models
+func (models BedrockCustomModels) ResourceIdentifiers() []string {
+	return models.Arns
+}
+
+func (models BedrockCustomModels) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (models BedrockCustomModels) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBedrockCustomModels(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Cost Explorer is a global service that is only served out of us-east-1
+const costExplorerRegion = "us-east-1"
+
+// Budget notifications report the actual spend in a line such as "ACTUAL Amount: $1,234.56"
+var budgetActualAmountRegexp = regexp.MustCompile(`ACTUAL Amount: \$([0-9,]+(\.[0-9]+)?)`)
+
+// GetMonthToDateSpend - Returns the unblended cost, in USD, the account has accrued since the start of the current
+// month according to Cost Explorer
+func GetMonthToDateSpend() (float64, error) {
+	svc := costexplorer.New(newSession(costExplorerRegion))
+
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: awsgo.String(costexplorer.GranularityMonthly),
+		Metrics:     []*string{awsgo.String("UnblendedCost")},
+		TimePeriod:  monthToDate(time.Now()),
+	}
+
+	var spend float64
+	for {
+		output, err := svc.GetCostAndUsage(input)
+		if err != nil {
+			return 0, errors.WithStackTrace(err)
+		}
+
+		for _, result := range output.ResultsByTime {
+			cost, ok := result.Total["UnblendedCost"]
+			if !ok {
+				continue
+			}
+			amount, err := strconv.ParseFloat(awsgo.StringValue(cost.Amount), 64)
+			if err != nil {
+				return 0, errors.WithStackTrace(err)
+			}
+			spend += amount
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = output.NextPageToken
+	}
+
+	return spend, nil
+}
+
+// GetMonthToDateSpendByTag - Returns the unblended cost, in USD, the account has accrued since the start of the
+// current month according to Cost Explorer, broken down by the value of the given tag. The cost of the resources that
+// don't carry the tag is reported under the empty value. Cost Explorer only knows about the tags activated as cost
+// allocation tags.
+func GetMonthToDateSpendByTag(tagKey string) (map[string]float64, error) {
+	svc := costexplorer.New(newSession(costExplorerRegion))
+
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: awsgo.String(costexplorer.GranularityMonthly),
+		Metrics:     []*string{awsgo.String("UnblendedCost")},
+		TimePeriod:  monthToDate(time.Now()),
+		GroupBy: []*costexplorer.GroupDefinition{
+			{
+				Type: awsgo.String(costexplorer.GroupDefinitionTypeTag),
+				Key:  awsgo.String(tagKey),
+			},
+		},
+	}
+
+	spend := map[string]float64{}
+	for {
+		output, err := svc.GetCostAndUsage(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, result := range output.ResultsByTime {
+			for _, group := range result.Groups {
+				cost, ok := group.Metrics["UnblendedCost"]
+				if !ok || len(group.Keys) == 0 {
+					continue
+				}
+				amount, err := strconv.ParseFloat(awsgo.StringValue(cost.Amount), 64)
+				if err != nil {
+					return nil, errors.WithStackTrace(err)
+				}
+				spend[tagValueOfCostGroupKey(awsgo.StringValue(group.Keys[0]))] += amount
+			}
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = output.NextPageToken
+	}
+
+	return spend, nil
+}
+
+// tagValueOfCostGroupKey - Cost Explorer reports the groups of a tag as KEY$VALUE, or KEY$ for the resources that
+// don't carry the tag. Returns just the value.
+func tagValueOfCostGroupKey(groupKey string) string {
+	parts := strings.SplitN(groupKey, "$", 2)
+	if len(parts) != 2 {
+		return groupKey
+	}
+	return parts[1]
+}
+
+// monthToDate - Returns the Cost Explorer time period from the start of the month up to, and including, the given
+// day. The end date is exclusive, so it is set to the next day. This also keeps the time period valid on the first
+// day of the month.
+func monthToDate(now time.Time) *costexplorer.DateInterval {
+	now = now.UTC()
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := now.AddDate(0, 0, 1)
+	const layout = "2006-01-02"
+
+	return &costexplorer.DateInterval{
+		Start: awsgo.String(startOfMonth.Format(layout)),
+		End:   awsgo.String(tomorrow.Format(layout)),
+	}
+}
+
+// ParseBudgetNotification - Extracts the actual spend, in USD, from an AWS Budgets notification delivered over SNS.
+// The payload can be the SNS event a Lambda function receives, a raw SNS message, or just the notification text.
+func ParseBudgetNotification(payload []byte) (float64, error) {
+	message := string(payload)
+
+	var event struct {
+		Records []struct {
+			Sns struct {
+				Message string
+			}
+		}
+		Message string
+	}
+	if err := json.Unmarshal(payload, &event); err == nil {
+		if len(event.Records) > 0 {
+			message = event.Records[0].Sns.Message
+		} else if event.Message != "" {
+			message = event.Message
+		}
+	}
+
+	matches := budgetActualAmountRegexp.FindStringSubmatch(message)
+	if matches == nil {
+		return 0, errors.WithStackTrace(InvalidBudgetNotificationError{})
+	}
+
+	spend, err := strconv.ParseFloat(strings.Replace(matches[1], ",", "", -1), 64)
+	if err != nil {
+		return 0, errors.WithStackTrace(err)
+	}
+	return spend, nil
+}
+
+// InvalidBudgetNotificationError - returned when a budget notification doesn't report an actual spend
+type InvalidBudgetNotificationError struct{}
+
+func (e InvalidBudgetNotificationError) Error() string {
+	return "Budget notification does not contain an actual spend amount"
+}
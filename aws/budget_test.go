@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBudgetNotification = `AWS Budget Notification November 12, 2019
+AWS Account 123456789012
+
+Dear AWS Customer,
+
+You requested that we alert you when the ACTUAL Cost associated with your sandbox budget is greater than 80% of $1,500.00 for the current month. The ACTUAL Cost associated with this budget is $1,234.56. You can find additional details below and by accessing the AWS Budgets dashboard.
+
+Budget Name: sandbox
+Budget Type: Cost
+Budgeted Amount: $1,500.00
+Alert Type: ACTUAL
+Alert Threshold: > $1,200.00
+ACTUAL Amount: $1,234.56
+`
+
+func TestParseBudgetNotification(t *testing.T) {
+	t.Parallel()
+
+	lambdaEvent := `{"Records":[{"EventSource":"aws:sns","Sns":{"Type":"Notification","Message":"Alert Type: ACTUAL\nACTUAL Amount: $1,234.56\n"}}]}`
+	snsMessage := `{"Type":"Notification","Subject":"AWS Budgets: sandbox has exceeded your alert threshold","Message":"ACTUAL Amount: $1,234.56"}`
+
+	for _, payload := range []string{testBudgetNotification, lambdaEvent, snsMessage} {
+		spend, err := ParseBudgetNotification([]byte(payload))
+		require.NoError(t, err)
+		assert.Equal(t, 1234.56, spend)
+	}
+}
+
+func TestParseBudgetNotificationWithoutActualAmount(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseBudgetNotification([]byte(`{"Records":[{"Sns":{"Message":"FORECASTED Amount: $10.00"}}]}`))
+	assert.Error(t, err)
+}
+
+func TestTagValueOfCostGroupKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "platform", tagValueOfCostGroupKey("team$platform"))
+	assert.Equal(t, "", tagValueOfCostGroupKey("team$"))
+	assert.Equal(t, "a$b", tagValueOfCostGroupKey("team$a$b"))
+}
+
+func TestMonthToDate(t *testing.T) {
+	t.Parallel()
+
+	period := monthToDate(time.Date(2020, 3, 31, 23, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2020-03-01", *period.Start)
+	assert.Equal(t, "2020-04-01", *period.End)
+
+	period = monthToDate(time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2020-03-01", *period.Start)
+	assert.Equal(t, "2020-03-02", *period.End)
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Cloud9. Refer to
+// https://aws.amazon.com/about-aws/global-infrastructure/regional-product-services/
+var cloud9Regions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+	"af-south-1",
+	"ap-east-1",
+	"ap-south-1",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-northeast-3",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"eu-north-1",
+	"eu-south-1",
+	"il-central-1",
+	"me-south-1",
+	"sa-east-1",
+}
+
+// DescribeEnvironments accepts at most 25 environment ids per call
+const cloud9DescribeBatchSize = 25
+
+// cloud9SupportedRegion returns true if the provided region supports Cloud9
+func cloud9SupportedRegion(region string) bool {
+	return collections.ListContainsElement(cloud9Regions, region)
+}
+
+// getCloud9FirstSeenTime returns the time cloud-nuke first saw the environment, tagging it with the current time if
+// it hasn't been seen before. We need this because a Cloud9 environment doesn't contain an attribute that gives us
+// it's creation time.
+func getCloud9FirstSeenTime(svc *cloud9.Cloud9, environmentArn *string) (*time.Time, error) {
+	result, err := svc.ListTagsForResource(&cloud9.ListTagsForResourceInput{
+		ResourceARN: environmentArn,
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	for _, tag := range result.Tags {
+		if awsgo.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(firstSeenTagLayout, awsgo.StringValue(tag.Value))
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			return &firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err = svc.TagResource(&cloud9.TagResourceInput{
+		ResourceARN: environmentArn,
+		Tags: []*cloud9.Tag{
+			{
+				Key:   awsgo.String(firstSeenTagKey),
+				Value: awsgo.String(now.Format(firstSeenTagLayout)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &now, nil
+}
+
+// Returns a formatted string of Cloud9 environment ids
+func getAllCloud9Environments(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := cloud9.New(session)
+
+	var allEnvironmentIds []string
+	err := svc.ListEnvironmentsPages(
+		&cloud9.ListEnvironmentsInput{},
+		func(page *cloud9.ListEnvironmentsOutput, lastPage bool) bool {
+			allEnvironmentIds = append(allEnvironmentIds, awsgo.StringValueSlice(page.EnvironmentIds)...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var environmentIds []*string
+	for _, batch := range split(allEnvironmentIds, cloud9DescribeBatchSize) {
+		result, err := svc.DescribeEnvironments(&cloud9.DescribeEnvironmentsInput{
+			EnvironmentIds: awsgo.StringSlice(batch),
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, environment := range result.Environments {
+			if environment.Lifecycle != nil && awsgo.StringValue(environment.Lifecycle.Status) == cloud9.EnvironmentLifecycleStatusDeleting {
+				continue
+			}
+
+			firstSeenTime, err := getCloud9FirstSeenTime(svc, environment.Arn)
+			if err != nil {
+				return nil, err
+			}
+
+			if timeFilter.IncludesFirstSeenResource(environment.Id, *firstSeenTime) {
+				environmentIds = append(environmentIds, environment.Id)
+			}
+		}
+	}
+
+	return environmentIds, nil
+}
+
+// Deletes all Cloud9 environments. Deleting an EC2 environment also terminates the instance backing it.
+func nukeAllCloud9Environments(session *session.Session, environmentIds []*string) error {
+	svc := cloud9.New(session)
+
+	if len(environmentIds) == 0 {
+		logging.Logger.Infof("No Cloud9 environments to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Cloud9 environments in region %s", *session.Config.Region)
+	var deletedEnvironmentIds []*string
+
+	for _, environmentId := range environmentIds {
+		_, err := svc.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
+			EnvironmentId: environmentId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == cloud9.ErrCodeNotFoundException {
+				logging.Logger.Infof("Cloud9 environment %s has already been deleted", *environmentId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedEnvironmentIds = append(deletedEnvironmentIds, environmentId)
+			logging.Logger.Infof("Deleted Cloud9 environment: %s", *environmentId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Cloud9 environment(s) deleted in %s", len(deletedEnvironmentIds), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given Cloud9 environments have actually been deleted
+func waitUntilCloud9EnvironmentsDeleted(session *session.Session, environmentIds []*string) error {
+	svc := cloud9.New(session)
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(environmentIds), func(environmentId string) (bool, error) {
+		result, err := svc.DescribeEnvironments(&cloud9.DescribeEnvironmentsInput{
+			EnvironmentIds: []*string{awsgo.String(environmentId)},
+		})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == cloud9.ErrCodeNotFoundException {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return len(result.Environments) > 0, nil
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(remaining) > 0 {
+		return ResourcesNotDeletedError{ResourceType: Cloud9Environments{}.ResourceName(), Identifiers: remaining}
+	}
+
+	return nil
+}
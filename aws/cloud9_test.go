@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCloud9Environment(t *testing.T, session *session.Session, name string) string {
+	svc := cloud9.New(session)
+	result, err := svc.CreateEnvironmentEC2(&cloud9.CreateEnvironmentEC2Input{
+		Name:                     awsgo.String(name),
+		InstanceType:             awsgo.String("t3.micro"),
+		ImageId:                  awsgo.String("amazonlinux-2023-x86_64"),
+		AutomaticStopTimeMinutes: awsgo.Int64(30),
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(result.EnvironmentId)
+}
+
+func TestListCloud9Environments(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, cloud9Regions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	environmentId := createTestCloud9Environment(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllCloud9Environments(session, []*string{awsgo.String(environmentId)})
+
+	// The environment is first seen, and tagged, on the first lookup
+	environmentIds, err := getAllCloud9Environments(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud9 environments")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(environmentIds), environmentId)
+
+	environmentIds, err = getAllCloud9Environments(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud9 environments")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(environmentIds), environmentId)
+}
+
+func TestNukeCloud9Environments(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, cloud9Regions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	environmentId := createTestCloud9Environment(t, session, uniqueTestID)
+
+	if err := nukeAllCloud9Environments(session, []*string{awsgo.String(environmentId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	environmentIds, err := getAllCloud9Environments(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud9 environments")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(environmentIds), environmentId)
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before EC2 instances, since deleting an environment also terminates the instance backing it
+	registerResourceType(awsResourceType{
+		resource: Cloud9Environments{},
+		weight:   1100,
+		actions:  []string{"cloud9:TagResource", "cloud9:DeleteEnvironment"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !cloud9SupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			environmentIds, err := getAllCloud9Environments(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return Cloud9Environments{EnvironmentIds: awsgo.StringValueSlice(environmentIds)}, nil
+		},
+	})
+}
+
+// Cloud9Environments - represents all Cloud9 environments
+type Cloud9Environments struct {
+	EnvironmentIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (environment Cloud9Environments) ResourceName() string {
+	return "cloud9env"
+}
+
+// ResourceIdentifiers - The ids of the Cloud9 environments
+func (environment Cloud9Environments) ResourceIdentifiers() []string {
+	return environment.EnvironmentIds
+}
+
+func (environment Cloud9Environments) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (environment Cloud9Environments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloud9Environments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (environment Cloud9Environments) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilCloud9EnvironmentsDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
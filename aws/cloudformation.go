@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of CloudFormation stack names. Nested stacks are left out, as they are deleted along with
+// their root stack.
+func getAllCloudFormationStacks(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := cloudformation.New(session)
+
+	var names []*string
+	err := svc.DescribeStacksPages(
+		&cloudformation.DescribeStacksInput{},
+		func(page *cloudformation.DescribeStacksOutput, lastPage bool) bool {
+			for _, stack := range page.Stacks {
+				if stack.ParentId != nil || awsgo.StringValue(stack.StackStatus) == cloudformation.StackStatusDeleteInProgress {
+					continue
+				}
+				if timeFilter.IncludesResource(stack.StackName, *stack.CreationTime) {
+					names = append(names, stack.StackName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return names, nil
+}
+
+// deleteCloudFormationStacks disables the termination protection of the stacks and requests their deletion. Returns
+// the names of the stacks that have been accepted by AWS for deletion.
+func deleteCloudFormationStacks(svc *cloudformation.CloudFormation, names []*string) []*string {
+	var requestedDeletes []*string
+	for _, name := range names {
+		_, err := svc.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+			StackName:                   name,
+			EnableTerminationProtection: awsgo.Bool(false),
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed disabling termination protection of CloudFormation stack %s: %s", *name, err)
+			continue
+		}
+
+		_, err = svc.DeleteStack(&cloudformation.DeleteStackInput{StackName: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed deleting CloudFormation stack %s: %s", *name, err)
+		} else {
+			requestedDeletes = append(requestedDeletes, name)
+		}
+	}
+	return requestedDeletes
+}
+
+// getDeleteFailedStackResources returns the logical ids of the resources of the stack that failed to be deleted
+func getDeleteFailedStackResources(svc *cloudformation.CloudFormation, name *string) ([]*string, error) {
+	result, err := svc.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{StackName: name})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var logicalIds []*string
+	for _, resource := range result.StackResources {
+		if awsgo.StringValue(resource.ResourceStatus) == cloudformation.ResourceStatusDeleteFailed {
+			logicalIds = append(logicalIds, resource.LogicalResourceId)
+		}
+	}
+	return logicalIds, nil
+}
+
+// retryCloudFormationStackDeletion deletes a stack that failed to be deleted once more, retaining the resources that
+// couldn't be deleted, so the stack itself doesn't linger. The retained resources are left for their own resource
+// types to nuke.
+func retryCloudFormationStackDeletion(svc *cloudformation.CloudFormation, name *string) error {
+	retainResources, err := getDeleteFailedStackResources(svc, name)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Warnf("Retrying deletion of CloudFormation stack %s, retaining the resources that failed to be deleted: %s", *name, strings.Join(awsgo.StringValueSlice(retainResources), ", "))
+	_, err = svc.DeleteStack(&cloudformation.DeleteStackInput{
+		StackName:       name,
+		RetainResources: retainResources,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	err = svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{StackName: name})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// waitUntilCloudFormationStacksDeleted waits until the stacks have actually been deleted, retrying the deletion of
+// the stacks that failed to be deleted. Returns the names of the stacks that have been successfully deleted.
+func waitUntilCloudFormationStacksDeleted(svc *cloudformation.CloudFormation, names []*string) []*string {
+	var successfullyDeleted []*string
+	for _, name := range names {
+		err := svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{StackName: name})
+		if err != nil {
+			err = retryCloudFormationStackDeletion(svc, name)
+		}
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed waiting for CloudFormation stack to be deleted %s: %s", *name, err)
+		} else {
+			logging.Logger.Infof("Deleted CloudFormation stack: %s", *name)
+			successfullyDeleted = append(successfullyDeleted, name)
+		}
+	}
+	return successfullyDeleted
+}
+
+// nukeAllCloudFormationStacks deletes all provided stacks, along with the resources they created, waiting for them to
+// be deleted before returning
+func nukeAllCloudFormationStacks(session *session.Session, names []*string) error {
+	svc := cloudformation.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No CloudFormation stacks to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all CloudFormation stacks in region %s", *session.Config.Region)
+
+	requestedDeletes := deleteCloudFormationStacks(svc, names)
+	successfullyDeleted := waitUntilCloudFormationStacksDeleted(svc, requestedDeletes)
+
+	logging.Logger.Infof("[OK] %d of %d CloudFormation stack(s) deleted in %s", len(successfullyDeleted), len(names), *session.Config.Region)
+	return nil
+}
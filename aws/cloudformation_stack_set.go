@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How long to wait for the stack instances of a stack set to be deleted across accounts and regions before giving up
+const stackSetOperationMaxAttempts = 240
+
+var stackSetOperationPollInterval = 15 * time.Second
+
+// getStackSetCreationTime - Returns when the first operation on the stack set started, as stack sets don't expose
+// their creation time. A stack set that was never operated on doesn't have any stack instances yet, so it's
+// considered just created.
+func getStackSetCreationTime(svc *cloudformation.CloudFormation, name *string) (time.Time, error) {
+	createdAt := time.Now()
+	err := svc.ListStackSetOperationsPages(
+		&cloudformation.ListStackSetOperationsInput{StackSetName: name},
+		func(page *cloudformation.ListStackSetOperationsOutput, lastPage bool) bool {
+			for _, operation := range page.Summaries {
+				if operation.CreationTimestamp != nil && operation.CreationTimestamp.Before(createdAt) {
+					createdAt = *operation.CreationTimestamp
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return createdAt, nil
+}
+
+// Returns the names of the active CloudFormation stack sets administered from the region
+func getAllCloudFormationStackSets(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := cloudformation.New(session)
+
+	var allNames []*string
+	err := svc.ListStackSetsPages(
+		&cloudformation.ListStackSetsInput{Status: awsgo.String(cloudformation.StackSetStatusActive)},
+		func(page *cloudformation.ListStackSetsOutput, lastPage bool) bool {
+			for _, stackSet := range page.Summaries {
+				allNames = append(allNames, stackSet.StackSetName)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, name := range allNames {
+		createdAt, err := getStackSetCreationTime(svc, name)
+		if err != nil {
+			return nil, err
+		}
+		if timeFilter.IncludesResource(name, createdAt) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// stackSetDeploymentTargets - Returns the targets and regions to delete the given stack instances from. Stack sets
+// with service-managed permissions are deployed to organizational units, the other ones to accounts.
+func stackSetDeploymentTargets(permissionModel string, instances []*cloudformation.StackInstanceSummary) (*cloudformation.DeploymentTargets, []*string) {
+	targets := map[string]bool{}
+	regions := map[string]bool{}
+	for _, instance := range instances {
+		if permissionModel == cloudformation.PermissionModelsServiceManaged {
+			targets[awsgo.StringValue(instance.OrganizationalUnitId)] = true
+		} else {
+			targets[awsgo.StringValue(instance.Account)] = true
+		}
+		regions[awsgo.StringValue(instance.Region)] = true
+	}
+
+	sortedKeys := func(set map[string]bool) []*string {
+		var keys []string
+		for key := range set {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return awsgo.StringSlice(keys)
+	}
+
+	deploymentTargets := &cloudformation.DeploymentTargets{}
+	if permissionModel == cloudformation.PermissionModelsServiceManaged {
+		deploymentTargets.OrganizationalUnitIds = sortedKeys(targets)
+	} else {
+		deploymentTargets.Accounts = sortedKeys(targets)
+	}
+	return deploymentTargets, sortedKeys(regions)
+}
+
+// waitForStackSetOperation - Waits for an operation on the stack set to complete, failing when it didn't succeed
+func waitForStackSetOperation(svc *cloudformation.CloudFormation, name *string, operationId *string) error {
+	for attempt := 0; attempt < stackSetOperationMaxAttempts; attempt++ {
+		result, err := svc.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+			StackSetName: name,
+			OperationId:  operationId,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		status := awsgo.StringValue(result.StackSetOperation.Status)
+		switch status {
+		case cloudformation.StackSetOperationStatusSucceeded:
+			return nil
+		case cloudformation.StackSetOperationStatusFailed, cloudformation.StackSetOperationStatusStopped:
+			return errors.WithStackTrace(StackSetOperationError{StackSetName: *name, OperationId: *operationId, Status: status})
+		}
+		time.Sleep(stackSetOperationPollInterval)
+	}
+	return errors.WithStackTrace(StackSetOperationError{StackSetName: *name, OperationId: *operationId, Status: "still running"})
+}
+
+// deleteStackInstances - Deletes all the stack instances of the stack set, in all accounts and regions, along with
+// the resources they created
+func deleteStackInstances(svc *cloudformation.CloudFormation, name *string) error {
+	stackSet, err := svc.DescribeStackSet(&cloudformation.DescribeStackSetInput{StackSetName: name})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	var instances []*cloudformation.StackInstanceSummary
+	err = svc.ListStackInstancesPages(
+		&cloudformation.ListStackInstancesInput{StackSetName: name},
+		func(page *cloudformation.ListStackInstancesOutput, lastPage bool) bool {
+			instances = append(instances, page.Summaries...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(instances) == 0 {
+		return nil
+	}
+
+	deploymentTargets, regions := stackSetDeploymentTargets(awsgo.StringValue(stackSet.StackSet.PermissionModel), instances)
+	logging.Logger.Infof("...deleting %d stack instance(s) of stack set %s", len(instances), *name)
+	result, err := svc.DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+		StackSetName:      name,
+		DeploymentTargets: deploymentTargets,
+		Regions:           regions,
+		RetainStacks:      awsgo.Bool(false),
+		OperationPreferences: &cloudformation.StackSetOperationPreferences{
+			RegionConcurrencyType:      awsgo.String(cloudformation.RegionConcurrencyTypeParallel),
+			MaxConcurrentPercentage:    awsgo.Int64(100),
+			FailureTolerancePercentage: awsgo.Int64(100),
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return waitForStackSetOperation(svc, name, result.OperationId)
+}
+
+// Deletes the stack instances of all the given stack sets across accounts and regions, and then the stack sets
+func nukeAllCloudFormationStackSets(session *session.Session, names []*string) error {
+	svc := cloudformation.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No CloudFormation stack sets to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all CloudFormation stack sets in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		err := deleteStackInstances(svc, name)
+		if err == nil {
+			_, err = svc.DeleteStackSet(&cloudformation.DeleteStackSetInput{StackSetName: name})
+		}
+		if err != nil {
+			if awsErr, isAwsErr := errors.Unwrap(err).(awserr.Error); isAwsErr && awsErr.Code() == cloudformation.ErrCodeStackSetNotFoundException {
+				logging.Logger.Infof("CloudFormation stack set %s has already been deleted", *name)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted CloudFormation stack set: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d CloudFormation stack set(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
+
+// StackSetOperationError - returned when deleting the stack instances of a stack set didn't succeed
+type StackSetOperationError struct {
+	StackSetName string
+	OperationId  string
+	Status       string
+}
+
+func (e StackSetOperationError) Error() string {
+	return fmt.Sprintf("Operation %s on CloudFormation stack set %s didn't succeed: %s", e.OperationId, e.StackSetName, e.Status)
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackSetDeploymentTargets(t *testing.T) {
+	t.Parallel()
+
+	instances := []*cloudformation.StackInstanceSummary{
+		{Account: awsgo.String("222222222222"), OrganizationalUnitId: awsgo.String("ou-ab12-sandbox"), Region: awsgo.String("us-east-1")},
+		{Account: awsgo.String("111111111111"), OrganizationalUnitId: awsgo.String("ou-ab12-sandbox"), Region: awsgo.String("eu-west-1")},
+		{Account: awsgo.String("111111111111"), OrganizationalUnitId: awsgo.String("ou-ab12-sandbox"), Region: awsgo.String("us-east-1")},
+	}
+
+	targets, regions := stackSetDeploymentTargets(cloudformation.PermissionModelsSelfManaged, instances)
+	assert.Equal(t, []string{"111111111111", "222222222222"}, awsgo.StringValueSlice(targets.Accounts))
+	assert.Empty(t, targets.OrganizationalUnitIds)
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, awsgo.StringValueSlice(regions))
+
+	targets, regions = stackSetDeploymentTargets(cloudformation.PermissionModelsServiceManaged, instances)
+	assert.Equal(t, []string{"ou-ab12-sandbox"}, awsgo.StringValueSlice(targets.OrganizationalUnitIds))
+	assert.Empty(t, targets.Accounts)
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, awsgo.StringValueSlice(regions))
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Stack sets go before stacks, so that their stack instances, which would recreate the resources cloud-nuke deletes
+	// directly, are torn down in all accounts and regions
+	registerResourceType(awsResourceType{
+		resource: CloudFormationStackSets{},
+		weight:   100,
+		actions:  []string{"cloudformation:DeleteStackInstances", "cloudformation:DeleteStackSet"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllCloudFormationStackSets(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return CloudFormationStackSets{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// CloudFormationStackSets - represents all CloudFormation stack sets administered from a region
+type CloudFormationStackSets struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (stackSets CloudFormationStackSets) ResourceName() string {
+	return "cloudformationstackset"
+}
+
+// ResourceIdentifiers - The names of the CloudFormation stack sets
+func (stackSets CloudFormationStackSets) ResourceIdentifiers() []string {
+	return stackSets.Names
+}
+
+func (stackSets CloudFormationStackSets) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (stackSets CloudFormationStackSets) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudFormationStackSets(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A template that doesn't create any billable resources
+const testCloudFormationTemplate = `{
+  "Resources": {
+    "WaitHandle": {
+      "Type": "AWS::CloudFormation::WaitConditionHandle"
+    }
+  }
+}`
+
+func createTestCloudFormationStack(t *testing.T, session *session.Session, name string) string {
+	svc := cloudformation.New(session)
+	_, err := svc.CreateStack(&cloudformation.CreateStackInput{
+		StackName:    awsgo.String(name),
+		TemplateBody: awsgo.String(testCloudFormationTemplate),
+		// Make sure termination protection doesn't get in the way of nuking
+		EnableTerminationProtection: awsgo.Bool(true),
+	})
+	require.NoError(t, err)
+
+	err = svc.WaitUntilStackCreateComplete(&cloudformation.DescribeStacksInput{
+		StackName: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListCloudFormationStacks(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestCloudFormationStack(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllCloudFormationStacks(session, []*string{awsgo.String(name)})
+
+	names, err := getAllCloudFormationStacks(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudFormation stacks")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+
+	names, err = getAllCloudFormationStacks(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudFormation stacks")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(names), name)
+}
+
+func TestNukeCloudFormationStacks(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestCloudFormationStack(t, session, uniqueTestID)
+
+	if err := nukeAllCloudFormationStacks(session, []*string{awsgo.String(name)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	names, err := getAllCloudFormationStacks(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudFormation stacks")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Stacks go first, so the resources they created are torn down through them
+	registerResourceType(awsResourceType{
+		resource: CloudFormationStacks{},
+		weight:   200,
+		actions:  []string{"cloudformation:UpdateTerminationProtection", "cloudformation:DeleteStack"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllCloudFormationStacks(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return CloudFormationStacks{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// CloudFormationStacks - represents all CloudFormation stacks
+type CloudFormationStacks struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (stacks CloudFormationStacks) ResourceName() string {
+	return "cloudformationstack"
+}
+
+// ResourceIdentifiers - The names of the CloudFormation stacks
+func (stacks CloudFormationStacks) ResourceIdentifiers() []string {
+	return stacks.Names
+}
+
+func (stacks CloudFormationStacks) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (stacks CloudFormationStacks) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudFormationStacks(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
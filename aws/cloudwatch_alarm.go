@@ -0,0 +1,78 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The most alarms DeleteAlarms accepts at once
+const cloudWatchAlarmsDeleteMaxBatchSize = 100
+
+// Returns the names of all CloudWatch alarms last configured before the cutoff. Composite alarms come first, so that
+// they are deleted before the alarms their rules refer to.
+func getAllCloudWatchAlarms(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := cloudwatch.New(session)
+
+	var alarmNames []*string
+	err := svc.DescribeAlarmsPages(
+		&cloudwatch.DescribeAlarmsInput{
+			AlarmTypes: awsgo.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm, cloudwatch.AlarmTypeMetricAlarm}),
+		},
+		func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+			// Alarms don't expose their creation time, but any change to them updates their configuration timestamp
+			for _, alarm := range page.CompositeAlarms {
+				if timeFilter.IncludesResource(alarm.AlarmName, *alarm.AlarmConfigurationUpdatedTimestamp) {
+					alarmNames = append(alarmNames, alarm.AlarmName)
+				}
+			}
+			for _, alarm := range page.MetricAlarms {
+				if timeFilter.IncludesResource(alarm.AlarmName, *alarm.AlarmConfigurationUpdatedTimestamp) {
+					alarmNames = append(alarmNames, alarm.AlarmName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return alarmNames, nil
+}
+
+// Deletes all the given CloudWatch alarms, as many at once as the API allows
+func nukeAllCloudWatchAlarms(session *session.Session, alarmNames []*string) error {
+	svc := cloudwatch.New(session)
+
+	if len(alarmNames) == 0 {
+		logging.Logger.Infof("No CloudWatch alarms to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all CloudWatch alarms in region %s", *session.Config.Region)
+	var deletedAlarmNames []*string
+
+	for start := 0; start < len(alarmNames); start += cloudWatchAlarmsDeleteMaxBatchSize {
+		end := start + cloudWatchAlarmsDeleteMaxBatchSize
+		if end > len(alarmNames) {
+			end = len(alarmNames)
+		}
+		batch := alarmNames[start:end]
+
+		_, err := svc.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{AlarmNames: batch})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+		for _, alarmName := range batch {
+			logging.Logger.Infof("Deleted CloudWatch alarm: %s", *alarmName)
+		}
+		deletedAlarmNames = append(deletedAlarmNames, batch...)
+	}
+
+	logging.Logger.Infof("[OK] %d CloudWatch alarm(s) deleted in %s", len(deletedAlarmNames), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCloudWatchAlarm(t *testing.T, session *session.Session, name string) string {
+	svc := cloudwatch.New(session)
+	_, err := svc.PutMetricAlarm(&cloudwatch.PutMetricAlarmInput{
+		AlarmName:          awsgo.String(name),
+		Namespace:          awsgo.String("cloud-nuke-test"),
+		MetricName:         awsgo.String("Errors"),
+		Statistic:          awsgo.String(cloudwatch.StatisticSum),
+		Period:             awsgo.Int64(300),
+		EvaluationPeriods:  awsgo.Int64(1),
+		Threshold:          awsgo.Float64(1),
+		ComparisonOperator: awsgo.String(cloudwatch.ComparisonOperatorGreaterThanOrEqualToThreshold),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListCloudWatchAlarms(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestCloudWatchAlarm(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllCloudWatchAlarms(session, []*string{awsgo.String(name)})
+
+	names, err := getAllCloudWatchAlarms(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudWatch alarms")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+
+	names, err = getAllCloudWatchAlarms(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudWatch alarms")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(names), name)
+}
+
+func TestNukeCloudWatchAlarms(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestCloudWatchAlarm(t, session, uniqueTestID)
+
+	if err := nukeAllCloudWatchAlarms(session, []*string{awsgo.String(name)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	names, err := getAllCloudWatchAlarms(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudWatch alarms")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
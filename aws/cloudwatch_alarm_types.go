@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: CloudWatchAlarms{},
+		weight:   5100,
+		actions:  []string{"cloudwatch:DeleteAlarms"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			alarmNames, err := getAllCloudWatchAlarms(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return CloudWatchAlarms{AlarmNames: awsgo.StringValueSlice(alarmNames)}, nil
+		},
+	})
+}
+
+// CloudWatchAlarms - represents all CloudWatch metric and composite alarms
+type CloudWatchAlarms struct {
+	AlarmNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (alarms CloudWatchAlarms) ResourceName() string {
+	return "cloudwatchalarm"
+}
+
+// ResourceIdentifiers - The names of the CloudWatch alarms
+func (alarms CloudWatchAlarms) ResourceIdentifiers() []string {
+	return alarms.AlarmNames
+}
+
+func (alarms CloudWatchAlarms) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (alarms CloudWatchAlarms) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudWatchAlarms(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns the names of all CloudWatch dashboards last modified before the cutoff
+func getAllCloudWatchDashboards(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := cloudwatch.New(session)
+
+	var dashboardNames []*string
+	err := svc.ListDashboardsPages(
+		&cloudwatch.ListDashboardsInput{},
+		func(page *cloudwatch.ListDashboardsOutput, lastPage bool) bool {
+			// Dashboards don't expose their creation time, only when they were last modified
+			for _, dashboard := range page.DashboardEntries {
+				if timeFilter.IncludesResource(dashboard.DashboardName, *dashboard.LastModified) {
+					dashboardNames = append(dashboardNames, dashboard.DashboardName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return dashboardNames, nil
+}
+
+// Deletes all the given CloudWatch dashboards at once
+func nukeAllCloudWatchDashboards(session *session.Session, dashboardNames []*string) error {
+	svc := cloudwatch.New(session)
+
+	if len(dashboardNames) == 0 {
+		logging.Logger.Infof("No CloudWatch dashboards to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all CloudWatch dashboards in region %s", *session.Config.Region)
+
+	_, err := svc.DeleteDashboards(&cloudwatch.DeleteDashboardsInput{DashboardNames: dashboardNames})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	for _, dashboardName := range dashboardNames {
+		logging.Logger.Infof("Deleted CloudWatch dashboard: %s", awsgo.StringValue(dashboardName))
+	}
+
+	logging.Logger.Infof("[OK] %d CloudWatch dashboard(s) deleted in %s", len(dashboardNames), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCloudWatchDashboard(t *testing.T, session *session.Session, name string) string {
+	svc := cloudwatch.New(session)
+	_, err := svc.PutDashboard(&cloudwatch.PutDashboardInput{
+		DashboardName: awsgo.String(name),
+		DashboardBody: awsgo.String(`{"widgets":[{"type":"text","x":0,"y":0,"width":6,"height":3,"properties":{"markdown":"cloud-nuke"}}]}`),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListCloudWatchDashboards(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestCloudWatchDashboard(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllCloudWatchDashboards(session, []*string{awsgo.String(name)})
+
+	names, err := getAllCloudWatchDashboards(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudWatch dashboards")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+
+	names, err = getAllCloudWatchDashboards(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudWatch dashboards")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(names), name)
+}
+
+func TestNukeCloudWatchDashboards(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestCloudWatchDashboard(t, session, uniqueTestID)
+
+	if err := nukeAllCloudWatchDashboards(session, []*string{awsgo.String(name)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	names, err := getAllCloudWatchDashboards(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of CloudWatch dashboards")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
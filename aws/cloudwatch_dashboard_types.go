@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: CloudWatchDashboards{},
+		weight:   5200,
+		actions:  []string{"cloudwatch:DeleteDashboards"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			dashboardNames, err := getAllCloudWatchDashboards(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return CloudWatchDashboards{DashboardNames: awsgo.StringValueSlice(dashboardNames)}, nil
+		},
+	})
+}
+
+// CloudWatchDashboards - represents all CloudWatch dashboards
+type CloudWatchDashboards struct {
+	DashboardNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (dashboards CloudWatchDashboards) ResourceName() string {
+	return "cloudwatchdashboard"
+}
+
+// ResourceIdentifiers - The names of the CloudWatch dashboards
+func (dashboards CloudWatchDashboards) ResourceIdentifiers() []string {
+	return dashboards.DashboardNames
+}
+
+func (dashboards CloudWatchDashboards) MaxBatchSize() int {
+	// DeleteDashboards deletes the whole batch in one call
+	return 100
+}
+
+// Nuke - nuke 'em all!!!
+func (dashboards CloudWatchDashboards) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudWatchDashboards(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
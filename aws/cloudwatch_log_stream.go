@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Log stream names can't contain colons, so a stream is identified by its group and its name joined by one
+const logStreamIdentifierSeparator = ":"
+
+// logStreamIdentifier - Returns the identifier of the log stream in the given group
+func logStreamIdentifier(groupName string, streamName string) string {
+	return groupName + logStreamIdentifierSeparator + streamName
+}
+
+// parseLogStreamIdentifier - Splits the identifier of a log stream into the name of its group and its own name
+func parseLogStreamIdentifier(identifier string) (string, string) {
+	parts := strings.SplitN(identifier, logStreamIdentifierSeparator, 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// logStreamLastActivity - Returns when an event was last written to the log stream, or when it was created if no
+// event ever was
+func logStreamLastActivity(stream *cloudwatchlogs.LogStream) time.Time {
+	timestamp := awsgo.Int64Value(stream.LastEventTimestamp)
+	if timestamp == 0 {
+		timestamp = awsgo.Int64Value(stream.CreationTime)
+	}
+	return time.Unix(0, timestamp*int64(time.Millisecond))
+}
+
+// Returns the identifiers of the log streams of the log groups matching the name filter, whose last event is older
+// than the cutoff. The groups themselves are kept.
+func getAllCloudWatchLogStreams(session *session.Session, timeFilter TimeFilter, groupNameFilter NameFilter) ([]*string, error) {
+	svc := cloudwatchlogs.New(session)
+
+	var groupNames []string
+	err := svc.DescribeLogGroupsPages(
+		&cloudwatchlogs.DescribeLogGroupsInput{},
+		func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+			for _, group := range page.LogGroups {
+				if groupNameFilter.Matches(awsgo.StringValue(group.LogGroupName)) {
+					groupNames = append(groupNames, awsgo.StringValue(group.LogGroupName))
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var identifiers []*string
+	for _, groupName := range groupNames {
+		err := svc.DescribeLogStreamsPages(
+			&cloudwatchlogs.DescribeLogStreamsInput{LogGroupName: awsgo.String(groupName)},
+			func(page *cloudwatchlogs.DescribeLogStreamsOutput, lastPage bool) bool {
+				for _, stream := range page.LogStreams {
+					identifier := awsgo.String(logStreamIdentifier(groupName, awsgo.StringValue(stream.LogStreamName)))
+					if timeFilter.IncludesResource(identifier, logStreamLastActivity(stream)) {
+						identifiers = append(identifiers, identifier)
+					}
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// Deletes all the given log streams, leaving their groups in place
+func nukeAllCloudWatchLogStreams(session *session.Session, identifiers []*string) error {
+	svc := cloudwatchlogs.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Infof("No CloudWatch log streams to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all CloudWatch log streams in region %s", *session.Config.Region)
+	var deletedIdentifiers []*string
+
+	for _, identifier := range identifiers {
+		groupName, streamName := parseLogStreamIdentifier(*identifier)
+		_, err := svc.DeleteLogStream(&cloudwatchlogs.DeleteLogStreamInput{
+			LogGroupName:  awsgo.String(groupName),
+			LogStreamName: awsgo.String(streamName),
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("CloudWatch log stream %s has already been deleted", *identifier)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Infof("Deleted CloudWatch log stream: %s", *identifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d CloudWatch log stream(s) deleted in %s", len(deletedIdentifiers), *session.Config.Region)
+	return nil
+}
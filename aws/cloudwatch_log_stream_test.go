@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogStreamIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := logStreamIdentifier("/aws/lambda/my-function", "2024/01/02/[$LATEST]abcdef")
+	assert.Equal(t, "/aws/lambda/my-function:2024/01/02/[$LATEST]abcdef", identifier)
+
+	groupName, streamName := parseLogStreamIdentifier(identifier)
+	assert.Equal(t, "/aws/lambda/my-function", groupName)
+	assert.Equal(t, "2024/01/02/[$LATEST]abcdef", streamName)
+}
+
+func TestLogStreamLastActivity(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	lastEvent := created.Add(48 * time.Hour)
+	millis := func(t time.Time) *int64 {
+		return awsgo.Int64(t.UnixNano() / int64(time.Millisecond))
+	}
+
+	withEvents := &cloudwatchlogs.LogStream{CreationTime: millis(created), LastEventTimestamp: millis(lastEvent)}
+	assert.True(t, lastEvent.Equal(logStreamLastActivity(withEvents)))
+
+	withoutEvents := &cloudwatchlogs.LogStream{CreationTime: millis(created)}
+	assert.True(t, created.Equal(logStreamLastActivity(withoutEvents)))
+}
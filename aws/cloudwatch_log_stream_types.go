@@ -0,0 +1,55 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Log groups are kept, and there can be a great many streams in them, so pruning them has to be explicitly opted
+	// into
+	registerResourceType(awsResourceType{
+		resource:     CloudWatchLogStreams{},
+		weight:       4700,
+		explicitOnly: true,
+		actions:      []string{"logs:DeleteLogStream"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			identifiers, err := getAllCloudWatchLogStreams(discovery.session, discovery.timeFilter, discovery.options.LogGroupNames)
+			if err != nil {
+				return nil, err
+			}
+			return CloudWatchLogStreams{Identifiers: awsgo.StringValueSlice(identifiers)}, nil
+		},
+	})
+}
+
+// CloudWatchLogStreams - represents all CloudWatch log streams, within groups that are kept
+type CloudWatchLogStreams struct {
+	// Each identifier is the name of the log group and the name of the stream, joined by a colon
+	Identifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (streams CloudWatchLogStreams) ResourceName() string {
+	return "cloudwatchlogstream"
+}
+
+// ResourceIdentifiers - The log groups and names of the log streams
+func (streams CloudWatchLogStreams) ResourceIdentifiers() []string {
+	return streams.Identifiers
+}
+
+func (streams CloudWatchLogStreams) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (streams CloudWatchLogStreams) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudWatchLogStreams(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
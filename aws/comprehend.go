@@ -0,0 +1,91 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Comprehend. Refer to
+// https://docs.aws.amazon.com/general/latest/gr/comprehend.html
+var comprehendRegions = []string{
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"us-east-1",
+	"us-east-2",
+	"us-west-2",
+}
+
+// comprehendSupportedRegion returns true if the provided region supports Comprehend
+func comprehendSupportedRegion(region string) bool {
+	return collections.ListContainsElement(comprehendRegions, region)
+}
+
+// Returns a formatted string of Comprehend endpoint ARNs
+func getAllComprehendEndpoints(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := comprehend.New(session)
+
+	var endpointArns []*string
+	err := svc.ListEndpointsPages(
+		&comprehend.ListEndpointsInput{},
+		func(page *comprehend.ListEndpointsOutput, lastPage bool) bool {
+			for _, endpoint := range page.EndpointPropertiesList {
+				if awsgo.StringValue(endpoint.Status) == comprehend.EndpointStatusDeleting {
+					continue
+				}
+				if timeFilter.IncludesResource(endpoint.EndpointArn, *endpoint.CreationTime) {
+					endpointArns = append(endpointArns, endpoint.EndpointArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return endpointArns, nil
+}
+
+// Deletes all Comprehend endpoints
+func nukeAllComprehendEndpoints(session *session.Session, endpointArns []*string) error {
+	svc := comprehend.New(session)
+
+	if len(endpointArns) == 0 {
+		logging.Logger.Infof("No Comprehend endpoints to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Comprehend endpoints in region %s", *session.Config.Region)
+	var deletedEndpointArns []*string
+
+	for _, endpointArn := range endpointArns {
+		_, err := svc.DeleteEndpoint(&comprehend.DeleteEndpointInput{
+			EndpointArn: endpointArn,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == comprehend.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Comprehend endpoint %s has already been deleted", *endpointArn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedEndpointArns = append(deletedEndpointArns, endpointArn)
+			logging.Logger.Infof("Deleted Comprehend endpoint: %s", *endpointArn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Comprehend endpoint(s) deleted in %s", len(deletedEndpointArns), *session.Config.Region)
+	return nil
+}
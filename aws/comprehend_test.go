@@ -0,0 +1,22 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Creating a Comprehend endpoint requires training a custom model first, which takes too long for a test, so only the
+// region lookup is tested here
+func TestComprehendSupportedRegions(t *testing.T) {
+	unsupportedRegions := []string{
+		"us-west-1",
+		"eu-north-1",
+	}
+	for _, region := range unsupportedRegions {
+		assert.False(t, comprehendSupportedRegion(region))
+	}
+	for _, region := range comprehendRegions {
+		assert.True(t, comprehendSupportedRegion(region))
+	}
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: ComprehendEndpoints{},
+		weight:   5500,
+		actions:  []string{"comprehend:DeleteEndpoint"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !comprehendSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			endpointArns, err := getAllComprehendEndpoints(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ComprehendEndpoints{EndpointArns: awsgo.StringValueSlice(endpointArns)}, nil
+		},
+	})
+}
+
+// ComprehendEndpoints - represents all Comprehend endpoints
+type ComprehendEndpoints struct {
+	EndpointArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (endpoints ComprehendEndpoints) ResourceName() string {
+	return "comprehendendpoint"
+}
+
+// ResourceIdentifiers - The ARNs of the Comprehend endpoints
+func (endpoints ComprehendEndpoints) ResourceIdentifiers() []string {
+	return endpoints.EndpointArns
+}
+
+func (endpoints ComprehendEndpoints) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (endpoints ComprehendEndpoints) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllComprehendEndpoints(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import "sync"
+
+// accountWideClaim - makes sure that a resource belonging to the whole account, rather than to a region, is only
+// returned by one of the regions discovered at the same time
+type accountWideClaim struct {
+	mutex   sync.Mutex
+	claimed bool
+	// The region holding the claim, when claimed with claimFor
+	region string
+}
+
+// isClaimed - Checks whether a region already returned the resource, so the others don't need to look for it
+func (claim *accountWideClaim) isClaimed() bool {
+	claim.mutex.Lock()
+	defer claim.mutex.Unlock()
+	return claim.claimed
+}
+
+// tryClaim - Claims the resource for the calling region. Returns false when another region claimed it first.
+func (claim *accountWideClaim) tryClaim() bool {
+	claim.mutex.Lock()
+	defer claim.mutex.Unlock()
+	if claim.claimed {
+		return false
+	}
+	claim.claimed = true
+	return true
+}
+
+// claimFor - Claims the resource for the given region, unless another region claimed it first. Keeps returning true
+// for the region holding the claim, so that the resource types sharing the claim are all found in that region.
+func (claim *accountWideClaim) claimFor(region string) bool {
+	claim.mutex.Lock()
+	defer claim.mutex.Unlock()
+	if !claim.claimed {
+		claim.claimed = true
+		claim.region = region
+	}
+	return claim.region == region
+}
+
+// regionFailure - the first error among the regions discovered at the same time
+type regionFailure struct {
+	mutex sync.Mutex
+	err   error
+}
+
+func (failure *regionFailure) set(err error) {
+	failure.mutex.Lock()
+	defer failure.mutex.Unlock()
+	if failure.err == nil {
+		failure.err = err
+	}
+}
+
+func (failure *regionFailure) get() error {
+	failure.mutex.Lock()
+	defer failure.mutex.Unlock()
+	return failure.err
+}
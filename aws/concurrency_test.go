@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountWideClaimFor(t *testing.T) {
+	t.Parallel()
+
+	claim := &accountWideClaim{}
+	assert.True(t, claim.claimFor("eu-west-1"))
+	// The resource types sharing the claim are all found in the region that claimed it first
+	assert.True(t, claim.claimFor("eu-west-1"))
+	assert.False(t, claim.claimFor("us-east-1"))
+	assert.True(t, claim.isClaimed())
+	assert.False(t, claim.tryClaim())
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// withContext - Binds all the calls made through the session to the given context, so that every resource type stops
+// making AWS API calls as soon as it is cancelled, e.g. on Ctrl+C: the calls in flight are aborted, and the calls made
+// afterwards fail right away with a RequestCanceled error instead of being sent.
+func withContext(session *session.Session, ctx context.Context) *session.Session {
+	session.Handlers.Validate.PushFront(func(r *request.Request) {
+		if ctx.Err() != nil {
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", ctx.Err())
+			return
+		}
+		r.SetContext(ctx)
+	})
+	return session
+}
+
+// isCanceled - Checks if the error is the one of a call aborted by the cancellation of its context
+func isCanceled(err error) bool {
+	awsErr, isAwsErr := err.(awserr.Error)
+	return isAwsErr && awsErr.Code() == request.CanceledErrorCode
+}
+
+// untilInterrupted - Runs the function, returning as soon as the context is cancelled rather than once it notices, e.g.
+// when it is sleeping between polls. The function carries on in the background, its calls failing right away.
+func untilInterrupted(ctx context.Context, run func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- run()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return interrupted(ctx)
+	}
+}
+
+// interrupted - Returns the error of a run whose context is done, telling whether it ran out of time
+func interrupted(ctx context.Context) InterruptedError {
+	return InterruptedError{OutOfTime: ctx.Err() == context.DeadlineExceeded}
+}
+
+// InterruptedError - returned when the context of a run is cancelled, e.g. on Ctrl+C, or passes its deadline, e.g.
+// --max-duration, before it completed
+type InterruptedError struct {
+	OutOfTime bool
+}
+
+func (e InterruptedError) Error() string {
+	if e.OutOfTime {
+		return "Ran out of time for the run, stopped making AWS API calls. The resources not nuked yet were left alone."
+	}
+	return "Interrupted, stopped making AWS API calls. The resources not nuked yet were left alone."
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithContextAbortsCallsOnceCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region:      awsgo.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	require.NoError(t, err)
+	withContext(session, ctx)
+
+	sent := false
+	session.Handlers.Send.PushFront(func(*request.Request) { sent = true })
+
+	_, err = sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	assert.True(t, isCanceled(err))
+	assert.False(t, sent)
+}
+
+func TestNukeBeforeInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	batch := []string{"i-0123456789abcdef0"}
+	assert.Equal(t, InterruptedError{}, nukeBefore(ctx, slowResources{nukeTime: time.Minute}, nil, batch, "us-east-1", time.Time{}, false))
+	assert.Equal(t, InterruptedError{}, untilInterrupted(ctx, func() error {
+		time.Sleep(time.Minute)
+		return nil
+	}))
+}
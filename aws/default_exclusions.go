@@ -0,0 +1,162 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// defaultExclusion - a set of resources of one type that are managed by AWS or part of the account defaults, and so
+// are never nuked, no matter the other filters
+type defaultExclusion struct {
+	// The cloud-nuke resource type the exclusion applies to
+	ResourceType string
+	// Why the resources are excluded, logged when they are skipped
+	Reason string
+	// Set when the exclusion is lifted for a resource type that is explicitly selected with --resource-type
+	LiftedWhenTargeted bool
+	// Returns the identifiers, among the given ones, that are covered by the exclusion
+	Excluded func(session *session.Session, identifiers []string) ([]string, error)
+}
+
+// The default exclusions shipped with cloud-nuke. They are enforced centrally on the output of the resource listing,
+// so resource types don't have to re-implement them.
+var defaultExclusions = []defaultExclusion{
+	{
+		ResourceType: SecurityGroups{}.ResourceName(),
+		Reason:       "default security groups can only be reset with the defaults-aws command",
+		Excluded:     getDefaultSecurityGroupIds,
+	},
+	{
+		ResourceType: KMSKeys{}.ResourceName(),
+		Reason:       "AWS managed keys are managed by AWS",
+		Excluded:     getAwsManagedKmsKeyIds,
+	},
+	{
+		ResourceType:       VPCs{}.ResourceName(),
+		Reason:             "default VPCs are only nuked when explicitly selected with --resource-type vpc",
+		LiftedWhenTargeted: true,
+		Excluded:           getDefaultVpcIds,
+	},
+}
+
+// excludedResources - the resources of a type that are left after applying the default exclusions, or deselecting
+// some of them
+type excludedResources struct {
+	AwsResources
+	identifiers []string
+}
+
+// ResourceIdentifiers - The identifiers that are not covered by any default exclusion
+func (resources excludedResources) ResourceIdentifiers() []string {
+	return resources.identifiers
+}
+
+// applyDefaultExclusions - Drops the resources covered by the given exclusions from the resources found in a region
+func applyDefaultExclusions(session *session.Session, resourcesInRegion AwsRegionResource, resourceTypes []string, exclusions []defaultExclusion) (AwsRegionResource, error) {
+	filtered := AwsRegionResource{}
+	for _, resources := range resourcesInRegion.Resources {
+		identifiers := resources.ResourceIdentifiers()
+		excludedAny := false
+
+		for _, exclusion := range exclusions {
+			if exclusion.ResourceType != resources.ResourceName() || len(identifiers) == 0 {
+				continue
+			}
+			if exclusion.LiftedWhenTargeted && IsExplicitlyNukeable(exclusion.ResourceType, resourceTypes) {
+				continue
+			}
+
+			excludedIdentifiers, err := exclusion.Excluded(session, identifiers)
+			if err != nil {
+				return AwsRegionResource{}, errors.WithStackTrace(err)
+			}
+
+			var remainingIdentifiers []string
+			for _, identifier := range identifiers {
+				if collections.ListContainsElement(excludedIdentifiers, identifier) {
+					logging.Logger.Infof("Skipping %s %s: %s", exclusion.ResourceType, identifier, exclusion.Reason)
+					excludedAny = true
+					continue
+				}
+				remainingIdentifiers = append(remainingIdentifiers, identifier)
+			}
+			identifiers = remainingIdentifiers
+		}
+
+		if excludedAny {
+			resources = excludedResources{AwsResources: resources, identifiers: identifiers}
+		}
+		filtered.Resources = append(filtered.Resources, resources)
+	}
+
+	return filtered, nil
+}
+
+// Returns the ids of the default security groups among the given security groups
+func getDefaultSecurityGroupIds(session *session.Session, groupIds []string) ([]string, error) {
+	svc := ec2.New(session)
+
+	result, err := svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: awsgo.StringSlice(groupIds),
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String("group-name"),
+				Values: []*string{awsgo.String("default")},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var defaultGroupIds []string
+	for _, securityGroup := range result.SecurityGroups {
+		defaultGroupIds = append(defaultGroupIds, awsgo.StringValue(securityGroup.GroupId))
+	}
+	return defaultGroupIds, nil
+}
+
+// Returns the ids of the AWS managed keys among the given KMS keys
+func getAwsManagedKmsKeyIds(session *session.Session, keyIds []string) ([]string, error) {
+	awsManagedKeyIds, err := getAwsManagedKmsKeySet(kms.New(session))
+	if err != nil {
+		return nil, err
+	}
+
+	var excludedKeyIds []string
+	for _, keyId := range keyIds {
+		if awsManagedKeyIds[keyId] {
+			excludedKeyIds = append(excludedKeyIds, keyId)
+		}
+	}
+	return excludedKeyIds, nil
+}
+
+// Returns the ids of the default VPCs among the given VPCs
+func getDefaultVpcIds(session *session.Session, vpcIds []string) ([]string, error) {
+	svc := ec2.New(session)
+
+	result, err := svc.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: awsgo.StringSlice(vpcIds),
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String("isDefault"),
+				Values: []*string{awsgo.String("true")},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var defaultVpcIds []string
+	for _, vpc := range result.Vpcs {
+		defaultVpcIds = append(defaultVpcIds, awsgo.StringValue(vpc.VpcId))
+	}
+	return defaultVpcIds, nil
+}
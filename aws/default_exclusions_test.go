@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Excludes the identifiers starting with "default-" without calling AWS
+func getFakeDefaultIdentifiers(session *session.Session, identifiers []string) ([]string, error) {
+	var defaultIdentifiers []string
+	for _, identifier := range identifiers {
+		if strings.HasPrefix(identifier, "default-") {
+			defaultIdentifiers = append(defaultIdentifiers, identifier)
+		}
+	}
+	return defaultIdentifiers, nil
+}
+
+func TestApplyDefaultExclusions(t *testing.T) {
+	t.Parallel()
+
+	exclusions := []defaultExclusion{
+		{ResourceType: VPCs{}.ResourceName(), Reason: "test", LiftedWhenTargeted: true, Excluded: getFakeDefaultIdentifiers},
+		{ResourceType: EBSVolumes{}.ResourceName(), Reason: "test", Excluded: getFakeDefaultIdentifiers},
+	}
+	resourcesInRegion := AwsRegionResource{
+		Resources: []AwsResources{
+			VPCs{VpcIds: []string{"default-vpc", "vpc-1"}},
+			EBSVolumes{VolumeIds: []string{"default-vol", "vol-1"}},
+			AMIs{ImageIds: []string{"default-ami", "ami-1"}},
+		},
+	}
+
+	testCases := []struct {
+		resourceTypes []string
+		expected      [][]string
+	}{
+		{nil, [][]string{{"vpc-1"}, {"vol-1"}, {"default-ami", "ami-1"}}},
+		{[]string{"all"}, [][]string{{"vpc-1"}, {"vol-1"}, {"default-ami", "ami-1"}}},
+		// Explicitly targeting vpc lifts the default VPC exclusion, but not the other ones
+		{[]string{"vpc", "ebs"}, [][]string{{"default-vpc", "vpc-1"}, {"vol-1"}, {"default-ami", "ami-1"}}},
+	}
+
+	for _, testCase := range testCases {
+		filtered, err := applyDefaultExclusions(nil, resourcesInRegion, testCase.resourceTypes, exclusions)
+		require.NoError(t, err)
+		require.Len(t, filtered.Resources, len(testCase.expected))
+
+		for i, resources := range filtered.Resources {
+			assert.Equal(t, resourcesInRegion.Resources[i].ResourceName(), resources.ResourceName())
+			assert.Equal(t, resourcesInRegion.Resources[i].MaxBatchSize(), resources.MaxBatchSize())
+			assert.Equal(t, testCase.expected[i], resources.ResourceIdentifiers())
+		}
+	}
+}
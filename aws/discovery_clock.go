@@ -0,0 +1,23 @@
+package aws
+
+import "time"
+
+// discoveryClock - measures how long discovering each resource type in a region takes. The resource types are
+// discovered one after the other, so each of them is timed from when the previous one was discovered. The types that
+// aren't selected are skipped right away, and don't need to be timed.
+type discoveryClock struct {
+	now      func() time.Time
+	lappedAt time.Time
+	times    map[string]time.Duration
+}
+
+func newDiscoveryClock() *discoveryClock {
+	return &discoveryClock{now: time.Now, lappedAt: time.Now(), times: map[string]time.Duration{}}
+}
+
+// lap - Records that the resources of the given type were just discovered
+func (clock *discoveryClock) lap(resourceType string) {
+	lappedAt := clock.now()
+	clock.times[resourceType] += lappedAt.Sub(clock.lappedAt)
+	clock.lappedAt = lappedAt
+}
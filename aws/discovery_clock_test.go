@@ -0,0 +1,22 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoveryClock(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &discoveryClock{now: func() time.Time { return now }, lappedAt: now, times: map[string]time.Duration{}}
+
+	now = now.Add(3 * time.Second)
+	clock.lap("ec2")
+	now = now.Add(time.Second)
+	clock.lap("ebs")
+
+	assert.Equal(t, map[string]time.Duration{"ec2": 3 * time.Second, "ebs": time.Second}, clock.times)
+}
@@ -1,27 +1,56 @@
 package aws
 
 import (
+	"fmt"
 	"time"
 
+	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
-// Returns a formatted string of EBS volume ids
-func getAllEbsVolumes(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+// The tags of the final snapshots taken of EBS volumes before they are nuked
+const (
+	finalSnapshotRunIdTagKey       = "cloud-nuke-run-id"
+	finalSnapshotVolumeTagKey      = "cloud-nuke-final-snapshot-of"
+	finalSnapshotRetainUntilTagKey = "cloud-nuke-retain-until"
+)
+
+// How many times to poll for a final snapshot to complete, every 15 seconds, before giving up on the volume
+const finalSnapshotMaxAttempts = 240
+
+// EBSConfig - what to do with EBS volumes before they are nuked
+type EBSConfig struct {
+	// Take a snapshot of each volume right before deleting it
+	FinalSnapshot bool
+	// The id of the run, tagged on the final snapshots
+	RunID string
+	// How many days to keep the final snapshots for. Until then, they are left alone by the Snapshots resource type.
+	RetentionDays int
+}
+
+// Returns a formatted string of EBS volume ids. Only the volumes in the given availability zones are returned, unless
+// none are given.
+func getAllEbsVolumes(session *session.Session, region string, timeFilter TimeFilter, availabilityZones []string) ([]*string, error) {
 	svc := ec2.New(session)
 
-	result, err := svc.DescribeVolumes(&ec2.DescribeVolumesInput{})
+	params := &ec2.DescribeVolumesInput{}
+	if len(availabilityZones) > 0 {
+		params.Filters = []*ec2.Filter{availabilityZoneFilter(availabilityZones)}
+	}
+
+	result, err := svc.DescribeVolumes(params)
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
 
 	var volumeIds []*string
 	for _, volume := range result.Volumes {
-		if excludeAfter.After(*volume.CreateTime) {
+		if timeFilter.IncludesResource(volume.VolumeId, *volume.CreateTime) {
 			volumeIds = append(volumeIds, volume.VolumeId)
 		}
 	}
@@ -29,8 +58,48 @@ func getAllEbsVolumes(session *session.Session, region string, excludeAfter time
 	return volumeIds, nil
 }
 
-// Deletes all EBS Volumes
-func nukeAllEbsVolumes(session *session.Session, volumeIds []*string) error {
+// takeFinalSnapshots takes a snapshot of each volume, and waits for them to complete. Returns the ids of the volumes
+// that are safe to delete, i.e. the ones that were snapshotted. Volumes that failed to be snapshotted are left alone.
+func takeFinalSnapshots(svc *ec2.EC2, config EBSConfig, volumeIds []*string) []*string {
+	retainUntil := time.Now().UTC().AddDate(0, 0, config.RetentionDays).Format(time.RFC3339)
+
+	var snapshottedVolumeIds []*string
+	for _, volumeId := range volumeIds {
+		result, err := svc.CreateSnapshot(&ec2.CreateSnapshotInput{
+			VolumeId:    volumeId,
+			Description: awsgo.String(fmt.Sprintf("Final snapshot of %s taken by cloud-nuke", *volumeId)),
+			TagSpecifications: []*ec2.TagSpecification{
+				{
+					ResourceType: awsgo.String(ec2.ResourceTypeSnapshot),
+					Tags: []*ec2.Tag{
+						{Key: awsgo.String(finalSnapshotRunIdTagKey), Value: awsgo.String(config.RunID)},
+						{Key: awsgo.String(finalSnapshotVolumeTagKey), Value: volumeId},
+						{Key: awsgo.String(finalSnapshotRetainUntilTagKey), Value: awsgo.String(retainUntil)},
+					},
+				},
+			},
+		})
+		if err == nil {
+			err = svc.WaitUntilSnapshotCompletedWithContext(
+				awsgo.BackgroundContext(),
+				&ec2.DescribeSnapshotsInput{SnapshotIds: []*string{result.SnapshotId}},
+				request.WithWaiterMaxAttempts(finalSnapshotMaxAttempts),
+			)
+		}
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Final snapshot of EBS volume %s not taken, so it won't be nuked: %s", *volumeId, err)
+			continue
+		}
+
+		logging.Logger.Infof("Took final snapshot %s of EBS volume %s", *result.SnapshotId, *volumeId)
+		snapshottedVolumeIds = append(snapshottedVolumeIds, volumeId)
+	}
+
+	return snapshottedVolumeIds
+}
+
+// Deletes all EBS Volumes, taking a final snapshot of each of them first when the config says so
+func nukeAllEbsVolumes(session *session.Session, config EBSConfig, volumeIds []*string) error {
 	svc := ec2.New(session)
 
 	if len(volumeIds) == 0 {
@@ -38,6 +107,11 @@ func nukeAllEbsVolumes(session *session.Session, volumeIds []*string) error {
 		return nil
 	}
 
+	if config.FinalSnapshot {
+		logging.Logger.Infof("Taking final snapshots of all EBS volumes in region %s", *session.Config.Region)
+		volumeIds = takeFinalSnapshots(svc, config, volumeIds)
+	}
+
 	logging.Logger.Infof("Deleting all EBS volumes in region %s", *session.Config.Region)
 	var deletedVolumeIDs []*string
 
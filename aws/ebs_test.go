@@ -99,16 +99,16 @@ func TestListEBSVolumes(t *testing.T) {
 	az := awsgo.StringValue(session.Config.Region) + "a"
 	volume := createTestEBSVolume(t, session, uniqueTestID, az)
 	// clean up after this test
-	defer nukeAllEbsVolumes(session, []*string{volume.VolumeId})
+	defer nukeAllEbsVolumes(session, EBSConfig{}, []*string{volume.VolumeId})
 
-	volumeIds, err := getAllEbsVolumes(session, region, time.Now().Add(1*time.Hour*-1))
+	volumeIds, err := getAllEbsVolumes(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EBS Volumes")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(volumeIds), awsgo.StringValue(volume.VolumeId))
 
-	volumeIds, err = getAllEbsVolumes(session, region, time.Now().Add(1*time.Hour))
+	volumeIds, err = getAllEbsVolumes(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EBS Volumes")
 	}
@@ -141,11 +141,11 @@ func TestNukeEBSVolumes(t *testing.T) {
 	assert.Len(t, volumeIds, 1)
 	assert.Equal(t, awsgo.StringValue(volume.VolumeId), awsgo.StringValue(volumeIds[0]))
 
-	if err := nukeAllEbsVolumes(session, volumeIds); err != nil {
+	if err := nukeAllEbsVolumes(session, EBSConfig{}, volumeIds); err != nil {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	volumeIds, err = getAllEbsVolumes(session, region, time.Now().Add(1*time.Hour))
+	volumeIds, err = getAllEbsVolumes(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EBS Volumes")
 	}
@@ -176,7 +176,7 @@ func TestNukeEBSVolumesInUse(t *testing.T) {
 	az := getAZFromSubnet(t, session, instance.SubnetId)
 	volume := createTestEBSVolume(t, session, uniqueTestID, az)
 
-	defer nukeAllEbsVolumes(session, []*string{volume.VolumeId})
+	defer nukeAllEbsVolumes(session, EBSConfig{}, []*string{volume.VolumeId})
 	defer nukeAllEc2Instances(session, []*string{instance.InstanceId})
 
 	// attach volume to protected instance
@@ -203,11 +203,11 @@ func TestNukeEBSVolumesInUse(t *testing.T) {
 	assert.Len(t, volumeIds, 1)
 	assert.Equal(t, awsgo.StringValue(volume.VolumeId), awsgo.StringValue(volumeIds[0]))
 
-	if err := nukeAllEbsVolumes(session, volumeIds); err != nil {
+	if err := nukeAllEbsVolumes(session, EBSConfig{}, volumeIds); err != nil {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	volumeIds, err = getAllEbsVolumes(session, region, time.Now().Add(1*time.Hour))
+	volumeIds, err = getAllEbsVolumes(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EBS Volumes")
 	}
@@ -6,9 +6,29 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: EBSVolumes{},
+		weight:   1300,
+		actions:  []string{"ec2:CreateSnapshot", "ec2:DeleteVolume"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			volumeIds, err := getAllEbsVolumes(discovery.session, discovery.region, discovery.timeFilter, discovery.options.AvailabilityZones)
+			if err != nil {
+				return nil, err
+			}
+			return EBSVolumes{Config: discovery.options.EBS, VolumeIds: awsgo.StringValueSlice(volumeIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return EBSVolumes{VolumeIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // EBSVolumes - represents all ebs volumes
 type EBSVolumes struct {
 	VolumeIds []string
+	// Whether to take a final snapshot of the volumes before they are nuked
+	Config EBSConfig
 }
 
 // ResourceName - the simple name of the aws resource
@@ -28,7 +48,7 @@ func (volume EBSVolumes) MaxBatchSize() int {
 
 // Nuke - nuke 'em all!!!
 func (volume EBSVolumes) Nuke(session *session.Session, identifiers []string) error {
-	if err := nukeAllEbsVolumes(session, awsgo.StringSlice(identifiers)); err != nil {
+	if err := nukeAllEbsVolumes(session, volume.Config, awsgo.StringSlice(identifiers)); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
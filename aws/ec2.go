@@ -2,23 +2,55 @@ package aws
 
 import (
 	"fmt"
-	"time"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
-// returns only instance Ids of unprotected ec2 instances
-func filterOutProtectedInstances(svc *ec2.EC2, output *ec2.DescribeInstancesOutput, excludeAfter time.Time) ([]*string, error) {
+// EC2Config - how EC2 instances are nuked
+type EC2Config struct {
+	// Stop the instances rather than terminate them, keeping their volumes and data, e.g. to cut the costs of a
+	// sandbox account overnight. Only the running instances are nuked then.
+	StopOnly bool
+	// Disable the termination protection of the instances having it enabled right before terminating them, rather
+	// than leaving them alone
+	DisableTerminationProtection bool
+}
+
+// ec2InstanceStates - Returns the states of the instances to nuke
+func ec2InstanceStates(config EC2Config) []*string {
+	if config.StopOnly {
+		return awsgo.StringSlice([]string{ec2.InstanceStateNameRunning, ec2.InstanceStateNamePending})
+	}
+	return awsgo.StringSlice([]string{
+		ec2.InstanceStateNameRunning, ec2.InstanceStateNamePending,
+		ec2.InstanceStateNameStopped, ec2.InstanceStateNameStopping,
+	})
+}
+
+// returns the instance Ids of the ec2 instances to nuke, along with the ones having termination protection enabled.
+// The protected instances are only nuked when their termination protection is to be disabled, and are logged as
+// skipped otherwise. Termination protection doesn't prevent stopping instances, so it isn't looked up when only
+// stopping them.
+func filterOutProtectedInstances(svc *ec2.EC2, output *ec2.DescribeInstancesOutput, timeFilter TimeFilter, config EC2Config) ([]*string, []*string, error) {
 	var filteredIds []*string
+	var protectedIds []*string
 	for _, reservation := range output.Reservations {
 		for _, instance := range reservation.Instances {
 			instanceID := *instance.InstanceId
+			if !timeFilter.IncludesResource(&instanceID, *instance.LaunchTime) {
+				continue
+			}
+			if config.StopOnly {
+				filteredIds = append(filteredIds, &instanceID)
+				continue
+			}
 
 			attr, err := svc.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
 				Attribute:  awsgo.String("disableApiTermination"),
@@ -26,49 +58,52 @@ func filterOutProtectedInstances(svc *ec2.EC2, output *ec2.DescribeInstancesOutp
 			})
 
 			if err != nil {
-				return nil, errors.WithStackTrace(err)
+				return nil, nil, errors.WithStackTrace(err)
 			}
 
-			protected := *attr.DisableApiTermination.Value
-			// Exclude protected EC2 instances
-			if !protected {
-				if excludeAfter.After(*instance.LaunchTime) {
-					filteredIds = append(filteredIds, &instanceID)
+			if *attr.DisableApiTermination.Value {
+				protectedIds = append(protectedIds, &instanceID)
+				// Exclude protected EC2 instances
+				if !config.DisableTerminationProtection {
+					logging.Logger.Infof("Skipping EC2 instance %s: termination protection is enabled. Use --ec2-disable-termination-protection to nuke it anyway.", instanceID)
+					continue
 				}
 			}
+			filteredIds = append(filteredIds, &instanceID)
 		}
 	}
 
-	return filteredIds, nil
+	return filteredIds, protectedIds, nil
 }
 
-// Returns a formatted string of EC2 instance ids
-func getAllEc2Instances(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+// Returns a formatted string of EC2 instance ids, along with the ids of the instances having termination protection
+// enabled. Only the instances in the given availability zones are returned, unless none are given.
+func getAllEc2Instances(session *session.Session, region string, timeFilter TimeFilter, availabilityZones []string, config EC2Config) ([]*string, []*string, error) {
 	svc := ec2.New(session)
 
 	params := &ec2.DescribeInstancesInput{
 		Filters: []*ec2.Filter{
 			&ec2.Filter{
-				Name: awsgo.String("instance-state-name"),
-				Values: []*string{
-					awsgo.String("running"), awsgo.String("pending"),
-					awsgo.String("stopped"), awsgo.String("stopping"),
-				},
+				Name:   awsgo.String("instance-state-name"),
+				Values: ec2InstanceStates(config),
 			},
 		},
 	}
+	if len(availabilityZones) > 0 {
+		params.Filters = append(params.Filters, availabilityZoneFilter(availabilityZones))
+	}
 
 	output, err := svc.DescribeInstances(params)
 	if err != nil {
-		return nil, errors.WithStackTrace(err)
+		return nil, nil, errors.WithStackTrace(err)
 	}
 
-	instanceIds, err := filterOutProtectedInstances(svc, output, excludeAfter)
+	instanceIds, protectedIds, err := filterOutProtectedInstances(svc, output, timeFilter, config)
 	if err != nil {
-		return nil, errors.WithStackTrace(err)
+		return nil, nil, errors.WithStackTrace(err)
 	}
 
-	return instanceIds, nil
+	return instanceIds, protectedIds, nil
 }
 
 // Deletes all non protected EC2 instances
@@ -114,6 +149,59 @@ func nukeAllEc2Instances(session *session.Session, instanceIds []*string) error
 	return nil
 }
 
+// Disables the termination protection of the given EC2 instances, so that they can be terminated
+func disableEc2TerminationProtection(session *session.Session, instanceIds []*string) error {
+	svc := ec2.New(session)
+
+	for _, instanceID := range instanceIds {
+		_, err := svc.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+			InstanceId:            instanceID,
+			DisableApiTermination: &ec2.AttributeBooleanValue{Value: awsgo.Bool(false)},
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	logging.Logger.Infof("Disabled the termination protection of %d instance(s) in %s", len(instanceIds), *session.Config.Region)
+	return nil
+}
+
+// Stops all the given EC2 instances, leaving their volumes alone
+func stopAllEc2Instances(session *session.Session, instanceIds []*string) error {
+	svc := ec2.New(session)
+
+	if len(instanceIds) == 0 {
+		logging.Logger.Infof("No EC2 instances to stop in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Stopping all EC2 instances in region %s", *session.Config.Region)
+
+	_, err := svc.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: instanceIds,
+	})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	err = svc.WaitUntilInstanceStopped(&ec2.DescribeInstancesInput{
+		InstanceIds: instanceIds,
+	})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	for _, instanceID := range instanceIds {
+		logging.Logger.Infof("Stopped EC2 Instance: %s", *instanceID)
+	}
+	logging.Logger.Infof("[OK] %d instance(s) stopped in %s", len(instanceIds), *session.Config.Region)
+	return nil
+}
+
 func GetEc2ServiceClient(region string) ec2iface.EC2API {
 	return ec2.New(newSession(region))
 }
@@ -122,6 +210,8 @@ type Vpc struct {
 	Region string
 	VpcId  string
 	svc    ec2iface.EC2API
+	// Only set for the VPCs nuked along with the resources they contain
+	lambdaSvc lambdaiface.LambdaAPI
 }
 
 // NewVpcPerRegion merely assigns a service client and region to a VPC object
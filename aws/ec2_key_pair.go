@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns the names of all EC2 key pairs that match the name filter. Only key pairs created recently expose their
+// creation time, so the older ones are tagged with the time cloud-nuke first saw them instead.
+func getAllEC2KeyPairs(session *session.Session, timeFilter TimeFilter, nameFilter NameFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	result, err := svc.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, keyPair := range result.KeyPairs {
+		if !nameFilter.Matches(*keyPair.KeyName) {
+			continue
+		}
+
+		createdAt := keyPair.CreateTime
+		// Only the key pairs created before AWS started recording creation times are told apart by when they were
+		// first seen
+		firstSeen := createdAt == nil
+		if firstSeen {
+			createdAt, err = getFirstSeenTimeFromTags(keyPair.Tags, firstSeenTagKey, firstSeenTagLayout)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+		}
+		if createdAt == nil {
+			now := time.Now().UTC()
+			createdAt = &now
+			if err := setFirstSeenResourceTag(svc, keyPair.KeyPairId, firstSeenTagKey, now, firstSeenTagLayout); err != nil {
+				return nil, err
+			}
+		}
+
+		var included bool
+		if firstSeen {
+			included = timeFilter.IncludesFirstSeenResource(keyPair.KeyName, *createdAt)
+		} else {
+			included = timeFilter.IncludesResource(keyPair.KeyName, *createdAt)
+		}
+		if included {
+			names = append(names, keyPair.KeyName)
+		}
+	}
+
+	return names, nil
+}
+
+// Deletes all EC2 key pairs
+func nukeAllEC2KeyPairs(session *session.Session, names []*string) error {
+	svc := ec2.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No EC2 key pairs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all EC2 key pairs in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteKeyPair(&ec2.DeleteKeyPairInput{
+			KeyName: name,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidKeyPair.NotFound" {
+				logging.Logger.Infof("EC2 key pair %s has already been deleted", *name)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted EC2 key pair: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d EC2 key pair(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
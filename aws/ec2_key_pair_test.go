@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestEC2KeyPair(t *testing.T, session *session.Session, name string) string {
+	svc := ec2.New(session)
+	_, err := svc.CreateKeyPair(&ec2.CreateKeyPairInput{
+		KeyName: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListEC2KeyPairs(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestEC2KeyPair(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllEC2KeyPairs(session, []*string{awsgo.String(name)})
+
+	names, err := getAllEC2KeyPairs(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)}, NameFilter{})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 key pairs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+
+	names, err = getAllEC2KeyPairs(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, NameFilter{})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 key pairs")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(names), name)
+
+	excludeTestKeyPairs := NameFilter{Exclude: []*regexp.Regexp{regexp.MustCompile(`^cloud-nuke-test-`)}}
+	names, err = getAllEC2KeyPairs(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, excludeTestKeyPairs)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 key pairs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
+
+func TestNukeEC2KeyPairs(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestEC2KeyPair(t, session, uniqueTestID)
+
+	if err := nukeAllEC2KeyPairs(session, []*string{awsgo.String(name)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	names, err := getAllEC2KeyPairs(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, NameFilter{})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 key pairs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
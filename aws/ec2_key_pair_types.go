@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: EC2KeyPairs{},
+		weight:   1600,
+		actions:  []string{"ec2:CreateTags", "ec2:DeleteKeyPair"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllEC2KeyPairs(discovery.session, discovery.timeFilter, discovery.options.KeyPairNames)
+			if err != nil {
+				return nil, err
+			}
+			return EC2KeyPairs{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// EC2KeyPairs - represents all EC2 key pairs
+type EC2KeyPairs struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (keyPairs EC2KeyPairs) ResourceName() string {
+	return "ec2keypair"
+}
+
+// ResourceIdentifiers - The names of the EC2 key pairs
+func (keyPairs EC2KeyPairs) ResourceIdentifiers() []string {
+	return keyPairs.Names
+}
+
+func (keyPairs EC2KeyPairs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (keyPairs EC2KeyPairs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEC2KeyPairs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -14,21 +14,26 @@ import (
 )
 
 const (
-	ExampleId                   = "a1b2c3d4e5f601345"
-	ExampleIdTwo                = "a1b2c3d4e5f654321"
-	ExampleIdThree              = "a1b2c3d4e5f632154"
-	ExampleVpcId                = "vpc-" + ExampleId
-	ExampleVpcIdTwo             = "vpc-" + ExampleIdTwo
-	ExampleVpcIdThree           = "vpc-" + ExampleIdThree
-	ExampleSubnetId             = "subnet-" + ExampleId
-	ExampleSubnetIdTwo          = "subnet-" + ExampleIdTwo
-	ExampleSubnetIdThree        = "subnet-" + ExampleIdThree
-	ExampleRouteTableId         = "rtb-" + ExampleId
-	ExampleNetworkAclId         = "acl-" + ExampleId
-	ExampleSecurityGroupId      = "sg-" + ExampleId
-	ExampleSecurityGroupIdTwo   = "sg-" + ExampleIdTwo
-	ExampleSecurityGroupIdThree = "sg-" + ExampleIdThree
-	ExampleInternetGatewayId    = "igw-" + ExampleId
+	ExampleId                    = "a1b2c3d4e5f601345"
+	ExampleIdTwo                 = "a1b2c3d4e5f654321"
+	ExampleIdThree               = "a1b2c3d4e5f632154"
+	ExampleVpcId                 = "vpc-" + ExampleId
+	ExampleVpcIdTwo              = "vpc-" + ExampleIdTwo
+	ExampleVpcIdThree            = "vpc-" + ExampleIdThree
+	ExampleSubnetId              = "subnet-" + ExampleId
+	ExampleSubnetIdTwo           = "subnet-" + ExampleIdTwo
+	ExampleSubnetIdThree         = "subnet-" + ExampleIdThree
+	ExampleRouteTableId          = "rtb-" + ExampleId
+	ExampleNetworkAclId          = "acl-" + ExampleId
+	ExampleSecurityGroupId       = "sg-" + ExampleId
+	ExampleSecurityGroupIdTwo    = "sg-" + ExampleIdTwo
+	ExampleSecurityGroupIdThree  = "sg-" + ExampleIdThree
+	ExampleInternetGatewayId     = "igw-" + ExampleId
+	ExamplePeeringConnectionId   = "pcx-" + ExampleId
+	ExampleVpcEndpointId         = "vpce-" + ExampleId
+	ExampleNatGatewayId          = "nat-" + ExampleId
+	ExampleNetworkInterfaceId    = "eni-" + ExampleId
+	ExampleNetworkInterfaceIdTwo = "eni-" + ExampleIdTwo
 )
 
 // getAMIIdByName - Retrieves an AMI ImageId given the name of the Id. Used for
@@ -193,7 +198,7 @@ func TestListInstances(t *testing.T) {
 	// clean up after this test
 	defer nukeAllEc2Instances(session, []*string{instance.InstanceId, protectedInstance.InstanceId})
 
-	instanceIds, err := getAllEc2Instances(session, region, time.Now().Add(1*time.Hour*-1))
+	instanceIds, _, err := getAllEc2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)}, nil, EC2Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EC2 Instances")
 	}
@@ -201,13 +206,31 @@ func TestListInstances(t *testing.T) {
 	assert.NotContains(t, instanceIds, instance.InstanceId)
 	assert.NotContains(t, instanceIds, protectedInstance.InstanceId)
 
-	instanceIds, err = getAllEc2Instances(session, region, time.Now().Add(1*time.Hour))
+	instanceIds, protectedIds, err := getAllEc2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil, EC2Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EC2 Instances")
 	}
 
 	assert.Contains(t, instanceIds, instance.InstanceId)
 	assert.NotContains(t, instanceIds, protectedInstance.InstanceId)
+	assert.Contains(t, protectedIds, protectedInstance.InstanceId)
+	assert.NotContains(t, protectedIds, instance.InstanceId)
+
+	instanceIds, _, err = getAllEc2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil, EC2Config{DisableTerminationProtection: true})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 Instances")
+	}
+
+	assert.Contains(t, instanceIds, instance.InstanceId)
+	assert.Contains(t, instanceIds, protectedInstance.InstanceId)
+
+	// Stopping instances isn't prevented by termination protection
+	instanceIds, _, err = getAllEc2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil, EC2Config{StopOnly: true})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 Instances")
+	}
+
+	assert.Contains(t, instanceIds, protectedInstance.InstanceId)
 
 	if err = removeEC2InstanceProtection(ec2.New(session), &protectedInstance); err != nil {
 		assert.Fail(t, gruntworkerrors.WithStackTrace(err).Error())
@@ -238,7 +261,7 @@ func TestNukeInstances(t *testing.T) {
 	if err := nukeAllEc2Instances(session, instanceIds); err != nil {
 		assert.Fail(t, gruntworkerrors.WithStackTrace(err).Error())
 	}
-	instances, err := getAllEc2Instances(session, region, time.Now().Add(1*time.Hour))
+	instances, _, err := getAllEc2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil, EC2Config{})
 
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of EC2 Instances")
@@ -248,3 +271,62 @@ func TestNukeInstances(t *testing.T) {
 		assert.NotContains(t, instances, *instanceID)
 	}
 }
+
+func TestNukeProtectedInstances(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, gruntworkerrors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, gruntworkerrors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	protectedInstance := createTestEC2Instance(t, session, uniqueTestID, true)
+
+	instances := EC2Instances{Config: EC2Config{DisableTerminationProtection: true}, ProtectedIds: []string{*protectedInstance.InstanceId}}
+	if err := instances.Nuke(session, []string{*protectedInstance.InstanceId}); err != nil {
+		assert.Fail(t, gruntworkerrors.WithStackTrace(err).Error())
+	}
+	instanceIds, _, err := getAllEc2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}, nil, EC2Config{DisableTerminationProtection: true})
+
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EC2 Instances")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(instanceIds), *protectedInstance.InstanceId)
+}
+
+func TestEc2InstanceStates(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"running", "pending", "stopped", "stopping"}, awsgo.StringValueSlice(ec2InstanceStates(EC2Config{})))
+	// The instances already stopped, or being stopped, are left alone when only stopping instances
+	assert.Equal(t, []string{"running", "pending"}, awsgo.StringValueSlice(ec2InstanceStates(EC2Config{StopOnly: true})))
+}
+
+func TestEc2SkippedResources(t *testing.T) {
+	t.Parallel()
+
+	instances := EC2Instances{InstanceIds: []string{"i-0123456789abcdef0"}, ProtectedIds: []string{"i-0fedcba9876543210"}}
+	assert.Equal(t, []SkippedResource{
+		{ResourceType: "ec2", Identifier: "i-0fedcba9876543210", Reason: "termination protection is enabled"},
+	}, instances.SkippedResources())
+
+	// Once their termination protection is to be disabled, the protected instances are nuked along with the others
+	instances = EC2Instances{
+		Config:       EC2Config{DisableTerminationProtection: true},
+		InstanceIds:  []string{"i-0123456789abcdef0", "i-0fedcba9876543210"},
+		ProtectedIds: []string{"i-0fedcba9876543210"},
+	}
+	assert.Empty(t, instances.SkippedResources())
+	assert.Equal(t, []string{"i-0fedcba9876543210"}, instances.protectedAmong(instances.InstanceIds))
+	assert.Empty(t, instances.protectedAmong([]string{"i-0123456789abcdef0"}))
+}
@@ -6,9 +6,35 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: EC2Instances{},
+		weight:   1200,
+		actions:  []string{"ec2:ModifyInstanceAttribute", "ec2:StopInstances", "ec2:TerminateInstances"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			instanceIds, protectedIds, err := getAllEc2Instances(discovery.session, discovery.region, discovery.timeFilter, discovery.options.AvailabilityZones, discovery.options.EC2)
+			if err != nil {
+				return nil, err
+			}
+			return EC2Instances{
+				Config:       discovery.options.EC2,
+				InstanceIds:  awsgo.StringValueSlice(instanceIds),
+				ProtectedIds: awsgo.StringValueSlice(protectedIds),
+			}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return EC2Instances{InstanceIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // EC2Instances - represents all ec2 instances
 type EC2Instances struct {
+	Config      EC2Config
 	InstanceIds []string
+	// The instances found with termination protection enabled. Their termination protection is disabled right before
+	// terminating them when configured to, and they are left alone otherwise.
+	ProtectedIds []string
 }
 
 // ResourceName - the simple name of the aws resource
@@ -26,11 +52,59 @@ func (instance EC2Instances) MaxBatchSize() int {
 	return 200
 }
 
-// Nuke - nuke 'em all!!!
+// Nuke - nuke 'em all!!! The instances are only stopped, or have their termination protection disabled first, when
+// configured to.
 func (instance EC2Instances) Nuke(session *session.Session, identifiers []string) error {
+	if instance.Config.StopOnly {
+		if err := stopAllEc2Instances(session, awsgo.StringSlice(identifiers)); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		return nil
+	}
+
+	// Only the instances found protected have their termination protection disabled, rather than all of them
+	if instance.Config.DisableTerminationProtection {
+		if protectedIds := instance.protectedAmong(identifiers); len(protectedIds) > 0 {
+			if err := disableEc2TerminationProtection(session, awsgo.StringSlice(protectedIds)); err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+	}
 	if err := nukeAllEc2Instances(session, awsgo.StringSlice(identifiers)); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
 	return nil
 }
+
+// SkippedResources - The instances left alone as their termination protection is enabled. Once it is to be disabled,
+// they are nuked along with the others.
+func (instance EC2Instances) SkippedResources() []SkippedResource {
+	if instance.Config.DisableTerminationProtection {
+		return nil
+	}
+	var skipped []SkippedResource
+	for _, instanceID := range instance.ProtectedIds {
+		skipped = append(skipped, SkippedResource{
+			ResourceType: instance.ResourceName(),
+			Identifier:   instanceID,
+			Reason:       "termination protection is enabled",
+		})
+	}
+	return skipped
+}
+
+// protectedAmong - Returns the instances among the given ones that were found with termination protection enabled
+func (instance EC2Instances) protectedAmong(identifiers []string) []string {
+	protected := map[string]bool{}
+	for _, instanceID := range instance.ProtectedIds {
+		protected[instanceID] = true
+	}
+	var protectedIds []string
+	for _, instanceID := range identifiers {
+		if protected[instanceID] {
+			protectedIds = append(protectedIds, instanceID)
+		}
+	}
+	return protectedIds
+}
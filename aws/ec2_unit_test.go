@@ -228,6 +228,127 @@ func TestNukeDefaultSecurityGroups(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNukeNonDefaultVpc(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	vpc := Vpc{
+		Region: "ap-southeast-1",
+		VpcId:  ExampleVpcId,
+		svc:    mockEC2,
+	}
+
+	describePeeringConnectionsOutput := &ec2.DescribeVpcPeeringConnectionsOutput{
+		VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+			{VpcPeeringConnectionId: awsgo.String(ExamplePeeringConnectionId)},
+		},
+	}
+	describeEndpointsOutput := &ec2.DescribeVpcEndpointsOutput{
+		VpcEndpoints: []*ec2.VpcEndpoint{
+			{VpcEndpointId: awsgo.String(ExampleVpcEndpointId), State: awsgo.String("available")},
+		},
+	}
+	describeEndpointsOutputDeleted := &ec2.DescribeVpcEndpointsOutput{
+		VpcEndpoints: []*ec2.VpcEndpoint{
+			{VpcEndpointId: awsgo.String(ExampleVpcEndpointId), State: awsgo.String("deleted")},
+		},
+	}
+	// Only the network interface of the endpoint is left in the VPC, which goes away along with the endpoint
+	describeNetworkInterfacesOutput := &ec2.DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []*ec2.NetworkInterface{
+			{
+				NetworkInterfaceId: awsgo.String(ExampleNetworkInterfaceId),
+				InterfaceType:      awsgo.String(ec2.NetworkInterfaceTypeVpcEndpoint),
+				Status:             awsgo.String("in-use"),
+			},
+		},
+	}
+
+	// The peering connection shows up on both the requester and the accepter side, but is only deleted once
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeNetworkInterfacesPages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(input *ec2.DescribeNetworkInterfacesInput, fn func(*ec2.DescribeNetworkInterfacesOutput, bool) bool) error {
+				fn(describeNetworkInterfacesOutput, true)
+				return nil
+			},
+		),
+		mockEC2.EXPECT().DescribeVpcPeeringConnections(gomock.Any()).Return(describePeeringConnectionsOutput, nil),
+		mockEC2.EXPECT().DescribeVpcPeeringConnections(gomock.Any()).Return(describePeeringConnectionsOutput, nil),
+		mockEC2.EXPECT().DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
+			VpcPeeringConnectionId: awsgo.String(ExamplePeeringConnectionId),
+		}),
+		mockEC2.EXPECT().DescribeVpcEndpoints(gomock.Any()).Return(describeEndpointsOutput, nil),
+		mockEC2.EXPECT().DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: []*string{awsgo.String(ExampleVpcEndpointId)},
+		}),
+		mockEC2.EXPECT().DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			VpcEndpointIds: []*string{awsgo.String(ExampleVpcEndpointId)},
+		}).Return(describeEndpointsOutputDeleted, nil),
+		// No Lambda function was attached to the VPC, so there are no network interfaces to wait for AWS to reclaim
+		mockEC2.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil),
+		mockEC2.EXPECT().DescribeInternetGateways(getDescribeInternetGatewaysInput(vpc.VpcId)).Return(&ec2.DescribeInternetGatewaysOutput{}, nil),
+		mockEC2.EXPECT().DescribeSubnets(getDescribeSubnetsInput(vpc.VpcId)).Return(getDescribeSubnetsOutput([]string{ExampleSubnetId}), nil),
+		mockEC2.EXPECT().DeleteSubnet(getDeleteSubnetInput(ExampleSubnetId)),
+		mockEC2.EXPECT().DescribeRouteTables(getDescribeRouteTablesInput(vpc.VpcId)).Return(&ec2.DescribeRouteTablesOutput{}, nil),
+		mockEC2.EXPECT().DescribeNetworkAcls(getDescribeNetworkAclsInput(vpc.VpcId)).Return(&ec2.DescribeNetworkAclsOutput{}, nil),
+		mockEC2.EXPECT().DescribeSecurityGroups(getDescribeSecurityGroupsInput(vpc.VpcId)).Return(&ec2.DescribeSecurityGroupsOutput{}, nil),
+		mockEC2.EXPECT().DeleteVpc(getDeleteVpcInput(vpc.VpcId)),
+	)
+
+	err := vpc.nukeNonDefault()
+	require.NoError(t, err)
+}
+
+func TestNukeNonDefaultVpcWithDependents(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	vpc := Vpc{
+		Region: "ap-southeast-1",
+		VpcId:  ExampleVpcId,
+		svc:    mockEC2,
+	}
+
+	// An instance cloud-nuke kept, e.g. because it's protected, and a NAT gateway left by the natgateway resource type
+	describeNetworkInterfacesOutput := &ec2.DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []*ec2.NetworkInterface{
+			{
+				NetworkInterfaceId: awsgo.String(ExampleNetworkInterfaceId),
+				InterfaceType:      awsgo.String("interface"),
+				Status:             awsgo.String("in-use"),
+				Attachment:         &ec2.NetworkInterfaceAttachment{InstanceId: awsgo.String("i-" + ExampleId)},
+			},
+			{
+				NetworkInterfaceId: awsgo.String(ExampleNetworkInterfaceIdTwo),
+				InterfaceType:      awsgo.String("nat_gateway"),
+				Status:             awsgo.String("in-use"),
+				Description:        awsgo.String("Interface for NAT Gateway " + ExampleNatGatewayId),
+			},
+		},
+	}
+
+	// Nothing in the VPC is deleted, as it would be kept around by its dependents anyway
+	mockEC2.EXPECT().DescribeNetworkInterfacesPages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(input *ec2.DescribeNetworkInterfacesInput, fn func(*ec2.DescribeNetworkInterfacesOutput, bool) bool) error {
+			fn(describeNetworkInterfacesOutput, true)
+			return nil
+		},
+	)
+
+	err := vpc.nukeNonDefault()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "instance i-"+ExampleId+" (network interface "+ExampleNetworkInterfaceId+")")
+	assert.Contains(t, err.Error(), "Interface for NAT Gateway "+ExampleNatGatewayId+" (network interface "+ExampleNetworkInterfaceIdTwo+")")
+}
+
 // **********************************************************************************
 // The test methodology below deletes default VPCs for reals which breaks other tests
 // and hence is commented out in favor of the mock testing approach above
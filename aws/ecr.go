@@ -0,0 +1,67 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of ECR repository names
+func getAllEcrRepositories(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ecr.New(session)
+
+	var repositoryNames []*string
+	err := svc.DescribeRepositoriesPages(
+		&ecr.DescribeRepositoriesInput{},
+		func(page *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+			for _, repository := range page.Repositories {
+				if timeFilter.IncludesResource(repository.RepositoryName, *repository.CreatedAt) {
+					repositoryNames = append(repositoryNames, repository.RepositoryName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return repositoryNames, nil
+}
+
+// Deletes all ECR repositories, along with the images in them
+func nukeAllEcrRepositories(session *session.Session, repositoryNames []*string) error {
+	svc := ecr.New(session)
+
+	if len(repositoryNames) == 0 {
+		logging.Logger.Infof("No ECR repositories to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all ECR repositories in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, repositoryName := range repositoryNames {
+		_, err := svc.DeleteRepository(&ecr.DeleteRepositoryInput{
+			RepositoryName: repositoryName,
+			// Deletes the images in the repository as well, which would otherwise block deleting it
+			Force: awsgo.Bool(true),
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == ecr.ErrCodeRepositoryNotFoundException {
+				logging.Logger.Infof("ECR repository %s has already been deleted", *repositoryName)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, repositoryName)
+			logging.Logger.Infof("Deleted ECR repository: %s", *repositoryName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d ECR repository(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
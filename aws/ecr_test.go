@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestEcrRepository(t *testing.T, session *session.Session, name string) string {
+	svc := ecr.New(session)
+	// Repository names have to be lower case
+	name = strings.ToLower(name)
+	_, err := svc.CreateRepository(&ecr.CreateRepositoryInput{
+		RepositoryName: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListEcrRepositories(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	repositoryName := createTestEcrRepository(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllEcrRepositories(session, []*string{awsgo.String(repositoryName)})
+
+	repositoryNames, err := getAllEcrRepositories(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ECR repositories")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(repositoryNames), repositoryName)
+
+	repositoryNames, err = getAllEcrRepositories(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ECR repositories")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(repositoryNames), repositoryName)
+}
+
+func TestNukeEcrRepositories(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	repositoryName := createTestEcrRepository(t, session, uniqueTestID)
+
+	if err := nukeAllEcrRepositories(session, []*string{awsgo.String(repositoryName)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	repositoryNames, err := getAllEcrRepositories(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ECR repositories")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(repositoryNames), repositoryName)
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked after ECS services and EKS clusters, which may still be pulling images from them
+	registerResourceType(awsResourceType{
+		resource: ECRRepositories{},
+		weight:   2600,
+		actions:  []string{"ecr:DeleteRepository"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			repositoryNames, err := getAllEcrRepositories(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ECRRepositories{RepositoryNames: awsgo.StringValueSlice(repositoryNames)}, nil
+		},
+	})
+}
+
+// ECRRepositories - represents all ECR repositories
+type ECRRepositories struct {
+	RepositoryNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (repositories ECRRepositories) ResourceName() string {
+	return "ecr"
+}
+
+// ResourceIdentifiers - The names of the ECR repositories
+func (repositories ECRRepositories) ResourceIdentifiers() []string {
+	return repositories.RepositoryNames
+}
+
+func (repositories ECRRepositories) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (repositories ECRRepositories) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEcrRepositories(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
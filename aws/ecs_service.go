@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"time"
-
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -21,9 +19,9 @@ func getAllEcsClusters(awsSession *session.Session) ([]*string, error) {
 	return result.ClusterArns, nil
 }
 
-// filterOutRecentServices - Given a list of services and an excludeAfter
-// timestamp, filter out any services that were created after `excludeAfter`.
-func filterOutRecentServices(svc *ecs.ECS, clusterArn *string, ecsServiceArns []string, excludeAfter time.Time) ([]*string, error) {
+// filterOutRecentServices - Given a list of services and a time filter,
+// filter out any services that were not created within its window.
+func filterOutRecentServices(svc *ecs.ECS, clusterArn *string, ecsServiceArns []string, timeFilter TimeFilter) ([]*string, error) {
 	// Fetch descriptions in batches of 10, which is the max that AWS
 	// accepts for describe service.
 	var filteredEcsServiceArns []*string
@@ -38,7 +36,7 @@ func filterOutRecentServices(svc *ecs.ECS, clusterArn *string, ecsServiceArns []
 			return nil, errors.WithStackTrace(err)
 		}
 		for _, service := range describeResult.Services {
-			if excludeAfter.After(*service.CreatedAt) {
+			if timeFilter.IncludesResource(service.ServiceArn, *service.CreatedAt) {
 				filteredEcsServiceArns = append(filteredEcsServiceArns, service.ServiceArn)
 			}
 		}
@@ -51,7 +49,7 @@ func filterOutRecentServices(svc *ecs.ECS, clusterArn *string, ecsServiceArns []
 // clusters. For ECS, need to track ECS clusters of services as all service
 // level API endpoints require providing the corresponding cluster.
 // Note that this looks up services by ECS cluster ARNs.
-func getAllEcsServices(awsSession *session.Session, ecsClusterArns []*string, excludeAfter time.Time) ([]*string, map[string]string, error) {
+func getAllEcsServices(awsSession *session.Session, ecsClusterArns []*string, timeFilter TimeFilter) ([]*string, map[string]string, error) {
 	ecsServiceClusterMap := map[string]string{}
 	svc := ecs.New(awsSession)
 
@@ -63,7 +61,7 @@ func getAllEcsServices(awsSession *session.Session, ecsClusterArns []*string, ex
 		if err != nil {
 			return nil, nil, errors.WithStackTrace(err)
 		}
-		filteredServiceArns, err := filterOutRecentServices(svc, clusterArn, awsgo.StringValueSlice(result.ServiceArns), excludeAfter)
+		filteredServiceArns, err := filterOutRecentServices(svc, clusterArn, awsgo.StringValueSlice(result.ServiceArns), timeFilter)
 		if err != nil {
 			return nil, nil, errors.WithStackTrace(err)
 		}
@@ -40,7 +40,7 @@ func TestListECSFargateServices(t *testing.T) {
 	ecsServiceClusterMap[*service.ServiceArn] = *cluster.ClusterArn
 	defer nukeAllEcsServices(awsSession, ecsServiceClusterMap, []*string{service.ServiceArn})
 
-	ecsServiceArns, newEcsServiceClusterMap, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, time.Now().Add(1*time.Hour*-1))
+	ecsServiceArns, newEcsServiceClusterMap, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of services: %s", err.Error())
 	}
@@ -48,7 +48,7 @@ func TestListECSFargateServices(t *testing.T) {
 	_, exists := newEcsServiceClusterMap[*service.ServiceArn]
 	assert.False(t, exists)
 
-	ecsServiceArns, newEcsServiceClusterMap, err = getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, time.Now().Add(1*time.Hour))
+	ecsServiceArns, newEcsServiceClusterMap, err = getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of services: %s", err.Error())
 	}
@@ -90,7 +90,7 @@ func TestNukeECSFargateServices(t *testing.T) {
 		assert.Fail(t, err.Error())
 	}
 
-	ecsServiceArns, _, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, time.Now().Add(1*time.Hour))
+	ecsServiceArns, _, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of services: %s", err.Error())
 	}
@@ -145,7 +145,7 @@ func TestListECSEC2Services(t *testing.T) {
 	defer nukeAllEcsServices(awsSession, ecsServiceClusterMap, []*string{service.ServiceArn})
 	// END prepare resources
 
-	ecsServiceArns, newEcsServiceClusterMap, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, time.Now().Add(1*time.Hour*-1))
+	ecsServiceArns, newEcsServiceClusterMap, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of services: %s", err.Error())
 	}
@@ -153,7 +153,7 @@ func TestListECSEC2Services(t *testing.T) {
 	_, exists := newEcsServiceClusterMap[*service.ServiceArn]
 	assert.False(t, exists)
 
-	ecsServiceArns, newEcsServiceClusterMap, err = getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, time.Now().Add(1*time.Hour))
+	ecsServiceArns, newEcsServiceClusterMap, err = getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of services: %s", err.Error())
 	}
@@ -211,7 +211,7 @@ func TestNukeECSEC2Services(t *testing.T) {
 
 	err = nukeAllEcsServices(awsSession, ecsServiceClusterMap, []*string{service.ServiceArn})
 
-	ecsServiceArns, _, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, time.Now().Add(1*time.Hour))
+	ecsServiceArns, _, err := getAllEcsServices(awsSession, []*string{cluster.ClusterArn}, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of services: %s", err.Error())
 	}
@@ -6,6 +6,32 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: ECSServices{},
+		weight:   2000,
+		actions:  []string{"ecs:UpdateService", "ecs:DeleteService"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			clusterArns, err := getAllEcsClusters(discovery.session)
+			if err != nil {
+				return nil, err
+			}
+			serviceArns, serviceClusterMap, err := getAllEcsServices(discovery.session, clusterArns, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ECSServices{Services: awsgo.StringValueSlice(serviceArns), ServiceClusterMap: serviceClusterMap}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			serviceClusterMap := map[string]string{}
+			for _, resource := range resources {
+				serviceClusterMap[resource.Identifier] = resource.Cluster
+			}
+			return ECSServices{Services: taggedIdentifiers(resources), ServiceClusterMap: serviceClusterMap}
+		},
+	})
+}
+
 // ECSServices - Represents all ECS services found in a region
 type ECSServices struct {
 	Services          []string
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of EFS file system ids
+func getAllElasticFileSystems(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := efs.New(session)
+
+	var fileSystemIds []*string
+	err := svc.DescribeFileSystemsPages(
+		&efs.DescribeFileSystemsInput{},
+		func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+			for _, fileSystem := range page.FileSystems {
+				lifeCycleState := awsgo.StringValue(fileSystem.LifeCycleState)
+				if lifeCycleState == efs.LifeCycleStateDeleting || lifeCycleState == efs.LifeCycleStateDeleted {
+					continue
+				}
+				if timeFilter.IncludesResource(fileSystem.FileSystemId, *fileSystem.CreationTime) {
+					fileSystemIds = append(fileSystemIds, fileSystem.FileSystemId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return fileSystemIds, nil
+}
+
+// getMountTargetIds returns the ids of the mount targets of the file system
+func getMountTargetIds(svc *efs.EFS, fileSystemId *string) ([]*string, error) {
+	var mountTargetIds []*string
+	err := svc.DescribeMountTargetsPages(
+		&efs.DescribeMountTargetsInput{FileSystemId: fileSystemId},
+		func(page *efs.DescribeMountTargetsOutput, lastPage bool) bool {
+			for _, mountTarget := range page.MountTargets {
+				mountTargetIds = append(mountTargetIds, mountTarget.MountTargetId)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return mountTargetIds, nil
+}
+
+// deleteMountTargets deletes the mount targets of the file system, and waits for them to be gone, as a file system
+// can't be deleted before
+func deleteMountTargets(svc *efs.EFS, fileSystemId *string) error {
+	mountTargetIds, err := getMountTargetIds(svc, fileSystemId)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(mountTargetIds) == 0 {
+		return nil
+	}
+
+	for _, mountTargetId := range mountTargetIds {
+		_, err := svc.DeleteMountTarget(&efs.DeleteMountTargetInput{MountTargetId: mountTargetId})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == efs.ErrCodeMountTargetNotFound {
+			continue
+		}
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	remaining, err := waitUntilDeleted([]string{*fileSystemId}, func(fileSystemId string) (bool, error) {
+		mountTargetIds, err := getMountTargetIds(svc, awsgo.String(fileSystemId))
+		return len(mountTargetIds) > 0, err
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(remaining) > 0 {
+		return ResourcesNotDeletedError{ResourceType: "EFS mount target", Identifiers: awsgo.StringValueSlice(mountTargetIds)}
+	}
+
+	return nil
+}
+
+// Deletes all EFS file systems, after deleting their mount targets
+func nukeAllElasticFileSystems(session *session.Session, fileSystemIds []*string) error {
+	svc := efs.New(session)
+
+	if len(fileSystemIds) == 0 {
+		logging.Logger.Infof("No EFS file systems to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all EFS file systems in region %s", *session.Config.Region)
+	var deletedFileSystemIds []*string
+
+	for _, fileSystemId := range fileSystemIds {
+		err := deleteMountTargets(svc, fileSystemId)
+		if err == nil {
+			_, err = svc.DeleteFileSystem(&efs.DeleteFileSystemInput{FileSystemId: fileSystemId})
+		}
+		if err != nil {
+			if awsErr, isAwsErr := errors.Unwrap(err).(awserr.Error); isAwsErr && awsErr.Code() == efs.ErrCodeFileSystemNotFound {
+				logging.Logger.Infof("EFS file system %s has already been deleted", *fileSystemId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedFileSystemIds = append(deletedFileSystemIds, fileSystemId)
+			logging.Logger.Infof("Deleted EFS file system: %s", *fileSystemId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d EFS file system(s) deleted in %s", len(deletedFileSystemIds), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given EFS file systems have actually been deleted
+func waitUntilElasticFileSystemsDeleted(session *session.Session, fileSystemIds []*string) error {
+	svc := efs.New(session)
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(fileSystemIds), func(fileSystemId string) (bool, error) {
+		_, err := svc.DescribeFileSystems(&efs.DescribeFileSystemsInput{
+			FileSystemId: awsgo.String(fileSystemId),
+		})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == efs.ErrCodeFileSystemNotFound {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(remaining) > 0 {
+		return ResourcesNotDeletedError{ResourceType: ElasticFileSystems{}.ResourceName(), Identifiers: remaining}
+	}
+
+	return nil
+}
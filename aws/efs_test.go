@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestElasticFileSystem creates a file system with a mount target in a default subnet, so nuking it has to
+// delete the mount target first
+func createTestElasticFileSystem(t *testing.T, session *session.Session, name string) string {
+	svc := efs.New(session)
+	fileSystem, err := svc.CreateFileSystem(&efs.CreateFileSystemInput{
+		CreationToken: awsgo.String(name),
+		Tags:          []*efs.Tag{{Key: awsgo.String("Name"), Value: awsgo.String(name)}},
+	})
+	require.NoError(t, err)
+
+	for {
+		result, err := svc.DescribeFileSystems(&efs.DescribeFileSystemsInput{FileSystemId: fileSystem.FileSystemId})
+		require.NoError(t, err)
+		if awsgo.StringValue(result.FileSystems[0].LifeCycleState) == efs.LifeCycleStateAvailable {
+			break
+		}
+		time.Sleep(5 * time.Second)
+	}
+
+	subnets, err := ec2.New(session).DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{{Name: awsgo.String("default-for-az"), Values: []*string{awsgo.String("true")}}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, subnets.Subnets)
+
+	_, err = svc.CreateMountTarget(&efs.CreateMountTargetInput{
+		FileSystemId: fileSystem.FileSystemId,
+		SubnetId:     subnets.Subnets[0].SubnetId,
+	})
+	require.NoError(t, err)
+
+	return *fileSystem.FileSystemId
+}
+
+func TestListElasticFileSystems(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	fileSystemId := createTestElasticFileSystem(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllElasticFileSystems(session, []*string{awsgo.String(fileSystemId)})
+
+	fileSystemIds, err := getAllElasticFileSystems(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EFS file systems")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(fileSystemIds), fileSystemId)
+
+	fileSystemIds, err = getAllElasticFileSystems(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EFS file systems")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(fileSystemIds), fileSystemId)
+}
+
+func TestNukeElasticFileSystems(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	fileSystemId := createTestElasticFileSystem(t, session, uniqueTestID)
+
+	if err := nukeAllElasticFileSystems(session, []*string{awsgo.String(fileSystemId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	fileSystemIds, err := getAllElasticFileSystems(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of EFS file systems")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(fileSystemIds), fileSystemId)
+}
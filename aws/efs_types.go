@@ -0,0 +1,60 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: ElasticFileSystems{},
+		weight:   2200,
+		actions:  []string{"elasticfilesystem:DeleteMountTarget", "elasticfilesystem:DeleteFileSystem"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			fileSystemIds, err := getAllElasticFileSystems(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ElasticFileSystems{FileSystemIds: awsgo.StringValueSlice(fileSystemIds)}, nil
+		},
+	})
+}
+
+// ElasticFileSystems - represents all EFS file systems
+type ElasticFileSystems struct {
+	FileSystemIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (fileSystems ElasticFileSystems) ResourceName() string {
+	return "efs"
+}
+
+// ResourceIdentifiers - The ids of the EFS file systems
+func (fileSystems ElasticFileSystems) ResourceIdentifiers() []string {
+	return fileSystems.FileSystemIds
+}
+
+func (fileSystems ElasticFileSystems) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (fileSystems ElasticFileSystems) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticFileSystems(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (fileSystems ElasticFileSystems) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilElasticFileSystemsDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
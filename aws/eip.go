@@ -7,14 +7,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+// Resources that don't expose their creation time are tagged with the time cloud-nuke first saw them instead
+const firstSeenTagKey = "cloud-nuke-first-seen"
+const firstSeenTagLayout = "2006-01-02 15:04:05"
+
 func setFirstSeenTag(svc *ec2.EC2, address ec2.Address, key string, value time.Time, layout string) error {
 	// We set a first seen tag because an Elastic IP doesn't contain an attribute that gives us it's creation time
+	return setFirstSeenResourceTag(svc, address.AllocationId, key, value, layout)
+}
+
+func getFirstSeenTag(svc *ec2.EC2, address ec2.Address, key string, layout string) (*time.Time, error) {
+	return getFirstSeenTimeFromTags(address.Tags, key, layout)
+}
+
+func setFirstSeenResourceTag(svc ec2iface.EC2API, resourceId *string, key string, value time.Time, layout string) error {
 	_, err := svc.CreateTags(&ec2.CreateTagsInput{
-		Resources: []*string{address.AllocationId},
+		Resources: []*string{resourceId},
 		Tags: []*ec2.Tag{
 			{
 				Key:   awsgo.String(key),
@@ -30,8 +43,7 @@ func setFirstSeenTag(svc *ec2.EC2, address ec2.Address, key string, value time.T
 	return nil
 }
 
-func getFirstSeenTag(svc *ec2.EC2, address ec2.Address, key string, layout string) (*time.Time, error) {
-	tags := address.Tags
+func getFirstSeenTimeFromTags(tags []*ec2.Tag, key string, layout string) (*time.Time, error) {
 	for _, tag := range tags {
 		if *tag.Key == key {
 			firstSeenTime, err := time.Parse(layout, *tag.Value)
@@ -47,10 +59,10 @@ func getFirstSeenTag(svc *ec2.EC2, address ec2.Address, key string, layout strin
 }
 
 // Returns a formatted string of EIP allocation ids
-func getAllEIPAddresses(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllEIPAddresses(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
 	svc := ec2.New(session)
-	const key = "cloud-nuke-first-seen"
-	const layout = "2006-01-02 15:04:05"
+	const key = firstSeenTagKey
+	const layout = firstSeenTagLayout
 
 	result, err := svc.DescribeAddresses(&ec2.DescribeAddressesInput{})
 	if err != nil {
@@ -72,7 +84,7 @@ func getAllEIPAddresses(session *session.Session, region string, excludeAfter ti
 			}
 		}
 
-		if excludeAfter.After(*firstSeenTime) {
+		if timeFilter.IncludesFirstSeenResource(address.AllocationId, *firstSeenTime) {
 			allocationIds = append(allocationIds, address.AllocationId)
 		}
 	}
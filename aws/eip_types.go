@@ -6,6 +6,24 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: EIPAddresses{},
+		weight:   1500,
+		actions:  []string{"ec2:CreateTags", "ec2:ReleaseAddress"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			allocationIds, err := getAllEIPAddresses(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return EIPAddresses{AllocationIds: awsgo.StringValueSlice(allocationIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return EIPAddresses{AllocationIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // EBSVolumes - represents all ebs volumes
 type EIPAddresses struct {
 	AllocationIds []string
@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"time"
-
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -34,22 +32,22 @@ func eksSupportedRegion(region string) bool {
 }
 
 // getAllEksClusters returns a list of strings of EKS Cluster Names that uniquely identify each cluster.
-func getAllEksClusters(awsSession *session.Session, excludeAfter time.Time) ([]*string, error) {
+func getAllEksClusters(awsSession *session.Session, timeFilter TimeFilter) ([]*string, error) {
 	svc := eks.New(awsSession)
 	result, err := svc.ListClusters(&eks.ListClustersInput{})
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
-	filteredClusters, err := filterOutRecentEksClusters(svc, result.Clusters, excludeAfter)
+	filteredClusters, err := filterOutRecentEksClusters(svc, result.Clusters, timeFilter)
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
 	return filteredClusters, nil
 }
 
-// filterOutRecentEksClusters will take in the list of clusters and filter out any clusters that were created after
-// `excludeAfter`.
-func filterOutRecentEksClusters(svc *eks.EKS, clusterNames []*string, excludeAfter time.Time) ([]*string, error) {
+// filterOutRecentEksClusters will take in the list of clusters and filter out any clusters that were not created
+// within the window of `timeFilter`.
+func filterOutRecentEksClusters(svc *eks.EKS, clusterNames []*string, timeFilter TimeFilter) ([]*string, error) {
 	var filteredEksClusterNames []*string
 	for _, clusterName := range clusterNames {
 		describeResult, err := svc.DescribeCluster(&eks.DescribeClusterInput{
@@ -59,7 +57,7 @@ func filterOutRecentEksClusters(svc *eks.EKS, clusterNames []*string, excludeAft
 			return nil, errors.WithStackTrace(err)
 		}
 		cluster := describeResult.Cluster
-		if excludeAfter.After(*cluster.CreatedAt) {
+		if timeFilter.IncludesResource(cluster.Name, *cluster.CreatedAt) {
 			filteredEksClusterNames = append(filteredEksClusterNames, cluster.Name)
 		}
 	}
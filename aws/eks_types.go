@@ -6,6 +6,27 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: EKSClusters{},
+		weight:   2100,
+		actions:  []string{"eks:DeleteCluster"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !eksSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			eksClusterNames, err := getAllEksClusters(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return EKSClusters{Clusters: awsgo.StringValueSlice(eksClusterNames)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return EKSClusters{Clusters: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // EKSClusters - Represents all EKS clusters found in a region
 type EKSClusters struct {
 	Clusters []string
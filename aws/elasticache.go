@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of ElastiCache replication group ids
+func getAllElasticacheReplicationGroups(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := elasticache.New(session)
+
+	var replicationGroupIds []*string
+	err := svc.DescribeReplicationGroupsPages(
+		&elasticache.DescribeReplicationGroupsInput{},
+		func(page *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+			for _, replicationGroup := range page.ReplicationGroups {
+				if replicationGroup.ReplicationGroupCreateTime != nil && timeFilter.IncludesResource(replicationGroup.ReplicationGroupId, *replicationGroup.ReplicationGroupCreateTime) {
+					replicationGroupIds = append(replicationGroupIds, replicationGroup.ReplicationGroupId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return replicationGroupIds, nil
+}
+
+// deleteElasticacheReplicationGroups requests the deletion of the replication groups, along with all the clusters in
+// them. Returns the ids of the replication groups that are being deleted.
+func deleteElasticacheReplicationGroups(svc *elasticache.ElastiCache, replicationGroupIds []*string) []*string {
+	var requestedDeletes []*string
+	for _, replicationGroupId := range replicationGroupIds {
+		_, err := svc.DeleteReplicationGroup(&elasticache.DeleteReplicationGroupInput{
+			ReplicationGroupId: replicationGroupId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == elasticache.ErrCodeReplicationGroupNotFoundFault {
+				logging.Logger.Infof("ElastiCache replication group %s has already been deleted", *replicationGroupId)
+			} else {
+				logging.Logger.Errorf("[Failed] Failed deleting ElastiCache replication group %s: %s", *replicationGroupId, err)
+			}
+		} else {
+			requestedDeletes = append(requestedDeletes, replicationGroupId)
+		}
+	}
+	return requestedDeletes
+}
+
+// waitUntilElasticacheReplicationGroupsDeleted waits until the replication groups have actually been deleted. Returns
+// the ids of the replication groups that have been successfully deleted.
+func waitUntilElasticacheReplicationGroupsDeleted(svc *elasticache.ElastiCache, replicationGroupIds []*string) []*string {
+	var successfullyDeleted []*string
+	for _, replicationGroupId := range replicationGroupIds {
+		err := svc.WaitUntilReplicationGroupDeleted(&elasticache.DescribeReplicationGroupsInput{
+			ReplicationGroupId: replicationGroupId,
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed waiting for ElastiCache replication group to be deleted %s: %s", *replicationGroupId, err)
+		} else {
+			logging.Logger.Infof("Deleted ElastiCache replication group: %s", *replicationGroupId)
+			successfullyDeleted = append(successfullyDeleted, replicationGroupId)
+		}
+	}
+	return successfullyDeleted
+}
+
+// nukeAllElasticacheReplicationGroups deletes all provided replication groups, waiting for them to be deleted before
+// returning
+func nukeAllElasticacheReplicationGroups(session *session.Session, replicationGroupIds []*string) error {
+	numNuking := len(replicationGroupIds)
+	svc := elasticache.New(session)
+
+	if numNuking == 0 {
+		logging.Logger.Infof("No ElastiCache replication groups to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting %d ElastiCache replication groups in region %s", numNuking, *session.Config.Region)
+
+	requestedDeletes := deleteElasticacheReplicationGroups(svc, replicationGroupIds)
+	successfullyDeleted := waitUntilElasticacheReplicationGroupsDeleted(svc, requestedDeletes)
+
+	logging.Logger.Infof("[OK] %d of %d ElastiCache replication group(s) deleted in %s", len(successfullyDeleted), numNuking, *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of ElastiCache cluster ids. Clusters that are part of a replication group can't be
+// deleted on their own, and go away along with their replication group instead, so they are left out.
+func getAllElasticacheClusters(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := elasticache.New(session)
+
+	var clusterIds []*string
+	err := svc.DescribeCacheClustersPages(
+		&elasticache.DescribeCacheClustersInput{},
+		func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+			for _, cluster := range page.CacheClusters {
+				if cluster.ReplicationGroupId != nil {
+					continue
+				}
+				if cluster.CacheClusterCreateTime != nil && timeFilter.IncludesResource(cluster.CacheClusterId, *cluster.CacheClusterCreateTime) {
+					clusterIds = append(clusterIds, cluster.CacheClusterId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return clusterIds, nil
+}
+
+// deleteElasticacheClusters requests the deletion of the clusters. Returns the ids of the clusters that are being
+// deleted.
+func deleteElasticacheClusters(svc *elasticache.ElastiCache, clusterIds []*string) []*string {
+	var requestedDeletes []*string
+	for _, clusterId := range clusterIds {
+		_, err := svc.DeleteCacheCluster(&elasticache.DeleteCacheClusterInput{
+			CacheClusterId: clusterId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == elasticache.ErrCodeCacheClusterNotFoundFault {
+				logging.Logger.Infof("ElastiCache cluster %s has already been deleted", *clusterId)
+			} else {
+				logging.Logger.Errorf("[Failed] Failed deleting ElastiCache cluster %s: %s", *clusterId, err)
+			}
+		} else {
+			requestedDeletes = append(requestedDeletes, clusterId)
+		}
+	}
+	return requestedDeletes
+}
+
+// waitUntilElasticacheClustersDeleted waits until the clusters have actually been deleted. Returns the ids of the
+// clusters that have been successfully deleted.
+func waitUntilElasticacheClustersDeleted(svc *elasticache.ElastiCache, clusterIds []*string) []*string {
+	var successfullyDeleted []*string
+	for _, clusterId := range clusterIds {
+		err := svc.WaitUntilCacheClusterDeleted(&elasticache.DescribeCacheClustersInput{
+			CacheClusterId: clusterId,
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed waiting for ElastiCache cluster to be deleted %s: %s", *clusterId, err)
+		} else {
+			logging.Logger.Infof("Deleted ElastiCache cluster: %s", *clusterId)
+			successfullyDeleted = append(successfullyDeleted, clusterId)
+		}
+	}
+	return successfullyDeleted
+}
+
+// nukeAllElasticacheClusters deletes all provided clusters, waiting for them to be deleted before returning
+func nukeAllElasticacheClusters(session *session.Session, clusterIds []*string) error {
+	numNuking := len(clusterIds)
+	svc := elasticache.New(session)
+
+	if numNuking == 0 {
+		logging.Logger.Infof("No ElastiCache clusters to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting %d ElastiCache clusters in region %s", numNuking, *session.Config.Region)
+
+	requestedDeletes := deleteElasticacheClusters(svc, clusterIds)
+	successfullyDeleted := waitUntilElasticacheClustersDeleted(svc, requestedDeletes)
+
+	logging.Logger.Infof("[OK] %d of %d ElastiCache cluster(s) deleted in %s", len(successfullyDeleted), numNuking, *session.Config.Region)
+	return nil
+}
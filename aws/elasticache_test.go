@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Cluster and replication group ids are limited to 40 characters, and are stored in lower case
+func uniqueElasticacheId() string {
+	return strings.ToLower("cloud-nuke-test-" + util.UniqueID())
+}
+
+func createTestElasticacheCluster(t *testing.T, session *session.Session, clusterId string) string {
+	svc := elasticache.New(session)
+	_, err := svc.CreateCacheCluster(&elasticache.CreateCacheClusterInput{
+		CacheClusterId: awsgo.String(clusterId),
+		CacheNodeType:  awsgo.String("cache.t3.micro"),
+		Engine:         awsgo.String("memcached"),
+		NumCacheNodes:  awsgo.Int64(1),
+	})
+	require.NoError(t, err)
+
+	// Clusters can only be deleted once they are available
+	err = svc.WaitUntilCacheClusterAvailable(&elasticache.DescribeCacheClustersInput{
+		CacheClusterId: awsgo.String(clusterId),
+	})
+	require.NoError(t, err)
+
+	return clusterId
+}
+
+func createTestElasticacheReplicationGroup(t *testing.T, session *session.Session, replicationGroupId string) string {
+	svc := elasticache.New(session)
+	_, err := svc.CreateReplicationGroup(&elasticache.CreateReplicationGroupInput{
+		ReplicationGroupId:          awsgo.String(replicationGroupId),
+		ReplicationGroupDescription: awsgo.String("cloud-nuke test"),
+		CacheNodeType:               awsgo.String("cache.t3.micro"),
+		Engine:                      awsgo.String("redis"),
+		NumCacheClusters:            awsgo.Int64(2),
+	})
+	require.NoError(t, err)
+
+	err = svc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: awsgo.String(replicationGroupId),
+	})
+	require.NoError(t, err)
+
+	return replicationGroupId
+}
+
+func TestListElasticacheClusters(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	clusterId := createTestElasticacheCluster(t, session, uniqueElasticacheId())
+	// clean up after this test
+	defer nukeAllElasticacheClusters(session, []*string{awsgo.String(clusterId)})
+
+	clusterIds, err := getAllElasticacheClusters(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ElastiCache clusters")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(clusterIds), clusterId)
+
+	clusterIds, err = getAllElasticacheClusters(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ElastiCache clusters")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(clusterIds), clusterId)
+}
+
+func TestNukeElasticacheClusters(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	clusterId := createTestElasticacheCluster(t, session, uniqueElasticacheId())
+
+	if err := nukeAllElasticacheClusters(session, []*string{awsgo.String(clusterId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	clusterIds, err := getAllElasticacheClusters(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ElastiCache clusters")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(clusterIds), clusterId)
+}
+
+func TestNukeElasticacheReplicationGroups(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	replicationGroupId := createTestElasticacheReplicationGroup(t, session, uniqueElasticacheId())
+	// clean up if the test fails before nuking the replication group
+	defer nukeAllElasticacheReplicationGroups(session, []*string{awsgo.String(replicationGroupId)})
+
+	replicationGroupIds, err := getAllElasticacheReplicationGroups(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ElastiCache replication groups")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(replicationGroupIds), replicationGroupId)
+
+	// The clusters of the replication group are nuked along with it, so they are not listed on their own
+	clusterIds, err := getAllElasticacheClusters(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ElastiCache clusters")
+	}
+
+	for _, clusterId := range awsgo.StringValueSlice(clusterIds) {
+		assert.False(t, strings.HasPrefix(clusterId, replicationGroupId))
+	}
+
+	if err := nukeAllElasticacheReplicationGroups(session, []*string{awsgo.String(replicationGroupId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	replicationGroupIds, err = getAllElasticacheReplicationGroups(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of ElastiCache replication groups")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(replicationGroupIds), replicationGroupId)
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before the clusters, since the clusters that are part of a replication group go away along with it
+	registerResourceType(awsResourceType{
+		resource: ElasticacheReplicationGroups{},
+		weight:   2700,
+		actions:  []string{"elasticache:DeleteReplicationGroup"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			replicationGroupIds, err := getAllElasticacheReplicationGroups(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ElasticacheReplicationGroups{ReplicationGroupIds: awsgo.StringValueSlice(replicationGroupIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: ElasticacheClusters{},
+		weight:   2800,
+		actions:  []string{"elasticache:DeleteCacheCluster"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			clusterIds, err := getAllElasticacheClusters(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ElasticacheClusters{ClusterIds: awsgo.StringValueSlice(clusterIds)}, nil
+		},
+	})
+}
+
+// ElasticacheReplicationGroups - represents all ElastiCache replication groups
+type ElasticacheReplicationGroups struct {
+	ReplicationGroupIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups ElasticacheReplicationGroups) ResourceName() string {
+	return "elasticachereplicationgroup"
+}
+
+// ResourceIdentifiers - The ids of the ElastiCache replication groups
+func (groups ElasticacheReplicationGroups) ResourceIdentifiers() []string {
+	return groups.ReplicationGroupIds
+}
+
+func (groups ElasticacheReplicationGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (groups ElasticacheReplicationGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticacheReplicationGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ElasticacheClusters - represents all ElastiCache clusters that are not part of a replication group
+type ElasticacheClusters struct {
+	ClusterIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (clusters ElasticacheClusters) ResourceName() string {
+	return "elasticache"
+}
+
+// ResourceIdentifiers - The ids of the ElastiCache clusters
+func (clusters ElasticacheClusters) ResourceIdentifiers() []string {
+	return clusters.ClusterIds
+}
+
+func (clusters ElasticacheClusters) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters ElasticacheClusters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticacheClusters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
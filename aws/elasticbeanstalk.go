@@ -0,0 +1,178 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Terminating an environment tears down its load balancer, Auto Scaling Group and instances, which can take a while,
+// so wait up to half an hour rather than the few minutes of the default waiter
+const elasticBeanstalkTerminationMaxAttempts = 90
+
+// Returns the ids of all Elastic Beanstalk environments created before the cutoff
+func getAllElasticBeanstalkEnvironments(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := elasticbeanstalk.New(session)
+
+	var environmentIds []*string
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{IncludeDeleted: awsgo.Bool(false)}
+	for {
+		output, err := svc.DescribeEnvironments(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, environment := range output.Environments {
+			// Already on its way out
+			if awsgo.StringValue(environment.Status) == elasticbeanstalk.EnvironmentStatusTerminating {
+				continue
+			}
+			if timeFilter.IncludesResource(environment.EnvironmentName, *environment.DateCreated) {
+				environmentIds = append(environmentIds, environment.EnvironmentId)
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return environmentIds, nil
+}
+
+// Terminates all the given Elastic Beanstalk environments, and waits for them to be terminated, so that the load
+// balancers, Auto Scaling Groups and instances they created are gone before the other resource types are nuked
+func nukeAllElasticBeanstalkEnvironments(session *session.Session, environmentIds []*string) error {
+	svc := elasticbeanstalk.New(session)
+
+	if len(environmentIds) == 0 {
+		logging.Logger.Infof("No Elastic Beanstalk environments to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Terminating all Elastic Beanstalk environments in region %s", *session.Config.Region)
+	var terminatedIds []*string
+
+	for _, environmentId := range environmentIds {
+		_, err := svc.TerminateEnvironment(&elasticbeanstalk.TerminateEnvironmentInput{
+			EnvironmentId: environmentId,
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+		terminatedIds = append(terminatedIds, environmentId)
+	}
+
+	if len(terminatedIds) == 0 {
+		return nil
+	}
+
+	err := svc.WaitUntilEnvironmentTerminatedWithContext(
+		awsgo.BackgroundContext(),
+		&elasticbeanstalk.DescribeEnvironmentsInput{EnvironmentIds: terminatedIds},
+		request.WithWaiterMaxAttempts(elasticBeanstalkTerminationMaxAttempts),
+	)
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	for _, environmentId := range terminatedIds {
+		logging.Logger.Infof("Terminated Elastic Beanstalk environment: %s", *environmentId)
+	}
+
+	logging.Logger.Infof("[OK] %d Elastic Beanstalk environment(s) terminated in %s", len(terminatedIds), *session.Config.Region)
+	return nil
+}
+
+// Returns the names of all Elastic Beanstalk applications created before the cutoff
+func getAllElasticBeanstalkApplications(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := elasticbeanstalk.New(session)
+
+	output, err := svc.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, application := range output.Applications {
+		if timeFilter.IncludesResource(application.ApplicationName, *application.DateCreated) {
+			names = append(names, application.ApplicationName)
+		}
+	}
+
+	return names, nil
+}
+
+// deleteApplicationVersions - Deletes the versions of the application along with their source bundles, which
+// deleting the application leaves behind in S3
+func deleteApplicationVersions(svc *elasticbeanstalk.ElasticBeanstalk, applicationName *string) error {
+	var versionLabels []*string
+	input := &elasticbeanstalk.DescribeApplicationVersionsInput{ApplicationName: applicationName}
+	for {
+		output, err := svc.DescribeApplicationVersions(input)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, version := range output.ApplicationVersions {
+			versionLabels = append(versionLabels, version.VersionLabel)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	for _, versionLabel := range versionLabels {
+		_, err := svc.DeleteApplicationVersion(&elasticbeanstalk.DeleteApplicationVersionInput{
+			ApplicationName:    applicationName,
+			VersionLabel:       versionLabel,
+			DeleteSourceBundle: awsgo.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("...deleted version %s and its source bundle", *versionLabel)
+	}
+	return nil
+}
+
+// Deletes all the given Elastic Beanstalk applications, along with their versions. The applications that still have
+// running environments, e.g. ones too recent to be nuked, are left alone.
+func nukeAllElasticBeanstalkApplications(session *session.Session, names []*string) error {
+	svc := elasticbeanstalk.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No Elastic Beanstalk applications to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Elastic Beanstalk applications in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		err := deleteApplicationVersions(svc, name)
+		if err == nil {
+			_, err = svc.DeleteApplication(&elasticbeanstalk.DeleteApplicationInput{
+				ApplicationName:     name,
+				TerminateEnvByForce: awsgo.Bool(false),
+			})
+		}
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+		deletedNames = append(deletedNames, name)
+		logging.Logger.Infof("Deleted Elastic Beanstalk application: %s", *name)
+	}
+
+	logging.Logger.Infof("[OK] %d Elastic Beanstalk application(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before ASGs, load balancers and EC2 instances, since terminating an environment also tears down the ones
+	// it created
+	registerResourceType(awsResourceType{
+		resource: ElasticBeanstalkEnvironments{},
+		weight:   500,
+		actions:  []string{"elasticbeanstalk:TerminateEnvironment"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			environmentIds, err := getAllElasticBeanstalkEnvironments(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ElasticBeanstalkEnvironments{EnvironmentIds: awsgo.StringValueSlice(environmentIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: ElasticBeanstalkApplications{},
+		weight:   600,
+		actions:  []string{"elasticbeanstalk:DeleteApplicationVersion", "elasticbeanstalk:DeleteApplication"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllElasticBeanstalkApplications(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ElasticBeanstalkApplications{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// ElasticBeanstalkEnvironments - represents all Elastic Beanstalk environments
+type ElasticBeanstalkEnvironments struct {
+	EnvironmentIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (environments ElasticBeanstalkEnvironments) ResourceName() string {
+	return "elasticbeanstalkenvironment"
+}
+
+// ResourceIdentifiers - The ids of the environments
+func (environments ElasticBeanstalkEnvironments) ResourceIdentifiers() []string {
+	return environments.EnvironmentIds
+}
+
+func (environments ElasticBeanstalkEnvironments) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (environments ElasticBeanstalkEnvironments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticBeanstalkEnvironments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ElasticBeanstalkApplications - represents all Elastic Beanstalk applications
+type ElasticBeanstalkApplications struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (applications ElasticBeanstalkApplications) ResourceName() string {
+	return "elasticbeanstalkapplication"
+}
+
+// ResourceIdentifiers - The names of the applications
+func (applications ElasticBeanstalkApplications) ResourceIdentifiers() []string {
+	return applications.Names
+}
+
+func (applications ElasticBeanstalkApplications) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (applications ElasticBeanstalkApplications) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticBeanstalkApplications(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -56,14 +56,14 @@ func TestListELBs(t *testing.T) {
 	// clean up after this test
 	defer nukeAllElbInstances(session, []*string{&elbName})
 
-	elbNames, err := getAllElbInstances(session, region, time.Now().Add(1*time.Hour*-1))
+	elbNames, err := getAllElbInstances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of ELBs", errors.WithStackTrace(err).Error())
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(elbNames), elbName)
 
-	elbNames, err = getAllElbInstances(session, region, time.Now().Add(1*time.Hour))
+	elbNames, err = getAllElbInstances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Failf(t, "Unable to fetch list of ELBs", errors.WithStackTrace(err).Error())
 	}
@@ -105,7 +105,7 @@ func TestNukeELBs(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	elbNames, err := getAllElbInstances(session, region, time.Now().Add(1*time.Hour))
+	elbNames, err := getAllElbInstances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of ELBs: %v", err)
 	}
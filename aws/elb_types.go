@@ -6,6 +6,24 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: LoadBalancers{},
+		weight:   900,
+		actions:  []string{"elasticloadbalancing:DeleteLoadBalancer"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			elbNames, err := getAllElbInstances(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return LoadBalancers{Names: awsgo.StringValueSlice(elbNames)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return LoadBalancers{Names: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // LoadBalancers - represents all load balancers
 type LoadBalancers struct {
 	Names []string
@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"time"
-
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -10,7 +8,7 @@ import (
 )
 
 // Returns a formatted string of ELBv2 Arns
-func getAllElbv2Instances(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllElbv2Instances(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
 	svc := elbv2.New(session)
 	result, err := svc.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{})
 	if err != nil {
@@ -19,7 +17,7 @@ func getAllElbv2Instances(session *session.Session, region string, excludeAfter
 
 	var arns []*string
 	for _, balancer := range result.LoadBalancers {
-		if excludeAfter.After(*balancer.CreatedTime) {
+		if timeFilter.IncludesResource(balancer.LoadBalancerArn, *balancer.CreatedTime) {
 			arns = append(arns, balancer.LoadBalancerArn)
 		}
 	}
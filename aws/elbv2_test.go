@@ -101,12 +101,12 @@ func TestListELBv2(t *testing.T) {
 	// clean up after this test
 	defer nukeAllElbv2Instances(session, []*string{balancer.LoadBalancerArn})
 
-	arns, err := getAllElbv2Instances(session, region, time.Now().Add(1*time.Hour*-1))
+	arns, err := getAllElbv2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	require.NoError(t, err)
 
 	assert.NotContains(t, awsgo.StringValueSlice(arns), awsgo.StringValue(balancer.LoadBalancerArn))
 
-	arns, err = getAllElbv2Instances(session, region, time.Now().Add(1*time.Hour))
+	arns, err = getAllElbv2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	require.NoError(t, err)
 
 	assert.Contains(t, awsgo.StringValueSlice(arns), awsgo.StringValue(balancer.LoadBalancerArn))
@@ -145,7 +145,7 @@ func TestNukeELBv2(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	arns, err := getAllElbv2Instances(session, region, time.Now().Add(1*time.Hour))
+	arns, err := getAllElbv2Instances(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	require.NoError(t, err)
 
 	assert.NotContains(t, awsgo.StringValueSlice(arns), awsgo.StringValue(balancer.LoadBalancerArn))
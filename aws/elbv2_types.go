@@ -6,6 +6,24 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: LoadBalancersV2{},
+		weight:   1000,
+		actions:  []string{"elasticloadbalancing:DeleteLoadBalancer"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			elbv2Arns, err := getAllElbv2Instances(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return LoadBalancersV2{Arns: awsgo.StringValueSlice(elbv2Arns)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return LoadBalancersV2{Arns: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // LoadBalancersV2 - represents all load balancers
 type LoadBalancersV2 struct {
 	Arns []string
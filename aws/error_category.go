@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+)
+
+// ErrorCategory - what kind of failure an AWS API call ran into, telling apart the failures that need IAM fixes from
+// the ones that only need a re-run
+type ErrorCategory string
+
+const (
+	// The call was still throttled once out of retries. A re-run, or fewer regions at once, usually gets through.
+	ThrottlingError ErrorCategory = "throttling"
+	// The credentials aren't allowed to make the call, which needs an IAM fix
+	PermissionDeniedError ErrorCategory = "permission denied"
+	// Another resource still depends on the one being deleted. A re-run usually gets through once it's gone.
+	DependencyViolationError ErrorCategory = "dependency violation"
+	// The resource doesn't exist, usually because it's already gone
+	NotFoundError ErrorCategory = "not found"
+	// Any other failure
+	UnknownError ErrorCategory = "unknown"
+)
+
+// ErrorCategories - all error categories, in the order they are reported in
+var ErrorCategories = []ErrorCategory{
+	ThrottlingError,
+	PermissionDeniedError,
+	DependencyViolationError,
+	NotFoundError,
+	UnknownError,
+}
+
+// The error codes, across services, of the calls the credentials aren't allowed to make
+var permissionDeniedErrorCodes = []string{
+	"AccessDenied",
+	"AccessDeniedException",
+	"AuthorizationError",
+	"AuthorizationErrorException",
+	"Forbidden",
+	"UnauthorizedOperation",
+	"UnauthorizedAccess",
+	"UnauthorizedException",
+	"UnrecognizedClientException",
+}
+
+// The error codes, across services, of the deletions blocked by another resource
+var dependencyViolationErrorCodes = []string{
+	"DependencyViolation",
+	"DeleteConflict",
+	"InvalidGroup.InUse",
+	"ResourceInUse",
+	"ResourceInUseException",
+	"ResourceInUseFault",
+}
+
+// classifyError - Returns the category of the error of a failed AWS API call
+func classifyError(err error) ErrorCategory {
+	if request.IsErrorThrottle(err) {
+		return ThrottlingError
+	}
+
+	awsErr, isAwsErr := err.(awserr.Error)
+	if !isAwsErr {
+		return UnknownError
+	}
+	code := awsErr.Code()
+
+	switch {
+	case collections.ListContainsElement(permissionDeniedErrorCodes, code):
+		return PermissionDeniedError
+	case collections.ListContainsElement(dependencyViolationErrorCodes, code):
+		return DependencyViolationError
+	// e.g. InvalidInstanceID.NotFound, ResourceNotFoundException or NoSuchEntity
+	case strings.Contains(code, "NotFound") || strings.HasPrefix(code, "NoSuch"):
+		return NotFoundError
+	}
+
+	// Fall back on the HTTP status of the errors whose codes aren't known above
+	if requestFailure, isRequestFailure := err.(awserr.RequestFailure); isRequestFailure {
+		switch requestFailure.StatusCode() {
+		case http.StatusForbidden:
+			return PermissionDeniedError
+		case http.StatusNotFound:
+			return NotFoundError
+		}
+	}
+	return UnknownError
+}
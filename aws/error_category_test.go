@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ThrottlingError, classifyError(awserr.New("ThrottlingException", "Rate exceeded", nil)))
+	assert.Equal(t, ThrottlingError, classifyError(awserr.New("RequestLimitExceeded", "Request limit exceeded", nil)))
+	assert.Equal(t, PermissionDeniedError, classifyError(awserr.New("AccessDeniedException", "not authorized", nil)))
+	assert.Equal(t, PermissionDeniedError, classifyError(awserr.New("UnauthorizedOperation", "not authorized", nil)))
+	assert.Equal(t, DependencyViolationError, classifyError(awserr.New("DependencyViolation", "has dependencies", nil)))
+	assert.Equal(t, DependencyViolationError, classifyError(awserr.New("DeleteConflict", "must detach all policies first", nil)))
+	assert.Equal(t, NotFoundError, classifyError(awserr.New("InvalidInstanceID.NotFound", "does not exist", nil)))
+	assert.Equal(t, NotFoundError, classifyError(awserr.New("ResourceNotFoundException", "does not exist", nil)))
+	assert.Equal(t, NotFoundError, classifyError(awserr.New("NoSuchEntity", "cannot be found", nil)))
+	assert.Equal(t, UnknownError, classifyError(awserr.New("InvalidParameterValue", "invalid", nil)))
+	assert.Equal(t, UnknownError, classifyError(errors.New("connection reset by peer")))
+
+	// Errors with unknown codes fall back on their HTTP status
+	assert.Equal(t, PermissionDeniedError, classifyError(awserr.NewRequestFailure(awserr.New("Denied", "denied", nil), http.StatusForbidden, "request-id")))
+	assert.Equal(t, NotFoundError, classifyError(awserr.NewRequestFailure(awserr.New("Missing", "missing", nil), http.StatusNotFound, "request-id")))
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+)
+
+// ResourceEstimate - roughly how many resources of a type an account holds, before any filtering
+type ResourceEstimate struct {
+	ResourceType string
+	// What the resources are, in plural, e.g. EC2 instances
+	Description string
+	Count       int
+	// Whether there are more resources than counted, as only the first page of them was listed in some region
+	AtLeast bool
+}
+
+// String - Renders the estimate, e.g. 120 EC2 instances, or 1000+ EBS snapshots
+func (estimate ResourceEstimate) String() string {
+	if estimate.AtLeast {
+		return fmt.Sprintf("%d+ %s", estimate.Count, estimate.Description)
+	}
+	return fmt.Sprintf("%d %s", estimate.Count, estimate.Description)
+}
+
+// resourceCounter - counts the resources of a type in a region with a single API call, listing as many of them as a
+// page can hold. Returns whether there are more.
+type resourceCounter struct {
+	resourceType string
+	description  string
+	count        func(svc ec2iface.EC2API) (int, bool, error)
+}
+
+// The resource types that can be counted cheaply, listing the largest page of them the API allows
+var resourceCounters = []resourceCounter{
+	{
+		resourceType: EC2Instances{}.ResourceName(),
+		description:  "EC2 instances",
+		count: func(svc ec2iface.EC2API) (int, bool, error) {
+			output, err := svc.DescribeInstances(&ec2.DescribeInstancesInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   awsgo.String("instance-state-name"),
+						Values: awsgo.StringSlice([]string{"pending", "running", "stopping", "stopped"}),
+					},
+				},
+				MaxResults: awsgo.Int64(1000),
+			})
+			if err != nil {
+				return 0, false, err
+			}
+			count := 0
+			for _, reservation := range output.Reservations {
+				count += len(reservation.Instances)
+			}
+			return count, output.NextToken != nil, nil
+		},
+	},
+	{
+		resourceType: EBSVolumes{}.ResourceName(),
+		description:  "EBS volumes",
+		count: func(svc ec2iface.EC2API) (int, bool, error) {
+			output, err := svc.DescribeVolumes(&ec2.DescribeVolumesInput{MaxResults: awsgo.Int64(500)})
+			if err != nil {
+				return 0, false, err
+			}
+			return len(output.Volumes), output.NextToken != nil, nil
+		},
+	},
+	{
+		resourceType: Snapshots{}.ResourceName(),
+		description:  "EBS snapshots",
+		count: func(svc ec2iface.EC2API) (int, bool, error) {
+			output, err := svc.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+				OwnerIds:   awsgo.StringSlice([]string{"self"}),
+				MaxResults: awsgo.Int64(1000),
+			})
+			if err != nil {
+				return 0, false, err
+			}
+			return len(output.Snapshots), output.NextToken != nil, nil
+		},
+	},
+	{
+		resourceType: AMIs{}.ResourceName(),
+		description:  "AMIs",
+		count: func(svc ec2iface.EC2API) (int, bool, error) {
+			output, err := svc.DescribeImages(&ec2.DescribeImagesInput{
+				Owners:     awsgo.StringSlice([]string{"self"}),
+				MaxResults: awsgo.Int64(1000),
+			})
+			if err != nil {
+				return 0, false, err
+			}
+			return len(output.Images), output.NextToken != nil, nil
+		},
+	},
+	{
+		resourceType: EIPAddresses{}.ResourceName(),
+		description:  "Elastic IPs",
+		count: func(svc ec2iface.EC2API) (int, bool, error) {
+			// Elastic IPs aren't paginated, a region only holds a few of them
+			output, err := svc.DescribeAddresses(&ec2.DescribeAddressesInput{})
+			if err != nil {
+				return 0, false, err
+			}
+			return len(output.Addresses), false, nil
+		},
+	},
+	{
+		resourceType: VPCs{}.ResourceName(),
+		description:  "VPCs",
+		count: func(svc ec2iface.EC2API) (int, bool, error) {
+			output, err := svc.DescribeVpcs(&ec2.DescribeVpcsInput{MaxResults: awsgo.Int64(1000)})
+			if err != nil {
+				return 0, false, err
+			}
+			return len(output.Vpcs), output.NextToken != nil, nil
+		},
+	},
+}
+
+// estimateRegionResources - Adds the resources of the selected types counted in a region to the estimates. Failing to
+// count some of them, e.g. for lack of permissions, is only logged, as the estimate is only a hint.
+func estimateRegionResources(svc ec2iface.EC2API, region string, resourceTypes []string, excludeResourceTypes []string, estimates map[string]*ResourceEstimate) {
+	for _, counter := range resourceCounters {
+		if !IsNukeable(counter.resourceType, resourceTypes, excludeResourceTypes) {
+			continue
+		}
+		count, more, err := counter.count(svc)
+		if err != nil {
+			logging.Logger.Warnf("Unable to estimate the number of %s in %s: %s", counter.description, region, err)
+			continue
+		}
+
+		if estimates[counter.resourceType] == nil {
+			estimates[counter.resourceType] = &ResourceEstimate{ResourceType: counter.resourceType, Description: counter.description}
+		}
+		estimates[counter.resourceType].Count += count
+		estimates[counter.resourceType].AtLeast = estimates[counter.resourceType].AtLeast || more
+	}
+}
+
+// EstimateResources - Returns roughly how many resources of some of the selected types the given regions hold, from a
+// single API call per resource type and region, so that the size of the account is known before the much longer
+// discovery of all its resources. The estimates count all the resources, whatever their age, and are sorted by
+// resource type.
+func EstimateResources(regions []string, excludedRegions []string, resourceTypes []string, excludeResourceTypes []string) []ResourceEstimate {
+	estimates := map[string]*ResourceEstimate{}
+	for _, region := range regions {
+		if collections.ListContainsElement(excludedRegions, region) {
+			continue
+		}
+		estimateRegionResources(ec2.New(newSession(region)), region, resourceTypes, excludeResourceTypes, estimates)
+	}
+
+	var sorted []ResourceEstimate
+	for _, estimate := range estimates {
+		sorted = append(sorted, *estimate)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ResourceType < sorted[j].ResourceType
+	})
+	return sorted
+}
+
+// FormatEstimates - Renders the estimates on a single line, e.g. This account contains roughly 120 EC2 instances, 300
+// EBS volumes
+func FormatEstimates(estimates []ResourceEstimate) string {
+	var counts []string
+	for _, estimate := range estimates {
+		counts = append(counts, estimate.String())
+	}
+	return "This account contains roughly " + strings.Join(counts, ", ")
+}
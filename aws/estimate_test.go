@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEstimateEC2 - an EC2 API returning the given page of instances and volumes
+type fakeEstimateEC2 struct {
+	ec2iface.EC2API
+	instances   int
+	volumes     int
+	moreVolumes bool
+}
+
+func (svc fakeEstimateEC2) DescribeInstances(input *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+	reservation := &ec2.Reservation{}
+	for i := 0; i < svc.instances; i++ {
+		reservation.Instances = append(reservation.Instances, &ec2.Instance{})
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{reservation}}, nil
+}
+
+func (svc fakeEstimateEC2) DescribeVolumes(input *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error) {
+	output := &ec2.DescribeVolumesOutput{}
+	for i := 0; i < svc.volumes; i++ {
+		output.Volumes = append(output.Volumes, &ec2.Volume{})
+	}
+	if svc.moreVolumes {
+		output.NextToken = awsgo.String("next")
+	}
+	return output, nil
+}
+
+func TestEstimateRegionResources(t *testing.T) {
+	t.Parallel()
+
+	estimates := map[string]*ResourceEstimate{}
+	resourceTypes := []string{"ec2", "ebs"}
+	estimateRegionResources(fakeEstimateEC2{instances: 3, volumes: 500, moreVolumes: true}, "us-east-1", resourceTypes, nil, estimates)
+	estimateRegionResources(fakeEstimateEC2{instances: 2, volumes: 4}, "us-west-2", resourceTypes, []string{"ebs"}, estimates)
+
+	assert.Equal(t, map[string]*ResourceEstimate{
+		"ec2": {ResourceType: "ec2", Description: "EC2 instances", Count: 5},
+		"ebs": {ResourceType: "ebs", Description: "EBS volumes", Count: 500, AtLeast: true},
+	}, estimates)
+	assert.Equal(t, "This account contains roughly 500+ EBS volumes, 5 EC2 instances", FormatEstimates([]ResourceEstimate{*estimates["ebs"], *estimates["ec2"]}))
+}
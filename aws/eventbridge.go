@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The default event bus of an account can't be deleted
+const defaultEventBusName = "default"
+
+// The most targets RemoveTargets accepts at once
+const eventBridgeRemoveTargetsMaxBatchSize = 100
+
+// Rules only exist within an event bus, so they are identified by the name of the bus and their own name, separated
+// by a slash. Partner event bus names contain slashes, while rule names can't.
+func eventBridgeRuleIdentifier(busName *string, ruleName *string) *string {
+	return awsgo.String(fmt.Sprintf("%s/%s", awsgo.StringValue(busName), awsgo.StringValue(ruleName)))
+}
+
+func parseEventBridgeRuleIdentifier(identifier string) (*string, *string, error) {
+	separator := strings.LastIndex(identifier, "/")
+	if separator < 1 || separator == len(identifier)-1 {
+		return nil, nil, errors.WithStackTrace(InvalidEventBridgeRuleIdentifierError{Identifier: identifier})
+	}
+	return awsgo.String(identifier[:separator]), awsgo.String(identifier[separator+1:]), nil
+}
+
+// listEventBuses - Returns all the event buses of the region, the default one included
+func listEventBuses(svc *eventbridge.EventBridge) ([]*eventbridge.EventBus, error) {
+	var buses []*eventbridge.EventBus
+	input := &eventbridge.ListEventBusesInput{}
+	for {
+		output, err := svc.ListEventBuses(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		buses = append(buses, output.EventBuses...)
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return buses, nil
+}
+
+// getEventBridgeRuleFirstSeenTime returns the time cloud-nuke first saw the rule, tagging it with the current time if
+// it hasn't been seen before. We need this because a rule doesn't contain an attribute that gives us its creation
+// time.
+func getEventBridgeRuleFirstSeenTime(svc *eventbridge.EventBridge, ruleArn *string) (*time.Time, error) {
+	result, err := svc.ListTagsForResource(&eventbridge.ListTagsForResourceInput{ResourceARN: ruleArn})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	for _, tag := range result.Tags {
+		if awsgo.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(firstSeenTagLayout, awsgo.StringValue(tag.Value))
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			return &firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err = svc.TagResource(&eventbridge.TagResourceInput{
+		ResourceARN: ruleArn,
+		Tags: []*eventbridge.Tag{
+			{
+				Key:   awsgo.String(firstSeenTagKey),
+				Value: awsgo.String(now.Format(firstSeenTagLayout)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &now, nil
+}
+
+// Returns the identifiers of the EventBridge rules of all event buses. The rules managed by other AWS services are
+// left alone, as they go away along with the resources they belong to.
+func getAllEventBridgeRules(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := eventbridge.New(session)
+
+	buses, err := listEventBuses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifiers []*string
+	for _, bus := range buses {
+		input := &eventbridge.ListRulesInput{EventBusName: bus.Name}
+		for {
+			output, err := svc.ListRules(input)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			for _, rule := range output.Rules {
+				if rule.ManagedBy != nil {
+					continue
+				}
+				firstSeenTime, err := getEventBridgeRuleFirstSeenTime(svc, rule.Arn)
+				if err != nil {
+					return nil, err
+				}
+				identifier := eventBridgeRuleIdentifier(bus.Name, rule.Name)
+				if timeFilter.IncludesFirstSeenResource(identifier, *firstSeenTime) {
+					identifiers = append(identifiers, identifier)
+				}
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+			input.NextToken = output.NextToken
+		}
+	}
+
+	return identifiers, nil
+}
+
+// removeEventBridgeRuleTargets - Removes all the targets of the rule, which has to be done before deleting it
+func removeEventBridgeRuleTargets(svc *eventbridge.EventBridge, busName *string, ruleName *string) error {
+	var targetIds []string
+	input := &eventbridge.ListTargetsByRuleInput{EventBusName: busName, Rule: ruleName}
+	for {
+		output, err := svc.ListTargetsByRule(input)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, target := range output.Targets {
+			targetIds = append(targetIds, awsgo.StringValue(target.Id))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	for _, batch := range split(targetIds, eventBridgeRemoveTargetsMaxBatchSize) {
+		output, err := svc.RemoveTargets(&eventbridge.RemoveTargetsInput{
+			EventBusName: busName,
+			Rule:         ruleName,
+			Ids:          awsgo.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if awsgo.Int64Value(output.FailedEntryCount) > 0 {
+			return errors.WithStackTrace(EventBridgeTargetsNotRemovedError{
+				RuleName:    awsgo.StringValue(ruleName),
+				FailedCount: awsgo.Int64Value(output.FailedEntryCount),
+			})
+		}
+	}
+	return nil
+}
+
+// Removes the targets of all the given EventBridge rules, and deletes them
+func nukeAllEventBridgeRules(session *session.Session, identifiers []*string) error {
+	svc := eventbridge.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Infof("No EventBridge rules to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all EventBridge rules in region %s", *session.Config.Region)
+	var deletedIdentifiers []*string
+
+	for _, identifier := range identifiers {
+		busName, ruleName, err := parseEventBridgeRuleIdentifier(*identifier)
+		if err == nil {
+			err = removeEventBridgeRuleTargets(svc, busName, ruleName)
+		}
+		if err == nil {
+			_, err = svc.DeleteRule(&eventbridge.DeleteRuleInput{EventBusName: busName, Name: ruleName})
+		}
+		if err != nil {
+			if awsErr, isAwsErr := errors.Unwrap(err).(awserr.Error); isAwsErr && awsErr.Code() == eventbridge.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("EventBridge rule %s has already been deleted", *identifier)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Infof("Deleted EventBridge rule: %s", *identifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d EventBridge rule(s) deleted in %s", len(deletedIdentifiers), *session.Config.Region)
+	return nil
+}
+
+// Returns the names of all custom and partner event buses. The default event bus can't be deleted.
+func getAllEventBridgeBuses(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := eventbridge.New(session)
+
+	buses, err := listEventBuses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []*string
+	for _, bus := range buses {
+		if awsgo.StringValue(bus.Name) == defaultEventBusName {
+			continue
+		}
+		createdAt := bus.CreationTime
+		if createdAt == nil {
+			createdAt = bus.LastModifiedTime
+		}
+		if createdAt != nil && timeFilter.IncludesResource(bus.Name, *createdAt) {
+			names = append(names, bus.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// Deletes all the given event buses. Their rules have to be deleted first.
+func nukeAllEventBridgeBuses(session *session.Session, names []*string) error {
+	svc := eventbridge.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No EventBridge event buses to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all EventBridge event buses in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteEventBus(&eventbridge.DeleteEventBusInput{Name: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted EventBridge event bus: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d EventBridge event bus(es) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEventBridgeRuleIdentifier(t *testing.T) {
+	t.Parallel()
+
+	busName, ruleName, err := parseEventBridgeRuleIdentifier(*eventBridgeRuleIdentifier(awsgo.String("default"), awsgo.String("nightly-cleanup")))
+	require.NoError(t, err)
+	assert.Equal(t, "default", *busName)
+	assert.Equal(t, "nightly-cleanup", *ruleName)
+
+	// Partner event bus names contain slashes
+	busName, ruleName, err = parseEventBridgeRuleIdentifier("aws.partner/example.com/123/orders/forward-orders")
+	require.NoError(t, err)
+	assert.Equal(t, "aws.partner/example.com/123/orders", *busName)
+	assert.Equal(t, "forward-orders", *ruleName)
+
+	_, _, err = parseEventBridgeRuleIdentifier("nightly-cleanup")
+	assert.Error(t, err)
+	_, _, err = parseEventBridgeRuleIdentifier("default/")
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before event buses, which can't be deleted while they have rules
+	registerResourceType(awsResourceType{
+		resource: EventBridgeRules{},
+		weight:   6100,
+		actions:  []string{"events:TagResource", "events:RemoveTargets", "events:DeleteRule"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			identifiers, err := getAllEventBridgeRules(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return EventBridgeRules{Identifiers: awsgo.StringValueSlice(identifiers)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: EventBridgeBuses{},
+		weight:   6200,
+		actions:  []string{"events:DeleteEventBus"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllEventBridgeBuses(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return EventBridgeBuses{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// EventBridgeRules - represents all EventBridge rules that aren't managed by other AWS services
+type EventBridgeRules struct {
+	// Each identifier is the name of the event bus and the name of the rule, separated by a slash
+	Identifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (rules EventBridgeRules) ResourceName() string {
+	return "eventbridgerule"
+}
+
+// ResourceIdentifiers - The event buses and names of the rules
+func (rules EventBridgeRules) ResourceIdentifiers() []string {
+	return rules.Identifiers
+}
+
+func (rules EventBridgeRules) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (rules EventBridgeRules) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEventBridgeRules(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// EventBridgeBuses - represents all custom and partner EventBridge event buses
+type EventBridgeBuses struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (buses EventBridgeBuses) ResourceName() string {
+	return "eventbridgebus"
+}
+
+// ResourceIdentifiers - The names of the event buses
+func (buses EventBridgeBuses) ResourceIdentifiers() []string {
+	return buses.Names
+}
+
+func (buses EventBridgeBuses) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (buses EventBridgeBuses) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEventBridgeBuses(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidEventBridgeRuleIdentifierError - returned when a rule identifier isn't an event bus name/rule name pair
+type InvalidEventBridgeRuleIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidEventBridgeRuleIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid EventBridge rule identifier %s, expected <event bus name>/<rule name>", e.Identifier)
+}
+
+// EventBridgeTargetsNotRemovedError - returned when some targets of a rule couldn't be removed, which keeps it from
+// being deleted
+type EventBridgeTargetsNotRemovedError struct {
+	RuleName    string
+	FailedCount int64
+}
+
+func (e EventBridgeTargetsNotRemovedError) Error() string {
+	return fmt.Sprintf("%d target(s) of EventBridge rule %s couldn't be removed", e.FailedCount, e.RuleName)
+}
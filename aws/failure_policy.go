@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FailurePolicy - what to do when nuking a batch of resources fails
+type FailurePolicy string
+
+const (
+	// FailurePolicyAbortRun - stop the run at the first failure, leaving all the resources left alone
+	FailurePolicyAbortRun FailurePolicy = "abort-run"
+	// FailurePolicyAbortType - give up on the resource type in the region, and move on to the next one
+	FailurePolicyAbortType FailurePolicy = "abort-type"
+	// FailurePolicyContinue - give up on the batch only, and move on to the next batch of the resource type
+	FailurePolicyContinue FailurePolicy = "continue"
+)
+
+// AllFailurePolicies - the supported failure policies
+var AllFailurePolicies = []FailurePolicy{FailurePolicyAbortRun, FailurePolicyAbortType, FailurePolicyContinue}
+
+// IsValidFailurePolicy - Checks if the failure policy is supported
+func IsValidFailurePolicy(policy string) bool {
+	for _, validPolicy := range AllFailurePolicies {
+		if FailurePolicy(policy) == validPolicy {
+			return true
+		}
+	}
+	return false
+}
+
+// FailurePolicies - what to do when nuking the resources of a type fails, e.g. so that best-effort resource types such
+// as log streams don't stop the run, while must-succeed ones such as NAT gateways do. Resource types without a policy
+// of their own, with no default policy either, abort the run.
+type FailurePolicies struct {
+	// The policy of the resource types without one of their own
+	Default FailurePolicy
+	// The policies of some resource types, keyed by resource type
+	PerResourceType map[string]FailurePolicy
+}
+
+// For - Returns the failure policy of the given resource type
+func (policies FailurePolicies) For(resourceType string) FailurePolicy {
+	if policy, hasPolicy := policies.PerResourceType[resourceType]; hasPolicy {
+		return policy
+	}
+	if policies.Default == "" {
+		return FailurePolicyAbortRun
+	}
+	return policies.Default
+}
+
+// NukeFailedError - returned by NukeAllResources when nuking some resource types failed, and their failure policy let
+// the run carry on
+type NukeFailedError struct {
+	// The resource types that failed, along with the region, e.g. cloudwatchlogstream in us-east-1
+	ResourceTypes []string
+}
+
+func (e NukeFailedError) Error() string {
+	return fmt.Sprintf("Failed to nuke these resource types, whose resources not nuked were recorded as failed: %s", strings.Join(e.ResourceTypes, ", "))
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingResources - ec2 instances failing to be nuked
+type failingResources struct {
+	EC2Instances
+}
+
+func (resources failingResources) Nuke(session *session.Session, identifiers []string) error {
+	return errors.New("UnauthorizedOperation")
+}
+
+// recordedResources - ebs volumes recording which of them were nuked
+type recordedResources struct {
+	EBSVolumes
+	nuked *[]string
+}
+
+func (resources recordedResources) Nuke(session *session.Session, identifiers []string) error {
+	*resources.nuked = append(*resources.nuked, identifiers...)
+	return nil
+}
+
+func TestFailurePoliciesFor(t *testing.T) {
+	t.Parallel()
+
+	policies := FailurePolicies{Default: FailurePolicyAbortType, PerResourceType: map[string]FailurePolicy{"cloudwatchlogstream": FailurePolicyContinue}}
+	assert.Equal(t, FailurePolicyContinue, policies.For("cloudwatchlogstream"))
+	assert.Equal(t, FailurePolicyAbortType, policies.For("natgateway"))
+	assert.Equal(t, FailurePolicyAbortRun, FailurePolicies{}.For("natgateway"))
+
+	assert.True(t, IsValidFailurePolicy("continue"))
+	assert.False(t, IsValidFailurePolicy("ignore"))
+}
+
+func TestNukeAllResourcesFailurePolicies(t *testing.T) {
+	t.Parallel()
+
+	newAccount := func(nuked *[]string) *AwsAccountResources {
+		return &AwsAccountResources{Resources: map[string]AwsRegionResource{
+			"us-east-1": {Resources: []AwsResources{
+				failingResources{EC2Instances{InstanceIds: []string{"i-123"}}},
+				recordedResources{EBSVolumes: EBSVolumes{VolumeIds: []string{"vol-123"}}, nuked: nuked},
+			}},
+		}}
+	}
+
+	var nuked []string
+	err := NukeAllResources(context.Background(), newAccount(&nuked), []string{"us-east-1"}, false, NukeTimeouts{}, FailurePolicies{}, nil)
+	assert.EqualError(t, err, "UnauthorizedOperation")
+	assert.Empty(t, nuked)
+
+	nuked = nil
+	policies := FailurePolicies{PerResourceType: map[string]FailurePolicy{"ec2": FailurePolicyContinue}}
+	err = NukeAllResources(context.Background(), newAccount(&nuked), []string{"us-east-1"}, false, NukeTimeouts{}, policies, nil)
+	assert.Equal(t, NukeFailedError{ResourceTypes: []string{"ec2 in us-east-1"}}, err)
+	assert.Equal(t, []string{"vol-123"}, nuked)
+}
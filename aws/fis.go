@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of FIS experiment template ids
+func getAllFisExperimentTemplates(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := fis.New(session)
+
+	var templateIds []*string
+	err := svc.ListExperimentTemplatesPages(
+		&fis.ListExperimentTemplatesInput{},
+		func(page *fis.ListExperimentTemplatesOutput, lastPage bool) bool {
+			for _, template := range page.ExperimentTemplates {
+				if timeFilter.IncludesResource(template.Id, *template.CreationTime) {
+					templateIds = append(templateIds, template.Id)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return templateIds, nil
+}
+
+// Deletes all FIS experiment templates
+func nukeAllFisExperimentTemplates(session *session.Session, templateIds []*string) error {
+	svc := fis.New(session)
+
+	if len(templateIds) == 0 {
+		logging.Logger.Infof("No FIS experiment templates to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all FIS experiment templates in region %s", *session.Config.Region)
+	var deletedTemplateIds []*string
+
+	for _, templateId := range templateIds {
+		_, err := svc.DeleteExperimentTemplate(&fis.DeleteExperimentTemplateInput{
+			Id: templateId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == fis.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("FIS experiment template %s has already been deleted", *templateId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedTemplateIds = append(deletedTemplateIds, templateId)
+			logging.Logger.Infof("Deleted FIS experiment template: %s", *templateId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d FIS experiment template(s) deleted in %s", len(deletedTemplateIds), *session.Config.Region)
+	return nil
+}
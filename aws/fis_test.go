@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fisAssumeRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "fis.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+func createTestFisRole(t *testing.T, session *session.Session, name string) iam.Role {
+	svc := iam.New(session)
+	result, err := svc.CreateRole(&iam.CreateRoleInput{
+		RoleName:                 awsgo.String(name),
+		AssumeRolePolicyDocument: awsgo.String(fisAssumeRolePolicy),
+	})
+	require.NoError(t, err)
+
+	// IAM resources are slow to propagate, so give it some
+	// time
+	time.Sleep(15 * time.Second)
+
+	return *result.Role
+}
+
+// createTestFisExperimentTemplate creates an experiment template that only waits, so that it doesn't need any targets
+func createTestFisExperimentTemplate(t *testing.T, session *session.Session, name string, roleArn *string) fis.ExperimentTemplate {
+	svc := fis.New(session)
+	result, err := svc.CreateExperimentTemplate(&fis.CreateExperimentTemplateInput{
+		Description: awsgo.String(name),
+		RoleArn:     roleArn,
+		Actions: map[string]*fis.CreateExperimentTemplateActionInput{
+			"wait": {
+				ActionId:   awsgo.String("aws:fis:wait"),
+				Parameters: map[string]*string{"duration": awsgo.String("PT1M")},
+			},
+		},
+		StopConditions: []*fis.CreateExperimentTemplateStopConditionInput{
+			{Source: awsgo.String("none")},
+		},
+	})
+	require.NoError(t, err)
+
+	return *result.ExperimentTemplate
+}
+
+func TestListFisExperimentTemplates(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	role := createTestFisRole(t, session, uniqueTestID)
+	defer deleteRole(session, role)
+
+	template := createTestFisExperimentTemplate(t, session, uniqueTestID, role.Arn)
+	// clean up after this test
+	defer nukeAllFisExperimentTemplates(session, []*string{template.Id})
+
+	templateIds, err := getAllFisExperimentTemplates(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of FIS experiment templates")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(templateIds), awsgo.StringValue(template.Id))
+
+	templateIds, err = getAllFisExperimentTemplates(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of FIS experiment templates")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(templateIds), awsgo.StringValue(template.Id))
+}
+
+func TestNukeFisExperimentTemplates(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	role := createTestFisRole(t, session, uniqueTestID)
+	defer deleteRole(session, role)
+
+	template := createTestFisExperimentTemplate(t, session, uniqueTestID, role.Arn)
+
+	if err := nukeAllFisExperimentTemplates(session, []*string{template.Id}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	templateIds, err := getAllFisExperimentTemplates(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of FIS experiment templates")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(templateIds), awsgo.StringValue(template.Id))
+}
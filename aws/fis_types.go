@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: FisExperimentTemplates{},
+		weight:   3600,
+		actions:  []string{"fis:DeleteExperimentTemplate"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			templateIds, err := getAllFisExperimentTemplates(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return FisExperimentTemplates{TemplateIds: awsgo.StringValueSlice(templateIds)}, nil
+		},
+	})
+}
+
+// FisExperimentTemplates - represents all Fault Injection Simulator experiment templates
+type FisExperimentTemplates struct {
+	TemplateIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (template FisExperimentTemplates) ResourceName() string {
+	return "fisexperimenttemplate"
+}
+
+// ResourceIdentifiers - The ids of the experiment templates
+func (template FisExperimentTemplates) ResourceIdentifiers() []string {
+	return template.TemplateIds
+}
+
+func (template FisExperimentTemplates) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (template FisExperimentTemplates) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllFisExperimentTemplates(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
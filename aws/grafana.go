@@ -0,0 +1,112 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Amazon Managed Grafana. Refer to
+// https://aws.amazon.com/about-aws/global-infrastructure/regional-product-services/
+var grafanaRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-2",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+}
+
+// grafanaSupportedRegion returns true if the provided region supports Amazon Managed Grafana
+func grafanaSupportedRegion(region string) bool {
+	return collections.ListContainsElement(grafanaRegions, region)
+}
+
+// Returns a formatted string of Managed Grafana workspace ids
+func getAllGrafanaWorkspaces(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := managedgrafana.New(session)
+
+	var workspaceIds []*string
+	err := svc.ListWorkspacesPages(
+		&managedgrafana.ListWorkspacesInput{},
+		func(page *managedgrafana.ListWorkspacesOutput, lastPage bool) bool {
+			for _, workspace := range page.Workspaces {
+				if awsgo.StringValue(workspace.Status) == managedgrafana.WorkspaceStatusDeleting {
+					continue
+				}
+				if timeFilter.IncludesResource(workspace.Id, *workspace.Created) {
+					workspaceIds = append(workspaceIds, workspace.Id)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return workspaceIds, nil
+}
+
+// Deletes all Managed Grafana workspaces
+func nukeAllGrafanaWorkspaces(session *session.Session, workspaceIds []*string) error {
+	svc := managedgrafana.New(session)
+
+	if len(workspaceIds) == 0 {
+		logging.Logger.Infof("No Managed Grafana workspaces to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Managed Grafana workspaces in region %s", *session.Config.Region)
+	var deletedWorkspaceIds []*string
+
+	for _, workspaceId := range workspaceIds {
+		_, err := svc.DeleteWorkspace(&managedgrafana.DeleteWorkspaceInput{
+			WorkspaceId: workspaceId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == managedgrafana.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Managed Grafana workspace %s has already been deleted", *workspaceId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedWorkspaceIds = append(deletedWorkspaceIds, workspaceId)
+			logging.Logger.Infof("Deleted Managed Grafana workspace: %s", *workspaceId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Managed Grafana workspace(s) deleted in %s", len(deletedWorkspaceIds), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given Managed Grafana workspaces have actually been deleted
+func waitUntilGrafanaWorkspacesDeleted(session *session.Session, workspaceIds []*string) error {
+	svc := managedgrafana.New(session)
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(workspaceIds), func(workspaceId string) (bool, error) {
+		_, err := svc.DescribeWorkspace(&managedgrafana.DescribeWorkspaceInput{
+			WorkspaceId: awsgo.String(workspaceId),
+		})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == managedgrafana.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(remaining) > 0 {
+		return ResourcesNotDeletedError{ResourceType: GrafanaWorkspaces{}.ResourceName(), Identifiers: remaining}
+	}
+
+	return nil
+}
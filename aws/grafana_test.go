@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGrafanaWorkspace(t *testing.T, session *session.Session, name string) string {
+	svc := managedgrafana.New(session)
+	result, err := svc.CreateWorkspace(&managedgrafana.CreateWorkspaceInput{
+		WorkspaceName:           awsgo.String(name),
+		AccountAccessType:       awsgo.String(managedgrafana.AccountAccessTypeCurrentAccount),
+		AuthenticationProviders: awsgo.StringSlice([]string{managedgrafana.AuthenticationProviderTypesSaml}),
+		PermissionType:          awsgo.String(managedgrafana.PermissionTypeServiceManaged),
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(result.Workspace.Id)
+}
+
+func TestListGrafanaWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, grafanaRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	workspaceId := createTestGrafanaWorkspace(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllGrafanaWorkspaces(session, []*string{awsgo.String(workspaceId)})
+
+	workspaceIds, err := getAllGrafanaWorkspaces(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Managed Grafana workspaces")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(workspaceIds), workspaceId)
+
+	workspaceIds, err = getAllGrafanaWorkspaces(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Managed Grafana workspaces")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(workspaceIds), workspaceId)
+}
+
+func TestNukeGrafanaWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, grafanaRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	workspaceId := createTestGrafanaWorkspace(t, session, uniqueTestID)
+
+	if err := nukeAllGrafanaWorkspaces(session, []*string{awsgo.String(workspaceId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	workspaceIds, err := getAllGrafanaWorkspaces(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Managed Grafana workspaces")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(workspaceIds), workspaceId)
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: GrafanaWorkspaces{},
+		weight:   3800,
+		actions:  []string{"grafana:DeleteWorkspace"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !grafanaSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			workspaceIds, err := getAllGrafanaWorkspaces(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return GrafanaWorkspaces{WorkspaceIds: awsgo.StringValueSlice(workspaceIds)}, nil
+		},
+	})
+}
+
+// GrafanaWorkspaces - represents all Managed Grafana workspaces
+type GrafanaWorkspaces struct {
+	WorkspaceIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (workspace GrafanaWorkspaces) ResourceName() string {
+	return "grafanaworkspace"
+}
+
+// ResourceIdentifiers - The ids of the Managed Grafana workspaces
+func (workspace GrafanaWorkspaces) ResourceIdentifiers() []string {
+	return workspace.WorkspaceIds
+}
+
+func (workspace GrafanaWorkspaces) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (workspace GrafanaWorkspaces) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllGrafanaWorkspaces(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (workspace GrafanaWorkspaces) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilGrafanaWorkspacesDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,450 @@
+package aws
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How long to wait for the metadata endpoints to answer. They answer right away from within AWS, and aren't there at
+// all outside of it, so this only has to be long enough not to slow down the runs from a laptop.
+var hostMetadataTimeout = 1 * time.Second
+
+// The environment variable ECS sets in the containers of its tasks, pointing to the task metadata endpoint
+const ecsContainerMetadataUriEnvVar = "ECS_CONTAINER_METADATA_URI_V4"
+
+// The environment variables Lambda sets in the runtime of its functions
+const (
+	lambdaFunctionNameEnvVar = "AWS_LAMBDA_FUNCTION_NAME"
+	lambdaRegionEnvVar       = "AWS_REGION"
+)
+
+// The tag CloudFormation puts on the resources it creates, holding the name of their stack
+const cloudFormationStackNameTag = "aws:cloudformation:stack-name"
+
+// HostResources - the resources cloud-nuke itself runs on, when it runs inside AWS, e.g. as a scheduled job, along
+// with the CloudFormation stack it was deployed with, and the VPC and NAT gateway it reaches AWS through. They are
+// never nuked, so that the run doesn't kill itself halfway through. The subnets of the host are nuked along with its
+// VPC, so they are protected with it.
+type HostResources struct {
+	// The account and region the host is in
+	AccountID string
+	Region    string
+	// The identifiers of the resources, keyed by resource type
+	Identifiers map[string][]string
+}
+
+func (host *HostResources) add(resourceType string, identifiers ...string) {
+	for _, identifier := range identifiers {
+		if identifier != "" && !collections.ListContainsElement(host.Identifiers[resourceType], identifier) {
+			host.Identifiers[resourceType] = append(host.Identifiers[resourceType], identifier)
+		}
+	}
+}
+
+// Contains - Checks if the given resource of the given account is one cloud-nuke runs on
+func (host *HostResources) Contains(accountID string, region string, resourceType string, identifier string) bool {
+	if host == nil || accountID != host.AccountID || region != host.Region {
+		return false
+	}
+	return collections.ListContainsElement(host.Identifiers[resourceType], identifier)
+}
+
+// GetHostResources - Looks up the resources cloud-nuke runs on, through the environment of Lambda functions, the ECS
+// task metadata and the EC2 instance metadata. Returns nil when cloud-nuke doesn't run inside AWS. Has to be called with
+// the credentials cloud-nuke was started with, before assuming roles in other accounts, since the resources are looked
+// up in the account of the host. The resources the credentials aren't allowed to look up are logged, and left
+// unprotected.
+func GetHostResources() (*HostResources, error) {
+	host := &HostResources{Identifiers: map[string][]string{}}
+
+	// Lambda functions and Fargate tasks don't run on EC2 instances, so there's no point in waiting for the instance
+	// metadata not to answer
+	if functionName := os.Getenv(lambdaFunctionNameEnvVar); functionName != "" {
+		if err := addLambdaFunctionResources(host, functionName, os.Getenv(lambdaRegionEnvVar)); err != nil {
+			return nil, err
+		}
+		return host, nil
+	}
+
+	if metadataUri := os.Getenv(ecsContainerMetadataUriEnvVar); metadataUri != "" {
+		onFargate, err := addEcsTaskResources(host, metadataUri)
+		if err != nil {
+			return nil, err
+		}
+		if onFargate {
+			return host, nil
+		}
+	}
+
+	// The tasks running on EC2 container instances also have access to the metadata of the instance
+	if err := addEc2InstanceResources(host); err != nil {
+		return nil, err
+	}
+
+	if host.Region == "" {
+		return nil, nil
+	}
+	return host, nil
+}
+
+// addEc2InstanceResources - Adds the instance cloud-nuke runs on, along with its Auto Scaling Group, network
+// interfaces, security groups, VPC and CloudFormation stack, when it runs on an EC2 instance
+func addEc2InstanceResources(host *HostResources) error {
+	metadata := ec2metadata.New(session.Must(session.NewSession()), awsgo.NewConfig().
+		WithHTTPClient(&http.Client{Timeout: hostMetadataTimeout}).
+		WithMaxRetries(0))
+	if !metadata.Available() {
+		return nil
+	}
+
+	document, err := metadata.GetInstanceIdentityDocument()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if host.Region == "" {
+		host.AccountID = document.AccountID
+		host.Region = document.Region
+	}
+	host.add(EC2Instances{}.ResourceName(), document.InstanceID)
+
+	session := newSession(document.Region)
+	instances, err := ec2.New(session).DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{awsgo.String(document.InstanceID)},
+	})
+	if err != nil {
+		if err := unlessAccessDenied(err, "the network interfaces and security groups of instance "+document.InstanceID); err != nil {
+			return err
+		}
+	} else {
+		for _, reservation := range instances.Reservations {
+			for _, instance := range reservation.Instances {
+				for _, networkInterface := range instance.NetworkInterfaces {
+					host.add(NetworkInterfaces{}.ResourceName(), awsgo.StringValue(networkInterface.NetworkInterfaceId))
+				}
+				for _, securityGroup := range instance.SecurityGroups {
+					host.add(SecurityGroups{}.ResourceName(), awsgo.StringValue(securityGroup.GroupId))
+				}
+				if err := addNetworkResources(host, session, awsgo.StringValue(instance.VpcId), awsgo.StringValue(instance.SubnetId)); err != nil {
+					return err
+				}
+				for _, tag := range instance.Tags {
+					if awsgo.StringValue(tag.Key) == cloudFormationStackNameTag {
+						if err := addCloudFormationStack(host, session, awsgo.StringValue(tag.Value)); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	asgInstances, err := autoscaling.New(session).DescribeAutoScalingInstances(&autoscaling.DescribeAutoScalingInstancesInput{
+		InstanceIds: []*string{awsgo.String(document.InstanceID)},
+	})
+	if err != nil {
+		return unlessAccessDenied(err, "the Auto Scaling Group of instance "+document.InstanceID)
+	}
+	for _, asgInstance := range asgInstances.AutoScalingInstances {
+		host.add(ASGroups{}.ResourceName(), awsgo.StringValue(asgInstance.AutoScalingGroupName))
+	}
+
+	return nil
+}
+
+// addLambdaFunctionResources - Adds the security groups, network interfaces, VPC and CloudFormation stack of the
+// Lambda function cloud-nuke runs in. Lambda functions aren't nuked by cloud-nuke themselves.
+func addLambdaFunctionResources(host *HostResources, functionName string, region string) error {
+	host.Region = region
+	session := newSession(region)
+	function, err := lambda.New(session).GetFunction(&lambda.GetFunctionInput{FunctionName: awsgo.String(functionName)})
+	if err != nil {
+		return unlessAccessDenied(err, "the security groups, network interfaces, VPC and CloudFormation stack of Lambda function "+functionName)
+	}
+	functionArn, err := arn.Parse(awsgo.StringValue(function.Configuration.FunctionArn))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	host.AccountID = functionArn.AccountID
+
+	if vpcConfig := function.Configuration.VpcConfig; vpcConfig != nil && awsgo.StringValue(vpcConfig.VpcId) != "" {
+		host.add(SecurityGroups{}.ResourceName(), awsgo.StringValueSlice(vpcConfig.SecurityGroupIds)...)
+		if err := addNetworkResources(host, session, awsgo.StringValue(vpcConfig.VpcId), awsgo.StringValueSlice(vpcConfig.SubnetIds)...); err != nil {
+			return err
+		}
+
+		// The network interfaces Lambda created for the function, which it shares with the functions of the same
+		// subnets and security groups
+		err := ec2.New(session).DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{
+				{Name: awsgo.String("vpc-id"), Values: []*string{vpcConfig.VpcId}},
+				{Name: awsgo.String("description"), Values: []*string{awsgo.String(lambdaNetworkInterfaceDescriptionPrefix + functionName + "-*")}},
+			},
+		}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			for _, networkInterface := range page.NetworkInterfaces {
+				host.add(NetworkInterfaces{}.ResourceName(), awsgo.StringValue(networkInterface.NetworkInterfaceId))
+			}
+			return true
+		})
+		if err != nil {
+			if err := unlessAccessDenied(err, "the network interfaces of Lambda function "+functionName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if stackName := awsgo.StringValue(function.Tags[cloudFormationStackNameTag]); stackName != "" {
+		return addCloudFormationStack(host, session, stackName)
+	}
+	return nil
+}
+
+// addNetworkResources - Adds the VPC the host runs in, along with the NAT gateways its subnets route through
+func addNetworkResources(host *HostResources, session *session.Session, vpcId string, subnetIds ...string) error {
+	if vpcId == "" {
+		return nil
+	}
+	host.add(VPCs{}.ResourceName(), vpcId)
+
+	var routeTables []*ec2.RouteTable
+	err := ec2.New(session).DescribeRouteTablesPages(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{{Name: awsgo.String("vpc-id"), Values: []*string{awsgo.String(vpcId)}}},
+	}, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+		routeTables = append(routeTables, page.RouteTables...)
+		return true
+	})
+	if err != nil {
+		return unlessAccessDenied(err, "the NAT gateways of VPC "+vpcId)
+	}
+	for _, subnetId := range subnetIds {
+		host.add(NatGateways{}.ResourceName(), subnetNatGateways(routeTables, subnetId)...)
+	}
+	return nil
+}
+
+// subnetNatGateways - Returns the NAT gateways the subnet routes through, given the route tables of its VPC. Subnets
+// that aren't associated with a route table explicitly use the main route table of the VPC.
+func subnetNatGateways(routeTables []*ec2.RouteTable, subnetId string) []string {
+	var subnetRouteTable, mainRouteTable *ec2.RouteTable
+	for _, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if awsgo.StringValue(association.SubnetId) == subnetId {
+				subnetRouteTable = routeTable
+			}
+			if awsgo.BoolValue(association.Main) {
+				mainRouteTable = routeTable
+			}
+		}
+	}
+	if subnetRouteTable == nil {
+		subnetRouteTable = mainRouteTable
+	}
+	if subnetRouteTable == nil {
+		return nil
+	}
+
+	var natGatewayIds []string
+	for _, route := range subnetRouteTable.Routes {
+		if natGatewayId := awsgo.StringValue(route.NatGatewayId); natGatewayId != "" {
+			natGatewayIds = append(natGatewayIds, natGatewayId)
+		}
+	}
+	return natGatewayIds
+}
+
+// addCloudFormationStack - Adds the CloudFormation stack the host was deployed with, along with the root stack it is
+// nested in, if any, as nuking the root stack deletes its nested stacks
+func addCloudFormationStack(host *HostResources, session *session.Session, stackName string) error {
+	host.add(CloudFormationStacks{}.ResourceName(), stackName)
+
+	stacks, err := cloudformation.New(session).DescribeStacks(&cloudformation.DescribeStacksInput{StackName: awsgo.String(stackName)})
+	if err != nil {
+		return unlessAccessDenied(err, "the root stack of CloudFormation stack "+stackName)
+	}
+	for _, stack := range stacks.Stacks {
+		if rootId := awsgo.StringValue(stack.RootId); rootId != "" {
+			host.add(CloudFormationStacks{}.ResourceName(), stackNameOfId(rootId))
+		}
+	}
+	return nil
+}
+
+// stackNameOfId - Returns the name of the CloudFormation stack of the given id, which is the ARN
+// arn:aws:cloudformation:<region>:<account>:stack/<name>/<uuid>
+func stackNameOfId(stackId string) string {
+	stackArn, err := arn.Parse(stackId)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(stackArn.Resource, "/")
+	if len(parts) != 3 || parts[0] != "stack" {
+		return ""
+	}
+	return parts[1]
+}
+
+// unlessAccessDenied - Returns the error of looking up some of the resources cloud-nuke runs on, unless the
+// credentials aren't allowed to look them up, in which case they are only logged as left unprotected
+func unlessAccessDenied(err error, resources string) error {
+	if classifyError(err) == PermissionDeniedError {
+		logging.Logger.Warnf("Unable to look up %s, which cloud-nuke runs on, so they aren't protected from being nuked: %s", resources, err)
+		return nil
+	}
+	return errors.WithStackTrace(err)
+}
+
+// ecsTaskMetadata - the parts of the response of the ECS task metadata endpoint we need
+type ecsTaskMetadata struct {
+	Cluster    string `json:"Cluster"`
+	TaskARN    string `json:"TaskARN"`
+	LaunchType string `json:"LaunchType"`
+}
+
+// parseEcsTaskMetadata - Parses the response of the ECS task metadata endpoint
+func parseEcsTaskMetadata(contents []byte) (*ecsTaskMetadata, error) {
+	var metadata ecsTaskMetadata
+	if err := json.Unmarshal(contents, &metadata); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if metadata.Cluster == "" || metadata.TaskARN == "" {
+		return nil, InvalidEcsTaskMetadataError{Contents: string(contents)}
+	}
+	return &metadata, nil
+}
+
+// addEcsTaskResources - Adds the ECS service the task cloud-nuke runs in belongs to, along with the network
+// interfaces, security groups, VPC and CloudFormation stack of the task. Returns whether the task runs on Fargate,
+// rather than on an EC2 container instance.
+func addEcsTaskResources(host *HostResources, metadataUri string) (bool, error) {
+	client := &http.Client{Timeout: hostMetadataTimeout}
+	response, err := client.Get(metadataUri + "/task")
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	defer response.Body.Close()
+	contents, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	taskMetadata, err := parseEcsTaskMetadata(contents)
+	if err != nil {
+		return false, err
+	}
+	onFargate := taskMetadata.LaunchType == ecs.LaunchTypeFargate
+
+	taskArn, err := arn.Parse(taskMetadata.TaskARN)
+	if err != nil {
+		return onFargate, errors.WithStackTrace(err)
+	}
+	host.AccountID = taskArn.AccountID
+	host.Region = taskArn.Region
+
+	session := newSession(taskArn.Region)
+	svc := ecs.New(session)
+	tasks, err := svc.DescribeTasks(&ecs.DescribeTasksInput{
+		Cluster: awsgo.String(taskMetadata.Cluster),
+		Tasks:   []*string{awsgo.String(taskMetadata.TaskARN)},
+		Include: []*string{awsgo.String(ecs.TaskFieldTags)},
+	})
+	if err != nil {
+		return onFargate, unlessAccessDenied(err, "the ECS service, network interfaces, security groups and VPC of task "+taskMetadata.TaskARN)
+	}
+
+	for _, task := range tasks.Tasks {
+		// Tasks only carry the tags of the stack of their service when it propagates them
+		stackNames := ecsStackNames(task.Tags)
+
+		// The tasks started by a service are in group service:<service name>
+		if serviceName := strings.TrimPrefix(awsgo.StringValue(task.Group), "service:"); serviceName != awsgo.StringValue(task.Group) {
+			services, err := svc.DescribeServices(&ecs.DescribeServicesInput{
+				Cluster:  awsgo.String(taskMetadata.Cluster),
+				Services: []*string{awsgo.String(serviceName)},
+				Include:  []*string{awsgo.String(ecs.ServiceFieldTags)},
+			})
+			if err != nil {
+				if err := unlessAccessDenied(err, "the ECS service of task "+taskMetadata.TaskARN); err != nil {
+					return onFargate, err
+				}
+			} else {
+				for _, service := range services.Services {
+					host.add(ECSServices{}.ResourceName(), awsgo.StringValue(service.ServiceArn))
+					stackNames = append(stackNames, ecsStackNames(service.Tags)...)
+				}
+			}
+		}
+
+		// Tasks in awsvpc network mode have a network interface of their own
+		var networkInterfaceIds []string
+		for _, attachment := range task.Attachments {
+			for _, detail := range attachment.Details {
+				if awsgo.StringValue(detail.Name) == "networkInterfaceId" {
+					networkInterfaceIds = append(networkInterfaceIds, awsgo.StringValue(detail.Value))
+				}
+			}
+		}
+		if len(networkInterfaceIds) > 0 {
+			host.add(NetworkInterfaces{}.ResourceName(), networkInterfaceIds...)
+			networkInterfaces, err := ec2.New(session).DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+				NetworkInterfaceIds: awsgo.StringSlice(networkInterfaceIds),
+			})
+			if err != nil {
+				if err := unlessAccessDenied(err, "the security groups and VPC of task "+taskMetadata.TaskARN); err != nil {
+					return onFargate, err
+				}
+			} else {
+				for _, networkInterface := range networkInterfaces.NetworkInterfaces {
+					for _, securityGroup := range networkInterface.Groups {
+						host.add(SecurityGroups{}.ResourceName(), awsgo.StringValue(securityGroup.GroupId))
+					}
+					if err := addNetworkResources(host, session, awsgo.StringValue(networkInterface.VpcId), awsgo.StringValue(networkInterface.SubnetId)); err != nil {
+						return onFargate, err
+					}
+				}
+			}
+		}
+
+		for _, stackName := range stackNames {
+			if err := addCloudFormationStack(host, session, stackName); err != nil {
+				return onFargate, err
+			}
+		}
+	}
+
+	return onFargate, nil
+}
+
+// ecsStackNames - Returns the name of the CloudFormation stack found in the tags of an ECS task or service, if any
+func ecsStackNames(tags []*ecs.Tag) []string {
+	var stackNames []string
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) == cloudFormationStackNameTag {
+			stackNames = append(stackNames, awsgo.StringValue(tag.Value))
+		}
+	}
+	return stackNames
+}
+
+// InvalidEcsTaskMetadataError - returned when the ECS task metadata endpoint doesn't return the task and its cluster
+type InvalidEcsTaskMetadataError struct {
+	Contents string
+}
+
+func (e InvalidEcsTaskMetadataError) Error() string {
+	return "Unable to find the task and its cluster in the ECS task metadata: " + e.Contents
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostResourcesContains(t *testing.T) {
+	t.Parallel()
+
+	host := &HostResources{AccountID: "123456789012", Region: "us-east-1", Identifiers: map[string][]string{}}
+	host.add(EC2Instances{}.ResourceName(), "i-0123456789abcdef0")
+
+	assert.True(t, host.Contains("123456789012", "us-east-1", EC2Instances{}.ResourceName(), "i-0123456789abcdef0"))
+	assert.False(t, host.Contains("123456789012", "us-west-2", EC2Instances{}.ResourceName(), "i-0123456789abcdef0"))
+	assert.False(t, host.Contains("123456789012", "us-east-1", EC2Instances{}.ResourceName(), "i-0fedcba9876543210"))
+	// The accounts reached by assuming a role don't hold the host, whatever the identifiers of their resources
+	assert.False(t, host.Contains("210987654321", "us-east-1", EC2Instances{}.ResourceName(), "i-0123456789abcdef0"))
+
+	var notInAws *HostResources
+	assert.False(t, notInAws.Contains("123456789012", "us-east-1", EC2Instances{}.ResourceName(), "i-0123456789abcdef0"))
+}
+
+func TestParseEcsTaskMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := parseEcsTaskMetadata([]byte(`{
+		"Cluster": "arn:aws:ecs:us-east-1:123456789012:cluster/scheduled-jobs",
+		"TaskARN": "arn:aws:ecs:us-east-1:123456789012:task/scheduled-jobs/0123456789abcdef0123456789abcdef",
+		"Family": "cloud-nuke",
+		"LaunchType": "FARGATE"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:cluster/scheduled-jobs", metadata.Cluster)
+	assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:task/scheduled-jobs/0123456789abcdef0123456789abcdef", metadata.TaskARN)
+	assert.Equal(t, ecs.LaunchTypeFargate, metadata.LaunchType)
+
+	_, err = parseEcsTaskMetadata([]byte(`{"Family": "cloud-nuke"}`))
+	assert.Error(t, err)
+}
+
+func TestUnlessAccessDenied(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, unlessAccessDenied(awserr.New("AccessDenied", "not allowed", nil), "the Auto Scaling Group of instance i-0123456789abcdef0"))
+	assert.Error(t, unlessAccessDenied(awserr.New("InvalidInstanceID.NotFound", "not found", nil), "the Auto Scaling Group of instance i-0123456789abcdef0"))
+}
+
+func TestSubnetNatGateways(t *testing.T) {
+	t.Parallel()
+
+	routeTables := []*ec2.RouteTable{
+		{
+			Associations: []*ec2.RouteTableAssociation{{Main: awsgo.Bool(true)}},
+			Routes: []*ec2.Route{
+				{DestinationCidrBlock: awsgo.String("10.0.0.0/16"), GatewayId: awsgo.String("local")},
+				{DestinationCidrBlock: awsgo.String("0.0.0.0/0"), NatGatewayId: awsgo.String("nat-0123456789abcdef0")},
+			},
+		},
+		{
+			Associations: []*ec2.RouteTableAssociation{{SubnetId: awsgo.String("subnet-public")}},
+			Routes: []*ec2.Route{
+				{DestinationCidrBlock: awsgo.String("0.0.0.0/0"), GatewayId: awsgo.String("igw-0123456789abcdef0")},
+			},
+		},
+		{
+			Associations: []*ec2.RouteTableAssociation{{SubnetId: awsgo.String("subnet-private")}},
+			Routes: []*ec2.Route{
+				{DestinationCidrBlock: awsgo.String("0.0.0.0/0"), NatGatewayId: awsgo.String("nat-0fedcba9876543210")},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"nat-0fedcba9876543210"}, subnetNatGateways(routeTables, "subnet-private"))
+	assert.Empty(t, subnetNatGateways(routeTables, "subnet-public"))
+	// Subnets that aren't associated with a route table use the main one
+	assert.Equal(t, []string{"nat-0123456789abcdef0"}, subnetNatGateways(routeTables, "subnet-other"))
+	assert.Empty(t, subnetNatGateways(nil, "subnet-other"))
+}
+
+func TestStackNameOfId(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "scheduled-jobs", stackNameOfId("arn:aws:cloudformation:us-east-1:123456789012:stack/scheduled-jobs/01234567-89ab-cdef-0123-456789abcdef"))
+	assert.Equal(t, "", stackNameOfId("scheduled-jobs"))
+}
+
+func TestEcsStackNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"scheduled-jobs"}, ecsStackNames([]*ecs.Tag{
+		{Key: awsgo.String("team"), Value: awsgo.String("platform")},
+		{Key: awsgo.String(cloudFormationStackNameTag), Value: awsgo.String("scheduled-jobs")},
+	}))
+	assert.Empty(t, ecsStackNames([]*ecs.Tag{{Key: awsgo.String("team"), Value: awsgo.String("platform")}}))
+}
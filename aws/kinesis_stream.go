@@ -0,0 +1,99 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of Kinesis stream names
+func getAllKinesisStreams(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := kinesis.New(session)
+
+	var allNames []*string
+	err := svc.ListStreamsPages(
+		&kinesis.ListStreamsInput{},
+		func(page *kinesis.ListStreamsOutput, lastPage bool) bool {
+			allNames = append(allNames, page.StreamNames...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The creation time of a stream is only returned when describing it
+	var names []*string
+	for _, name := range allNames {
+		result, err := svc.DescribeStreamSummary(&kinesis.DescribeStreamSummaryInput{
+			StreamName: name,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		// Streams that are already being deleted will be gone soon enough
+		summary := result.StreamDescriptionSummary
+		if awsgo.StringValue(summary.StreamStatus) == kinesis.StreamStatusDeleting {
+			continue
+		}
+		if timeFilter.IncludesResource(name, *summary.StreamCreationTimestamp) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// Deletes all Kinesis streams, along with their registered consumers
+func nukeAllKinesisStreams(session *session.Session, names []*string) error {
+	svc := kinesis.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No Kinesis streams to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Kinesis streams in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteStream(&kinesis.DeleteStreamInput{
+			StreamName: name,
+			// Streams with registered consumers can't be deleted otherwise
+			EnforceConsumerDeletion: awsgo.Bool(true),
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == kinesis.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Kinesis stream %s has already been deleted", *name)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted Kinesis stream: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Kinesis stream(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given Kinesis streams have actually been deleted
+func waitUntilKinesisStreamsDeleted(session *session.Session, names []*string) error {
+	svc := kinesis.New(session)
+
+	for _, name := range names {
+		err := svc.WaitUntilStreamNotExists(&kinesis.DescribeStreamInput{
+			StreamName: name,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
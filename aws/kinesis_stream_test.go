@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestKinesisStream(t *testing.T, session *session.Session, name string) string {
+	svc := kinesis.New(session)
+	_, err := svc.CreateStream(&kinesis.CreateStreamInput{
+		StreamName: awsgo.String(name),
+		ShardCount: awsgo.Int64(1),
+	})
+	require.NoError(t, err)
+
+	// Streams can only be deleted once they are active
+	err = svc.WaitUntilStreamExists(&kinesis.DescribeStreamInput{
+		StreamName: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListKinesisStreams(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestKinesisStream(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllKinesisStreams(session, []*string{awsgo.String(name)})
+
+	names, err := getAllKinesisStreams(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Kinesis streams")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+
+	names, err = getAllKinesisStreams(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Kinesis streams")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(names), name)
+}
+
+func TestNukeKinesisStreams(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestKinesisStream(t, session, uniqueTestID)
+
+	if err := nukeAllKinesisStreams(session, []*string{awsgo.String(name)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	names, err := getAllKinesisStreams(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Kinesis streams")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: KinesisStreams{},
+		weight:   2900,
+		actions:  []string{"kinesis:DeleteStream"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllKinesisStreams(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return KinesisStreams{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// KinesisStreams - represents all Kinesis streams
+type KinesisStreams struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (streams KinesisStreams) ResourceName() string {
+	return "kinesisstream"
+}
+
+// ResourceIdentifiers - The names of the Kinesis streams
+func (streams KinesisStreams) ResourceIdentifiers() []string {
+	return streams.Names
+}
+
+func (streams KinesisStreams) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (streams KinesisStreams) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllKinesisStreams(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (streams KinesisStreams) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilKinesisStreamsDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"strings"
+	"sync"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The prefix of the aliases AWS reserves for the keys it manages on behalf of its services
+const awsManagedKmsAliasPrefix = "alias/aws/"
+
+// How many keys are described at once. KMS throttles the requests of an account beyond a few dozen per second.
+const kmsDescribeKeyConcurrency = 10
+
+// KMSConfig - how the deletion of KMS keys is scheduled
+type KMSConfig struct {
+	// How many days to wait before the keys are actually deleted, from 7 to 30. Until then, the deletion can be
+	// cancelled. AWS defaults to 30 when zero.
+	PendingWindowInDays int64
+}
+
+// Returns a formatted string of KMS key ids. Keys that are already pending deletion are left out. AWS managed keys
+// are listed too, and left out by the default exclusions.
+func getAllKMSKeys(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := kms.New(session)
+
+	var allKeyIds []*string
+	err := svc.ListKeysPages(
+		&kms.ListKeysInput{},
+		func(page *kms.ListKeysOutput, lastPage bool) bool {
+			for _, key := range page.Keys {
+				allKeyIds = append(allKeyIds, key.KeyId)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// AWS managed keys can't be deleted, and are left out by the default exclusions, so there's no need to describe
+	// them, which is slow on accounts holding many of them
+	awsManagedKeyIds, err := getAwsManagedKmsKeySet(svc)
+	if err != nil {
+		return nil, err
+	}
+	var keyIds []*string
+	var customerKeyIds []*string
+	for _, keyId := range allKeyIds {
+		if awsManagedKeyIds[awsgo.StringValue(keyId)] {
+			keyIds = append(keyIds, keyId)
+		} else {
+			customerKeyIds = append(customerKeyIds, keyId)
+		}
+	}
+
+	// The creation time and state of a key are only returned when describing it
+	keys, err := describeKMSKeys(svc, customerKeyIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		keyState := awsgo.StringValue(key.KeyState)
+		if keyState == kms.KeyStatePendingDeletion || keyState == kms.KeyStatePendingReplicaDeletion {
+			continue
+		}
+		if timeFilter.IncludesResource(key.KeyId, *key.CreationDate) {
+			keyIds = append(keyIds, key.KeyId)
+		}
+	}
+
+	return keyIds, nil
+}
+
+// getAwsManagedKmsKeySet - Returns the ids of the AWS managed keys, which are the targets of the aliases AWS reserves,
+// named alias/aws/<service>. Listing the aliases takes one call per page, rather than describing every key.
+func getAwsManagedKmsKeySet(svc kmsiface.KMSAPI) (map[string]bool, error) {
+	keyIds := map[string]bool{}
+	err := svc.ListAliasesPages(
+		&kms.ListAliasesInput{},
+		func(page *kms.ListAliasesOutput, lastPage bool) bool {
+			for _, alias := range page.Aliases {
+				if strings.HasPrefix(awsgo.StringValue(alias.AliasName), awsManagedKmsAliasPrefix) && alias.TargetKeyId != nil {
+					keyIds[awsgo.StringValue(alias.TargetKeyId)] = true
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return keyIds, nil
+}
+
+// describeKMSKeys - Describes the keys, a few at a time, and returns their metadata in the same order
+func describeKMSKeys(svc kmsiface.KMSAPI, keyIds []*string) ([]*kms.KeyMetadata, error) {
+	keys := make([]*kms.KeyMetadata, len(keyIds))
+	var failure regionFailure
+	var waitGroup sync.WaitGroup
+	slots := make(chan struct{}, kmsDescribeKeyConcurrency)
+	for i, keyId := range keyIds {
+		slots <- struct{}{}
+		if failure.get() != nil {
+			<-slots
+			break
+		}
+		waitGroup.Add(1)
+		go func(i int, keyId *string) {
+			defer waitGroup.Done()
+			defer func() { <-slots }()
+			result, err := svc.DescribeKey(&kms.DescribeKeyInput{KeyId: keyId})
+			if err != nil {
+				failure.set(errors.WithStackTrace(err))
+				return
+			}
+			keys[i] = result.KeyMetadata
+		}(i, keyId)
+	}
+	waitGroup.Wait()
+	if err := failure.get(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// deleteKMSKeyAliases deletes the aliases pointing to the key, so they can be reused right away
+func deleteKMSKeyAliases(svc *kms.KMS, keyId *string) error {
+	var aliasNames []*string
+	err := svc.ListAliasesPages(
+		&kms.ListAliasesInput{KeyId: keyId},
+		func(page *kms.ListAliasesOutput, lastPage bool) bool {
+			for _, alias := range page.Aliases {
+				aliasNames = append(aliasNames, alias.AliasName)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, aliasName := range aliasNames {
+		if _, err := svc.DeleteAlias(&kms.DeleteAliasInput{AliasName: aliasName}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("Deleted KMS alias: %s", *aliasName)
+	}
+
+	return nil
+}
+
+// Schedules the deletion of all KMS keys, after deleting their aliases
+func nukeAllKMSKeys(session *session.Session, config KMSConfig, keyIds []*string) error {
+	svc := kms.New(session)
+
+	if len(keyIds) == 0 {
+		logging.Logger.Infof("No KMS keys to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Scheduling the deletion of all KMS keys in region %s", *session.Config.Region)
+	var scheduledKeyIds []*string
+
+	for _, keyId := range keyIds {
+		err := deleteKMSKeyAliases(svc, keyId)
+		if err == nil {
+			input := &kms.ScheduleKeyDeletionInput{KeyId: keyId}
+			if config.PendingWindowInDays != 0 {
+				input.PendingWindowInDays = awsgo.Int64(config.PendingWindowInDays)
+			}
+			_, err = svc.ScheduleKeyDeletion(input)
+		}
+		if err != nil {
+			if awsErr, isAwsErr := errors.Unwrap(err).(awserr.Error); isAwsErr && awsErr.Code() == kms.ErrCodeNotFoundException {
+				logging.Logger.Infof("KMS key %s has already been deleted", *keyId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			scheduledKeyIds = append(scheduledKeyIds, keyId)
+			logging.Logger.Infof("Scheduled the deletion of KMS key: %s", *keyId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d KMS key(s) scheduled for deletion in %s", len(scheduledKeyIds), *session.Config.Region)
+	return nil
+}
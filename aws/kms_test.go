@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestKMSKey(t *testing.T, session *session.Session, aliasName string) string {
+	svc := kms.New(session)
+	result, err := svc.CreateKey(&kms.CreateKeyInput{
+		Description: awsgo.String("cloud-nuke test key"),
+	})
+	require.NoError(t, err)
+
+	// Make sure the aliases get deleted along with the key
+	_, err = svc.CreateAlias(&kms.CreateAliasInput{
+		AliasName:   awsgo.String(aliasName),
+		TargetKeyId: result.KeyMetadata.KeyId,
+	})
+	require.NoError(t, err)
+
+	return *result.KeyMetadata.KeyId
+}
+
+// Test keys are deleted as soon as possible
+var testKMSConfig = KMSConfig{PendingWindowInDays: 7}
+
+func TestListKMSKeys(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	aliasName := "alias/cloud-nuke-test-" + util.UniqueID()
+	keyId := createTestKMSKey(t, session, aliasName)
+	// clean up after this test
+	defer nukeAllKMSKeys(session, testKMSConfig, []*string{awsgo.String(keyId)})
+
+	keyIds, err := getAllKMSKeys(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of KMS keys")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(keyIds), keyId)
+
+	keyIds, err = getAllKMSKeys(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of KMS keys")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(keyIds), keyId)
+}
+
+func TestNukeKMSKeys(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	aliasName := "alias/cloud-nuke-test-" + util.UniqueID()
+	keyId := createTestKMSKey(t, session, aliasName)
+
+	if err := nukeAllKMSKeys(session, testKMSConfig, []*string{awsgo.String(keyId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	keyIds, err := getAllKMSKeys(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of KMS keys")
+	}
+	assert.NotContains(t, awsgo.StringValueSlice(keyIds), keyId)
+
+	aliases, err := kms.New(session).ListAliases(&kms.ListAliasesInput{KeyId: awsgo.String(keyId)})
+	require.NoError(t, err)
+	assert.Empty(t, aliases.Aliases)
+}
+
+// fakeKMS - lists the aliases of the keys page by page, and describes the keys, keeping track of how many are described
+// at once
+type fakeKMS struct {
+	kmsiface.KMSAPI
+	aliasPages [][]*kms.AliasListEntry
+
+	mutex       sync.Mutex
+	describing  int
+	maxDescribe int
+}
+
+func (fake *fakeKMS) ListAliasesPages(input *kms.ListAliasesInput, fn func(*kms.ListAliasesOutput, bool) bool) error {
+	for i, aliases := range fake.aliasPages {
+		if !fn(&kms.ListAliasesOutput{Aliases: aliases}, i == len(fake.aliasPages)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (fake *fakeKMS) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	fake.mutex.Lock()
+	fake.describing++
+	if fake.describing > fake.maxDescribe {
+		fake.maxDescribe = fake.describing
+	}
+	fake.mutex.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	fake.mutex.Lock()
+	fake.describing--
+	fake.mutex.Unlock()
+	return &kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{KeyId: input.KeyId}}, nil
+}
+
+func TestGetAwsManagedKmsKeySet(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeKMS{aliasPages: [][]*kms.AliasListEntry{
+		{
+			{AliasName: awsgo.String("alias/aws/ebs"), TargetKeyId: awsgo.String("aws-ebs-key")},
+			{AliasName: awsgo.String("alias/my-key"), TargetKeyId: awsgo.String("customer-key")},
+		},
+		{
+			{AliasName: awsgo.String("alias/aws/s3"), TargetKeyId: awsgo.String("aws-s3-key")},
+			// AWS reserves the aliases of its services before their keys are created
+			{AliasName: awsgo.String("alias/aws/rds")},
+		},
+	}}
+
+	keyIds, err := getAwsManagedKmsKeySet(svc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"aws-ebs-key": true, "aws-s3-key": true}, keyIds)
+}
+
+func TestDescribeKMSKeys(t *testing.T) {
+	t.Parallel()
+
+	var keyIds []*string
+	for i := 0; i < 3*kmsDescribeKeyConcurrency; i++ {
+		keyIds = append(keyIds, awsgo.String(fmt.Sprintf("key-%d", i)))
+	}
+
+	svc := &fakeKMS{}
+	keys, err := describeKMSKeys(svc, keyIds)
+	require.NoError(t, err)
+	require.Len(t, keys, len(keyIds))
+	for i, key := range keys {
+		assert.Equal(t, keyIds[i], key.KeyId)
+	}
+	assert.True(t, svc.maxDescribe > 1)
+	assert.True(t, svc.maxDescribe <= kmsDescribeKeyConcurrency)
+}
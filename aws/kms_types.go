@@ -0,0 +1,53 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: KMSKeys{},
+		weight:   3100,
+		actions:  []string{"kms:DeleteAlias", "kms:ScheduleKeyDeletion"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			keyIds, err := getAllKMSKeys(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return KMSKeys{Config: discovery.options.KMS, KeyIds: awsgo.StringValueSlice(keyIds)}, nil
+		},
+	})
+}
+
+// KMSKeys - represents all customer managed KMS keys
+type KMSKeys struct {
+	KeyIds []string
+	// How long to wait before the keys are actually deleted
+	Config KMSConfig
+}
+
+// ResourceName - the simple name of the aws resource
+func (keys KMSKeys) ResourceName() string {
+	return "kmskey"
+}
+
+// ResourceIdentifiers - The ids of the KMS keys
+func (keys KMSKeys) ResourceIdentifiers() []string {
+	return keys.KeyIds
+}
+
+func (keys KMSKeys) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (keys KMSKeys) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllKMSKeys(session, keys.Config, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
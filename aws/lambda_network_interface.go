@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The network interfaces Lambda creates for the functions attached to a VPC are described as
+// "AWS Lambda VPC ENI-<function name>-<uuid>". They are shared by all the functions using the same subnet and
+// security groups, and AWS only reclaims them some time after the last of those functions is deleted or detached.
+const lambdaNetworkInterfaceDescriptionPrefix = "AWS Lambda VPC ENI-"
+
+// How long to wait for AWS to reclaim the network interfaces of Lambda functions, which can take up to 40 minutes
+const lambdaNetworkInterfaceMaxAttempts = 90
+
+var lambdaNetworkInterfaceRetryInterval = 30 * time.Second
+
+// lambdaFunctionName - Returns the name of the Lambda function the network interface was created for, or an empty
+// string if its description doesn't tell
+func lambdaFunctionName(networkInterface *ec2.NetworkInterface) string {
+	description := awsgo.StringValue(networkInterface.Description)
+	if !strings.HasPrefix(description, lambdaNetworkInterfaceDescriptionPrefix) {
+		return ""
+	}
+	// Strip the uuid, which is 36 characters long, along with the dash before it
+	name := strings.TrimPrefix(description, lambdaNetworkInterfaceDescriptionPrefix)
+	if len(name) <= 37 || name[len(name)-37] != '-' {
+		return ""
+	}
+	return name[:len(name)-37]
+}
+
+// vpcConfigUsesNetworkInterface - Checks if a Lambda function with the given VPC config still uses the network
+// interface, i.e. is attached to its subnet with all its security groups
+func vpcConfigUsesNetworkInterface(vpcConfig *lambda.VpcConfigResponse, networkInterface *ec2.NetworkInterface) bool {
+	if vpcConfig == nil {
+		return false
+	}
+	subnetIds := map[string]bool{}
+	for _, subnetId := range vpcConfig.SubnetIds {
+		subnetIds[awsgo.StringValue(subnetId)] = true
+	}
+	if !subnetIds[awsgo.StringValue(networkInterface.SubnetId)] {
+		return false
+	}
+	groupIds := map[string]bool{}
+	for _, groupId := range vpcConfig.SecurityGroupIds {
+		groupIds[awsgo.StringValue(groupId)] = true
+	}
+	for _, group := range networkInterface.Groups {
+		if !groupIds[awsgo.StringValue(group.GroupId)] {
+			return false
+		}
+	}
+	return true
+}
+
+// describeLambdaNetworkInterfaces - Returns the network interfaces of Lambda functions matching the filter
+func describeLambdaNetworkInterfaces(svc ec2iface.EC2API, filter *ec2.Filter) ([]*ec2.NetworkInterface, error) {
+	result, err := svc.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			filter,
+			{
+				Name:   awsgo.String("description"),
+				Values: []*string{awsgo.String(lambdaNetworkInterfaceDescriptionPrefix + "*")},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return result.NetworkInterfaces, nil
+}
+
+// checkLambdaFunctionDetached - Fails when the Lambda function the network interface was created for still uses it,
+// since AWS would then never reclaim it
+func checkLambdaFunctionDetached(lambdaSvc lambdaiface.LambdaAPI, networkInterface *ec2.NetworkInterface, dependent string) error {
+	functionName := lambdaFunctionName(networkInterface)
+	if functionName == "" {
+		return nil
+	}
+	function, err := lambdaSvc.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{
+		FunctionName: awsgo.String(functionName),
+	})
+	if err != nil {
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == lambda.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		return errors.WithStackTrace(err)
+	}
+	if vpcConfigUsesNetworkInterface(function.VpcConfig, networkInterface) {
+		return LambdaNetworkInterfaceInUseError{
+			Dependent:          dependent,
+			NetworkInterfaceId: awsgo.StringValue(networkInterface.NetworkInterfaceId),
+			FunctionName:       functionName,
+		}
+	}
+	return nil
+}
+
+// waitForLambdaNetworkInterfaces - Waits for AWS to reclaim the network interfaces of Lambda functions matching the
+// filter, which block deleting the dependent resource, e.g. a security group or a subnet. Gives up right away when a
+// function that still exists still uses one of them. Returns whether there were any to wait for.
+func waitForLambdaNetworkInterfaces(svc ec2iface.EC2API, lambdaSvc lambdaiface.LambdaAPI, filter *ec2.Filter, dependent string) (bool, error) {
+	networkInterfaces, err := describeLambdaNetworkInterfaces(svc, filter)
+	if err != nil || len(networkInterfaces) == 0 {
+		return false, err
+	}
+
+	for _, networkInterface := range networkInterfaces {
+		if err := checkLambdaFunctionDetached(lambdaSvc, networkInterface, dependent); err != nil {
+			return true, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		var networkInterfaceIds []string
+		for _, networkInterface := range networkInterfaces {
+			networkInterfaceIds = append(networkInterfaceIds, awsgo.StringValue(networkInterface.NetworkInterfaceId))
+		}
+		if attempt == lambdaNetworkInterfaceMaxAttempts {
+			return true, LambdaNetworkInterfacesNotReclaimedError{Dependent: dependent, NetworkInterfaceIds: networkInterfaceIds}
+		}
+		logging.Logger.Infof("...waiting for AWS to reclaim the network interfaces of Lambda functions blocking %s: %s", dependent, strings.Join(networkInterfaceIds, ", "))
+		time.Sleep(lambdaNetworkInterfaceRetryInterval)
+
+		networkInterfaces, err = describeLambdaNetworkInterfaces(svc, filter)
+		if err != nil {
+			return true, err
+		}
+		if len(networkInterfaces) == 0 {
+			return true, nil
+		}
+	}
+}
+
+// LambdaNetworkInterfaceInUseError - returned when a network interface blocking the deletion of a resource still
+// belongs to a Lambda function, which cloud-nuke doesn't delete
+type LambdaNetworkInterfaceInUseError struct {
+	Dependent          string
+	NetworkInterfaceId string
+	FunctionName       string
+}
+
+func (e LambdaNetworkInterfaceInUseError) Error() string {
+	return fmt.Sprintf(
+		"%s is used by network interface %s of Lambda function %s, which cloud-nuke doesn't delete. Delete the function, or remove it from the VPC, and AWS reclaims the network interface within 40 minutes.",
+		e.Dependent,
+		e.NetworkInterfaceId,
+		e.FunctionName,
+	)
+}
+
+// LambdaNetworkInterfacesNotReclaimedError - returned when AWS hasn't reclaimed the network interfaces of Lambda
+// functions blocking the deletion of a resource after waiting for them
+type LambdaNetworkInterfacesNotReclaimedError struct {
+	Dependent           string
+	NetworkInterfaceIds []string
+}
+
+func (e LambdaNetworkInterfacesNotReclaimedError) Error() string {
+	return fmt.Sprintf(
+		"AWS hasn't reclaimed the network interfaces of Lambda functions blocking %s yet: %s. Try again later.",
+		e.Dependent,
+		strings.Join(e.NetworkInterfaceIds, ", "),
+	)
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/golang/mock/gomock"
+	"github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLambda - returns the VPC configs of the functions it holds, and a not found error for the other ones
+type fakeLambda struct {
+	lambdaiface.LambdaAPI
+	vpcConfigs map[string]*lambda.VpcConfigResponse
+}
+
+func (fake fakeLambda) GetFunctionConfiguration(input *lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+	vpcConfig, exists := fake.vpcConfigs[awsgo.StringValue(input.FunctionName)]
+	if !exists {
+		return nil, awserr.New(lambda.ErrCodeResourceNotFoundException, "Function not found", nil)
+	}
+	return &lambda.FunctionConfiguration{FunctionName: input.FunctionName, VpcConfig: vpcConfig}, nil
+}
+
+func lambdaNetworkInterface(functionName string) *ec2.NetworkInterface {
+	return &ec2.NetworkInterface{
+		NetworkInterfaceId: awsgo.String(ExampleNetworkInterfaceId),
+		Description:        awsgo.String("AWS Lambda VPC ENI-" + functionName + "-0b7a4c2e-5f3d-4e8a-9c1b-2d6f8e0a4b3c"),
+		SubnetId:           awsgo.String(ExampleSubnetId),
+		Groups:             []*ec2.GroupIdentifier{{GroupId: awsgo.String(ExampleSecurityGroupId)}},
+	}
+}
+
+func TestLambdaFunctionName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "my-function", lambdaFunctionName(lambdaNetworkInterface("my-function")))
+	assert.Equal(t, "", lambdaFunctionName(&ec2.NetworkInterface{Description: awsgo.String("AWS Lambda VPC ENI-short")}))
+	assert.Equal(t, "", lambdaFunctionName(&ec2.NetworkInterface{Description: awsgo.String("ELB app/my-load-balancer/50dc6c495c0c9188")}))
+}
+
+func TestVpcConfigUsesNetworkInterface(t *testing.T) {
+	t.Parallel()
+
+	networkInterface := lambdaNetworkInterface("my-function")
+	assert.True(t, vpcConfigUsesNetworkInterface(&lambda.VpcConfigResponse{
+		SubnetIds:        awsgo.StringSlice([]string{ExampleSubnetId, ExampleSubnetIdTwo}),
+		SecurityGroupIds: awsgo.StringSlice([]string{ExampleSecurityGroupId}),
+	}, networkInterface))
+	// Moved to other subnets, or to other security groups
+	assert.False(t, vpcConfigUsesNetworkInterface(&lambda.VpcConfigResponse{
+		SubnetIds:        awsgo.StringSlice([]string{ExampleSubnetIdTwo}),
+		SecurityGroupIds: awsgo.StringSlice([]string{ExampleSecurityGroupId}),
+	}, networkInterface))
+	assert.False(t, vpcConfigUsesNetworkInterface(&lambda.VpcConfigResponse{
+		SubnetIds:        awsgo.StringSlice([]string{ExampleSubnetId}),
+		SecurityGroupIds: awsgo.StringSlice([]string{ExampleSecurityGroupIdTwo}),
+	}, networkInterface))
+	// Removed from the VPC
+	assert.False(t, vpcConfigUsesNetworkInterface(&lambda.VpcConfigResponse{}, networkInterface))
+	assert.False(t, vpcConfigUsesNetworkInterface(nil, networkInterface))
+}
+
+func TestWaitForLambdaNetworkInterfacesUntilReclaimed(t *testing.T) {
+	lambdaNetworkInterfaceRetryInterval = 0
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	pending := &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{lambdaNetworkInterface("deleted-function")}}
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(pending, nil).Times(2),
+		mockEC2.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil),
+	)
+
+	filter := &ec2.Filter{Name: awsgo.String("group-id"), Values: awsgo.StringSlice([]string{ExampleSecurityGroupId})}
+	found, err := waitForLambdaNetworkInterfaces(mockEC2, fakeLambda{}, filter, "security group "+ExampleSecurityGroupId)
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestWaitForLambdaNetworkInterfacesInUse(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	inUse := &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{lambdaNetworkInterface("my-function")}}
+	mockEC2.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(inUse, nil)
+
+	lambdaSvc := fakeLambda{vpcConfigs: map[string]*lambda.VpcConfigResponse{
+		"my-function": {
+			SubnetIds:        awsgo.StringSlice([]string{ExampleSubnetId}),
+			SecurityGroupIds: awsgo.StringSlice([]string{ExampleSecurityGroupId}),
+		},
+	}}
+	filter := &ec2.Filter{Name: awsgo.String("vpc-id"), Values: awsgo.StringSlice([]string{ExampleVpcId})}
+	found, err := waitForLambdaNetworkInterfaces(mockEC2, lambdaSvc, filter, "VPC "+ExampleVpcId)
+	assert.True(t, found)
+	assert.Equal(t, LambdaNetworkInterfaceInUseError{
+		Dependent:          "VPC " + ExampleVpcId,
+		NetworkInterfaceId: ExampleNetworkInterfaceId,
+		FunctionName:       "my-function",
+	}, err)
+}
+
+func TestWaitForLambdaNetworkInterfacesNone(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	mockEC2.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil)
+
+	filter := &ec2.Filter{Name: awsgo.String("vpc-id"), Values: awsgo.StringSlice([]string{ExampleVpcId})}
+	found, err := waitForLambdaNetworkInterfaces(mockEC2, fakeLambda{}, filter, "VPC "+ExampleVpcId)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"time"
-
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -10,7 +8,7 @@ import (
 )
 
 // Returns a formatted string of Launch config Names
-func getAllLaunchConfigurations(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllLaunchConfigurations(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
 	svc := autoscaling.New(session)
 	result, err := svc.DescribeLaunchConfigurations(&autoscaling.DescribeLaunchConfigurationsInput{})
 
@@ -20,7 +18,7 @@ func getAllLaunchConfigurations(session *session.Session, region string, exclude
 
 	var configNames []*string
 	for _, config := range result.LaunchConfigurations {
-		if excludeAfter.After(*config.CreatedTime) {
+		if timeFilter.IncludesResource(config.LaunchConfigurationName, *config.CreatedTime) {
 			configNames = append(configNames, config.LaunchConfigurationName)
 		}
 	}
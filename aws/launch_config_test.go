@@ -54,14 +54,14 @@ func TestListLaunchConfigurations(t *testing.T) {
 	defer nukeAllLaunchConfigurations(session, []*string{&uniqueTestID})
 	defer nukeAllEc2Instances(session, findEC2InstancesByNameTag(t, session, uniqueTestID))
 
-	configNames, err := getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour*-1))
+	configNames, err := getAllLaunchConfigurations(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Launch Configurations")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(configNames), uniqueTestID)
 
-	configNames, err = getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour))
+	configNames, err = getAllLaunchConfigurations(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Launch Configurations")
 	}
@@ -104,7 +104,7 @@ func TestNukeLaunchConfigurations(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	groupNames, err := getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour))
+	groupNames, err := getAllLaunchConfigurations(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Launch Configurations")
 	}
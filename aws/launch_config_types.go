@@ -6,6 +6,21 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: LaunchConfigs{},
+		weight:   800,
+		actions:  []string{"autoscaling:DeleteLaunchConfiguration"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			configNames, err := getAllLaunchConfigurations(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return LaunchConfigs{LaunchConfigurationNames: awsgo.StringValueSlice(configNames)}, nil
+		},
+	})
+}
+
 // LaunchConfigs - represents all launch configurations
 type LaunchConfigs struct {
 	LaunchConfigurationNames []string
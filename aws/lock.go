@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The attributes of the lock items. LockID is also the partition key of the lock tables of Terraform's S3 backend, so
+// that one of those can be reused.
+const (
+	lockIDAttribute     = "LockID"
+	lockOwnerAttribute  = "Owner"
+	lockTokenAttribute  = "Token"
+	lockSinceAttribute  = "AcquiredAt"
+	lockExpiryAttribute = "ExpiresAt"
+)
+
+// RunLock - a lock held in a DynamoDB table while cloud-nuke runs against an account, so that two runs against the
+// same account can't interleave and trip over each other's deletions
+type RunLock struct {
+	svc    dynamodbiface.DynamoDBAPI
+	table  string
+	lockID string
+	owner  string
+	// Unique to the run, as the owner isn't: containers and Lambda functions get the same hostname and pid run after run
+	token string
+}
+
+// runLockID - Returns the id of the lock of the given account
+func runLockID(accountID string) string {
+	return "cloud-nuke/" + accountID
+}
+
+// runLockOwner - Returns who holds the locks acquired by this run, e.g. ip-10-0-0-1 (pid 1234)
+func runLockOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown host"
+	}
+	return fmt.Sprintf("%s (pid %d)", hostname, os.Getpid())
+}
+
+// runLockToken - Returns a token unique to this run, telling its locks apart from the ones of other runs
+func runLockToken() string {
+	return fmt.Sprintf("%d-%s", time.Now().UnixNano(), util.UniqueID())
+}
+
+// AcquireRunLock - Acquires the lock of the account in the DynamoDB table with the given name or ARN, in the given
+// region. The table needs a string partition key named LockID. The lock expires after the given time to live, so that
+// a run that crashed doesn't hold it forever. Fails with a RunLockHeldError when another run holds it. The table is
+// accessed with the credentials cloud-nuke was started with, not the ones of the role assumed for the account.
+func AcquireRunLock(table string, region string, accountID string, ttl time.Duration) (*RunLock, error) {
+	lock := &RunLock{
+		svc:    dynamodb.New(newCallerSession(region)),
+		table:  table,
+		lockID: runLockID(accountID),
+		owner:  runLockOwner(),
+		token:  runLockToken(),
+	}
+	if err := lock.acquire(time.Now(), ttl); err != nil {
+		return nil, err
+	}
+	logging.Logger.Infof("Acquired the lock %s in DynamoDB table %s", lock.lockID, table)
+	return lock, nil
+}
+
+// acquire - Writes the lock item, unless another run holds a lock that hasn't expired yet
+func (lock *RunLock) acquire(now time.Time, ttl time.Duration) error {
+	_, err := lock.svc.PutItem(&dynamodb.PutItemInput{
+		TableName: awsgo.String(lock.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			lockIDAttribute:     {S: awsgo.String(lock.lockID)},
+			lockOwnerAttribute:  {S: awsgo.String(lock.owner)},
+			lockTokenAttribute:  {S: awsgo.String(lock.token)},
+			lockSinceAttribute:  {S: awsgo.String(now.UTC().Format(time.RFC3339))},
+			lockExpiryAttribute: {N: awsgo.String(strconv.FormatInt(now.Add(ttl).Unix(), 10))},
+		},
+		ConditionExpression: awsgo.String("attribute_not_exists(#id) OR #expiry < :now"),
+		ExpressionAttributeNames: map[string]*string{
+			"#id":     awsgo.String(lockIDAttribute),
+			"#expiry": awsgo.String(lockExpiryAttribute),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":now": {N: awsgo.String(strconv.FormatInt(now.Unix(), 10))},
+		},
+	})
+	if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		return lock.heldError()
+	}
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// heldError - Returns the error telling which run holds the lock
+func (lock *RunLock) heldError() error {
+	heldErr := RunLockHeldError{LockID: lock.lockID, Table: lock.table}
+	output, err := lock.svc.GetItem(&dynamodb.GetItemInput{
+		TableName:      awsgo.String(lock.table),
+		Key:            map[string]*dynamodb.AttributeValue{lockIDAttribute: {S: awsgo.String(lock.lockID)}},
+		ConsistentRead: awsgo.Bool(true),
+	})
+	// Who holds the lock is only a hint, the lock is held either way
+	if err == nil && output.Item != nil {
+		if owner := output.Item[lockOwnerAttribute]; owner != nil {
+			heldErr.Owner = awsgo.StringValue(owner.S)
+		}
+		if since := output.Item[lockSinceAttribute]; since != nil {
+			heldErr.Since = awsgo.StringValue(since.S)
+		}
+	}
+	return errors.WithStackTrace(heldErr)
+}
+
+// Release - Releases the lock, unless it expired and was acquired by another run in the meantime
+func (lock *RunLock) Release() error {
+	_, err := lock.svc.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName:           awsgo.String(lock.table),
+		Key:                 map[string]*dynamodb.AttributeValue{lockIDAttribute: {S: awsgo.String(lock.lockID)}},
+		ConditionExpression: awsgo.String("#token = :token"),
+		ExpressionAttributeNames: map[string]*string{
+			"#token": awsgo.String(lockTokenAttribute),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":token": {S: awsgo.String(lock.token)},
+		},
+	})
+	if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		logging.Logger.Warnf("The lock %s in DynamoDB table %s expired before the run was over, and was acquired by another run", lock.lockID, lock.table)
+		return nil
+	}
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	logging.Logger.Infof("Released the lock %s in DynamoDB table %s", lock.lockID, lock.table)
+	return nil
+}
+
+// RunLockHeldError - returned when another run holds the lock of the account
+type RunLockHeldError struct {
+	LockID string
+	Table  string
+	// Who holds the lock and since when, when known
+	Owner string
+	Since string
+}
+
+func (e RunLockHeldError) Error() string {
+	if e.Owner == "" {
+		return fmt.Sprintf("Another cloud-nuke run holds the lock %s in DynamoDB table %s", e.LockID, e.Table)
+	}
+	return fmt.Sprintf("Another cloud-nuke run, on %s since %s, holds the lock %s in DynamoDB table %s", e.Owner, e.Since, e.LockID, e.Table)
+}
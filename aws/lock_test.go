@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLockTable - a lock table holding a single lock, evaluating the conditions of the lock writes
+type fakeLockTable struct {
+	dynamodbiface.DynamoDBAPI
+	item map[string]*dynamodb.AttributeValue
+}
+
+func (fake *fakeLockTable) conditionFailed() error {
+	return awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed", nil)
+}
+
+func (fake *fakeLockTable) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	if fake.item != nil {
+		expiry, _ := strconv.ParseInt(awsgo.StringValue(fake.item[lockExpiryAttribute].N), 10, 64)
+		now, _ := strconv.ParseInt(awsgo.StringValue(input.ExpressionAttributeValues[":now"].N), 10, 64)
+		if expiry >= now {
+			return nil, fake.conditionFailed()
+		}
+	}
+	fake.item = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (fake *fakeLockTable) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	return &dynamodb.GetItemOutput{Item: fake.item}, nil
+}
+
+func (fake *fakeLockTable) DeleteItem(input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	if fake.item == nil || awsgo.StringValue(fake.item[lockTokenAttribute].S) != awsgo.StringValue(input.ExpressionAttributeValues[":token"].S) {
+		return nil, fake.conditionFailed()
+	}
+	fake.item = nil
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func TestRunLock(t *testing.T) {
+	t.Parallel()
+
+	table := &fakeLockTable{}
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Runs in containers look the same, but have tokens of their own
+	first := &RunLock{svc: table, table: "locks", lockID: runLockID("123456789012"), owner: "localhost (pid 1)", token: "first"}
+	second := &RunLock{svc: table, table: "locks", lockID: runLockID("123456789012"), owner: "localhost (pid 1)", token: "second"}
+
+	require.NoError(t, first.acquire(now, time.Hour))
+	assert.Equal(t, "cloud-nuke/123456789012", awsgo.StringValue(table.item[lockIDAttribute].S))
+
+	err := second.acquire(now.Add(time.Minute), time.Hour)
+	assert.Equal(t, RunLockHeldError{LockID: "cloud-nuke/123456789012", Table: "locks", Owner: "localhost (pid 1)", Since: "2021-01-01T00:00:00Z"}, errors.Unwrap(err))
+
+	// Once expired, the lock can be taken over, and the run that lost it leaves it alone
+	require.NoError(t, second.acquire(now.Add(2*time.Hour), time.Hour))
+	require.NoError(t, first.Release())
+	assert.Equal(t, "second", awsgo.StringValue(table.item[lockTokenAttribute].S))
+
+	require.NoError(t, second.Release())
+	assert.Nil(t, table.item)
+	require.NoError(t, first.acquire(now.Add(2*time.Hour), time.Hour))
+
+	assert.NotEqual(t, runLockToken(), runLockToken())
+}
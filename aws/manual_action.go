@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// ManualAction - a commitment to physical hardware, which cloud-nuke can't nuke, e.g. a Snowball device shipped to a
+// customer or an Outposts rack. Listed along with the resources to nuke, so that an account with nothing left to nuke
+// isn't reported as clean while such commitments are still running.
+type ManualAction struct {
+	// snowballjob, outpost or outpostsorder
+	Kind       string
+	Identifier string
+	Region     string
+	// The state of the job, outpost or order, as reported by AWS
+	State string
+	// What to do about it
+	Action string
+}
+
+// String - Renders the manual action on a single line, e.g. snowballjob-JID123-us-east-1 (WithCustomer): return...
+func (action ManualAction) String() string {
+	return fmt.Sprintf("%s-%s-%s (%s): %s", action.Kind, action.Identifier, action.Region, action.State, action.Action)
+}
+
+// The states of the Snow Family jobs that are over
+var doneSnowballJobStates = []string{snowball.JobStateComplete, snowball.JobStateCancelled}
+
+// The statuses of the Outposts orders that are over
+var doneOutpostsOrderStatuses = []string{outposts.OrderStatusCompleted, outposts.OrderStatusFulfilled, outposts.OrderStatusCancelled}
+
+// isServiceAvailable - Checks if the service has an endpoint in the region. Regions unknown to the SDK are assumed to
+// have one.
+func isServiceAvailable(serviceID string, region string) bool {
+	partition, found := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !found {
+		return true
+	}
+	service, found := partition.Services()[serviceID]
+	if !found {
+		return false
+	}
+	_, available := service.Regions()[region]
+	return available
+}
+
+// snowballJobAction - Returns what to do about a Snow Family job in the given state
+func snowballJobAction(state string) string {
+	if state == snowball.JobStateNew {
+		return "cancel the job before the device gets prepared"
+	}
+	return "wait for the device to be shipped, then return it to AWS once done with it"
+}
+
+// getSnowballJobManualActions - Returns the Snow Family jobs of the region that aren't over
+func getSnowballJobManualActions(session *session.Session) ([]ManualAction, error) {
+	svc := snowball.New(session)
+
+	var actions []ManualAction
+	input := &snowball.ListJobsInput{}
+	for {
+		output, err := svc.ListJobs(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, job := range output.JobListEntries {
+			state := awsgo.StringValue(job.JobState)
+			if collections.ListContainsElement(doneSnowballJobStates, state) {
+				continue
+			}
+			actions = append(actions, ManualAction{
+				Kind:       "snowballjob",
+				Identifier: awsgo.StringValue(job.JobId),
+				Region:     *session.Config.Region,
+				State:      state,
+				Action:     snowballJobAction(state),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return actions, nil
+}
+
+// getOutpostsManualActions - Returns the outposts of the region, which are capacity paid for over a term, along with
+// their orders that aren't over
+func getOutpostsManualActions(session *session.Session) ([]ManualAction, error) {
+	svc := outposts.New(session)
+
+	var actions []ManualAction
+	outpostsInput := &outposts.ListOutpostsInput{}
+	for {
+		output, err := svc.ListOutposts(outpostsInput)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, outpost := range output.Outposts {
+			actions = append(actions, ManualAction{
+				Kind:       "outpost",
+				Identifier: awsgo.StringValue(outpost.OutpostId),
+				Region:     *session.Config.Region,
+				State:      awsgo.StringValue(outpost.LifeCycleStatus),
+				Action:     "contact AWS to end the Outposts term and have the hardware picked up",
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		outpostsInput.NextToken = output.NextToken
+	}
+
+	ordersInput := &outposts.ListOrdersInput{}
+	for {
+		output, err := svc.ListOrders(ordersInput)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, order := range output.Orders {
+			status := awsgo.StringValue(order.Status)
+			if collections.ListContainsElement(doneOutpostsOrderStatuses, status) {
+				continue
+			}
+			actions = append(actions, ManualAction{
+				Kind:       "outpostsorder",
+				Identifier: awsgo.StringValue(order.OrderId),
+				Region:     *session.Config.Region,
+				State:      status,
+				Action:     "cancel the order in the Outposts console, or contact AWS once it is being processed",
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		ordersInput.NextToken = output.NextToken
+	}
+
+	return actions, nil
+}
+
+// GetManualActions - Returns the commitments to physical hardware in the given regions that need someone to act on
+// them. Failing to look them up in a region, e.g. for lack of permissions, is only logged, since they can't be nuked
+// either way.
+func GetManualActions(regions []string) []ManualAction {
+	var actions []ManualAction
+	for _, region := range regions {
+		session := newSession(region)
+
+		if isServiceAvailable(snowball.EndpointsID, region) {
+			snowballActions, err := getSnowballJobManualActions(session)
+			if err != nil {
+				logging.Logger.Warnf("Unable to list the Snow Family jobs in %s: %s", region, err)
+			}
+			actions = append(actions, snowballActions...)
+		}
+
+		if isServiceAvailable(outposts.EndpointsID, region) {
+			outpostsActions, err := getOutpostsManualActions(session)
+			if err != nil {
+				logging.Logger.Warnf("Unable to list the outposts and their orders in %s: %s", region, err)
+			}
+			actions = append(actions, outpostsActions...)
+		}
+	}
+	return actions
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsServiceAvailable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isServiceAvailable(snowball.EndpointsID, "us-east-1"))
+	assert.False(t, isServiceAvailable("not-a-service", "us-east-1"))
+	// Regions the SDK doesn't know about are left to the calls to tell
+	assert.True(t, isServiceAvailable(snowball.EndpointsID, "xx-nowhere-1"))
+}
+
+func TestManualActionString(t *testing.T) {
+	t.Parallel()
+
+	action := ManualAction{
+		Kind:       "snowballjob",
+		Identifier: "JID123",
+		Region:     "us-east-1",
+		State:      snowball.JobStateNew,
+		Action:     snowballJobAction(snowball.JobStateNew),
+	}
+	assert.Equal(t, "snowballjob-JID123-us-east-1 (New): cancel the job before the device gets prepared", action.String())
+}
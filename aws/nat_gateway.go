@@ -0,0 +1,79 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of NAT gateway ids
+func getAllNatGateways(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	params := &ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   awsgo.String("state"),
+				Values: []*string{awsgo.String("pending"), awsgo.String("available")},
+			},
+		},
+	}
+
+	var natGatewayIds []*string
+	err := svc.DescribeNatGatewaysPages(params, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, natGateway := range page.NatGateways {
+			if timeFilter.IncludesResource(natGateway.NatGatewayId, *natGateway.CreateTime) {
+				natGatewayIds = append(natGatewayIds, natGateway.NatGatewayId)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return natGatewayIds, nil
+}
+
+// Deletes all NAT gateways, waiting for them to be deleted so that their Elastic IPs, nuked afterwards, are released
+func nukeAllNatGateways(session *session.Session, natGatewayIds []*string) error {
+	svc := ec2.New(session)
+
+	if len(natGatewayIds) == 0 {
+		logging.Logger.Infof("No NAT gateways to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all NAT gateways in region %s", *session.Config.Region)
+	var deletedNatGatewayIDs []*string
+
+	for _, natGatewayID := range natGatewayIds {
+		params := &ec2.DeleteNatGatewayInput{
+			NatGatewayId: natGatewayID,
+		}
+
+		_, err := svc.DeleteNatGateway(params)
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedNatGatewayIDs = append(deletedNatGatewayIDs, natGatewayID)
+			logging.Logger.Infof("Deleted NAT gateway: %s", *natGatewayID)
+		}
+	}
+
+	if len(deletedNatGatewayIDs) > 0 {
+		err := svc.WaitUntilNatGatewayDeleted(&ec2.DescribeNatGatewaysInput{
+			NatGatewayIds: deletedNatGatewayIDs,
+		})
+
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d NAT gateway(s) deleted in %s", len(deletedNatGatewayIDs), *session.Config.Region)
+	return nil
+}
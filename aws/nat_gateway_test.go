@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestNatGateway(t *testing.T, session *session.Session, name string) (ec2.NatGateway, ec2.Address) {
+	svc := ec2.New(session)
+
+	subnet, _ := getSubnetsInDifferentAZs(t, session)
+	address := createTestEIPAddress(t, session, name)
+
+	result, err := svc.CreateNatGateway(&ec2.CreateNatGatewayInput{
+		AllocationId: address.AllocationId,
+		SubnetId:     subnet.SubnetId,
+	})
+	require.NoError(t, err)
+
+	err = svc.WaitUntilNatGatewayAvailable(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{result.NatGateway.NatGatewayId},
+	})
+	require.NoError(t, err)
+
+	return *result.NatGateway, address
+}
+
+func TestListNatGateways(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	natGateway, address := createTestNatGateway(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllEIPAddresses(session, []*string{address.AllocationId})
+	defer nukeAllNatGateways(session, []*string{natGateway.NatGatewayId})
+
+	natGatewayIds, err := getAllNatGateways(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of NAT gateways")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(natGatewayIds), awsgo.StringValue(natGateway.NatGatewayId))
+
+	natGatewayIds, err = getAllNatGateways(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of NAT gateways")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(natGatewayIds), awsgo.StringValue(natGateway.NatGatewayId))
+}
+
+func TestNukeNatGateways(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	natGateway, address := createTestNatGateway(t, session, uniqueTestID)
+
+	// clean up after this test
+	defer nukeAllEIPAddresses(session, []*string{address.AllocationId})
+
+	if err := nukeAllNatGateways(session, []*string{natGateway.NatGatewayId}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	natGatewayIds, err := getAllNatGateways(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of NAT gateways")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(natGatewayIds), awsgo.StringValue(natGateway.NatGatewayId))
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: NatGateways{},
+		weight:   1400,
+		actions:  []string{"ec2:DeleteNatGateway"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			natGatewayIds, err := getAllNatGateways(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return NatGateways{NatGatewayIds: awsgo.StringValueSlice(natGatewayIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return NatGateways{NatGatewayIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
+// NatGateways - represents all NAT gateways
+type NatGateways struct {
+	NatGatewayIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (natGateway NatGateways) ResourceName() string {
+	return "natgateway"
+}
+
+// ResourceIdentifiers - The ids of the NAT gateways
+func (natGateway NatGateways) ResourceIdentifiers() []string {
+	return natGateway.NatGatewayIds
+}
+
+func (natGateway NatGateways) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (natGateway NatGateways) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllNatGateways(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How long to wait for a network interface that is still being detached before giving up on deleting it
+const networkInterfaceDeleteMaxAttempts = 20
+
+var networkInterfaceDeleteRetryInterval = 15 * time.Second
+
+// isDeletableNetworkInterface - Checks whether the network interface is unattached, or is being detached from a
+// resource it doesn't belong to. The network interfaces managed by AWS services go away along with their resources.
+func isDeletableNetworkInterface(networkInterface *ec2.NetworkInterface) bool {
+	if awsgo.StringValue(networkInterface.Status) == ec2.NetworkInterfaceStatusAvailable {
+		return true
+	}
+	if awsgo.BoolValue(networkInterface.RequesterManaged) || networkInterface.Attachment == nil {
+		return false
+	}
+	return awsgo.StringValue(networkInterface.Attachment.Status) == ec2.AttachmentStatusDetaching
+}
+
+// Returns the ids of all network interfaces that are unattached, or being detached. Network interfaces don't expose
+// their creation time, so they are tagged with the time cloud-nuke first saw them instead.
+func getAllNetworkInterfaces(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var networkInterfaces []*ec2.NetworkInterface
+	err := svc.DescribeNetworkInterfacesPages(
+		&ec2.DescribeNetworkInterfacesInput{},
+		func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			networkInterfaces = append(networkInterfaces, page.NetworkInterfaces...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var networkInterfaceIds []*string
+	for _, networkInterface := range networkInterfaces {
+		if !isDeletableNetworkInterface(networkInterface) {
+			continue
+		}
+
+		firstSeenTime, err := getFirstSeenTimeFromTags(networkInterface.TagSet, firstSeenTagKey, firstSeenTagLayout)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if firstSeenTime == nil {
+			now := time.Now().UTC()
+			firstSeenTime = &now
+			if err := setFirstSeenResourceTag(svc, networkInterface.NetworkInterfaceId, firstSeenTagKey, now, firstSeenTagLayout); err != nil {
+				return nil, err
+			}
+		}
+
+		if timeFilter.IncludesFirstSeenResource(networkInterface.NetworkInterfaceId, *firstSeenTime) {
+			networkInterfaceIds = append(networkInterfaceIds, networkInterface.NetworkInterfaceId)
+		}
+	}
+
+	return networkInterfaceIds, nil
+}
+
+// deleteNetworkInterface - Deletes the network interface, retrying for as long as it's still being detached
+func deleteNetworkInterface(svc ec2iface.EC2API, networkInterfaceId *string) error {
+	for attempt := 1; ; attempt++ {
+		_, err := svc.DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: networkInterfaceId,
+		})
+		awsErr, isAwsErr := err.(awserr.Error)
+		if !isAwsErr || awsErr.Code() != "InvalidNetworkInterface.InUse" || attempt == networkInterfaceDeleteMaxAttempts {
+			return err
+		}
+		logging.Logger.Infof("Network interface %s is still being detached, retrying", awsgo.StringValue(networkInterfaceId))
+		time.Sleep(networkInterfaceDeleteRetryInterval)
+	}
+}
+
+// Deletes all network interfaces
+func nukeAllNetworkInterfaces(session *session.Session, networkInterfaceIds []*string) error {
+	svc := ec2.New(session)
+
+	if len(networkInterfaceIds) == 0 {
+		logging.Logger.Infof("No network interfaces to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all network interfaces in region %s", *session.Config.Region)
+	var deletedNetworkInterfaceIds []*string
+
+	for _, networkInterfaceId := range networkInterfaceIds {
+		if err := deleteNetworkInterface(svc, networkInterfaceId); err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidNetworkInterfaceID.NotFound" {
+				logging.Logger.Infof("Network interface %s has already been deleted", *networkInterfaceId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNetworkInterfaceIds = append(deletedNetworkInterfaceIds, networkInterfaceId)
+			logging.Logger.Infof("Deleted network interface: %s", *networkInterfaceId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d network interface(s) deleted in %s", len(deletedNetworkInterfaceIds), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDeletableNetworkInterface(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isDeletableNetworkInterface(&ec2.NetworkInterface{
+		Status: awsgo.String(ec2.NetworkInterfaceStatusAvailable),
+	}))
+	assert.True(t, isDeletableNetworkInterface(&ec2.NetworkInterface{
+		Status:     awsgo.String(ec2.NetworkInterfaceStatusInUse),
+		Attachment: &ec2.NetworkInterfaceAttachment{Status: awsgo.String(ec2.AttachmentStatusDetaching)},
+	}))
+	assert.False(t, isDeletableNetworkInterface(&ec2.NetworkInterface{
+		Status:     awsgo.String(ec2.NetworkInterfaceStatusInUse),
+		Attachment: &ec2.NetworkInterfaceAttachment{Status: awsgo.String(ec2.AttachmentStatusAttached)},
+	}))
+	// Managed by an AWS service, which deletes it once detached
+	assert.False(t, isDeletableNetworkInterface(&ec2.NetworkInterface{
+		Status:           awsgo.String(ec2.NetworkInterfaceStatusInUse),
+		RequesterManaged: awsgo.Bool(true),
+		Attachment:       &ec2.NetworkInterfaceAttachment{Status: awsgo.String(ec2.AttachmentStatusDetaching)},
+	}))
+}
+
+func TestDeleteNetworkInterfaceRetriesWhileDetaching(t *testing.T) {
+	networkInterfaceDeleteRetryInterval = 0
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	input := &ec2.DeleteNetworkInterfaceInput{NetworkInterfaceId: awsgo.String(ExampleNetworkInterfaceId)}
+	inUse := awserr.New("InvalidNetworkInterface.InUse", "Interface is still attached", nil)
+	gomock.InOrder(
+		mockEC2.EXPECT().DeleteNetworkInterface(input).Return(nil, inUse),
+		mockEC2.EXPECT().DeleteNetworkInterface(input).Return(nil, inUse),
+		mockEC2.EXPECT().DeleteNetworkInterface(input).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil),
+	)
+
+	require.NoError(t, deleteNetworkInterface(mockEC2, awsgo.String(ExampleNetworkInterfaceId)))
+}
+
+func TestDeleteNetworkInterfaceGivesUp(t *testing.T) {
+	networkInterfaceDeleteRetryInterval = 0
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	inUse := awserr.New("InvalidNetworkInterface.InUse", "Interface is still attached", nil)
+	mockEC2.EXPECT().DeleteNetworkInterface(gomock.Any()).Return(nil, inUse).Times(networkInterfaceDeleteMaxAttempts)
+
+	err := deleteNetworkInterface(mockEC2, awsgo.String(ExampleNetworkInterfaceId))
+	assert.Equal(t, inUse, err)
+}
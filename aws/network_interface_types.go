@@ -0,0 +1,53 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked after the resources they were attached to, since the ones left unattached block deleting VPCs and
+	// security groups
+	registerResourceType(awsResourceType{
+		resource: NetworkInterfaces{},
+		weight:   6700,
+		actions:  []string{"ec2:CreateTags", "ec2:DeleteNetworkInterface"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			networkInterfaceIds, err := getAllNetworkInterfaces(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return NetworkInterfaces{NetworkInterfaceIds: awsgo.StringValueSlice(networkInterfaceIds)}, nil
+		},
+	})
+}
+
+// NetworkInterfaces - represents all unattached network interfaces
+type NetworkInterfaces struct {
+	NetworkInterfaceIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (networkInterfaces NetworkInterfaces) ResourceName() string {
+	return "networkinterface"
+}
+
+// ResourceIdentifiers - The ids of the network interfaces
+func (networkInterfaces NetworkInterfaces) ResourceIdentifiers() []string {
+	return networkInterfaces.NetworkInterfaceIds
+}
+
+func (networkInterfaces NetworkInterfaces) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (networkInterfaces NetworkInterfaces) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllNetworkInterfaces(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// DescribeDomains accepts up to 5 domain names per call
+const openSearchDescribeDomainsBatchSize = 5
+
+// Returns a formatted string of OpenSearch domain names. This includes the Elasticsearch domains, which are managed
+// by the same service.
+func getAllOpenSearchDomains(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := opensearchservice.New(session)
+
+	output, err := svc.ListDomainNames(&opensearchservice.ListDomainNamesInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	var domainNames []*string
+	for _, domain := range output.DomainNames {
+		domainNames = append(domainNames, domain.DomainName)
+	}
+
+	var names []*string
+	for len(domainNames) > 0 {
+		batch := domainNames
+		if len(batch) > openSearchDescribeDomainsBatchSize {
+			batch = batch[:openSearchDescribeDomainsBatchSize]
+		}
+		domainNames = domainNames[len(batch):]
+
+		output, err := svc.DescribeDomains(&opensearchservice.DescribeDomainsInput{DomainNames: batch})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, domain := range output.DomainStatusList {
+			// Domains that are being deleted are still listed
+			if awsgo.BoolValue(domain.Deleted) {
+				continue
+			}
+
+			// The domain status doesn't expose when the domain was created, but its configuration does: the engine
+			// version is set once, when the domain is created
+			config, err := svc.DescribeDomainConfig(&opensearchservice.DescribeDomainConfigInput{
+				DomainName: domain.DomainName,
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			engineVersion := config.DomainConfig.EngineVersion
+			if engineVersion == nil || engineVersion.Status == nil || engineVersion.Status.CreationDate == nil {
+				logging.Logger.Warnf("Skipping OpenSearch domain %s: unable to tell when it was created", *domain.DomainName)
+				continue
+			}
+
+			if timeFilter.IncludesResource(domain.DomainName, *engineVersion.Status.CreationDate) {
+				names = append(names, domain.DomainName)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// Deletes all OpenSearch domains
+func nukeAllOpenSearchDomains(session *session.Session, names []*string) error {
+	svc := opensearchservice.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No OpenSearch domains to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all OpenSearch domains in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteDomain(&opensearchservice.DeleteDomainInput{
+			DomainName: name,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == opensearchservice.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("OpenSearch domain %s has already been deleted", *name)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted OpenSearch domain: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d OpenSearch domain(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given OpenSearch domains have actually been deleted. Deleting a domain takes several minutes, during
+// which it is still described, flagged as deleted.
+func waitUntilOpenSearchDomainsDeleted(session *session.Session, names []*string) error {
+	svc := opensearchservice.New(session)
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(names), func(name string) (bool, error) {
+		_, err := svc.DescribeDomain(&opensearchservice.DescribeDomainInput{
+			DomainName: awsgo.String(name),
+		})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == opensearchservice.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(remaining) > 0 {
+		return ResourcesNotDeletedError{ResourceType: OpenSearchDomains{}.ResourceName(), Identifiers: remaining}
+	}
+
+	return nil
+}
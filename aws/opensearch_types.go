@@ -0,0 +1,60 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: OpenSearchDomains{},
+		weight:   2300,
+		actions:  []string{"es:DeleteDomain"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			domainNames, err := getAllOpenSearchDomains(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return OpenSearchDomains{DomainNames: awsgo.StringValueSlice(domainNames)}, nil
+		},
+	})
+}
+
+// OpenSearchDomains - represents all OpenSearch domains, including Elasticsearch domains
+type OpenSearchDomains struct {
+	DomainNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (domains OpenSearchDomains) ResourceName() string {
+	return "opensearchdomain"
+}
+
+// ResourceIdentifiers - The names of the OpenSearch domains
+func (domains OpenSearchDomains) ResourceIdentifiers() []string {
+	return domains.DomainNames
+}
+
+func (domains OpenSearchDomains) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (domains OpenSearchDomains) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllOpenSearchDomains(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (domains OpenSearchDomains) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilOpenSearchDomainsDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
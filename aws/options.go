@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"regexp"
+)
+
+// ResourceOptions - settings that change which resources of some resource types are nuked, and how
+type ResourceOptions struct {
+	// Only nuke the resources in these availability zones, for the resource types scoped to availability zones. All
+	// availability zones when empty.
+	AvailabilityZones []string
+	// Where to archive AMIs and Snapshots to before they are nuked. Nothing is archived when nil.
+	Archive *ArchiveConfig
+	// How Secrets Manager secrets are deleted
+	SecretsManager SecretsManagerConfig
+	// How the deletion of KMS keys is scheduled
+	KMS KMSConfig
+	// Whether to stop EC2 instances rather than terminate them
+	EC2 EC2Config
+	// Whether to take a final snapshot of EBS volumes before they are nuked
+	EBS EBSConfig
+	// Whether to delete the snapshots backing AMIs along with them
+	AMI AMIConfig
+	// Which EC2 key pairs to include, by name
+	KeyPairNames NameFilter
+	// Which CloudWatch log groups to prune the log streams of, by name
+	LogGroupNames NameFilter
+	// Which organizational units and service control policies to include, by name
+	OrganizationsNames NameFilter
+	// How many regions are discovered at the same time. Regions are discovered one after the other when below 2.
+	RegionConcurrency int
+}
+
+// NameFilter - regular expressions on the names of resources. A name matches when it matches any of the include
+// expressions, or when there are none, and none of the exclude expressions.
+type NameFilter struct {
+	Include []*regexp.Regexp
+	Exclude []*regexp.Regexp
+}
+
+// Matches - Checks if the name passes the filter
+func (filter NameFilter) Matches(name string) bool {
+	for _, exclude := range filter.Exclude {
+		if exclude.MatchString(name) {
+			return false
+		}
+	}
+	if len(filter.Include) == 0 {
+		return true
+	}
+	for _, include := range filter.Include {
+		if include.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, NameFilter{}.Matches("anything"))
+
+	filter := NameFilter{
+		Include: []*regexp.Regexp{regexp.MustCompile(`^test-`), regexp.MustCompile(`-ci$`)},
+		Exclude: []*regexp.Regexp{regexp.MustCompile(`keep`)},
+	}
+	assert.True(t, filter.Matches("test-key"))
+	assert.True(t, filter.Matches("key-ci"))
+	assert.False(t, filter.Matches("prod-key"))
+	assert.False(t, filter.Matches("test-keep-key"))
+
+	excludeOnly := NameFilter{Exclude: []*regexp.Regexp{regexp.MustCompile(`^prod`)}}
+	assert.True(t, excludeOnly.Matches("test-key"))
+	assert.False(t, excludeOnly.Matches("prod-key"))
+}
@@ -0,0 +1,316 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// getOrganizationsFirstSeenTime returns the time cloud-nuke first saw the organizational unit or policy, tagging it
+// with the current time if it hasn't been seen before. We need this because neither of them contains an attribute
+// that gives us their creation time.
+func getOrganizationsFirstSeenTime(svc *organizations.Organizations, resourceId *string) (*time.Time, error) {
+	var tags []*organizations.Tag
+	err := svc.ListTagsForResourcePages(
+		&organizations.ListTagsForResourceInput{ResourceId: resourceId},
+		func(page *organizations.ListTagsForResourceOutput, lastPage bool) bool {
+			tags = append(tags, page.Tags...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(firstSeenTagLayout, awsgo.StringValue(tag.Value))
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			return &firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err = svc.TagResource(&organizations.TagResourceInput{
+		ResourceId: resourceId,
+		Tags: []*organizations.Tag{
+			{
+				Key:   awsgo.String(firstSeenTagKey),
+				Value: awsgo.String(now.Format(firstSeenTagLayout)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &now, nil
+}
+
+// listChildOrganizationalUnits - Returns the organizational units right below the given root or organizational unit
+func listChildOrganizationalUnits(svc *organizations.Organizations, parentId *string) ([]*organizations.OrganizationalUnit, error) {
+	var units []*organizations.OrganizationalUnit
+	err := svc.ListOrganizationalUnitsForParentPages(
+		&organizations.ListOrganizationalUnitsForParentInput{ParentId: parentId},
+		func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+			units = append(units, page.OrganizationalUnits...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return units, nil
+}
+
+// Returns the ids of the organizational units whose name passes the filter, children before their parents, so that
+// they are deleted in an order that works. Only organizational units without accounts can be deleted.
+func getAllOrganizationalUnits(session *session.Session, timeFilter TimeFilter, nameFilter NameFilter) ([]*string, error) {
+	svc := organizations.New(session)
+
+	roots, err := svc.ListRoots(&organizations.ListRootsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var unitIds []*string
+	var visit func(parentId *string) error
+	visit = func(parentId *string) error {
+		units, err := listChildOrganizationalUnits(svc, parentId)
+		if err != nil {
+			return err
+		}
+		for _, unit := range units {
+			if err := visit(unit.Id); err != nil {
+				return err
+			}
+			if !nameFilter.Matches(awsgo.StringValue(unit.Name)) {
+				continue
+			}
+			firstSeenTime, err := getOrganizationsFirstSeenTime(svc, unit.Id)
+			if err != nil {
+				return err
+			}
+			if timeFilter.IncludesFirstSeenResource(unit.Id, *firstSeenTime) {
+				unitIds = append(unitIds, unit.Id)
+			}
+		}
+		return nil
+	}
+	for _, root := range roots.Roots {
+		if err := visit(root.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return unitIds, nil
+}
+
+// Deletes all the given organizational units
+func nukeAllOrganizationalUnits(session *session.Session, unitIds []*string) error {
+	svc := organizations.New(session)
+
+	if len(unitIds) == 0 {
+		logging.Logger.Infof("No organizational units to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all organizational units in region %s", *session.Config.Region)
+	var deletedUnitIds []*string
+
+	for _, unitId := range unitIds {
+		_, err := svc.DeleteOrganizationalUnit(&organizations.DeleteOrganizationalUnitInput{OrganizationalUnitId: unitId})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == organizations.ErrCodeOrganizationalUnitNotEmptyException {
+				logging.Logger.Errorf("[Failed] Organizational unit %s still holds accounts or organizational units that aren't nuked: %s", *unitId, err)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedUnitIds = append(deletedUnitIds, unitId)
+			logging.Logger.Infof("Deleted organizational unit: %s", *unitId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d organizational unit(s) deleted in %s", len(deletedUnitIds), *session.Config.Region)
+	return nil
+}
+
+// Returns the ids of the service control policies whose name passes the filter. The policies managed by AWS, such as
+// FullAWSAccess, are left alone.
+func getAllServiceControlPolicies(session *session.Session, timeFilter TimeFilter, nameFilter NameFilter) ([]*string, error) {
+	svc := organizations.New(session)
+
+	var policies []*organizations.PolicySummary
+	err := svc.ListPoliciesPages(
+		&organizations.ListPoliciesInput{Filter: awsgo.String(organizations.PolicyTypeServiceControlPolicy)},
+		func(page *organizations.ListPoliciesOutput, lastPage bool) bool {
+			policies = append(policies, page.Policies...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var policyIds []*string
+	for _, policy := range policies {
+		if awsgo.BoolValue(policy.AwsManaged) || !nameFilter.Matches(awsgo.StringValue(policy.Name)) {
+			continue
+		}
+		firstSeenTime, err := getOrganizationsFirstSeenTime(svc, policy.Id)
+		if err != nil {
+			return nil, err
+		}
+		if timeFilter.IncludesFirstSeenResource(policy.Id, *firstSeenTime) {
+			policyIds = append(policyIds, policy.Id)
+		}
+	}
+
+	return policyIds, nil
+}
+
+// detachPolicy - Detaches the policy from all the roots, organizational units and accounts it's attached to
+func detachPolicy(svc *organizations.Organizations, policyId *string) error {
+	var targets []*organizations.PolicyTargetSummary
+	err := svc.ListTargetsForPolicyPages(
+		&organizations.ListTargetsForPolicyInput{PolicyId: policyId},
+		func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+			targets = append(targets, page.Targets...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, target := range targets {
+		logging.Logger.Infof("...detaching policy %s from %s", *policyId, awsgo.StringValue(target.TargetId))
+		_, err := svc.DetachPolicy(&organizations.DetachPolicyInput{PolicyId: policyId, TargetId: target.TargetId})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
+
+// Detaches all the given service control policies from their targets, and deletes them
+func nukeAllServiceControlPolicies(session *session.Session, policyIds []*string) error {
+	svc := organizations.New(session)
+
+	if len(policyIds) == 0 {
+		logging.Logger.Infof("No service control policies to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all service control policies in region %s", *session.Config.Region)
+	var deletedPolicyIds []*string
+
+	for _, policyId := range policyIds {
+		err := detachPolicy(svc, policyId)
+		if err == nil {
+			_, err = svc.DeletePolicy(&organizations.DeletePolicyInput{PolicyId: policyId})
+		}
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedPolicyIds = append(deletedPolicyIds, policyId)
+			logging.Logger.Infof("Deleted service control policy: %s", *policyId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d service control polic(ies) deleted in %s", len(deletedPolicyIds), *session.Config.Region)
+	return nil
+}
+
+// An account is registered as the delegated administrator of each service separately, so the registrations are
+// identified by the account id and the service principal, separated by a slash
+func delegatedAdministratorIdentifier(accountId *string, servicePrincipal *string) *string {
+	return awsgo.String(fmt.Sprintf("%s/%s", awsgo.StringValue(accountId), awsgo.StringValue(servicePrincipal)))
+}
+
+func parseDelegatedAdministratorIdentifier(identifier string) (*string, *string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return nil, nil, errors.WithStackTrace(InvalidDelegatedAdministratorIdentifierError{Identifier: identifier})
+	}
+	return awsgo.String(parts[0]), awsgo.String(parts[1]), nil
+}
+
+// Returns the delegated administrator registrations made before the cutoff
+func getAllDelegatedAdministrators(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := organizations.New(session)
+
+	var administrators []*organizations.DelegatedAdministrator
+	err := svc.ListDelegatedAdministratorsPages(
+		&organizations.ListDelegatedAdministratorsInput{},
+		func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+			administrators = append(administrators, page.DelegatedAdministrators...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var identifiers []*string
+	for _, administrator := range administrators {
+		err := svc.ListDelegatedServicesForAccountPages(
+			&organizations.ListDelegatedServicesForAccountInput{AccountId: administrator.Id},
+			func(page *organizations.ListDelegatedServicesForAccountOutput, lastPage bool) bool {
+				for _, service := range page.DelegatedServices {
+					identifier := delegatedAdministratorIdentifier(administrator.Id, service.ServicePrincipal)
+					if timeFilter.IncludesResource(identifier, *service.DelegationEnabledDate) {
+						identifiers = append(identifiers, identifier)
+					}
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// Deregisters all the given delegated administrators from their services
+func nukeAllDelegatedAdministrators(session *session.Session, identifiers []*string) error {
+	svc := organizations.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Infof("No delegated administrators to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deregistering all delegated administrators in region %s", *session.Config.Region)
+	var deregisteredIdentifiers []*string
+
+	for _, identifier := range identifiers {
+		accountId, servicePrincipal, err := parseDelegatedAdministratorIdentifier(*identifier)
+		if err == nil {
+			_, err = svc.DeregisterDelegatedAdministrator(&organizations.DeregisterDelegatedAdministratorInput{
+				AccountId:        accountId,
+				ServicePrincipal: servicePrincipal,
+			})
+		}
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deregisteredIdentifiers = append(deregisteredIdentifiers, identifier)
+			logging.Logger.Infof("Deregistered delegated administrator: %s", *identifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d delegated administrator(s) deregistered in %s", len(deregisteredIdentifiers), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,24 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegatedAdministratorIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := delegatedAdministratorIdentifier(awsgo.String("123456789012"), awsgo.String("securityhub.amazonaws.com"))
+	assert.Equal(t, "123456789012/securityhub.amazonaws.com", *identifier)
+
+	accountId, servicePrincipal, err := parseDelegatedAdministratorIdentifier(*identifier)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789012", *accountId)
+	assert.Equal(t, "securityhub.amazonaws.com", *servicePrincipal)
+
+	_, _, err = parseDelegatedAdministratorIdentifier("123456789012")
+	assert.Error(t, err)
+}
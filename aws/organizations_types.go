@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Only the management account of an organization can clean it up, so its resources have to be explicitly opted
+	// into. The organization is global, so they are only looked for in the first of the selected regions to claim it. Service control policies are
+	// nuked first, so that the organizational units they are attached to are left empty of them.
+	registerResourceType(awsResourceType{
+		resource:     ServiceControlPolicies{},
+		weight:       4800,
+		explicitOnly: true,
+		actions:      []string{"organizations:TagResource", "organizations:DetachPolicy", "organizations:DeletePolicy"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !discovery.organizationClaim.claimFor(discovery.region) {
+				return nil, nil
+			}
+			policyIds, err := getAllServiceControlPolicies(discovery.session, discovery.timeFilter, discovery.options.OrganizationsNames)
+			if err != nil {
+				return nil, err
+			}
+			return ServiceControlPolicies{PolicyIds: awsgo.StringValueSlice(policyIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource:     DelegatedAdministrators{},
+		weight:       4900,
+		explicitOnly: true,
+		actions:      []string{"organizations:DeregisterDelegatedAdministrator"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !discovery.organizationClaim.claimFor(discovery.region) {
+				return nil, nil
+			}
+			identifiers, err := getAllDelegatedAdministrators(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return DelegatedAdministrators{Identifiers: awsgo.StringValueSlice(identifiers)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource:     OrganizationalUnits{},
+		weight:       5000,
+		explicitOnly: true,
+		actions:      []string{"organizations:TagResource", "organizations:DeleteOrganizationalUnit"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !discovery.organizationClaim.claimFor(discovery.region) {
+				return nil, nil
+			}
+			unitIds, err := getAllOrganizationalUnits(discovery.session, discovery.timeFilter, discovery.options.OrganizationsNames)
+			if err != nil {
+				return nil, err
+			}
+			return OrganizationalUnits{UnitIds: awsgo.StringValueSlice(unitIds)}, nil
+		},
+	})
+}
+
+// OrganizationalUnits - represents the organizational units of the organization, e.g. the ones created by tests
+type OrganizationalUnits struct {
+	UnitIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (units OrganizationalUnits) ResourceName() string {
+	return "organizationalunit"
+}
+
+// ResourceIdentifiers - The ids of the organizational units
+func (units OrganizationalUnits) ResourceIdentifiers() []string {
+	return units.UnitIds
+}
+
+func (units OrganizationalUnits) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (units OrganizationalUnits) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllOrganizationalUnits(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ServiceControlPolicies - represents the service control policies of the organization that aren't managed by AWS
+type ServiceControlPolicies struct {
+	PolicyIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (policies ServiceControlPolicies) ResourceName() string {
+	return "servicecontrolpolicy"
+}
+
+// ResourceIdentifiers - The ids of the service control policies
+func (policies ServiceControlPolicies) ResourceIdentifiers() []string {
+	return policies.PolicyIds
+}
+
+func (policies ServiceControlPolicies) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (policies ServiceControlPolicies) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllServiceControlPolicies(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// DelegatedAdministrators - represents the registrations of member accounts as delegated administrators of services
+type DelegatedAdministrators struct {
+	// Each identifier is the account id and the service principal, separated by a slash
+	Identifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (administrators DelegatedAdministrators) ResourceName() string {
+	return "delegatedadministrator"
+}
+
+// ResourceIdentifiers - The account ids and service principals of the registrations
+func (administrators DelegatedAdministrators) ResourceIdentifiers() []string {
+	return administrators.Identifiers
+}
+
+func (administrators DelegatedAdministrators) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (administrators DelegatedAdministrators) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllDelegatedAdministrators(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidDelegatedAdministratorIdentifierError - returned when a delegated administrator identifier isn't an account
+// id/service principal pair
+type InvalidDelegatedAdministratorIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidDelegatedAdministratorIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid delegated administrator identifier %s, expected <account id>/<service principal>", e.Identifier)
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// ResourceOwner - a resource found by cloud-nuke, along with who owns it according to its tags. Owner and Team are
+// empty when the resource doesn't carry the tag, or when its tags can't be looked up.
+type ResourceOwner struct {
+	ResourceType string
+	Identifier   string
+	Region       string
+	Owner        string
+	Team         string
+}
+
+// resourceTagsKey - Returns the key the tags of a resource are indexed by
+func resourceTagsKey(resourceType string, identifier string) string {
+	return resourceType + "/" + identifier
+}
+
+// getAllResourceTags - Returns the tags of all resources in the region whose type the tagging API, or the Auto
+// Scaling API, can resolve to a cloud-nuke resource type, indexed by resourceTagsKey
+func getAllResourceTags(session *session.Session) (map[string]map[string]string, error) {
+	resourceTags := map[string]map[string]string{}
+
+	svc := resourcegroupstaggingapi.New(session)
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: awsgo.StringSlice(taggedResourceTypeFilters),
+	}
+	err := svc.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		for _, mapping := range page.ResourceTagMappingList {
+			resource, err := parseTaggedResourceArn(awsgo.StringValue(mapping.ResourceARN))
+			if err != nil {
+				continue
+			}
+			tags := map[string]string{}
+			for _, tag := range mapping.Tags {
+				tags[awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+			}
+			resourceTags[resourceTagsKey(resource.ResourceName, resource.Identifier)] = tags
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The tagging API doesn't know about Auto Scaling Groups, so look those up directly
+	asgSvc := autoscaling.New(session)
+	err = asgSvc.DescribeTagsPages(&autoscaling.DescribeTagsInput{}, func(page *autoscaling.DescribeTagsOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			key := resourceTagsKey(ASGroups{}.ResourceName(), awsgo.StringValue(tag.ResourceId))
+			if _, ok := resourceTags[key]; !ok {
+				resourceTags[key] = map[string]string{}
+			}
+			resourceTags[key][awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return resourceTags, nil
+}
+
+// GetResourceOwners - Joins the given resources with the values of their owner and team tags. Only the resource
+// types supported by the tagging API have their tags looked up; the others are reported without an owner or team.
+func GetResourceOwners(account *AwsAccountResources, ownerTagKey string, teamTagKey string) ([]ResourceOwner, error) {
+	var owners []ResourceOwner
+
+	for region, resourcesInRegion := range account.Resources {
+		session, err := session.NewSession(withSessionSettings(&awsgo.Config{
+			Region: awsgo.String(region)},
+		))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		instrumentSession(session)
+
+		logging.Logger.Infof("Looking up the owners of the resources in region %s", region)
+		resourceTags, err := getAllResourceTags(session)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, resources := range resourcesInRegion.Resources {
+			for _, identifier := range resources.ResourceIdentifiers() {
+				tags := resourceTags[resourceTagsKey(resources.ResourceName(), identifier)]
+				owners = append(owners, ResourceOwner{
+					ResourceType: resources.ResourceName(),
+					Identifier:   identifier,
+					Region:       region,
+					Owner:        tags[ownerTagKey],
+					Team:         tags[teamTagKey],
+				})
+			}
+		}
+	}
+
+	return owners, nil
+}
@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Pinpoint. Refer to
+// https://docs.aws.amazon.com/general/latest/gr/pinpoint.html
+var pinpointRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-2",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+}
+
+// pinpointSupportedRegion returns true if the provided region supports Pinpoint
+func pinpointSupportedRegion(region string) bool {
+	return collections.ListContainsElement(pinpointRegions, region)
+}
+
+// Campaigns and segments only exist within an application, so they are identified by the application id and their
+// own id, separated by a slash
+func pinpointChildIdentifier(applicationId *string, childId *string) *string {
+	return awsgo.String(fmt.Sprintf("%s/%s", awsgo.StringValue(applicationId), awsgo.StringValue(childId)))
+}
+
+func parsePinpointChildIdentifier(identifier string) (*string, *string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return nil, nil, errors.WithStackTrace(InvalidPinpointIdentifierError{Identifier: identifier})
+	}
+	return awsgo.String(parts[0]), awsgo.String(parts[1]), nil
+}
+
+// Pinpoint returns creation dates as ISO 8601 strings
+func parsePinpointCreationDate(creationDate *string) (time.Time, error) {
+	createdAt, err := time.Parse(time.RFC3339, awsgo.StringValue(creationDate))
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return createdAt, nil
+}
+
+func getAllPinpointApplicationResponses(svc *pinpoint.Pinpoint) ([]*pinpoint.ApplicationResponse, error) {
+	var applications []*pinpoint.ApplicationResponse
+	input := &pinpoint.GetAppsInput{}
+	for {
+		result, err := svc.GetApps(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		applications = append(applications, result.ApplicationsResponse.Item...)
+		if result.ApplicationsResponse.NextToken == nil {
+			return applications, nil
+		}
+		input.Token = result.ApplicationsResponse.NextToken
+	}
+}
+
+// Returns a formatted string of Pinpoint application ids
+func getAllPinpointApplications(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := pinpoint.New(session)
+
+	applications, err := getAllPinpointApplicationResponses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	var applicationIds []*string
+	for _, application := range applications {
+		createdAt, err := parsePinpointCreationDate(application.CreationDate)
+		if err != nil {
+			return nil, err
+		}
+		if timeFilter.IncludesResource(application.Id, createdAt) {
+			applicationIds = append(applicationIds, application.Id)
+		}
+	}
+
+	return applicationIds, nil
+}
+
+// Deletes all Pinpoint applications, along with their campaigns and segments
+func nukeAllPinpointApplications(session *session.Session, applicationIds []*string) error {
+	svc := pinpoint.New(session)
+
+	if len(applicationIds) == 0 {
+		logging.Logger.Infof("No Pinpoint applications to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Pinpoint applications in region %s", *session.Config.Region)
+	var deletedApplicationIds []*string
+
+	for _, applicationId := range applicationIds {
+		_, err := svc.DeleteApp(&pinpoint.DeleteAppInput{
+			ApplicationId: applicationId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == pinpoint.ErrCodeNotFoundException {
+				logging.Logger.Infof("Pinpoint application %s has already been deleted", *applicationId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedApplicationIds = append(deletedApplicationIds, applicationId)
+			logging.Logger.Infof("Deleted Pinpoint application: %s", *applicationId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Pinpoint application(s) deleted in %s", len(deletedApplicationIds), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of Pinpoint campaign identifiers, across all applications
+func getAllPinpointCampaigns(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := pinpoint.New(session)
+
+	applications, err := getAllPinpointApplicationResponses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	var campaignIdentifiers []*string
+	for _, application := range applications {
+		input := &pinpoint.GetCampaignsInput{ApplicationId: application.Id}
+		for {
+			result, err := svc.GetCampaigns(input)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			for _, campaign := range result.CampaignsResponse.Item {
+				createdAt, err := parsePinpointCreationDate(campaign.CreationDate)
+				if err != nil {
+					return nil, err
+				}
+				identifier := pinpointChildIdentifier(application.Id, campaign.Id)
+				if timeFilter.IncludesResource(identifier, createdAt) {
+					campaignIdentifiers = append(campaignIdentifiers, identifier)
+				}
+			}
+
+			if result.CampaignsResponse.NextToken == nil {
+				break
+			}
+			input.Token = result.CampaignsResponse.NextToken
+		}
+	}
+
+	return campaignIdentifiers, nil
+}
+
+// Deletes all Pinpoint campaigns
+func nukeAllPinpointCampaigns(session *session.Session, campaignIdentifiers []*string) error {
+	svc := pinpoint.New(session)
+
+	if len(campaignIdentifiers) == 0 {
+		logging.Logger.Infof("No Pinpoint campaigns to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Pinpoint campaigns in region %s", *session.Config.Region)
+	var deletedCampaignIdentifiers []*string
+
+	for _, campaignIdentifier := range campaignIdentifiers {
+		applicationId, campaignId, err := parsePinpointChildIdentifier(*campaignIdentifier)
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+
+		_, err = svc.DeleteCampaign(&pinpoint.DeleteCampaignInput{
+			ApplicationId: applicationId,
+			CampaignId:    campaignId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == pinpoint.ErrCodeNotFoundException {
+				logging.Logger.Infof("Pinpoint campaign %s has already been deleted", *campaignIdentifier)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedCampaignIdentifiers = append(deletedCampaignIdentifiers, campaignIdentifier)
+			logging.Logger.Infof("Deleted Pinpoint campaign: %s", *campaignIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Pinpoint campaign(s) deleted in %s", len(deletedCampaignIdentifiers), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of Pinpoint segment identifiers, across all applications
+func getAllPinpointSegments(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := pinpoint.New(session)
+
+	applications, err := getAllPinpointApplicationResponses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	var segmentIdentifiers []*string
+	for _, application := range applications {
+		input := &pinpoint.GetSegmentsInput{ApplicationId: application.Id}
+		for {
+			result, err := svc.GetSegments(input)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			for _, segment := range result.SegmentsResponse.Item {
+				createdAt, err := parsePinpointCreationDate(segment.CreationDate)
+				if err != nil {
+					return nil, err
+				}
+				identifier := pinpointChildIdentifier(application.Id, segment.Id)
+				if timeFilter.IncludesResource(identifier, createdAt) {
+					segmentIdentifiers = append(segmentIdentifiers, identifier)
+				}
+			}
+
+			if result.SegmentsResponse.NextToken == nil {
+				break
+			}
+			input.Token = result.SegmentsResponse.NextToken
+		}
+	}
+
+	return segmentIdentifiers, nil
+}
+
+// Deletes all Pinpoint segments
+func nukeAllPinpointSegments(session *session.Session, segmentIdentifiers []*string) error {
+	svc := pinpoint.New(session)
+
+	if len(segmentIdentifiers) == 0 {
+		logging.Logger.Infof("No Pinpoint segments to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Pinpoint segments in region %s", *session.Config.Region)
+	var deletedSegmentIdentifiers []*string
+
+	for _, segmentIdentifier := range segmentIdentifiers {
+		applicationId, segmentId, err := parsePinpointChildIdentifier(*segmentIdentifier)
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+
+		_, err = svc.DeleteSegment(&pinpoint.DeleteSegmentInput{
+			ApplicationId: applicationId,
+			SegmentId:     segmentId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == pinpoint.ErrCodeNotFoundException {
+				logging.Logger.Infof("Pinpoint segment %s has already been deleted", *segmentIdentifier)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedSegmentIdentifiers = append(deletedSegmentIdentifiers, segmentIdentifier)
+			logging.Logger.Infof("Deleted Pinpoint segment: %s", *segmentIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Pinpoint segment(s) deleted in %s", len(deletedSegmentIdentifiers), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPinpointApplication(t *testing.T, session *session.Session, name string) string {
+	svc := pinpoint.New(session)
+	result, err := svc.CreateApp(&pinpoint.CreateAppInput{
+		CreateApplicationRequest: &pinpoint.CreateApplicationRequest{
+			Name: awsgo.String(name),
+		},
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(result.ApplicationResponse.Id)
+}
+
+func createTestPinpointSegment(t *testing.T, session *session.Session, applicationId string, name string) string {
+	svc := pinpoint.New(session)
+	result, err := svc.CreateSegment(&pinpoint.CreateSegmentInput{
+		ApplicationId: awsgo.String(applicationId),
+		WriteSegmentRequest: &pinpoint.WriteSegmentRequest{
+			Name:       awsgo.String(name),
+			Dimensions: &pinpoint.SegmentDimensions{},
+		},
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(pinpointChildIdentifier(result.SegmentResponse.ApplicationId, result.SegmentResponse.Id))
+}
+
+func TestListPinpointApplications(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, pinpointRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	applicationId := createTestPinpointApplication(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllPinpointApplications(session, []*string{awsgo.String(applicationId)})
+
+	applicationIds, err := getAllPinpointApplications(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Pinpoint applications")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(applicationIds), applicationId)
+
+	applicationIds, err = getAllPinpointApplications(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Pinpoint applications")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(applicationIds), applicationId)
+}
+
+func TestNukePinpointApplications(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, pinpointRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	applicationId := createTestPinpointApplication(t, session, uniqueTestID)
+
+	if err := nukeAllPinpointApplications(session, []*string{awsgo.String(applicationId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	applicationIds, err := getAllPinpointApplications(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Pinpoint applications")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(applicationIds), applicationId)
+}
+
+func TestNukePinpointSegments(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, pinpointRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	applicationId := createTestPinpointApplication(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllPinpointApplications(session, []*string{awsgo.String(applicationId)})
+	segmentIdentifier := createTestPinpointSegment(t, session, applicationId, uniqueTestID)
+
+	segmentIdentifiers, err := getAllPinpointSegments(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Pinpoint segments")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(segmentIdentifiers), segmentIdentifier)
+
+	if err := nukeAllPinpointSegments(session, []*string{awsgo.String(segmentIdentifier)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	segmentIdentifiers, err = getAllPinpointSegments(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Pinpoint segments")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(segmentIdentifiers), segmentIdentifier)
+}
+
+func TestParsePinpointChildIdentifier(t *testing.T) {
+	applicationId, childId, err := parsePinpointChildIdentifier("app-id/segment-id")
+	require.NoError(t, err)
+	assert.Equal(t, "app-id", awsgo.StringValue(applicationId))
+	assert.Equal(t, "segment-id", awsgo.StringValue(childId))
+
+	_, _, err = parsePinpointChildIdentifier("app-id")
+	assert.Error(t, err)
+}
+
+func TestParsePinpointCreationDate(t *testing.T) {
+	createdAt, err := parsePinpointCreationDate(awsgo.String("2019-07-24T20:58:05.386Z"))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 7, 24, 20, 58, 5, 386000000, time.UTC), createdAt)
+}
+
+func TestPinpointSupportedRegions(t *testing.T) {
+	unsupportedRegions := []string{
+		"us-west-1",
+		"eu-north-1",
+	}
+	for _, region := range unsupportedRegions {
+		assert.False(t, pinpointSupportedRegion(region))
+	}
+	for _, region := range pinpointRegions {
+		assert.True(t, pinpointSupportedRegion(region))
+	}
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before segments, since campaigns target segments
+	registerResourceType(awsResourceType{
+		resource: PinpointCampaigns{},
+		weight:   4000,
+		actions:  []string{"mobiletargeting:DeleteCampaign"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !pinpointSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			campaignIdentifiers, err := getAllPinpointCampaigns(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return PinpointCampaigns{CampaignIdentifiers: awsgo.StringValueSlice(campaignIdentifiers)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: PinpointSegments{},
+		weight:   4100,
+		actions:  []string{"mobiletargeting:DeleteSegment"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !pinpointSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			segmentIdentifiers, err := getAllPinpointSegments(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return PinpointSegments{SegmentIdentifiers: awsgo.StringValueSlice(segmentIdentifiers)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: PinpointApplications{},
+		weight:   4200,
+		actions:  []string{"mobiletargeting:DeleteApp"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !pinpointSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			applicationIds, err := getAllPinpointApplications(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return PinpointApplications{ApplicationIds: awsgo.StringValueSlice(applicationIds)}, nil
+		},
+	})
+}
+
+// PinpointApplications - represents all Pinpoint applications
+type PinpointApplications struct {
+	ApplicationIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (apps PinpointApplications) ResourceName() string {
+	return "pinpointapp"
+}
+
+// ResourceIdentifiers - The ids of the Pinpoint applications
+func (apps PinpointApplications) ResourceIdentifiers() []string {
+	return apps.ApplicationIds
+}
+
+func (apps PinpointApplications) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (apps PinpointApplications) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllPinpointApplications(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// PinpointCampaigns - represents all Pinpoint campaigns
+type PinpointCampaigns struct {
+	CampaignIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (campaigns PinpointCampaigns) ResourceName() string {
+	return "pinpointcampaign"
+}
+
+// ResourceIdentifiers - The application id/campaign id pairs of the Pinpoint campaigns
+func (campaigns PinpointCampaigns) ResourceIdentifiers() []string {
+	return campaigns.CampaignIdentifiers
+}
+
+func (campaigns PinpointCampaigns) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (campaigns PinpointCampaigns) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllPinpointCampaigns(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// PinpointSegments - represents all Pinpoint segments
+type PinpointSegments struct {
+	SegmentIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (segments PinpointSegments) ResourceName() string {
+	return "pinpointsegment"
+}
+
+// ResourceIdentifiers - The application id/segment id pairs of the Pinpoint segments
+func (segments PinpointSegments) ResourceIdentifiers() []string {
+	return segments.SegmentIdentifiers
+}
+
+func (segments PinpointSegments) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (segments PinpointSegments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllPinpointSegments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidPinpointIdentifierError - returned when a campaign or segment identifier isn't an application id/id pair
+type InvalidPinpointIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidPinpointIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid Pinpoint identifier %s, expected <application id>/<id>", e.Identifier)
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Amazon Managed Service for Prometheus. Refer to
+// https://aws.amazon.com/about-aws/global-infrastructure/regional-product-services/
+var prometheusRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-2",
+	"ap-south-1",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"eu-north-1",
+	"sa-east-1",
+}
+
+// prometheusSupportedRegion returns true if the provided region supports Amazon Managed Service for Prometheus
+func prometheusSupportedRegion(region string) bool {
+	return collections.ListContainsElement(prometheusRegions, region)
+}
+
+// Returns a formatted string of Managed Prometheus workspace ids
+func getAllPrometheusWorkspaces(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := prometheusservice.New(session)
+
+	var workspaceIds []*string
+	err := svc.ListWorkspacesPages(
+		&prometheusservice.ListWorkspacesInput{},
+		func(page *prometheusservice.ListWorkspacesOutput, lastPage bool) bool {
+			for _, workspace := range page.Workspaces {
+				if awsgo.StringValue(workspace.Status.StatusCode) == prometheusservice.WorkspaceStatusCodeDeleting {
+					continue
+				}
+				if timeFilter.IncludesResource(workspace.WorkspaceId, *workspace.CreatedAt) {
+					workspaceIds = append(workspaceIds, workspace.WorkspaceId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return workspaceIds, nil
+}
+
+// Deletes all Managed Prometheus workspaces
+func nukeAllPrometheusWorkspaces(session *session.Session, workspaceIds []*string) error {
+	svc := prometheusservice.New(session)
+
+	if len(workspaceIds) == 0 {
+		logging.Logger.Infof("No Managed Prometheus workspaces to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Managed Prometheus workspaces in region %s", *session.Config.Region)
+	var deletedWorkspaceIds []*string
+
+	for _, workspaceId := range workspaceIds {
+		_, err := svc.DeleteWorkspace(&prometheusservice.DeleteWorkspaceInput{
+			WorkspaceId: workspaceId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == prometheusservice.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Managed Prometheus workspace %s has already been deleted", *workspaceId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedWorkspaceIds = append(deletedWorkspaceIds, workspaceId)
+			logging.Logger.Infof("Deleted Managed Prometheus workspace: %s", *workspaceId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Managed Prometheus workspace(s) deleted in %s", len(deletedWorkspaceIds), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given Managed Prometheus workspaces have actually been deleted
+func waitUntilPrometheusWorkspacesDeleted(session *session.Session, workspaceIds []*string) error {
+	svc := prometheusservice.New(session)
+
+	for _, workspaceId := range workspaceIds {
+		err := svc.WaitUntilWorkspaceDeleted(&prometheusservice.DescribeWorkspaceInput{
+			WorkspaceId: workspaceId,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPrometheusWorkspace(t *testing.T, session *session.Session, name string) string {
+	svc := prometheusservice.New(session)
+	result, err := svc.CreateWorkspace(&prometheusservice.CreateWorkspaceInput{
+		Alias: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return awsgo.StringValue(result.WorkspaceId)
+}
+
+func TestListPrometheusWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, prometheusRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	workspaceId := createTestPrometheusWorkspace(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllPrometheusWorkspaces(session, []*string{awsgo.String(workspaceId)})
+
+	workspaceIds, err := getAllPrometheusWorkspaces(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Managed Prometheus workspaces")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(workspaceIds), workspaceId)
+
+	workspaceIds, err = getAllPrometheusWorkspaces(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Managed Prometheus workspaces")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(workspaceIds), workspaceId)
+}
+
+func TestNukePrometheusWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, prometheusRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	workspaceId := createTestPrometheusWorkspace(t, session, uniqueTestID)
+
+	if err := nukeAllPrometheusWorkspaces(session, []*string{awsgo.String(workspaceId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	workspaceIds, err := getAllPrometheusWorkspaces(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Managed Prometheus workspaces")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(workspaceIds), workspaceId)
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: PrometheusWorkspaces{},
+		weight:   3900,
+		actions:  []string{"aps:DeleteWorkspace"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !prometheusSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			workspaceIds, err := getAllPrometheusWorkspaces(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return PrometheusWorkspaces{WorkspaceIds: awsgo.StringValueSlice(workspaceIds)}, nil
+		},
+	})
+}
+
+// PrometheusWorkspaces - represents all Managed Prometheus workspaces
+type PrometheusWorkspaces struct {
+	WorkspaceIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (workspace PrometheusWorkspaces) ResourceName() string {
+	return "prometheusworkspace"
+}
+
+// ResourceIdentifiers - The ids of the Managed Prometheus workspaces
+func (workspace PrometheusWorkspaces) ResourceIdentifiers() []string {
+	return workspace.WorkspaceIds
+}
+
+func (workspace PrometheusWorkspaces) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (workspace PrometheusWorkspaces) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllPrometheusWorkspaces(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (workspace PrometheusWorkspaces) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilPrometheusWorkspacesDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support QuickSight. Refer to
+// https://docs.aws.amazon.com/quicksight/latest/user/regions.html
+var quickSightRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-2",
+	"af-south-1",
+	"ap-east-1",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-northeast-3",
+	"ap-south-1",
+	"ap-south-2",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ap-southeast-3",
+	"ap-southeast-4",
+	"ca-central-1",
+	"ca-west-1",
+	"eu-central-1",
+	"eu-central-2",
+	"eu-north-1",
+	"eu-south-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"sa-east-1",
+}
+
+// The namespace every QuickSight account is created with
+const quickSightDefaultNamespace = "default"
+
+// The status of an account subscription that has been deleted
+const quickSightUnsubscribedStatus = "UNSUBSCRIBED"
+
+// quickSightSupportedRegion returns true if the provided region supports QuickSight
+func quickSightSupportedRegion(region string) bool {
+	return collections.ListContainsElement(quickSightRegions, region)
+}
+
+// QuickSight answers with a ResourceNotFoundException when the account has never signed up for it, in which case there
+// is nothing to nuke
+func isQuickSightNotSubscribedErr(err error) bool {
+	awsErr, isAwsErr := err.(awserr.Error)
+	return isAwsErr && awsErr.Code() == quicksight.ErrCodeResourceNotFoundException
+}
+
+// Returns a formatted string of QuickSight analysis ids
+func getAllQuickSightAnalyses(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := quicksight.New(session)
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, err
+	}
+
+	var analysisIds []*string
+	err = svc.ListAnalysesPages(
+		&quicksight.ListAnalysesInput{AwsAccountId: accountId},
+		func(page *quicksight.ListAnalysesOutput, lastPage bool) bool {
+			for _, analysis := range page.AnalysisSummaryList {
+				// Deleted analyses stay listed until their recovery window is over
+				if awsgo.StringValue(analysis.Status) == quicksight.ResourceStatusDeleted {
+					continue
+				}
+				if timeFilter.IncludesResource(analysis.AnalysisId, *analysis.CreatedTime) {
+					analysisIds = append(analysisIds, analysis.AnalysisId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		if isQuickSightNotSubscribedErr(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return analysisIds, nil
+}
+
+// Deletes all QuickSight analyses, without a recovery window
+func nukeAllQuickSightAnalyses(session *session.Session, analysisIds []*string) error {
+	svc := quicksight.New(session)
+
+	if len(analysisIds) == 0 {
+		logging.Logger.Infof("No QuickSight analyses to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Infof("Deleting all QuickSight analyses in region %s", *session.Config.Region)
+	var deletedAnalysisIds []*string
+
+	for _, analysisId := range analysisIds {
+		_, err := svc.DeleteAnalysis(&quicksight.DeleteAnalysisInput{
+			AwsAccountId:               accountId,
+			AnalysisId:                 analysisId,
+			ForceDeleteWithoutRecovery: awsgo.Bool(true),
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == quicksight.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("QuickSight analysis %s has already been deleted", *analysisId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedAnalysisIds = append(deletedAnalysisIds, analysisId)
+			logging.Logger.Infof("Deleted QuickSight analysis: %s", *analysisId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d QuickSight analysis(es) deleted in %s", len(deletedAnalysisIds), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of QuickSight dashboard ids
+func getAllQuickSightDashboards(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := quicksight.New(session)
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, err
+	}
+
+	var dashboardIds []*string
+	err = svc.ListDashboardsPages(
+		&quicksight.ListDashboardsInput{AwsAccountId: accountId},
+		func(page *quicksight.ListDashboardsOutput, lastPage bool) bool {
+			for _, dashboard := range page.DashboardSummaryList {
+				if timeFilter.IncludesResource(dashboard.DashboardId, *dashboard.CreatedTime) {
+					dashboardIds = append(dashboardIds, dashboard.DashboardId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		if isQuickSightNotSubscribedErr(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return dashboardIds, nil
+}
+
+// Deletes all QuickSight dashboards
+func nukeAllQuickSightDashboards(session *session.Session, dashboardIds []*string) error {
+	svc := quicksight.New(session)
+
+	if len(dashboardIds) == 0 {
+		logging.Logger.Infof("No QuickSight dashboards to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Infof("Deleting all QuickSight dashboards in region %s", *session.Config.Region)
+	var deletedDashboardIds []*string
+
+	for _, dashboardId := range dashboardIds {
+		_, err := svc.DeleteDashboard(&quicksight.DeleteDashboardInput{
+			AwsAccountId: accountId,
+			DashboardId:  dashboardId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == quicksight.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("QuickSight dashboard %s has already been deleted", *dashboardId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedDashboardIds = append(deletedDashboardIds, dashboardId)
+			logging.Logger.Infof("Deleted QuickSight dashboard: %s", *dashboardId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d QuickSight dashboard(s) deleted in %s", len(deletedDashboardIds), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of QuickSight dataset ids
+func getAllQuickSightDataSets(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := quicksight.New(session)
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataSetIds []*string
+	err = svc.ListDataSetsPages(
+		&quicksight.ListDataSetsInput{AwsAccountId: accountId},
+		func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+			for _, dataSet := range page.DataSetSummaries {
+				if timeFilter.IncludesResource(dataSet.DataSetId, *dataSet.CreatedTime) {
+					dataSetIds = append(dataSetIds, dataSet.DataSetId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		if isQuickSightNotSubscribedErr(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return dataSetIds, nil
+}
+
+// Deletes all QuickSight datasets
+func nukeAllQuickSightDataSets(session *session.Session, dataSetIds []*string) error {
+	svc := quicksight.New(session)
+
+	if len(dataSetIds) == 0 {
+		logging.Logger.Infof("No QuickSight datasets to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Infof("Deleting all QuickSight datasets in region %s", *session.Config.Region)
+	var deletedDataSetIds []*string
+
+	for _, dataSetId := range dataSetIds {
+		_, err := svc.DeleteDataSet(&quicksight.DeleteDataSetInput{
+			AwsAccountId: accountId,
+			DataSetId:    dataSetId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == quicksight.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("QuickSight dataset %s has already been deleted", *dataSetId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedDataSetIds = append(deletedDataSetIds, dataSetId)
+			logging.Logger.Infof("Deleted QuickSight dataset: %s", *dataSetId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d QuickSight dataset(s) deleted in %s", len(deletedDataSetIds), *session.Config.Region)
+	return nil
+}
+
+// Returns the id of the account if it is subscribed to QuickSight. The subscription doesn't expose its creation time,
+// so it isn't filtered by age.
+func getQuickSightSubscription(session *session.Session) ([]*string, error) {
+	svc := quicksight.New(session)
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := svc.DescribeAccountSubscription(&quicksight.DescribeAccountSubscriptionInput{
+		AwsAccountId: accountId,
+	})
+	if err != nil {
+		if isQuickSightNotSubscribedErr(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+
+	if result.AccountInfo == nil || awsgo.StringValue(result.AccountInfo.AccountSubscriptionStatus) == quickSightUnsubscribedStatus {
+		return nil, nil
+	}
+	return []*string{accountId}, nil
+}
+
+// Unsubscribes the accounts from QuickSight, which deletes all QuickSight data in them
+func nukeQuickSightSubscription(session *session.Session, accountIds []*string) error {
+	svc := quicksight.New(session)
+
+	if len(accountIds) == 0 {
+		logging.Logger.Infof("No QuickSight subscription to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting QuickSight subscription in region %s", *session.Config.Region)
+	var deletedAccountIds []*string
+
+	for _, accountId := range accountIds {
+		// Termination protection is enabled by default and has to be turned off before the subscription can be deleted
+		_, err := svc.UpdateAccountSettings(&quicksight.UpdateAccountSettingsInput{
+			AwsAccountId:                 accountId,
+			DefaultNamespace:             awsgo.String(quickSightDefaultNamespace),
+			TerminationProtectionEnabled: awsgo.Bool(false),
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+
+		_, err = svc.DeleteAccountSubscription(&quicksight.DeleteAccountSubscriptionInput{
+			AwsAccountId: accountId,
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedAccountIds = append(deletedAccountIds, accountId)
+			logging.Logger.Infof("Deleted QuickSight subscription of account: %s", *accountId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d QuickSight subscription(s) deleted in %s", len(deletedAccountIds), *session.Config.Region)
+	return nil
+}
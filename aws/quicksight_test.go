@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+// Creating QuickSight assets requires the test account to be subscribed to QuickSight, so this only checks that
+// listing them works, whether the account is subscribed or not
+func TestListQuickSightResources(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, quickSightRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	timeFilter := TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}
+
+	_, err = getAllQuickSightAnalyses(session, timeFilter)
+	assert.NoError(t, err)
+
+	_, err = getAllQuickSightDashboards(session, timeFilter)
+	assert.NoError(t, err)
+
+	_, err = getAllQuickSightDataSets(session, timeFilter)
+	assert.NoError(t, err)
+
+	_, err = getQuickSightSubscription(session)
+	assert.NoError(t, err)
+}
+
+func TestQuickSightSupportedRegions(t *testing.T) {
+	unsupportedRegions := []string{
+		"us-west-1",
+		"me-central-1",
+	}
+	for _, region := range unsupportedRegions {
+		assert.False(t, quickSightSupportedRegion(region))
+	}
+	for _, region := range quickSightRegions {
+		assert.True(t, quickSightSupportedRegion(region))
+	}
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before datasets, since analyses and dashboards are built on top of them
+	registerResourceType(awsResourceType{
+		resource: QuickSightAnalyses{},
+		weight:   4300,
+		actions:  []string{"quicksight:DeleteAnalysis"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !quickSightSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			analysisIds, err := getAllQuickSightAnalyses(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return QuickSightAnalyses{AnalysisIds: awsgo.StringValueSlice(analysisIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: QuickSightDashboards{},
+		weight:   4400,
+		actions:  []string{"quicksight:DeleteDashboard"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !quickSightSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			dashboardIds, err := getAllQuickSightDashboards(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return QuickSightDashboards{DashboardIds: awsgo.StringValueSlice(dashboardIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: QuickSightDataSets{},
+		weight:   4500,
+		actions:  []string{"quicksight:DeleteDataSet"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !quickSightSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			dataSetIds, err := getAllQuickSightDataSets(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return QuickSightDataSets{DataSetIds: awsgo.StringValueSlice(dataSetIds)}, nil
+		},
+	})
+
+	// Unsubscribing deletes all QuickSight data in the account, so it has to be explicitly opted into
+	registerResourceType(awsResourceType{
+		resource:     QuickSightSubscription{},
+		weight:       4600,
+		explicitOnly: true,
+		actions:      []string{"quicksight:UpdateAccountSettings", "quicksight:DeleteAccountSubscription"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !quickSightSupportedRegion(discovery.region) || discovery.quickSightSubscriptionClaim.isClaimed() {
+				return nil, nil
+			}
+			accountIds, err := getQuickSightSubscription(discovery.session)
+			if err != nil {
+				return nil, err
+			}
+			if len(accountIds) == 0 || !discovery.quickSightSubscriptionClaim.tryClaim() {
+				return nil, nil
+			}
+			return QuickSightSubscription{AccountIds: awsgo.StringValueSlice(accountIds)}, nil
+		},
+	})
+}
+
+// QuickSightAnalyses - represents all QuickSight analyses
+type QuickSightAnalyses struct {
+	AnalysisIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (analyses QuickSightAnalyses) ResourceName() string {
+	return "quicksightanalysis"
+}
+
+// ResourceIdentifiers - The ids of the QuickSight analyses
+func (analyses QuickSightAnalyses) ResourceIdentifiers() []string {
+	return analyses.AnalysisIds
+}
+
+func (analyses QuickSightAnalyses) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (analyses QuickSightAnalyses) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllQuickSightAnalyses(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// QuickSightDashboards - represents all QuickSight dashboards
+type QuickSightDashboards struct {
+	DashboardIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (dashboards QuickSightDashboards) ResourceName() string {
+	return "quicksightdashboard"
+}
+
+// ResourceIdentifiers - The ids of the QuickSight dashboards
+func (dashboards QuickSightDashboards) ResourceIdentifiers() []string {
+	return dashboards.DashboardIds
+}
+
+func (dashboards QuickSightDashboards) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (dashboards QuickSightDashboards) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllQuickSightDashboards(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// QuickSightDataSets - represents all QuickSight datasets
+type QuickSightDataSets struct {
+	DataSetIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (dataSets QuickSightDataSets) ResourceName() string {
+	return "quicksightdataset"
+}
+
+// ResourceIdentifiers - The ids of the QuickSight datasets
+func (dataSets QuickSightDataSets) ResourceIdentifiers() []string {
+	return dataSets.DataSetIds
+}
+
+func (dataSets QuickSightDataSets) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (dataSets QuickSightDataSets) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllQuickSightDataSets(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// QuickSightSubscription - represents all QuickSight subscriptions. Only nuked when explicitly selected with --resource-type
+type QuickSightSubscription struct {
+	AccountIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (subscription QuickSightSubscription) ResourceName() string {
+	return "quicksightsubscription"
+}
+
+// ResourceIdentifiers - The ids of the accounts subscribed to QuickSight
+func (subscription QuickSightSubscription) ResourceIdentifiers() []string {
+	return subscription.AccountIds
+}
+
+func (subscription QuickSightSubscription) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (subscription QuickSightSubscription) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeQuickSightSubscription(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"sort"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// resourceQuota - the Service Quotas quota that caps how many resources of a cloud-nuke resource type a region can
+// hold. Refer to https://docs.aws.amazon.com/servicequotas/latest/userguide/intro.html
+type resourceQuota struct {
+	ResourceType string
+	ServiceCode  string
+	QuotaCode    string
+}
+
+// The resource types with a per region quota on their count. Quotas on other dimensions, such as the vCPUs of EC2
+// instances or the NAT Gateways per availability zone, don't map onto a count of discovered resources.
+var resourceQuotas = []resourceQuota{
+	{ResourceType: CloudFormationStacks{}.ResourceName(), ServiceCode: "cloudformation", QuotaCode: "L-0485CB21"},
+	{ResourceType: ASGroups{}.ResourceName(), ServiceCode: "autoscaling", QuotaCode: "L-CDE20ADC"},
+	{ResourceType: LaunchConfigs{}.ResourceName(), ServiceCode: "autoscaling", QuotaCode: "L-6B80B8FA"},
+	{ResourceType: LoadBalancers{}.ResourceName(), ServiceCode: "elasticloadbalancing", QuotaCode: "L-E9E9831D"},
+	{ResourceType: AMIs{}.ResourceName(), ServiceCode: "ec2", QuotaCode: "L-B665C33B"},
+	{ResourceType: Snapshots{}.ResourceName(), ServiceCode: "ebs", QuotaCode: "L-309BACF6"},
+	{ResourceType: EIPAddresses{}.ResourceName(), ServiceCode: "ec2", QuotaCode: "L-0263D0A3"},
+	{ResourceType: VPCs{}.ResourceName(), ServiceCode: "vpc", QuotaCode: "L-F678F1CE"},
+	{ResourceType: ECRRepositories{}.ResourceName(), ServiceCode: "ecr", QuotaCode: "L-CFEB8E8D"},
+	{ResourceType: ElasticFileSystems{}.ResourceName(), ServiceCode: "elasticfilesystem", QuotaCode: "L-848C634D"},
+	{ResourceType: EKSClusters{}.ResourceName(), ServiceCode: "eks", QuotaCode: "L-1194D53C"},
+}
+
+// QuotaUsage - how many resources of a type were found in a region, against the quota on them
+type QuotaUsage struct {
+	ResourceType string
+	Region       string
+	QuotaName    string
+	Count        int
+	Quota        float64
+}
+
+// Utilization - Returns the share of the quota the resources found use up, from 0 to 1, or more when the count
+// exceeds the quota
+func (usage QuotaUsage) Utilization() float64 {
+	if usage.Quota <= 0 {
+		return 0
+	}
+	return float64(usage.Count) / usage.Quota
+}
+
+// QuotaResourceTypes - Returns the resource types whose count can be checked against a quota
+func QuotaResourceTypes() []string {
+	var resourceTypes []string
+	for _, quota := range resourceQuotas {
+		resourceTypes = append(resourceTypes, quota.ResourceType)
+	}
+	return resourceTypes
+}
+
+// getServiceQuota - Returns the name and value of the quota applied to the account in the region, or the AWS default
+// when the quota was never raised
+func getServiceQuota(svc *servicequotas.ServiceQuotas, quota resourceQuota) (string, float64, error) {
+	output, err := svc.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: awsgo.String(quota.ServiceCode),
+		QuotaCode:   awsgo.String(quota.QuotaCode),
+	})
+	if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == servicequotas.ErrCodeNoSuchResourceException {
+		defaultOutput, defaultErr := svc.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+			ServiceCode: awsgo.String(quota.ServiceCode),
+			QuotaCode:   awsgo.String(quota.QuotaCode),
+		})
+		if defaultErr != nil {
+			return "", 0, errors.WithStackTrace(defaultErr)
+		}
+		return awsgo.StringValue(defaultOutput.Quota.QuotaName), awsgo.Float64Value(defaultOutput.Quota.Value), nil
+	}
+	if err != nil {
+		return "", 0, errors.WithStackTrace(err)
+	}
+	return awsgo.StringValue(output.Quota.QuotaName), awsgo.Float64Value(output.Quota.Value), nil
+}
+
+// GetQuotaUsage - Checks the number of resources found in each region against the Service Quotas quota on them, for
+// the resource types that have one. Quotas that can't be looked up are skipped. Usages are sorted by utilization,
+// highest first.
+func GetQuotaUsage(account *AwsAccountResources) ([]QuotaUsage, error) {
+	var usages []QuotaUsage
+
+	for region, resourcesInRegion := range account.Resources {
+		counts := map[string]int{}
+		for _, resources := range resourcesInRegion.Resources {
+			counts[resources.ResourceName()] += len(resources.ResourceIdentifiers())
+		}
+
+		session, err := session.NewSession(withSessionSettings(&awsgo.Config{
+			Region: awsgo.String(region)},
+		))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		instrumentSession(session)
+		svc := servicequotas.New(session)
+
+		for _, quota := range resourceQuotas {
+			count, ok := counts[quota.ResourceType]
+			if !ok || count == 0 {
+				continue
+			}
+
+			quotaName, value, err := getServiceQuota(svc, quota)
+			if err != nil {
+				logging.Logger.Warnf("Unable to look up the quota on %s in region %s: %s", quota.ResourceType, region, err)
+				continue
+			}
+			usages = append(usages, QuotaUsage{
+				ResourceType: quota.ResourceType,
+				Region:       region,
+				QuotaName:    quotaName,
+				Count:        count,
+				Quota:        value,
+			})
+		}
+	}
+
+	sortQuotaUsages(usages)
+	return usages, nil
+}
+
+// sortQuotaUsages - Sorts usages by utilization, highest first, then by region and resource type
+func sortQuotaUsages(usages []QuotaUsage) {
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Utilization() != usages[j].Utilization() {
+			return usages[i].Utilization() > usages[j].Utilization()
+		}
+		if usages[i].Region != usages[j].Region {
+			return usages[i].Region < usages[j].Region
+		}
+		return usages[i].ResourceType < usages[j].ResourceType
+	})
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaUsageUtilization(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0.8, QuotaUsage{Count: 4, Quota: 5}.Utilization())
+	assert.Equal(t, 1.2, QuotaUsage{Count: 6, Quota: 5}.Utilization())
+	assert.Equal(t, 0.0, QuotaUsage{Count: 4}.Utilization())
+}
+
+func TestSortQuotaUsages(t *testing.T) {
+	t.Parallel()
+
+	usages := []QuotaUsage{
+		{ResourceType: "vpc", Region: "us-east-1", Count: 1, Quota: 5},
+		{ResourceType: "eip", Region: "us-east-1", Count: 4, Quota: 5},
+		{ResourceType: "vpc", Region: "eu-west-1", Count: 4, Quota: 5},
+		{ResourceType: "asg", Region: "us-east-1", Count: 10, Quota: 500},
+	}
+	sortQuotaUsages(usages)
+
+	assert.Equal(t, []QuotaUsage{
+		{ResourceType: "vpc", Region: "eu-west-1", Count: 4, Quota: 5},
+		{ResourceType: "eip", Region: "us-east-1", Count: 4, Quota: 5},
+		{ResourceType: "vpc", Region: "us-east-1", Count: 1, Quota: 5},
+		{ResourceType: "asg", Region: "us-east-1", Count: 10, Quota: 500},
+	}, usages)
+}
+
+func TestQuotaResourceTypesAreValid(t *testing.T) {
+	t.Parallel()
+
+	for _, resourceType := range QuotaResourceTypes() {
+		assert.True(t, IsValidResourceType(resourceType, ListResourceTypes()), resourceType)
+	}
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/ram/ramiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// resourceShareOwner - Returns the id of the account owning the resource share with the given ARN, e.g.
+// arn:aws:ram:us-east-1:123456789012:resource-share/73da1ab9-b94a-4ba3-8eb4-45917f7f4b12
+func resourceShareOwner(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		return ""
+	}
+	return parts[4]
+}
+
+// isActiveResourceShare - Checks if the resource share is still around, as deleted shares are listed for a while
+func isActiveResourceShare(share *ram.ResourceShare) bool {
+	switch awsgo.StringValue(share.Status) {
+	case ram.ResourceShareStatusActive, ram.ResourceShareStatusPending:
+		return true
+	}
+	return false
+}
+
+// Returns the ARNs of the resource shares the account owns, and of the ones it received from other accounts
+func getAllRAMResourceShares(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ram.New(session)
+
+	var arns []*string
+	for _, owner := range []string{ram.ResourceOwnerSelf, ram.ResourceOwnerOtherAccounts} {
+		err := svc.GetResourceSharesPages(
+			&ram.GetResourceSharesInput{ResourceOwner: awsgo.String(owner)},
+			func(page *ram.GetResourceSharesOutput, lastPage bool) bool {
+				for _, share := range page.ResourceShares {
+					if isActiveResourceShare(share) && timeFilter.IncludesResource(share.ResourceShareArn, *share.CreationTime) {
+						arns = append(arns, share.ResourceShareArn)
+					}
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+
+	return arns, nil
+}
+
+// Deletes the resource shares the account owns, and disassociates the account from the ones it received from other
+// accounts, which only their owner can delete
+func nukeAllRAMResourceShares(session *session.Session, arns []*string) error {
+	svc := ram.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No RAM resource shares to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Deleting all RAM resource shares in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		if err := nukeRAMResourceShare(svc, *accountId, arn); err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == ram.ErrCodeUnknownResourceException {
+				logging.Logger.Infof("RAM resource share %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Infof("Deleted RAM resource share: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d RAM resource share(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
+
+// nukeRAMResourceShare - Deletes the resource share when the account owns it. Only the owner can delete a share, but
+// the accounts it's shared with can leave it, so the account is disassociated from the ones it received.
+func nukeRAMResourceShare(svc ramiface.RAMAPI, accountId string, arn *string) error {
+	if resourceShareOwner(*arn) == accountId {
+		_, err := svc.DeleteResourceShare(&ram.DeleteResourceShareInput{ResourceShareArn: arn})
+		return err
+	}
+	_, err := svc.DisassociateResourceShare(&ram.DisassociateResourceShareInput{
+		ResourceShareArn: arn,
+		Principals:       []*string{awsgo.String(accountId)},
+	})
+	return err
+}
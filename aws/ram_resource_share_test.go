@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/ram/ramiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceShareOwner(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "123456789012", resourceShareOwner("arn:aws:ram:us-east-1:123456789012:resource-share/73da1ab9-b94a-4ba3-8eb4-45917f7f4b12"))
+	assert.Equal(t, "", resourceShareOwner("resource-share/73da1ab9-b94a-4ba3-8eb4-45917f7f4b12"))
+}
+
+func TestIsActiveResourceShare(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isActiveResourceShare(&ram.ResourceShare{Status: awsgo.String(ram.ResourceShareStatusActive)}))
+	assert.True(t, isActiveResourceShare(&ram.ResourceShare{Status: awsgo.String(ram.ResourceShareStatusPending)}))
+	assert.False(t, isActiveResourceShare(&ram.ResourceShare{Status: awsgo.String(ram.ResourceShareStatusDeleting)}))
+	assert.False(t, isActiveResourceShare(&ram.ResourceShare{Status: awsgo.String(ram.ResourceShareStatusDeleted)}))
+}
+
+// fakeRAM - records the resource shares deleted and disassociated
+type fakeRAM struct {
+	ramiface.RAMAPI
+	deleted        []string
+	disassociated  []string
+	fromPrincipals []string
+}
+
+func (fake *fakeRAM) DeleteResourceShare(input *ram.DeleteResourceShareInput) (*ram.DeleteResourceShareOutput, error) {
+	fake.deleted = append(fake.deleted, awsgo.StringValue(input.ResourceShareArn))
+	return &ram.DeleteResourceShareOutput{}, nil
+}
+
+func (fake *fakeRAM) DisassociateResourceShare(input *ram.DisassociateResourceShareInput) (*ram.DisassociateResourceShareOutput, error) {
+	fake.disassociated = append(fake.disassociated, awsgo.StringValue(input.ResourceShareArn))
+	fake.fromPrincipals = append(fake.fromPrincipals, awsgo.StringValueSlice(input.Principals)...)
+	return &ram.DisassociateResourceShareOutput{}, nil
+}
+
+func TestNukeRAMResourceShare(t *testing.T) {
+	t.Parallel()
+
+	owned := "arn:aws:ram:us-east-1:123456789012:resource-share/73da1ab9-b94a-4ba3-8eb4-45917f7f4b12"
+	received := "arn:aws:ram:us-east-1:210987654321:resource-share/0f3b1a2c-8d4e-4f5a-9b6c-7d8e9f0a1b2c"
+
+	svc := &fakeRAM{}
+	require.NoError(t, nukeRAMResourceShare(svc, "123456789012", awsgo.String(owned)))
+	assert.Equal(t, []string{owned}, svc.deleted)
+	assert.Empty(t, svc.disassociated)
+
+	// The share received from another account can't be deleted, so the account leaves it
+	require.NoError(t, nukeRAMResourceShare(svc, "123456789012", awsgo.String(received)))
+	assert.Equal(t, []string{owned}, svc.deleted)
+	assert.Equal(t, []string{received}, svc.disassociated)
+	assert.Equal(t, []string{"123456789012"}, svc.fromPrincipals)
+}
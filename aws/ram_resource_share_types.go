@@ -0,0 +1,52 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before the subnets and transit gateways they share, which can't be deleted while they are shared
+	registerResourceType(awsResourceType{
+		resource: RAMResourceShares{},
+		weight:   6300,
+		actions:  []string{"ram:DisassociateResourceShare", "ram:DeleteResourceShare"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			arns, err := getAllRAMResourceShares(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return RAMResourceShares{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+}
+
+// RAMResourceShares - represents all RAM resource shares owned by the account, or shared with it
+type RAMResourceShares struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (shares RAMResourceShares) ResourceName() string {
+	return "ramresourceshare"
+}
+
+// ResourceIdentifiers - The ARNs of the RAM resource shares
+func (shares RAMResourceShares) ResourceIdentifiers() []string {
+	return shares.Arns
+}
+
+func (shares RAMResourceShares) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (shares RAMResourceShares) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRAMResourceShares(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
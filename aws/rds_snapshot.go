@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The kinds of RDS backups, as they appear in their ARNs, e.g. arn:aws:rds:us-east-1:123456789012:snapshot:my-snapshot
+const (
+	rdsSnapshotArnKind        = "snapshot"
+	rdsClusterSnapshotArnKind = "cluster-snapshot"
+	rdsAutomatedBackupArnKind = "auto-backup"
+)
+
+// parseRdsSnapshotArn - Returns the kind of RDS backup the ARN points to, and its name
+func parseRdsSnapshotArn(arn string) (string, string) {
+	// arn:partition:rds:region:account-id:kind:name
+	parts := strings.SplitN(arn, ":", 7)
+	if len(parts) != 7 {
+		return "", ""
+	}
+	return parts[5], parts[6]
+}
+
+// automatedBackupTime - Returns when the retained automated backup was last taken, or when its DB instance was
+// created if that isn't known
+func automatedBackupTime(backup *rds.DBInstanceAutomatedBackup) *time.Time {
+	if backup.RestoreWindow != nil && backup.RestoreWindow.LatestTime != nil {
+		return backup.RestoreWindow.LatestTime
+	}
+	return backup.InstanceCreateTime
+}
+
+// Returns the ARNs of the manual DB snapshots and DB cluster snapshots, and of the automated backups retained after
+// their DB instance was deleted, that are older than the cutoff. The automated backups of the DB instances that still
+// exist go away with them, so they are left alone.
+func getAllRdsSnapshots(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := rds.New(session)
+
+	var arns []*string
+	err := svc.DescribeDBSnapshotsPages(
+		&rds.DescribeDBSnapshotsInput{SnapshotType: awsgo.String("manual")},
+		func(page *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
+			for _, snapshot := range page.DBSnapshots {
+				// Snapshots still being created can't be deleted yet
+				if snapshot.SnapshotCreateTime == nil {
+					continue
+				}
+				if timeFilter.IncludesResource(snapshot.DBSnapshotArn, *snapshot.SnapshotCreateTime) {
+					arns = append(arns, snapshot.DBSnapshotArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	err = svc.DescribeDBClusterSnapshotsPages(
+		&rds.DescribeDBClusterSnapshotsInput{SnapshotType: awsgo.String("manual")},
+		func(page *rds.DescribeDBClusterSnapshotsOutput, lastPage bool) bool {
+			for _, snapshot := range page.DBClusterSnapshots {
+				if snapshot.SnapshotCreateTime == nil {
+					continue
+				}
+				if timeFilter.IncludesResource(snapshot.DBClusterSnapshotArn, *snapshot.SnapshotCreateTime) {
+					arns = append(arns, snapshot.DBClusterSnapshotArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	err = svc.DescribeDBInstanceAutomatedBackupsPages(
+		&rds.DescribeDBInstanceAutomatedBackupsInput{},
+		func(page *rds.DescribeDBInstanceAutomatedBackupsOutput, lastPage bool) bool {
+			for _, backup := range page.DBInstanceAutomatedBackups {
+				backupTime := automatedBackupTime(backup)
+				if awsgo.StringValue(backup.Status) != "retained" || backupTime == nil {
+					continue
+				}
+				if timeFilter.IncludesResource(backup.DBInstanceAutomatedBackupsArn, *backupTime) {
+					arns = append(arns, backup.DBInstanceAutomatedBackupsArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return arns, nil
+}
+
+// deleteRdsSnapshot - Deletes the DB snapshot, DB cluster snapshot or retained automated backup with the given ARN
+func deleteRdsSnapshot(svc rdsiface.RDSAPI, arn string) error {
+	kind, name := parseRdsSnapshotArn(arn)
+	var err error
+	switch kind {
+	case rdsSnapshotArnKind:
+		_, err = svc.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{DBSnapshotIdentifier: awsgo.String(name)})
+	case rdsClusterSnapshotArnKind:
+		_, err = svc.DeleteDBClusterSnapshot(&rds.DeleteDBClusterSnapshotInput{DBClusterSnapshotIdentifier: awsgo.String(name)})
+	case rdsAutomatedBackupArnKind:
+		_, err = svc.DeleteDBInstanceAutomatedBackup(&rds.DeleteDBInstanceAutomatedBackupInput{DBInstanceAutomatedBackupsArn: awsgo.String(arn)})
+	default:
+		return UnsupportedRdsSnapshotError{Arn: arn}
+	}
+	return err
+}
+
+// isRdsSnapshotNotFound - Checks if the error is about a backup that has already been deleted
+func isRdsSnapshotNotFound(err error) bool {
+	awsErr, isAwsErr := err.(awserr.Error)
+	if !isAwsErr {
+		return false
+	}
+	switch awsErr.Code() {
+	case rds.ErrCodeDBSnapshotNotFoundFault, rds.ErrCodeDBClusterSnapshotNotFoundFault, rds.ErrCodeDBInstanceAutomatedBackupNotFoundFault:
+		return true
+	}
+	return false
+}
+
+// Deletes all the given RDS snapshots and retained automated backups
+func nukeAllRdsSnapshots(session *session.Session, arns []*string) error {
+	svc := rds.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No RDS snapshots to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all RDS snapshots in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		err := deleteRdsSnapshot(svc, *arn)
+		if err != nil {
+			if isRdsSnapshotNotFound(err) {
+				logging.Logger.Infof("RDS snapshot %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Infof("Deleted RDS snapshot: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d RDS snapshot(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
+
+// UnsupportedRdsSnapshotError - returned when an ARN doesn't point to a kind of RDS backup that can be deleted
+type UnsupportedRdsSnapshotError struct {
+	Arn string
+}
+
+func (e UnsupportedRdsSnapshotError) Error() string {
+	return fmt.Sprintf("%s is not an RDS snapshot or automated backup", e.Arn)
+}
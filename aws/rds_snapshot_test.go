@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRdsSnapshotArn(t *testing.T) {
+	t.Parallel()
+
+	kind, name := parseRdsSnapshotArn("arn:aws:rds:us-east-1:123456789012:snapshot:my-snapshot")
+	assert.Equal(t, rdsSnapshotArnKind, kind)
+	assert.Equal(t, "my-snapshot", name)
+
+	kind, name = parseRdsSnapshotArn("arn:aws:rds:us-east-1:123456789012:cluster-snapshot:my-cluster-snapshot")
+	assert.Equal(t, rdsClusterSnapshotArnKind, kind)
+	assert.Equal(t, "my-cluster-snapshot", name)
+
+	kind, name = parseRdsSnapshotArn("arn:aws:rds:us-east-1:123456789012:auto-backup:ab-abcdefghijklmnop")
+	assert.Equal(t, rdsAutomatedBackupArnKind, kind)
+	assert.Equal(t, "ab-abcdefghijklmnop", name)
+
+	kind, name = parseRdsSnapshotArn("my-snapshot")
+	assert.Equal(t, "", kind)
+	assert.Equal(t, "", name)
+}
+
+func TestAutomatedBackupTime(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	latest := created.Add(72 * time.Hour)
+
+	withRestoreWindow := &rds.DBInstanceAutomatedBackup{
+		InstanceCreateTime: awsgo.Time(created),
+		RestoreWindow:      &rds.RestoreWindow{EarliestTime: awsgo.Time(created), LatestTime: awsgo.Time(latest)},
+	}
+	assert.Equal(t, latest, *automatedBackupTime(withRestoreWindow))
+
+	withoutRestoreWindow := &rds.DBInstanceAutomatedBackup{InstanceCreateTime: awsgo.Time(created)}
+	assert.Equal(t, created, *automatedBackupTime(withoutRestoreWindow))
+
+	assert.Nil(t, automatedBackupTime(&rds.DBInstanceAutomatedBackup{}))
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: RdsSnapshots{},
+		weight:   1900,
+		actions:  []string{"rds:DeleteDBSnapshot", "rds:DeleteDBClusterSnapshot", "rds:DeleteDBInstanceAutomatedBackup"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			snapshotArns, err := getAllRdsSnapshots(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return RdsSnapshots{SnapshotArns: awsgo.StringValueSlice(snapshotArns)}, nil
+		},
+	})
+}
+
+// RdsSnapshots - represents all manual DB snapshots and DB cluster snapshots, and the automated backups retained
+// after their DB instance was deleted
+type RdsSnapshots struct {
+	SnapshotArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (snapshots RdsSnapshots) ResourceName() string {
+	return "rdssnapshot"
+}
+
+// ResourceIdentifiers - The ARNs of the RDS snapshots
+func (snapshots RdsSnapshots) ResourceIdentifiers() []string {
+	return snapshots.SnapshotArns
+}
+
+func (snapshots RdsSnapshots) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (snapshots RdsSnapshots) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsSnapshots(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
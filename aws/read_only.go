@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// commonWriteActions - the writes that cloud-nuke doesn't call, but that could be reached by a bug, checked by
+// VerifyReadOnly along with the actions of the resource types
+var commonWriteActions = []string{
+	"ec2:CreateTags",
+	"ec2:RunInstances",
+	"iam:AttachRolePolicy",
+	"iam:CreateAccessKey",
+	"iam:CreateUser",
+	"iam:DeleteRole",
+	"iam:DeleteUser",
+	"kms:DisableKey",
+	"s3:DeleteBucket",
+	"s3:DeleteObject",
+	"s3:PutObject",
+}
+
+// destructiveActions - Returns the actions checked by VerifyReadOnly: the ones each registered resource type declares,
+// along with the common writes, sorted and without duplicates
+func destructiveActions() []string {
+	actions := append([]string{}, commonWriteActions...)
+	for _, resourceType := range registeredResourceTypes {
+		actions = append(actions, resourceType.actions...)
+	}
+	sort.Strings(actions)
+
+	var deduplicated []string
+	for i, action := range actions {
+		if i == 0 || action != actions[i-1] {
+			deduplicated = append(deduplicated, action)
+		}
+	}
+	return deduplicated
+}
+
+// simulatedActionsPerCall - how many actions are simulated per call to the IAM policy simulator
+const simulatedActionsPerCall = 50
+
+// ReadOnlyViolationError - returned by VerifyReadOnly when the credentials are allowed to perform destructive actions
+type ReadOnlyViolationError struct {
+	Principal string
+	Actions   []string
+}
+
+func (e ReadOnlyViolationError) Error() string {
+	return fmt.Sprintf("The credentials of %s aren't read-only: they are allowed to perform %s", e.Principal, strings.Join(e.Actions, ", "))
+}
+
+// VerifyReadOnly - Checks, through the IAM policy simulator, that the credentials can't perform any destructive
+// action, apart from the allowed ones, given as patterns such as s3:PutObject or s3:*. Returns a
+// ReadOnlyViolationError listing the actions they are allowed to perform anyway. The check fails closed: when the
+// simulation can't be run, e.g. because the credentials can't call iam:SimulatePrincipalPolicy, an error is returned.
+func VerifyReadOnly(allowedActions []string) error {
+	// IAM and STS are global services, homed in us-east-1
+	session := newSession("us-east-1")
+	iamSvc := iam.New(session)
+
+	identity, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	callerArn := awsgo.StringValue(identity.Arn)
+
+	principalArn, err := simulatedPrincipalArn(callerArn, func(roleName string) (string, error) {
+		role, err := iamSvc.GetRole(&iam.GetRoleInput{RoleName: awsgo.String(roleName)})
+		if err != nil {
+			return "", err
+		}
+		return awsgo.StringValue(role.Role.Arn), nil
+	})
+	if err != nil {
+		return err
+	}
+	// The root user can do anything, and can't be simulated
+	if principalArn == "" {
+		return errors.WithStackTrace(ReadOnlyViolationError{Principal: callerArn, Actions: []string{"*"}})
+	}
+
+	logging.Logger.Infof("Verifying that %s can't perform any destructive action", principalArn)
+	var permittedActions []string
+	for _, actions := range split(destructiveActions(), simulatedActionsPerCall) {
+		input := &iam.SimulatePrincipalPolicyInput{
+			PolicySourceArn: awsgo.String(principalArn),
+			ActionNames:     awsgo.StringSlice(actions),
+		}
+		err := iamSvc.SimulatePrincipalPolicyPages(input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+			for _, result := range page.EvaluationResults {
+				if awsgo.StringValue(result.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed {
+					permittedActions = append(permittedActions, awsgo.StringValue(result.EvalActionName))
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	if violations := unexpectedActions(permittedActions, allowedActions); len(violations) > 0 {
+		return errors.WithStackTrace(ReadOnlyViolationError{Principal: principalArn, Actions: violations})
+	}
+	logging.Logger.Infof("%s can't perform any destructive action", principalArn)
+	return nil
+}
+
+// simulatedPrincipalArn - Returns the ARN of the IAM user or role to simulate the policies of for the caller. The
+// policy simulator doesn't know about STS sessions, so the role of an assumed role session is looked up, its ARN
+// holding the path of the role which the session ARN lacks. Returns an empty ARN for the root user.
+func simulatedPrincipalArn(callerArn string, getRoleArn func(roleName string) (string, error)) (string, error) {
+	parsed, err := arn.Parse(callerArn)
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+
+	switch {
+	case parsed.Service == "iam" && parsed.Resource == "root":
+		return "", nil
+	case parsed.Service == "iam":
+		return callerArn, nil
+	case parsed.Service == "sts" && strings.HasPrefix(parsed.Resource, "assumed-role/"):
+		// assumed-role/<role name>/<session name>
+		parts := strings.Split(parsed.Resource, "/")
+		if len(parts) < 3 {
+			return "", errors.WithStackTrace(UnsupportedPrincipalError{Arn: callerArn})
+		}
+		roleArn, err := getRoleArn(parts[1])
+		if err != nil {
+			return "", errors.WithStackTrace(err)
+		}
+		return roleArn, nil
+	default:
+		return "", errors.WithStackTrace(UnsupportedPrincipalError{Arn: callerArn})
+	}
+}
+
+// unexpectedActions - Returns the permitted actions that don't match any of the allowed patterns. IAM actions are
+// case insensitive.
+func unexpectedActions(permittedActions []string, allowedPatterns []string) []string {
+	var unexpected []string
+	for _, action := range permittedActions {
+		allowed := false
+		for _, pattern := range allowedPatterns {
+			if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(action)); matched {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			unexpected = append(unexpected, action)
+		}
+	}
+	return unexpected
+}
+
+// UnsupportedPrincipalError - returned by VerifyReadOnly when the policies of the caller can't be simulated, e.g. for
+// federated users
+type UnsupportedPrincipalError struct {
+	Arn string
+}
+
+func (e UnsupportedPrincipalError) Error() string {
+	return fmt.Sprintf("Can't verify that the credentials of %s are read-only, as the IAM policy simulator doesn't support them", e.Arn)
+}
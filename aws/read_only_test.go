@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatedPrincipalArn(t *testing.T) {
+	t.Parallel()
+
+	getRoleArn := func(roleName string) (string, error) {
+		if roleName != "Inspector" {
+			return "", fmt.Errorf("no role %s", roleName)
+		}
+		return "arn:aws:iam::123456789012:role/cloud-nuke/Inspector", nil
+	}
+
+	principalArn, err := simulatedPrincipalArn("arn:aws:iam::123456789012:user/ci", getRoleArn)
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:iam::123456789012:user/ci", principalArn)
+
+	// The path of the role is looked up, as the session ARN lacks it
+	principalArn, err = simulatedPrincipalArn("arn:aws:sts::123456789012:assumed-role/Inspector/session", getRoleArn)
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/cloud-nuke/Inspector", principalArn)
+
+	principalArn, err = simulatedPrincipalArn("arn:aws:iam::123456789012:root", getRoleArn)
+	require.NoError(t, err)
+	assert.Empty(t, principalArn)
+
+	_, err = simulatedPrincipalArn("arn:aws:sts::123456789012:federated-user/alice", getRoleArn)
+	assert.Equal(t, UnsupportedPrincipalError{Arn: "arn:aws:sts::123456789012:federated-user/alice"}, errors.Unwrap(err))
+}
+
+func TestUnexpectedActions(t *testing.T) {
+	t.Parallel()
+
+	permitted := []string{"s3:PutObject", "s3:DeleteObject", "ec2:TerminateInstances", "ec2:CreateTags"}
+	assert.Equal(t, permitted, unexpectedActions(permitted, nil))
+	assert.Equal(t, []string{"ec2:TerminateInstances", "ec2:CreateTags"}, unexpectedActions(permitted, []string{"s3:*"}))
+	assert.Equal(t, []string{"s3:DeleteObject", "ec2:TerminateInstances"}, unexpectedActions(permitted, []string{"S3:putobject", "ec2:Create*"}))
+	assert.Empty(t, unexpectedActions(nil, nil))
+}
+
+func TestDestructiveActions(t *testing.T) {
+	t.Parallel()
+
+	actions := destructiveActions()
+	for _, action := range []string{
+		"ec2:TerminateInstances",
+		"ec2:StopInstances",
+		"ec2:ModifyInstanceAttribute",
+		"ec2:DeleteNetworkInterface",
+		"rds:DeleteDBSnapshot",
+		"states:DeleteStateMachine",
+		"events:DeleteRule",
+		"organizations:DeletePolicy",
+		"kms:DisableKey",
+		"s3:PutObject",
+	} {
+		assert.Contains(t, actions, action)
+	}
+	assert.True(t, sort.StringsAreSorted(actions))
+	for i := 1; i < len(actions); i++ {
+		assert.NotEqual(t, actions[i-1], actions[i])
+	}
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+)
+
+// regionDiscovery - what the resources of a region are discovered with
+type regionDiscovery struct {
+	session              *session.Session
+	region               string
+	timeFilter           TimeFilter
+	resourceTypes        []string
+	excludeResourceTypes []string
+	options              ResourceOptions
+	// The QuickSight subscription belongs to the whole account, so it is only nuked in one of the regions it's found in
+	quickSightSubscriptionClaim *accountWideClaim
+	// The organization is global too, so its resources are only looked for in the first region to claim it
+	organizationClaim *accountWideClaim
+}
+
+// awsResourceType - an aws resource type, along with how to find its resources in a region
+type awsResourceType struct {
+	// The resource, without any identifiers, e.g. for its name
+	resource AwsResources
+	// Where the resource type is nuked, relative to the others: the resource types with the lowest weight are nuked
+	// first, so that the resources depending on others are gone before them. The weights are spaced out, so that new
+	// resource types can be slotted in between.
+	weight int
+	// Only nuked when explicitly selected with --resource-type, as nuking it by default would be too destructive
+	explicitOnly bool
+	// The IAM actions that discovering and nuking the resource type calls, other than the reads, which VerifyReadOnly
+	// checks the credentials can't perform
+	actions []string
+	// Returns the resources of the type to nuke in the region, or nil when the resource type isn't available there
+	getAll func(discovery regionDiscovery) (AwsResources, error)
+	// Returns the resources of the type found through their tags, for the resource types that can be looked up by tag,
+	// i.e. that parseTaggedResourceArn resolves to. Left nil for the others.
+	fromTagged func(resources []taggedResource) AwsResources
+}
+
+// registeredResourceTypes - all aws resource types, as registered by the files defining them
+var registeredResourceTypes []awsResourceType
+
+// registerResourceType - Registers a resource type, to be listed and nuked along with the others. Called from the init
+// function of the file defining the resource type.
+func registerResourceType(resourceType awsResourceType) {
+	registeredResourceTypes = append(registeredResourceTypes, resourceType)
+}
+
+// resourceTypesInNukeOrder - Returns the registered resource types in the order they are discovered and nuked in
+func resourceTypesInNukeOrder() []awsResourceType {
+	resourceTypes := append([]awsResourceType{}, registeredResourceTypes...)
+	sort.SliceStable(resourceTypes, func(i, j int) bool {
+		if resourceTypes[i].weight != resourceTypes[j].weight {
+			return resourceTypes[i].weight < resourceTypes[j].weight
+		}
+		return resourceTypes[i].resource.ResourceName() < resourceTypes[j].resource.ResourceName()
+	})
+	return resourceTypes
+}
+
+// isSelected - Checks if the resource type is selected for the run. The excluded resource types are left out, even
+// the ones that are explicitly selected, e.g. by a config file shared with other runs.
+func (resourceType awsResourceType) isSelected(resourceTypes []string, excludeResourceTypes []string) bool {
+	if resourceType.explicitOnly {
+		return IsExplicitlyNukeable(resourceType.resource.ResourceName(), resourceTypes) &&
+			!collections.ListContainsElement(excludeResourceTypes, resourceType.resource.ResourceName())
+	}
+	return IsNukeable(resourceType.resource.ResourceName(), resourceTypes, excludeResourceTypes)
+}
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisteredResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	// Each resource type is registered once, with a weight of its own, so that the nuke order doesn't depend on names
+	names := map[string]bool{}
+	weights := map[int]string{}
+	for _, resourceType := range registeredResourceTypes {
+		name := resourceType.resource.ResourceName()
+		assert.False(t, names[name], "%s is registered twice", name)
+		names[name] = true
+		assert.Empty(t, weights[resourceType.weight], "%s has the same weight as %s", name, weights[resourceType.weight])
+		weights[resourceType.weight] = name
+	}
+	assert.Len(t, ListResourceTypes(), len(registeredResourceTypes))
+}
+
+func TestRegisteredResourceTypesDeclareActions(t *testing.T) {
+	t.Parallel()
+
+	// The actions checked by --verify-read-only are built from the ones each resource type declares, so a resource type
+	// declaring none would go unchecked
+	for _, resourceType := range registeredResourceTypes {
+		assert.NotEmpty(t, resourceType.actions, "%s doesn't declare the actions it calls", resourceType.resource.ResourceName())
+	}
+}
+
+func TestResourceTypesInNukeOrder(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	for _, resourceType := range resourceTypesInNukeOrder() {
+		order = append(order, resourceType.resource.ResourceName())
+	}
+	indexOf := func(resourceType string) int {
+		for i, name := range order {
+			if name == resourceType {
+				return i
+			}
+		}
+		t.Fatalf("%s isn't registered", resourceType)
+		return -1
+	}
+
+	// Each resource type is nuked before the ones it depends on
+	dependencies := [][2]string{
+		{CloudFormationStackSets{}.ResourceName(), CloudFormationStacks{}.ResourceName()},
+		{BatchJobQueues{}.ResourceName(), BatchComputeEnvironments{}.ResourceName()},
+		{ASGroups{}.ResourceName(), EC2Instances{}.ResourceName()},
+		{EC2Instances{}.ResourceName(), EBSVolumes{}.ResourceName()},
+		{NatGateways{}.ResourceName(), EIPAddresses{}.ResourceName()},
+		{EventBridgeRules{}.ResourceName(), EventBridgeBuses{}.ResourceName()},
+		{TransitGatewayAttachments{}.ResourceName(), TransitGateways{}.ResourceName()},
+		{NetworkInterfaces{}.ResourceName(), SecurityGroups{}.ResourceName()},
+		{SecurityGroups{}.ResourceName(), VPCs{}.ResourceName()},
+	}
+	for _, dependency := range dependencies {
+		assert.True(t, indexOf(dependency[0]) < indexOf(dependency[1]), "%s should be nuked before %s", dependency[0], dependency[1])
+	}
+	assert.Equal(t, VPCs{}.ResourceName(), order[len(order)-1])
+}
+
+func TestResourceTypeIsSelected(t *testing.T) {
+	t.Parallel()
+
+	ec2 := awsResourceType{resource: EC2Instances{}}
+	assert.True(t, ec2.isSelected(nil, nil))
+	assert.False(t, ec2.isSelected(nil, []string{"ec2"}))
+
+	// The resource types that are too destructive are only nuked when explicitly selected
+	logStreams := awsResourceType{resource: CloudWatchLogStreams{}, explicitOnly: true}
+	assert.False(t, logStreams.isSelected(nil, nil))
+	assert.False(t, logStreams.isSelected([]string{"all"}, nil))
+	assert.True(t, logStreams.isSelected([]string{logStreams.resource.ResourceName()}, nil))
+	assert.False(t, logStreams.isSelected([]string{logStreams.resource.ResourceName()}, []string{logStreams.resource.ResourceName()}))
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Rekognition. Refer to
+// https://docs.aws.amazon.com/general/latest/gr/rekognition.html
+var rekognitionRegions = []string{
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"il-central-1",
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+}
+
+// How long to wait for a stream processor to stop before giving up
+const streamProcessorStopMaxAttempts = 20
+const streamProcessorStopRetryInterval = 5 * time.Second
+
+// rekognitionSupportedRegion returns true if the provided region supports Rekognition
+func rekognitionSupportedRegion(region string) bool {
+	return collections.ListContainsElement(rekognitionRegions, region)
+}
+
+// Returns a formatted string of Rekognition collection ids
+func getAllRekognitionCollections(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := rekognition.New(session)
+
+	var allCollectionIds []*string
+	err := svc.ListCollectionsPages(
+		&rekognition.ListCollectionsInput{},
+		func(page *rekognition.ListCollectionsOutput, lastPage bool) bool {
+			allCollectionIds = append(allCollectionIds, page.CollectionIds...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The creation time of a collection is only returned when describing it
+	var collectionIds []*string
+	for _, collectionId := range allCollectionIds {
+		result, err := svc.DescribeCollection(&rekognition.DescribeCollectionInput{
+			CollectionId: collectionId,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if timeFilter.IncludesResource(collectionId, *result.CreationTimestamp) {
+			collectionIds = append(collectionIds, collectionId)
+		}
+	}
+
+	return collectionIds, nil
+}
+
+// Deletes all Rekognition collections
+func nukeAllRekognitionCollections(session *session.Session, collectionIds []*string) error {
+	svc := rekognition.New(session)
+
+	if len(collectionIds) == 0 {
+		logging.Logger.Infof("No Rekognition collections to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Rekognition collections in region %s", *session.Config.Region)
+	var deletedCollectionIds []*string
+
+	for _, collectionId := range collectionIds {
+		_, err := svc.DeleteCollection(&rekognition.DeleteCollectionInput{
+			CollectionId: collectionId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == rekognition.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Rekognition collection %s has already been deleted", *collectionId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedCollectionIds = append(deletedCollectionIds, collectionId)
+			logging.Logger.Infof("Deleted Rekognition collection: %s", *collectionId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Rekognition collection(s) deleted in %s", len(deletedCollectionIds), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of Rekognition stream processor names
+func getAllRekognitionStreamProcessors(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := rekognition.New(session)
+
+	var allNames []*string
+	err := svc.ListStreamProcessorsPages(
+		&rekognition.ListStreamProcessorsInput{},
+		func(page *rekognition.ListStreamProcessorsOutput, lastPage bool) bool {
+			for _, streamProcessor := range page.StreamProcessors {
+				allNames = append(allNames, streamProcessor.Name)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The creation time of a stream processor is only returned when describing it
+	var names []*string
+	for _, name := range allNames {
+		result, err := svc.DescribeStreamProcessor(&rekognition.DescribeStreamProcessorInput{
+			Name: name,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if timeFilter.IncludesResource(name, *result.CreationTimestamp) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// stopRekognitionStreamProcessor stops the stream processor if it is running, as running stream processors can't be
+// deleted, and waits for it to be stopped
+func stopRekognitionStreamProcessor(svc *rekognition.Rekognition, name *string) error {
+	for i := 0; i < streamProcessorStopMaxAttempts; i++ {
+		result, err := svc.DescribeStreamProcessor(&rekognition.DescribeStreamProcessorInput{
+			Name: name,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		switch awsgo.StringValue(result.Status) {
+		case rekognition.StreamProcessorStatusRunning:
+			logging.Logger.Infof("...stopping Rekognition stream processor %s", *name)
+			_, err := svc.StopStreamProcessor(&rekognition.StopStreamProcessorInput{
+				Name: name,
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+		case rekognition.StreamProcessorStatusStarting, rekognition.StreamProcessorStatusStopping, rekognition.StreamProcessorStatusUpdating:
+		default:
+			return nil
+		}
+		time.Sleep(streamProcessorStopRetryInterval)
+	}
+	return errors.WithStackTrace(StreamProcessorNotStoppedError{Name: *name})
+}
+
+// Deletes all Rekognition stream processors
+func nukeAllRekognitionStreamProcessors(session *session.Session, names []*string) error {
+	svc := rekognition.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No Rekognition stream processors to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Rekognition stream processors in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		err := stopRekognitionStreamProcessor(svc, name)
+		if err == nil {
+			_, err = svc.DeleteStreamProcessor(&rekognition.DeleteStreamProcessorInput{
+				Name: name,
+			})
+		}
+		if err != nil {
+			if awsErr, isAwsErr := errors.Unwrap(err).(awserr.Error); isAwsErr && awsErr.Code() == rekognition.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Rekognition stream processor %s has already been deleted", *name)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted Rekognition stream processor: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Rekognition stream processor(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
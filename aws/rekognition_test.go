@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestRekognitionCollection(t *testing.T, session *session.Session, name string) string {
+	svc := rekognition.New(session)
+	_, err := svc.CreateCollection(&rekognition.CreateCollectionInput{
+		CollectionId: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListRekognitionCollections(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, rekognitionRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	collectionId := createTestRekognitionCollection(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllRekognitionCollections(session, []*string{awsgo.String(collectionId)})
+
+	collectionIds, err := getAllRekognitionCollections(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Rekognition collections")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(collectionIds), collectionId)
+
+	collectionIds, err = getAllRekognitionCollections(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Rekognition collections")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(collectionIds), collectionId)
+}
+
+func TestNukeRekognitionCollections(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, rekognitionRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	collectionId := createTestRekognitionCollection(t, session, uniqueTestID)
+
+	if err := nukeAllRekognitionCollections(session, []*string{awsgo.String(collectionId)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	collectionIds, err := getAllRekognitionCollections(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Rekognition collections")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(collectionIds), collectionId)
+}
+
+func TestRekognitionSupportedRegions(t *testing.T) {
+	unsupportedRegions := []string{
+		"eu-north-1",
+		"sa-east-1",
+	}
+	for _, region := range unsupportedRegions {
+		assert.False(t, rekognitionSupportedRegion(region))
+	}
+	for _, region := range rekognitionRegions {
+		assert.True(t, rekognitionSupportedRegion(region))
+	}
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before collections, since stream processors searching faces use a collection
+	registerResourceType(awsResourceType{
+		resource: RekognitionStreamProcessors{},
+		weight:   5300,
+		actions:  []string{"rekognition:StopStreamProcessor", "rekognition:DeleteStreamProcessor"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !rekognitionSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			names, err := getAllRekognitionStreamProcessors(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return RekognitionStreamProcessors{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: RekognitionCollections{},
+		weight:   5400,
+		actions:  []string{"rekognition:DeleteCollection"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !rekognitionSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			collectionIds, err := getAllRekognitionCollections(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return RekognitionCollections{CollectionIds: awsgo.StringValueSlice(collectionIds)}, nil
+		},
+	})
+}
+
+// RekognitionCollections - represents all Rekognition collections
+type RekognitionCollections struct {
+	CollectionIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (collections RekognitionCollections) ResourceName() string {
+	return "rekognitioncollection"
+}
+
+// ResourceIdentifiers - The ids of the Rekognition collections
+func (collections RekognitionCollections) ResourceIdentifiers() []string {
+	return collections.CollectionIds
+}
+
+func (collections RekognitionCollections) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (collections RekognitionCollections) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRekognitionCollections(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// RekognitionStreamProcessors - represents all Rekognition stream processors
+type RekognitionStreamProcessors struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (processors RekognitionStreamProcessors) ResourceName() string {
+	return "rekognitionstreamprocessor"
+}
+
+// ResourceIdentifiers - The names of the Rekognition stream processors
+func (processors RekognitionStreamProcessors) ResourceIdentifiers() []string {
+	return processors.Names
+}
+
+func (processors RekognitionStreamProcessors) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (processors RekognitionStreamProcessors) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRekognitionStreamProcessors(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// StreamProcessorNotStoppedError - returned when a stream processor is still running after waiting for it to stop
+type StreamProcessorNotStoppedError struct {
+	Name string
+}
+
+func (e StreamProcessorNotStoppedError) Error() string {
+	return fmt.Sprintf("Timed out waiting for Rekognition stream processor %s to stop", e.Name)
+}
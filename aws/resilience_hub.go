@@ -0,0 +1,97 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Resilience Hub. Refer to
+// https://aws.amazon.com/about-aws/global-infrastructure/regional-product-services/
+var resilienceHubRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+	"af-south-1",
+	"ap-east-1",
+	"ap-south-1",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"eu-north-1",
+	"eu-south-1",
+	"me-south-1",
+	"sa-east-1",
+}
+
+// resilienceHubSupportedRegion returns true if the provided region supports Resilience Hub
+func resilienceHubSupportedRegion(region string) bool {
+	return collections.ListContainsElement(resilienceHubRegions, region)
+}
+
+// Returns a formatted string of Resilience Hub application ARNs
+func getAllResilienceHubApps(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := resiliencehub.New(session)
+
+	var appArns []*string
+	err := svc.ListAppsPages(
+		&resiliencehub.ListAppsInput{},
+		func(page *resiliencehub.ListAppsOutput, lastPage bool) bool {
+			for _, app := range page.AppSummaries {
+				if timeFilter.IncludesResource(app.AppArn, *app.CreationTime) {
+					appArns = append(appArns, app.AppArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return appArns, nil
+}
+
+// Deletes all Resilience Hub applications, along with their assessments
+func nukeAllResilienceHubApps(session *session.Session, appArns []*string) error {
+	svc := resiliencehub.New(session)
+
+	if len(appArns) == 0 {
+		logging.Logger.Infof("No Resilience Hub applications to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Resilience Hub applications in region %s", *session.Config.Region)
+	var deletedAppArns []*string
+
+	for _, appArn := range appArns {
+		_, err := svc.DeleteApp(&resiliencehub.DeleteAppInput{
+			AppArn:      appArn,
+			ForceDelete: awsgo.Bool(true),
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == resiliencehub.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Resilience Hub application %s has already been deleted", *appArn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedAppArns = append(deletedAppArns, appArn)
+			logging.Logger.Infof("Deleted Resilience Hub application: %s", *appArn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Resilience Hub application(s) deleted in %s", len(deletedAppArns), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestResilienceHubApp(t *testing.T, session *session.Session, name string) resiliencehub.App {
+	svc := resiliencehub.New(session)
+	result, err := svc.CreateApp(&resiliencehub.CreateAppInput{
+		Name: awsgo.String(name),
+	})
+	require.NoError(t, err)
+
+	return *result.App
+}
+
+func TestListResilienceHubApps(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, resilienceHubRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	app := createTestResilienceHubApp(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllResilienceHubApps(session, []*string{app.AppArn})
+
+	appArns, err := getAllResilienceHubApps(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Resilience Hub applications")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(appArns), awsgo.StringValue(app.AppArn))
+
+	appArns, err = getAllResilienceHubApps(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Resilience Hub applications")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(appArns), awsgo.StringValue(app.AppArn))
+}
+
+func TestNukeResilienceHubApps(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, resilienceHubRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	app := createTestResilienceHubApp(t, session, uniqueTestID)
+
+	if err := nukeAllResilienceHubApps(session, []*string{app.AppArn}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	appArns, err := getAllResilienceHubApps(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Resilience Hub applications")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(appArns), awsgo.StringValue(app.AppArn))
+}
+
+// Test that resilienceHubSupportedRegion allows the Resilience Hub regions but not the regions that don't
+func TestResilienceHubSupportedRegions(t *testing.T) {
+	unsupportedRegions := []string{
+		"ap-northeast-3",
+		"me-central-1",
+	}
+	for _, region := range unsupportedRegions {
+		assert.False(t, resilienceHubSupportedRegion(region))
+	}
+	for _, region := range resilienceHubRegions {
+		assert.True(t, resilienceHubSupportedRegion(region))
+	}
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: ResilienceHubApps{},
+		weight:   3700,
+		actions:  []string{"resiliencehub:DeleteApp"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !resilienceHubSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			appArns, err := getAllResilienceHubApps(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return ResilienceHubApps{AppArns: awsgo.StringValueSlice(appArns)}, nil
+		},
+	})
+}
+
+// ResilienceHubApps - represents all Resilience Hub applications
+type ResilienceHubApps struct {
+	AppArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (app ResilienceHubApps) ResourceName() string {
+	return "resiliencehubapp"
+}
+
+// ResourceIdentifiers - The ARNs of the applications
+func (app ResilienceHubApps) ResourceIdentifiers() []string {
+	return app.AppArns
+}
+
+func (app ResilienceHubApps) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (app ResilienceHubApps) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllResilienceHubApps(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
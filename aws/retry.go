@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// RetryConfig - how the AWS API calls that are throttled, or fail with a transient error, are retried. Delays grow
+// exponentially: the nth retry waits up to BaseDelay * 2^(n-1), capped at MaxDelay.
+type RetryConfig struct {
+	// How many times a call is attempted at most, the first attempt included
+	MaxAttempts int
+	// The delay before the first retry
+	BaseDelay time.Duration
+	// The longest delay between two attempts
+	MaxDelay time.Duration
+	// Pick each delay at random between zero and its exponential value, so that the calls throttled together, e.g.
+	// across regions, don't all retry at the same time
+	Jitter bool
+}
+
+// DefaultRetryConfig - the retry settings used unless configured otherwise
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts: 8,
+	BaseDelay:   1 * time.Second,
+	MaxDelay:    1 * time.Minute,
+	Jitter:      true,
+}
+
+var retryConfigMutex sync.Mutex
+var retryConfig = DefaultRetryConfig
+
+// SetRetryConfig - Sets how the AWS API calls made from then on are retried
+func SetRetryConfig(config RetryConfig) {
+	retryConfigMutex.Lock()
+	defer retryConfigMutex.Unlock()
+	retryConfig = config
+}
+
+func getRetryConfig() RetryConfig {
+	retryConfigMutex.Lock()
+	defer retryConfigMutex.Unlock()
+	return retryConfig
+}
+
+// backoffDelay - Returns how long to wait before the given retry, counted from zero. random is a number in [0, 1),
+// only used with jitter.
+func backoffDelay(config RetryConfig, retryCount int, random float64) time.Duration {
+	delay := config.BaseDelay
+	// Stop doubling once the delay is capped anyway, so it can't overflow
+	for i := 0; i < retryCount && delay < config.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > config.MaxDelay {
+		delay = config.MaxDelay
+	}
+	if config.Jitter {
+		delay = time.Duration(random * float64(delay))
+	}
+	return delay
+}
+
+// backoffRetryer - retries the throttled calls, and the ones failing with a transient error, with exponential backoff
+type backoffRetryer struct {
+	config RetryConfig
+}
+
+// RetryRules - Returns how long to wait before retrying the request
+func (retryer backoffRetryer) RetryRules(r *request.Request) time.Duration {
+	return backoffDelay(retryer.config, r.RetryCount, rand.Float64())
+}
+
+// ShouldRetry - Checks whether the failed request is worth retrying
+func (retryer backoffRetryer) ShouldRetry(r *request.Request) bool {
+	if r.Retryable != nil {
+		return *r.Retryable
+	}
+	return r.IsErrorRetryable() || r.IsErrorThrottle()
+}
+
+// MaxRetries - Returns how many times a request is retried at most
+func (retryer backoffRetryer) MaxRetries() int {
+	if retryer.config.MaxAttempts < 1 {
+		return 0
+	}
+	return retryer.config.MaxAttempts - 1
+}
+
+// withRetries - Configures the AWS API calls made with the given config to be retried with exponential backoff
+func withRetries(config *awsgo.Config) *awsgo.Config {
+	return request.WithRetryer(config, backoffRetryer{config: getRetryConfig()})
+}
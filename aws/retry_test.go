@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	t.Parallel()
+
+	config := RetryConfig{MaxAttempts: 8, BaseDelay: 1 * time.Second, MaxDelay: 10 * time.Second}
+	assert.Equal(t, 1*time.Second, backoffDelay(config, 0, 0.5))
+	assert.Equal(t, 2*time.Second, backoffDelay(config, 1, 0.5))
+	assert.Equal(t, 8*time.Second, backoffDelay(config, 3, 0.5))
+	assert.Equal(t, 10*time.Second, backoffDelay(config, 4, 0.5))
+	// Doubling stops at the cap, so the delay can't overflow
+	assert.Equal(t, 10*time.Second, backoffDelay(config, 1000, 0.5))
+
+	config.Jitter = true
+	assert.Equal(t, 4*time.Second, backoffDelay(config, 3, 0.5))
+	assert.Equal(t, time.Duration(0), backoffDelay(config, 3, 0))
+	assert.Equal(t, 5*time.Second, backoffDelay(config, 1000, 0.5))
+}
+
+func TestBackoffRetryerMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 7, backoffRetryer{config: RetryConfig{MaxAttempts: 8}}.MaxRetries())
+	assert.Equal(t, 0, backoffRetryer{config: RetryConfig{MaxAttempts: 1}}.MaxRetries())
+	assert.Equal(t, 0, backoffRetryer{config: RetryConfig{}}.MaxRetries())
+}
+
+func TestWithRetries(t *testing.T) {
+	SetRetryConfig(RetryConfig{MaxAttempts: 3, BaseDelay: 1 * time.Second, MaxDelay: 1 * time.Minute})
+	defer SetRetryConfig(DefaultRetryConfig)
+
+	config := withRetries(&awsgo.Config{Region: awsgo.String("us-east-1")})
+	assert.Equal(t, 2, config.Retryer.(backoffRetryer).MaxRetries())
+}
@@ -0,0 +1,292 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of SageMaker notebook instance names
+func getAllSageMakerNotebookInstances(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var names []*string
+	err := svc.ListNotebookInstancesPages(
+		&sagemaker.ListNotebookInstancesInput{},
+		func(page *sagemaker.ListNotebookInstancesOutput, lastPage bool) bool {
+			for _, notebookInstance := range page.NotebookInstances {
+				if awsgo.StringValue(notebookInstance.NotebookInstanceStatus) == sagemaker.NotebookInstanceStatusDeleting {
+					continue
+				}
+				if timeFilter.IncludesResource(notebookInstance.NotebookInstanceName, *notebookInstance.CreationTime) {
+					names = append(names, notebookInstance.NotebookInstanceName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return names, nil
+}
+
+// stopNotebookInstance stops the notebook instance, as only stopped notebook instances can be deleted, and waits for
+// it to be stopped. Notebook instances that are starting or updating are first waited for to be in service.
+func stopNotebookInstance(svc *sagemaker.SageMaker, name *string) error {
+	result, err := svc.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{NotebookInstanceName: name})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	input := &sagemaker.DescribeNotebookInstanceInput{NotebookInstanceName: name}
+	status := awsgo.StringValue(result.NotebookInstanceStatus)
+	switch status {
+	case sagemaker.NotebookInstanceStatusPending, sagemaker.NotebookInstanceStatusUpdating:
+		if err := svc.WaitUntilNotebookInstanceInService(input); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		fallthrough
+	case sagemaker.NotebookInstanceStatusInService:
+		if _, err := svc.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{NotebookInstanceName: name}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		fallthrough
+	case sagemaker.NotebookInstanceStatusStopping:
+		if err := svc.WaitUntilNotebookInstanceStopped(input); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+// deleteSageMakerNotebookInstances stops and deletes all notebook instances requested. Returns a list of notebook
+// instance names that have been accepted by AWS for deletion.
+func deleteSageMakerNotebookInstances(svc *sagemaker.SageMaker, names []*string) []*string {
+	var requestedDeletes []*string
+	for _, name := range names {
+		err := stopNotebookInstance(svc, name)
+		if err == nil {
+			_, err = svc.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{NotebookInstanceName: name})
+		}
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed deleting SageMaker notebook instance %s: %s", *name, err)
+		} else {
+			requestedDeletes = append(requestedDeletes, name)
+		}
+	}
+	return requestedDeletes
+}
+
+// waitUntilSageMakerNotebookInstancesDeleted waits until the notebook instances have actually been deleted. Returns
+// the names of the notebook instances that have been successfully deleted.
+func waitUntilSageMakerNotebookInstancesDeleted(svc *sagemaker.SageMaker, names []*string) []*string {
+	var successfullyDeleted []*string
+	for _, name := range names {
+		err := svc.WaitUntilNotebookInstanceDeleted(&sagemaker.DescribeNotebookInstanceInput{NotebookInstanceName: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed waiting for SageMaker notebook instance to be deleted %s: %s", *name, err)
+		} else {
+			logging.Logger.Infof("Deleted SageMaker notebook instance: %s", *name)
+			successfullyDeleted = append(successfullyDeleted, name)
+		}
+	}
+	return successfullyDeleted
+}
+
+// nukeAllSageMakerNotebookInstances stops and deletes all provided notebook instances, waiting for them to be deleted
+// before returning
+func nukeAllSageMakerNotebookInstances(session *session.Session, names []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No SageMaker notebook instances to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all SageMaker notebook instances in region %s", *session.Config.Region)
+
+	requestedDeletes := deleteSageMakerNotebookInstances(svc, names)
+	successfullyDeleted := waitUntilSageMakerNotebookInstancesDeleted(svc, requestedDeletes)
+
+	logging.Logger.Infof("[OK] %d of %d SageMaker notebook instance(s) deleted in %s", len(successfullyDeleted), len(names), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of SageMaker endpoint names
+func getAllSageMakerEndpoints(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var names []*string
+	err := svc.ListEndpointsPages(
+		&sagemaker.ListEndpointsInput{},
+		func(page *sagemaker.ListEndpointsOutput, lastPage bool) bool {
+			for _, endpoint := range page.Endpoints {
+				if awsgo.StringValue(endpoint.EndpointStatus) == sagemaker.EndpointStatusDeleting {
+					continue
+				}
+				if timeFilter.IncludesResource(endpoint.EndpointName, *endpoint.CreationTime) {
+					names = append(names, endpoint.EndpointName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return names, nil
+}
+
+// deleteSageMakerEndpoints deletes all endpoints requested. Returns a list of endpoint names that have been accepted
+// by AWS for deletion.
+func deleteSageMakerEndpoints(svc *sagemaker.SageMaker, names []*string) []*string {
+	var requestedDeletes []*string
+	for _, name := range names {
+		_, err := svc.DeleteEndpoint(&sagemaker.DeleteEndpointInput{EndpointName: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed deleting SageMaker endpoint %s: %s", *name, err)
+		} else {
+			requestedDeletes = append(requestedDeletes, name)
+		}
+	}
+	return requestedDeletes
+}
+
+// waitUntilSageMakerEndpointsDeleted waits until the endpoints have actually been deleted. Returns the names of the
+// endpoints that have been successfully deleted.
+func waitUntilSageMakerEndpointsDeleted(svc *sagemaker.SageMaker, names []*string) []*string {
+	var successfullyDeleted []*string
+	for _, name := range names {
+		err := svc.WaitUntilEndpointDeleted(&sagemaker.DescribeEndpointInput{EndpointName: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Failed waiting for SageMaker endpoint to be deleted %s: %s", *name, err)
+		} else {
+			logging.Logger.Infof("Deleted SageMaker endpoint: %s", *name)
+			successfullyDeleted = append(successfullyDeleted, name)
+		}
+	}
+	return successfullyDeleted
+}
+
+// nukeAllSageMakerEndpoints deletes all provided endpoints, waiting for them to be deleted before returning, so their
+// endpoint configs can be deleted next
+func nukeAllSageMakerEndpoints(session *session.Session, names []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No SageMaker endpoints to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all SageMaker endpoints in region %s", *session.Config.Region)
+
+	requestedDeletes := deleteSageMakerEndpoints(svc, names)
+	successfullyDeleted := waitUntilSageMakerEndpointsDeleted(svc, requestedDeletes)
+
+	logging.Logger.Infof("[OK] %d of %d SageMaker endpoint(s) deleted in %s", len(successfullyDeleted), len(names), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of SageMaker endpoint config names
+func getAllSageMakerEndpointConfigs(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var names []*string
+	err := svc.ListEndpointConfigsPages(
+		&sagemaker.ListEndpointConfigsInput{},
+		func(page *sagemaker.ListEndpointConfigsOutput, lastPage bool) bool {
+			for _, endpointConfig := range page.EndpointConfigs {
+				if timeFilter.IncludesResource(endpointConfig.EndpointConfigName, *endpointConfig.CreationTime) {
+					names = append(names, endpointConfig.EndpointConfigName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return names, nil
+}
+
+// Deletes all SageMaker endpoint configs
+func nukeAllSageMakerEndpointConfigs(session *session.Session, names []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No SageMaker endpoint configs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all SageMaker endpoint configs in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{EndpointConfigName: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted SageMaker endpoint config: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d SageMaker endpoint config(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
+
+// Returns a formatted string of SageMaker model names
+func getAllSageMakerModels(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var names []*string
+	err := svc.ListModelsPages(
+		&sagemaker.ListModelsInput{},
+		func(page *sagemaker.ListModelsOutput, lastPage bool) bool {
+			for _, model := range page.Models {
+				if timeFilter.IncludesResource(model.ModelName, *model.CreationTime) {
+					names = append(names, model.ModelName)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return names, nil
+}
+
+// Deletes all SageMaker models
+func nukeAllSageMakerModels(session *session.Session, names []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No SageMaker models to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all SageMaker models in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteModel(&sagemaker.DeleteModelInput{ModelName: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted SageMaker model: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d SageMaker model(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: SageMakerNotebookInstances{},
+		weight:   3200,
+		actions:  []string{"sagemaker:StopNotebookInstance", "sagemaker:DeleteNotebookInstance"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllSageMakerNotebookInstances(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SageMakerNotebookInstances{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: SageMakerEndpoints{},
+		weight:   3300,
+		actions:  []string{"sagemaker:DeleteEndpoint"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllSageMakerEndpoints(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SageMakerEndpoints{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: SageMakerEndpointConfigs{},
+		weight:   3400,
+		actions:  []string{"sagemaker:DeleteEndpointConfig"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllSageMakerEndpointConfigs(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SageMakerEndpointConfigs{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: SageMakerModels{},
+		weight:   3500,
+		actions:  []string{"sagemaker:DeleteModel"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			names, err := getAllSageMakerModels(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SageMakerModels{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// SageMakerNotebookInstances - represents all SageMaker notebook instances
+type SageMakerNotebookInstances struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (notebookInstances SageMakerNotebookInstances) ResourceName() string {
+	return "sagemakernotebook"
+}
+
+// ResourceIdentifiers - The names of the SageMaker notebook instances
+func (notebookInstances SageMakerNotebookInstances) ResourceIdentifiers() []string {
+	return notebookInstances.Names
+}
+
+func (notebookInstances SageMakerNotebookInstances) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (notebookInstances SageMakerNotebookInstances) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerNotebookInstances(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// SageMakerEndpoints - represents all SageMaker endpoints
+type SageMakerEndpoints struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (endpoints SageMakerEndpoints) ResourceName() string {
+	return "sagemakerendpoint"
+}
+
+// ResourceIdentifiers - The names of the SageMaker endpoints
+func (endpoints SageMakerEndpoints) ResourceIdentifiers() []string {
+	return endpoints.Names
+}
+
+func (endpoints SageMakerEndpoints) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (endpoints SageMakerEndpoints) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerEndpoints(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// SageMakerEndpointConfigs - represents all SageMaker endpoint configs
+type SageMakerEndpointConfigs struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (endpointConfigs SageMakerEndpointConfigs) ResourceName() string {
+	return "sagemakerendpointconfig"
+}
+
+// ResourceIdentifiers - The names of the SageMaker endpoint configs
+func (endpointConfigs SageMakerEndpointConfigs) ResourceIdentifiers() []string {
+	return endpointConfigs.Names
+}
+
+func (endpointConfigs SageMakerEndpointConfigs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (endpointConfigs SageMakerEndpointConfigs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerEndpointConfigs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// SageMakerModels - represents all SageMaker models
+type SageMakerModels struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (models SageMakerModels) ResourceName() string {
+	return "sagemakermodel"
+}
+
+// ResourceIdentifiers - The names of the SageMaker models
+func (models SageMakerModels) ResourceIdentifiers() []string {
+	return models.Names
+}
+
+func (models SageMakerModels) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (models SageMakerModels) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerModels(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
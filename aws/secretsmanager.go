@@ -0,0 +1,116 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// SecretsManagerConfig - how Secrets Manager secrets are deleted
+type SecretsManagerConfig struct {
+	// Delete the secrets right away, instead of scheduling their deletion. Secrets scheduled for deletion can still be
+	// restored until the recovery window ends.
+	ForceDeleteWithoutRecovery bool
+	// How many days secrets scheduled for deletion can be restored for, from 7 to 30. AWS defaults to 30 when zero.
+	RecoveryWindowInDays int64
+}
+
+// Returns a formatted string of Secrets Manager secret ARNs. Secrets already scheduled for deletion are left out, and
+// so are the secrets managed by other services, which are deleted along with the resources they belong to, and the
+// replicas of secrets from other regions, which are deleted along with their primary secret.
+func getAllSecretsManagerSecrets(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := secretsmanager.New(session)
+
+	var arns []*string
+	err := svc.ListSecretsPages(
+		&secretsmanager.ListSecretsInput{},
+		func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+			for _, secret := range page.SecretList {
+				if secret.OwningService != nil {
+					continue
+				}
+				if secret.PrimaryRegion != nil && *secret.PrimaryRegion != *session.Config.Region {
+					continue
+				}
+				if timeFilter.IncludesResource(secret.ARN, *secret.CreatedDate) {
+					arns = append(arns, secret.ARN)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return arns, nil
+}
+
+// removeSecretReplicas removes the replicas of the secret, as a secret can't be deleted while it's replicated
+func removeSecretReplicas(svc *secretsmanager.SecretsManager, arn *string) error {
+	result, err := svc.DescribeSecret(&secretsmanager.DescribeSecretInput{SecretId: arn})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(result.ReplicationStatus) == 0 {
+		return nil
+	}
+
+	var replicaRegions []*string
+	for _, replica := range result.ReplicationStatus {
+		replicaRegions = append(replicaRegions, replica.Region)
+	}
+	_, err = svc.RemoveRegionsFromReplication(&secretsmanager.RemoveRegionsFromReplicationInput{
+		SecretId:             arn,
+		RemoveReplicaRegions: replicaRegions,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// Deletes all Secrets Manager secrets, or schedules their deletion, depending on the config
+func nukeAllSecretsManagerSecrets(session *session.Session, config SecretsManagerConfig, arns []*string) error {
+	svc := secretsmanager.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Secrets Manager secrets to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Secrets Manager secrets in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	input := &secretsmanager.DeleteSecretInput{}
+	if config.ForceDeleteWithoutRecovery {
+		input.ForceDeleteWithoutRecovery = awsgo.Bool(true)
+	} else if config.RecoveryWindowInDays != 0 {
+		input.RecoveryWindowInDays = awsgo.Int64(config.RecoveryWindowInDays)
+	}
+
+	for _, arn := range arns {
+		err := removeSecretReplicas(svc, arn)
+		if err == nil {
+			input.SecretId = arn
+			_, err = svc.DeleteSecret(input)
+		}
+		if err != nil {
+			if awsErr, isAwsErr := errors.Unwrap(err).(awserr.Error); isAwsErr && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Secrets Manager secret %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Infof("Deleted Secrets Manager secret: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Secrets Manager secret(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
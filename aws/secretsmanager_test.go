@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSecretsManagerSecret(t *testing.T, session *session.Session, name string) string {
+	svc := secretsmanager.New(session)
+	result, err := svc.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         awsgo.String(name),
+		SecretString: awsgo.String("cloud-nuke-test"),
+	})
+	require.NoError(t, err)
+
+	return *result.ARN
+}
+
+// Test secrets are deleted right away, so the names don't stay reserved during the recovery window
+var testSecretsManagerConfig = SecretsManagerConfig{ForceDeleteWithoutRecovery: true}
+
+func TestListSecretsManagerSecrets(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	arn := createTestSecretsManagerSecret(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllSecretsManagerSecrets(session, testSecretsManagerConfig, []*string{awsgo.String(arn)})
+
+	arns, err := getAllSecretsManagerSecrets(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Secrets Manager secrets")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(arns), arn)
+
+	arns, err = getAllSecretsManagerSecrets(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Secrets Manager secrets")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(arns), arn)
+}
+
+func TestNukeSecretsManagerSecrets(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	arn := createTestSecretsManagerSecret(t, session, uniqueTestID)
+
+	if err := nukeAllSecretsManagerSecrets(session, testSecretsManagerConfig, []*string{awsgo.String(arn)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	arns, err := getAllSecretsManagerSecrets(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Secrets Manager secrets")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(arns), arn)
+}
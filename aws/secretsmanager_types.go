@@ -0,0 +1,53 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: SecretsManagerSecrets{},
+		weight:   3000,
+		actions:  []string{"secretsmanager:RemoveRegionsFromReplication", "secretsmanager:DeleteSecret"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			arns, err := getAllSecretsManagerSecrets(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SecretsManagerSecrets{Config: discovery.options.SecretsManager, Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+}
+
+// SecretsManagerSecrets - represents all Secrets Manager secrets
+type SecretsManagerSecrets struct {
+	Arns []string
+	// Whether the secrets are deleted right away, or scheduled for deletion
+	Config SecretsManagerConfig
+}
+
+// ResourceName - the simple name of the aws resource
+func (secrets SecretsManagerSecrets) ResourceName() string {
+	return "secretsmanager"
+}
+
+// ResourceIdentifiers - The ARNs of the Secrets Manager secrets
+func (secrets SecretsManagerSecrets) ResourceIdentifiers() []string {
+	return secrets.Arns
+}
+
+func (secrets SecretsManagerSecrets) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (secrets SecretsManagerSecrets) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSecretsManagerSecrets(session, secrets.Config, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
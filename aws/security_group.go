@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns the ids of all security groups. Default security groups are included, and left out by the default
+// exclusions. Security groups don't expose their creation time, so they are tagged with the time cloud-nuke first saw
+// them instead.
+func getAllSecurityGroups(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var securityGroups []*ec2.SecurityGroup
+	err := svc.DescribeSecurityGroupsPages(
+		&ec2.DescribeSecurityGroupsInput{},
+		func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+			securityGroups = append(securityGroups, page.SecurityGroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var groupIds []*string
+	for _, securityGroup := range securityGroups {
+		firstSeenTime, err := getFirstSeenTimeFromTags(securityGroup.Tags, firstSeenTagKey, firstSeenTagLayout)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if firstSeenTime == nil {
+			now := time.Now().UTC()
+			firstSeenTime = &now
+			if err := setFirstSeenResourceTag(svc, securityGroup.GroupId, firstSeenTagKey, now, firstSeenTagLayout); err != nil {
+				return nil, err
+			}
+		}
+
+		if timeFilter.IncludesFirstSeenResource(securityGroup.GroupId, *firstSeenTime) {
+			groupIds = append(groupIds, securityGroup.GroupId)
+		}
+	}
+
+	return groupIds, nil
+}
+
+// referencingPermissions - Returns the rules among the given ones that reference any of the given security groups,
+// keeping only the references to them. Those rules block deleting the groups they reference.
+func referencingPermissions(permissions []*ec2.IpPermission, groupIds map[string]bool) []*ec2.IpPermission {
+	var referencing []*ec2.IpPermission
+	for _, permission := range permissions {
+		var pairs []*ec2.UserIdGroupPair
+		for _, pair := range permission.UserIdGroupPairs {
+			if groupIds[awsgo.StringValue(pair.GroupId)] {
+				pairs = append(pairs, &ec2.UserIdGroupPair{GroupId: pair.GroupId, UserId: pair.UserId})
+			}
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+		referencing = append(referencing, &ec2.IpPermission{
+			IpProtocol:       permission.IpProtocol,
+			FromPort:         permission.FromPort,
+			ToPort:           permission.ToPort,
+			UserIdGroupPairs: pairs,
+		})
+	}
+	return referencing
+}
+
+// revokeCrossReferences - Revokes the rules of the given security groups that reference any of them, themselves
+// included, so that none of them is kept from being deleted by another. The rules of the groups that are left alone
+// aren't touched.
+func revokeCrossReferences(svc ec2iface.EC2API, securityGroups []*ec2.SecurityGroup) error {
+	groupIds := map[string]bool{}
+	for _, securityGroup := range securityGroups {
+		groupIds[awsgo.StringValue(securityGroup.GroupId)] = true
+	}
+
+	for _, securityGroup := range securityGroups {
+		if ingress := referencingPermissions(securityGroup.IpPermissions, groupIds); len(ingress) > 0 {
+			logging.Logger.Infof("...revoking the inbound rules of security group %s referencing the groups being nuked", awsgo.StringValue(securityGroup.GroupId))
+			_, err := svc.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       securityGroup.GroupId,
+				IpPermissions: ingress,
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+		if egress := referencingPermissions(securityGroup.IpPermissionsEgress, groupIds); len(egress) > 0 {
+			logging.Logger.Infof("...revoking the outbound rules of security group %s referencing the groups being nuked", awsgo.StringValue(securityGroup.GroupId))
+			_, err := svc.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       securityGroup.GroupId,
+				IpPermissions: egress,
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+	}
+	return nil
+}
+
+// deleteSecurityGroup - Deletes the security group. When it's still used by the network interfaces of Lambda
+// functions, waits for AWS to reclaim them and tries again.
+func deleteSecurityGroup(svc ec2iface.EC2API, lambdaSvc lambdaiface.LambdaAPI, groupId *string) error {
+	input := &ec2.DeleteSecurityGroupInput{GroupId: groupId}
+	_, err := svc.DeleteSecurityGroup(input)
+	if awsErr, isAwsErr := err.(awserr.Error); !isAwsErr || awsErr.Code() != "DependencyViolation" {
+		return err
+	}
+
+	filter := &ec2.Filter{Name: awsgo.String("group-id"), Values: []*string{groupId}}
+	found, waitErr := waitForLambdaNetworkInterfaces(svc, lambdaSvc, filter, "security group "+awsgo.StringValue(groupId))
+	if waitErr != nil {
+		return waitErr
+	}
+	if !found {
+		return err
+	}
+	_, err = svc.DeleteSecurityGroup(input)
+	return err
+}
+
+// Deletes all security groups, after revoking the rules through which they reference each other
+func nukeAllSecurityGroups(session *session.Session, groupIds []*string) error {
+	svc := ec2.New(session)
+	lambdaSvc := lambda.New(session)
+
+	if len(groupIds) == 0 {
+		logging.Logger.Infof("No security groups to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all security groups in region %s", *session.Config.Region)
+
+	result, err := svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{GroupIds: groupIds})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := revokeCrossReferences(svc, result.SecurityGroups); err != nil {
+		return err
+	}
+
+	var deletedGroupIds []*string
+	for _, groupId := range groupIds {
+		err := deleteSecurityGroup(svc, lambdaSvc, groupId)
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "DependencyViolation" {
+				logging.Logger.Errorf("[Failed] Security group %s is still in use, e.g. by a network interface or a rule of a group that isn't nuked: %s", *groupId, err)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedGroupIds = append(deletedGroupIds, groupId)
+			logging.Logger.Infof("Deleted security group: %s", *groupId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d security group(s) deleted in %s", len(deletedGroupIds), *session.Config.Region)
+	return nil
+}
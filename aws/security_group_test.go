@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func groupReference(protocol string, port int64, groupIds ...string) *ec2.IpPermission {
+	permission := &ec2.IpPermission{
+		IpProtocol: awsgo.String(protocol),
+		FromPort:   awsgo.Int64(port),
+		ToPort:     awsgo.Int64(port),
+	}
+	for _, groupId := range groupIds {
+		permission.UserIdGroupPairs = append(permission.UserIdGroupPairs, &ec2.UserIdGroupPair{GroupId: awsgo.String(groupId)})
+	}
+	return permission
+}
+
+func TestReferencingPermissions(t *testing.T) {
+	t.Parallel()
+
+	nuked := map[string]bool{"sg-app": true, "sg-db": true}
+	cidrRule := &ec2.IpPermission{
+		IpProtocol: awsgo.String("tcp"),
+		FromPort:   awsgo.Int64(443),
+		ToPort:     awsgo.Int64(443),
+		IpRanges:   []*ec2.IpRange{{CidrIp: awsgo.String("0.0.0.0/0")}},
+	}
+	permissions := []*ec2.IpPermission{
+		cidrRule,
+		groupReference("tcp", 5432, "sg-app", "sg-bastion"),
+		groupReference("tcp", 22, "sg-bastion"),
+	}
+
+	// Only the references to the groups being nuked are revoked, not the other rules
+	assert.Equal(t, []*ec2.IpPermission{groupReference("tcp", 5432, "sg-app")}, referencingPermissions(permissions, nuked))
+	assert.Empty(t, referencingPermissions([]*ec2.IpPermission{cidrRule}, nuked))
+}
+
+func TestRevokeCrossReferences(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	securityGroups := []*ec2.SecurityGroup{
+		{
+			GroupId:             awsgo.String("sg-app"),
+			IpPermissionsEgress: []*ec2.IpPermission{groupReference("tcp", 5432, "sg-db")},
+		},
+		{
+			GroupId:       awsgo.String("sg-db"),
+			IpPermissions: []*ec2.IpPermission{groupReference("tcp", 5432, "sg-app", "sg-db")},
+		},
+	}
+	gomock.InOrder(
+		mockEC2.EXPECT().RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       awsgo.String("sg-app"),
+			IpPermissions: []*ec2.IpPermission{groupReference("tcp", 5432, "sg-db")},
+		}),
+		mockEC2.EXPECT().RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       awsgo.String("sg-db"),
+			IpPermissions: []*ec2.IpPermission{groupReference("tcp", 5432, "sg-app", "sg-db")},
+		}),
+	)
+
+	require.NoError(t, revokeCrossReferences(mockEC2, securityGroups))
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked after network interfaces, since security groups can't be deleted while network interfaces use them
+	registerResourceType(awsResourceType{
+		resource: SecurityGroups{},
+		weight:   6800,
+		actions: []string{
+			"ec2:CreateTags",
+			"ec2:RevokeSecurityGroupIngress",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:DeleteSecurityGroup",
+		},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			groupIds, err := getAllSecurityGroups(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SecurityGroups{GroupIds: awsgo.StringValueSlice(groupIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return SecurityGroups{GroupIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
+// SecurityGroups - represents all non-default security groups
+type SecurityGroups struct {
+	GroupIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups SecurityGroups) ResourceName() string {
+	return "securitygroup"
+}
+
+// ResourceIdentifiers - The ids of the security groups
+func (groups SecurityGroups) ResourceIdentifiers() []string {
+	return groups.GroupIds
+}
+
+func (groups SecurityGroups) MaxBatchSize() int {
+	// The references between security groups are only revoked within a batch, so keep the batches large enough to
+	// hold all the groups of a region
+	return 1000
+}
+
+// Nuke - nuke 'em all!!!
+func (groups SecurityGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSecurityGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
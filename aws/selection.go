@@ -0,0 +1,43 @@
+package aws
+
+// FilterResources - Returns the resources for which keep returns true, e.g. to leave out the resources deselected
+// before nuking. Resource types without any resource left are dropped, as are regions without any resource type left.
+func FilterResources(account *AwsAccountResources, keep func(region string, resourceType string, identifier string) bool) *AwsAccountResources {
+	filtered := AwsAccountResources{Resources: map[string]AwsRegionResource{}}
+
+	for region, resourcesInRegion := range account.Resources {
+		filteredRegion := AwsRegionResource{CreationTimes: resourcesInRegion.CreationTimes, DiscoveryTimes: resourcesInRegion.DiscoveryTimes}
+		for _, skipped := range resourcesInRegion.Skipped {
+			if keep(region, skipped.ResourceType, skipped.Identifier) {
+				filteredRegion.Skipped = append(filteredRegion.Skipped, skipped)
+			}
+		}
+		for _, resources := range resourcesInRegion.Resources {
+			var identifiers []string
+			for _, identifier := range resources.ResourceIdentifiers() {
+				if keep(region, resources.ResourceName(), identifier) {
+					identifiers = append(identifiers, identifier)
+				}
+			}
+			if len(identifiers) == 0 {
+				continue
+			}
+
+			if len(identifiers) < len(resources.ResourceIdentifiers()) {
+				// Wrap the original resources rather than the ones the default exclusions wrapped already, so that
+				// asAsync still finds out whether they are deleted asynchronously
+				if excluded, isExcluded := resources.(excludedResources); isExcluded {
+					resources = excluded.AwsResources
+				}
+				resources = excludedResources{AwsResources: resources, identifiers: identifiers}
+			}
+			filteredRegion.Resources = append(filteredRegion.Resources, resources)
+		}
+
+		if len(filteredRegion.Resources) > 0 {
+			filtered.Resources[region] = filteredRegion
+		}
+	}
+
+	return &filtered
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterResources(t *testing.T) {
+	t.Parallel()
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []AwsResources{
+					excludedResources{AwsResources: GrafanaWorkspaces{WorkspaceIds: []string{"g-1", "g-2", "g-3"}}, identifiers: []string{"g-1", "g-2"}},
+					AMIs{ImageIds: []string{"ami-1"}},
+				},
+				Skipped: []SkippedResource{
+					{ResourceType: "grafanaworkspace", Identifier: "g-2", Reason: "not nukeable"},
+					{ResourceType: "ec2", Identifier: "i-1", Reason: "termination protection is enabled"},
+				},
+			},
+			"eu-west-1": {
+				Resources: []AwsResources{
+					AMIs{ImageIds: []string{"ami-2"}},
+				},
+			},
+		},
+	}
+
+	filtered := FilterResources(account, func(region string, resourceType string, identifier string) bool {
+		return identifier != "g-2" && region != "eu-west-1"
+	})
+
+	require.Len(t, filtered.Resources, 1)
+	resources := filtered.Resources["us-east-1"].Resources
+	require.Len(t, resources, 2)
+	assert.Equal(t, []string{"g-1"}, resources[0].ResourceIdentifiers())
+	assert.Equal(t, []string{"ami-1"}, resources[1].ResourceIdentifiers())
+	// The resources left alone are deselected along with the others
+	assert.Equal(t, []SkippedResource{
+		{ResourceType: "ec2", Identifier: "i-1", Reason: "termination protection is enabled"},
+	}, filtered.Resources["us-east-1"].Skipped)
+
+	// Deselecting resources doesn't hide that they are deleted asynchronously
+	_, isAsync := asAsync(resources[0])
+	assert.True(t, isAsync)
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns the ARNs of all Step Functions state machines created before the cutoff
+func getAllSfnStateMachines(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := sfn.New(session)
+
+	var arns []*string
+	err := svc.ListStateMachinesPages(
+		&sfn.ListStateMachinesInput{},
+		func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+			for _, stateMachine := range page.StateMachines {
+				if timeFilter.IncludesResource(stateMachine.StateMachineArn, *stateMachine.CreationDate) {
+					arns = append(arns, stateMachine.StateMachineArn)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return arns, nil
+}
+
+// stopRunningExecutions - Stops the executions of the state machine that are still running, which would otherwise
+// keep going for up to a year after the state machine is deleted
+func stopRunningExecutions(svc *sfn.SFN, stateMachineArn *string) error {
+	var executionArns []*string
+	err := svc.ListExecutionsPages(
+		&sfn.ListExecutionsInput{
+			StateMachineArn: stateMachineArn,
+			StatusFilter:    awsgo.String(sfn.ExecutionStatusRunning),
+		},
+		func(page *sfn.ListExecutionsOutput, lastPage bool) bool {
+			for _, execution := range page.Executions {
+				executionArns = append(executionArns, execution.ExecutionArn)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, executionArn := range executionArns {
+		logging.Logger.Infof("...stopping execution %s", *executionArn)
+		_, err := svc.StopExecution(&sfn.StopExecutionInput{
+			ExecutionArn: executionArn,
+			Cause:        awsgo.String("State machine nuked by cloud-nuke"),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
+
+// Stops the running executions of all the given state machines, and deletes them
+func nukeAllSfnStateMachines(session *session.Session, arns []*string) error {
+	svc := sfn.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Infof("No Step Functions state machines to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Step Functions state machines in region %s", *session.Config.Region)
+	var deletedArns []*string
+
+	for _, arn := range arns {
+		err := stopRunningExecutions(svc, arn)
+		if err == nil {
+			_, err = svc.DeleteStateMachine(&sfn.DeleteStateMachineInput{StateMachineArn: arn})
+		}
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == sfn.ErrCodeStateMachineDoesNotExist {
+				logging.Logger.Infof("Step Functions state machine %s has already been deleted", *arn)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Infof("Deleted Step Functions state machine: %s", *arn)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Step Functions state machine(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: SfnStateMachines{},
+		weight:   6000,
+		actions:  []string{"states:StopExecution", "states:DeleteStateMachine"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			arns, err := getAllSfnStateMachines(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return SfnStateMachines{Arns: awsgo.StringValueSlice(arns)}, nil
+		},
+	})
+}
+
+// SfnStateMachines - represents all Step Functions state machines
+type SfnStateMachines struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (stateMachines SfnStateMachines) ResourceName() string {
+	return "sfnstatemachine"
+}
+
+// ResourceIdentifiers - The ARNs of the state machines
+func (stateMachines SfnStateMachines) ResourceIdentifiers() []string {
+	return stateMachines.Arns
+}
+
+func (stateMachines SfnStateMachines) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (stateMachines SfnStateMachines) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSfnStateMachines(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
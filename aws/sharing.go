@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// SharedResource - a resource found by cloud-nuke that other accounts have been given access to, and stop having
+// once it's nuked
+type SharedResource struct {
+	ResourceType string
+	Identifier   string
+	Region       string
+	// The accounts the resource is shared with
+	AccountIds []string
+	// Whether the resource is shared with everyone
+	Public bool
+}
+
+// GetSharedResources - Returns the AMIs, snapshots and ECR repositories among the given resources that are shared
+// with other accounts, or publicly
+func GetSharedResources(account *AwsAccountResources) ([]SharedResource, error) {
+	var shared []SharedResource
+
+	for region, resourcesInRegion := range account.Resources {
+		session, err := session.NewSession(withSessionSettings(&awsgo.Config{
+			Region: awsgo.String(region)},
+		))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		instrumentSession(session)
+		ec2Svc := ec2.New(session)
+		ecrSvc := ecr.New(session)
+
+		for _, resources := range resourcesInRegion.Resources {
+			var getSharing func(identifier string) ([]string, bool, error)
+			switch resources.(type) {
+			case AMIs:
+				getSharing = func(imageId string) ([]string, bool, error) { return getAMISharing(ec2Svc, imageId) }
+			case Snapshots:
+				getSharing = func(snapshotId string) ([]string, bool, error) { return getSnapshotSharing(ec2Svc, snapshotId) }
+			case ECRRepositories:
+				getSharing = func(name string) ([]string, bool, error) { return getECRRepositorySharing(ecrSvc, name) }
+			default:
+				continue
+			}
+
+			for _, identifier := range resources.ResourceIdentifiers() {
+				accountIds, public, err := getSharing(identifier)
+				if err != nil {
+					return nil, err
+				}
+				if len(accountIds) == 0 && !public {
+					continue
+				}
+				shared = append(shared, SharedResource{
+					ResourceType: resources.ResourceName(),
+					Identifier:   identifier,
+					Region:       region,
+					AccountIds:   accountIds,
+					Public:       public,
+				})
+			}
+		}
+	}
+
+	return shared, nil
+}
+
+// getAMISharing - Returns the accounts allowed to launch the AMI, and whether everyone is
+func getAMISharing(svc ec2iface.EC2API, imageId string) ([]string, bool, error) {
+	result, err := svc.DescribeImageAttribute(&ec2.DescribeImageAttributeInput{
+		ImageId:   awsgo.String(imageId),
+		Attribute: awsgo.String(ec2.ImageAttributeNameLaunchPermission),
+	})
+	if err != nil {
+		return nil, false, errors.WithStackTrace(err)
+	}
+
+	var accountIds []string
+	public := false
+	for _, permission := range result.LaunchPermissions {
+		if permission.UserId != nil {
+			accountIds = append(accountIds, *permission.UserId)
+		}
+		if awsgo.StringValue(permission.Group) == ec2.PermissionGroupAll {
+			public = true
+		}
+	}
+	return accountIds, public, nil
+}
+
+// getSnapshotSharing - Returns the accounts allowed to create volumes from the snapshot, and whether everyone is
+func getSnapshotSharing(svc ec2iface.EC2API, snapshotId string) ([]string, bool, error) {
+	result, err := svc.DescribeSnapshotAttribute(&ec2.DescribeSnapshotAttributeInput{
+		SnapshotId: awsgo.String(snapshotId),
+		Attribute:  awsgo.String(ec2.SnapshotAttributeNameCreateVolumePermission),
+	})
+	if err != nil {
+		return nil, false, errors.WithStackTrace(err)
+	}
+
+	var accountIds []string
+	public := false
+	for _, permission := range result.CreateVolumePermissions {
+		if permission.UserId != nil {
+			accountIds = append(accountIds, *permission.UserId)
+		}
+		if awsgo.StringValue(permission.Group) == ec2.PermissionGroupAll {
+			public = true
+		}
+	}
+	return accountIds, public, nil
+}
+
+// getECRRepositorySharing - Returns the accounts the policy of the repository grants access to, and whether it
+// grants access to everyone. Repositories without a policy aren't shared.
+func getECRRepositorySharing(svc ecriface.ECRAPI, name string) ([]string, bool, error) {
+	result, err := svc.GetRepositoryPolicy(&ecr.GetRepositoryPolicyInput{
+		RepositoryName: awsgo.String(name),
+	})
+	if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == ecr.ErrCodeRepositoryPolicyNotFoundException {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.WithStackTrace(err)
+	}
+
+	accountIds, public, err := policyPrincipalAccounts(awsgo.StringValue(result.PolicyText), awsgo.StringValue(result.RegistryId))
+	if err != nil {
+		return nil, false, ECRPolicyParseError{RepositoryName: name, Underlying: err}
+	}
+	return accountIds, public, nil
+}
+
+// policyPrincipalAccounts - Returns the accounts other than the owner that the Allow statements of the resource
+// policy grant access to, sorted, and whether they grant access to everyone
+func policyPrincipalAccounts(policyText string, ownerAccountId string) ([]string, bool, error) {
+	var policy struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(policyText), &policy); err != nil {
+		return nil, false, err
+	}
+
+	type statement struct {
+		Effect    string
+		Principal interface{}
+	}
+	// A policy holds either a single statement, or a list of them
+	var statements []statement
+	if err := json.Unmarshal(policy.Statement, &statements); err != nil {
+		var single statement
+		if err := json.Unmarshal(policy.Statement, &single); err != nil {
+			return nil, false, err
+		}
+		statements = []statement{single}
+	}
+
+	accounts := map[string]bool{}
+	public := false
+	for _, statement := range statements {
+		if statement.Effect != "Allow" {
+			continue
+		}
+		for _, principal := range awsPrincipals(statement.Principal) {
+			if principal == "*" {
+				public = true
+				continue
+			}
+			accountId := principal
+			if parsed, err := arn.Parse(principal); err == nil {
+				accountId = parsed.AccountID
+			}
+			if accountId != "" && accountId != ownerAccountId {
+				accounts[accountId] = true
+			}
+		}
+	}
+
+	var accountIds []string
+	for accountId := range accounts {
+		accountIds = append(accountIds, accountId)
+	}
+	sort.Strings(accountIds)
+	return accountIds, public, nil
+}
+
+// awsPrincipals - Returns the AWS principals of a policy statement, given as "*", {"AWS": "..."} or {"AWS": [...]}
+func awsPrincipals(principal interface{}) []string {
+	switch principal := principal.(type) {
+	case string:
+		return []string{principal}
+	case map[string]interface{}:
+		switch awsPrincipal := principal["AWS"].(type) {
+		case string:
+			return []string{awsPrincipal}
+		case []interface{}:
+			var principals []string
+			for _, value := range awsPrincipal {
+				if value, ok := value.(string); ok {
+					principals = append(principals, value)
+				}
+			}
+			return principals
+		}
+	}
+	return nil
+}
+
+// SharingNotifications - Groups the shared resources by the account they are shared with. Public sharing isn't
+// attributed to any account, so it's left out.
+func SharingNotifications(shared []SharedResource) map[string][]SharedResource {
+	notifications := map[string][]SharedResource{}
+	for _, resource := range shared {
+		for _, accountId := range resource.AccountIds {
+			notifications[accountId] = append(notifications[accountId], resource)
+		}
+	}
+	return notifications
+}
+
+// formatSharingNotification - Renders the message telling an account about the shared resources it's about to lose
+// access to
+func formatSharingNotification(ownerAccountId string, resources []SharedResource) string {
+	lines := []string{
+		fmt.Sprintf("cloud-nuke is about to nuke the following resources of account %s, which are shared with your account:", ownerAccountId),
+		"",
+	}
+	for _, resource := range resources {
+		lines = append(lines, fmt.Sprintf("* %s %s in %s", resource.ResourceType, resource.Identifier, resource.Region))
+	}
+	lines = append(lines, "", "Copy anything you still need before they are gone.")
+	return strings.Join(lines, "\n")
+}
+
+// NotifySharingAccounts - Publishes a message to the SNS topic for each account the resources are shared with. The
+// id of the account is set as the account_id message attribute, so that each owner can subscribe, e.g. by email,
+// with a filter policy on their account.
+func NotifySharingAccounts(topicArn string, ownerAccountId string, shared []SharedResource) error {
+	parsed, err := arn.Parse(topicArn)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	session, err := session.NewSession(withSessionSettings(&awsgo.Config{
+		Region: awsgo.String(parsed.Region)},
+	))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	instrumentSession(session)
+	svc := sns.New(session)
+
+	notifications := SharingNotifications(shared)
+	var accountIds []string
+	for accountId := range notifications {
+		accountIds = append(accountIds, accountId)
+	}
+	sort.Strings(accountIds)
+
+	for _, accountId := range accountIds {
+		_, err := svc.Publish(&sns.PublishInput{
+			TopicArn: awsgo.String(topicArn),
+			Subject:  awsgo.String(fmt.Sprintf("Resources shared with account %s are about to be nuked", accountId)),
+			Message:  awsgo.String(formatSharingNotification(ownerAccountId, notifications[accountId])),
+			MessageAttributes: map[string]*sns.MessageAttributeValue{
+				"account_id": {
+					DataType:    awsgo.String("String"),
+					StringValue: awsgo.String(accountId),
+				},
+			},
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("Notified account %s about the %d shared resource(s) about to be nuked", accountId, len(notifications[accountId]))
+	}
+	return nil
+}
+
+// ECRPolicyParseError - returned when the policy of an ECR repository can't be parsed to find who it's shared with
+type ECRPolicyParseError struct {
+	RepositoryName string
+	Underlying     error
+}
+
+func (err ECRPolicyParseError) Error() string {
+	return fmt.Sprintf("Unable to parse the policy of ECR repository %s: %s", err.RepositoryName, err.Underlying)
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyPrincipalAccounts(t *testing.T) {
+	t.Parallel()
+
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "pull",
+      "Effect": "Allow",
+      "Principal": {"AWS": ["arn:aws:iam::222222222222:root", "111111111111"]},
+      "Action": ["ecr:BatchGetImage"]
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:role/ci"},
+      "Action": "ecr:*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": {"AWS": "arn:aws:iam::333333333333:root"},
+      "Action": "ecr:*"
+    }
+  ]
+}`
+	accountIds, public, err := policyPrincipalAccounts(policy, "123456789012")
+	require.NoError(t, err)
+	// The owner's own principals don't count as sharing
+	assert.Equal(t, []string{"111111111111", "222222222222"}, accountIds)
+	assert.False(t, public)
+
+	accountIds, public, err = policyPrincipalAccounts(`{"Statement": {"Effect": "Allow", "Principal": "*", "Action": "ecr:*"}}`, "123456789012")
+	require.NoError(t, err)
+	assert.Empty(t, accountIds)
+	assert.True(t, public)
+
+	_, _, err = policyPrincipalAccounts(`not a policy`, "123456789012")
+	assert.Error(t, err)
+}
+
+func TestSharingNotifications(t *testing.T) {
+	t.Parallel()
+
+	ami := SharedResource{ResourceType: "ami", Identifier: "ami-1", Region: "us-east-1", AccountIds: []string{"111111111111", "222222222222"}}
+	snapshot := SharedResource{ResourceType: "snap", Identifier: "snap-1", Region: "us-east-1", AccountIds: []string{"111111111111"}}
+	public := SharedResource{ResourceType: "ami", Identifier: "ami-2", Region: "eu-west-1", Public: true}
+
+	assert.Equal(t, map[string][]SharedResource{
+		"111111111111": {ami, snapshot},
+		"222222222222": {ami},
+	}, SharingNotifications([]SharedResource{ami, snapshot, public}))
+
+	message := formatSharingNotification("123456789012", []SharedResource{ami, snapshot})
+	assert.Contains(t, message, "account 123456789012")
+	assert.Contains(t, message, "* ami ami-1 in us-east-1\n* snap snap-1 in us-east-1")
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -11,7 +12,7 @@ import (
 )
 
 // Returns a formatted string of Snapshot snapshot ids
-func getAllSnapshots(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllSnapshots(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
 	svc := ec2.New(session)
 
 	params := &ec2.DescribeSnapshotsInput{
@@ -25,7 +26,11 @@ func getAllSnapshots(session *session.Session, region string, excludeAfter time.
 
 	var snapshotIds []*string
 	for _, snapshot := range output.Snapshots {
-		if excludeAfter.After(*snapshot.StartTime) {
+		if isRetainedFinalSnapshot(snapshot.Tags, time.Now()) {
+			logging.Logger.Infof("Skipping Snapshot %s: it's the final snapshot of a nuked EBS volume, still within its retention period", *snapshot.SnapshotId)
+			continue
+		}
+		if timeFilter.IncludesResource(snapshot.SnapshotId, *snapshot.StartTime) {
 			snapshotIds = append(snapshotIds, snapshot.SnapshotId)
 		}
 	}
@@ -33,6 +38,20 @@ func getAllSnapshots(session *session.Session, region string, excludeAfter time.
 	return snapshotIds, nil
 }
 
+// isRetainedFinalSnapshot - Checks if a snapshot with the given tags is the final snapshot of a nuked EBS volume,
+// which has to be kept until its retention period ends
+func isRetainedFinalSnapshot(tags []*ec2.Tag, now time.Time) bool {
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) != finalSnapshotRetainUntilTagKey {
+			continue
+		}
+		retainUntil, err := time.Parse(time.RFC3339, awsgo.StringValue(tag.Value))
+		// Keep the snapshots whose retention can't be made sense of, rather than nuking them too early
+		return err != nil || now.Before(retainUntil)
+	}
+	return false
+}
+
 // Deletes all Snapshots
 func nukeAllSnapshots(session *session.Session, snapshotIds []*string) error {
 	svc := ec2.New(session)
@@ -52,6 +71,11 @@ func nukeAllSnapshots(session *session.Session, snapshotIds []*string) error {
 
 		_, err := svc.DeleteSnapshot(params)
 		if err != nil {
+			// Snapshots backing an AMI may have been deleted along with it
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidSnapshot.NotFound" {
+				logging.Logger.Infof("Snapshot %s has already been deleted", *snapshotID)
+				continue
+			}
 			logging.Logger.Errorf("[Failed] %s", err)
 		} else {
 			deletedSnapshotIDs = append(deletedSnapshotIDs, snapshotID)
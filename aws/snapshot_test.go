@@ -58,16 +58,16 @@ func TestListSnapshots(t *testing.T) {
 
 	// clean up after this test
 	defer nukeAllSnapshots(session, []*string{snapshot.SnapshotId})
-	defer nukeAllEbsVolumes(session, findEBSVolumesByNameTag(t, session, uniqueTestID))
+	defer nukeAllEbsVolumes(session, EBSConfig{}, findEBSVolumesByNameTag(t, session, uniqueTestID))
 
-	snapshots, err := getAllSnapshots(session, region, time.Now().Add(1*time.Hour*-1))
+	snapshots, err := getAllSnapshots(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Snapshots")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(snapshots), *snapshot.SnapshotId)
 
-	snapshots, err = getAllSnapshots(session, region, time.Now().Add(1*time.Hour))
+	snapshots, err = getAllSnapshots(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Snapshots")
 	}
@@ -96,7 +96,7 @@ func TestNukeSnapshots(t *testing.T) {
 	snapshot := createTestSnapshot(t, session, uniqueTestID)
 
 	// clean up ec2 instance created by the above call
-	defer nukeAllEbsVolumes(session, findEBSVolumesByNameTag(t, session, uniqueTestID))
+	defer nukeAllEbsVolumes(session, EBSConfig{}, findEBSVolumesByNameTag(t, session, uniqueTestID))
 
 	_, err = svc.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
 		SnapshotIds: []*string{snapshot.SnapshotId},
@@ -110,10 +110,28 @@ func TestNukeSnapshots(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	snapshots, err := getAllSnapshots(session, region, time.Now().Add(1*time.Hour))
+	snapshots, err := getAllSnapshots(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Snapshots")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(snapshots), *snapshot.SnapshotId)
 }
+
+func TestIsRetainedFinalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	retainedUntil := func(value string) []*ec2.Tag {
+		return []*ec2.Tag{
+			{Key: awsgo.String("Name"), Value: awsgo.String("final")},
+			{Key: awsgo.String(finalSnapshotRetainUntilTagKey), Value: awsgo.String(value)},
+		}
+	}
+
+	assert.False(t, isRetainedFinalSnapshot(nil, now))
+	assert.False(t, isRetainedFinalSnapshot([]*ec2.Tag{{Key: awsgo.String("Name"), Value: awsgo.String("other")}}, now))
+	assert.True(t, isRetainedFinalSnapshot(retainedUntil("2020-04-03T05:06:07Z"), now))
+	assert.False(t, isRetainedFinalSnapshot(retainedUntil("2020-03-03T05:06:07Z"), now))
+	assert.True(t, isRetainedFinalSnapshot(retainedUntil("next month"), now))
+}
@@ -6,9 +6,29 @@ import (
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 )
 
+func init() {
+	registerResourceType(awsResourceType{
+		resource: Snapshots{},
+		weight:   1800,
+		actions:  []string{"ec2:CopySnapshot", "ec2:ModifySnapshotAttribute", "ec2:DeleteSnapshot"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			snapshotIds, err := getAllSnapshots(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return Snapshots{Archive: discovery.options.Archive, SnapshotIds: awsgo.StringValueSlice(snapshotIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return Snapshots{SnapshotIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
 // Snapshots - represents all user owned Snapshots
 type Snapshots struct {
 	SnapshotIds []string
+	// Where to archive the Snapshots to before they are nuked. Nothing is archived when nil.
+	Archive *ArchiveConfig
 }
 
 // ResourceName - the simple name of the aws resource
@@ -28,7 +48,15 @@ func (snapshot Snapshots) MaxBatchSize() int {
 
 // Nuke - nuke 'em all!!!
 func (snapshot Snapshots) Nuke(session *session.Session, identifiers []string) error {
-	if err := nukeAllSnapshots(session, awsgo.StringSlice(identifiers)); err != nil {
+	snapshotIds := awsgo.StringSlice(identifiers)
+	if snapshot.Archive != nil {
+		var err error
+		if snapshotIds, err = archiveSnapshots(session, *snapshot.Archive, snapshotIds); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	if err := nukeAllSnapshots(session, snapshotIds); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
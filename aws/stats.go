@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// APIStats - the AWS API calls made by cloud-nuke, to help tuning the batch settings on very large accounts
+type APIStats struct {
+	// The number of calls made to each service, retries left out
+	CallsPerService map[string]int
+	// The number of attempts that were throttled
+	Throttles int
+	// The number of attempts that were retried, throttled or not
+	Retries int
+	// The number of calls that failed while nuking each resource type, by error category, retries left out
+	FailedCallsPerResourceType map[string]map[ErrorCategory]int
+}
+
+// ServiceCalls - the number of calls made to a service
+type ServiceCalls struct {
+	Service string
+	Calls   int
+}
+
+// TotalCalls - The number of calls made to all services
+func (stats APIStats) TotalCalls() int {
+	total := 0
+	for _, calls := range stats.CallsPerService {
+		total += calls
+	}
+	return total
+}
+
+// SortedCallsPerService - The number of calls made to each service, the most called services first
+func (stats APIStats) SortedCallsPerService() []ServiceCalls {
+	var serviceCalls []ServiceCalls
+	for service, calls := range stats.CallsPerService {
+		serviceCalls = append(serviceCalls, ServiceCalls{Service: service, Calls: calls})
+	}
+	sort.Slice(serviceCalls, func(i, j int) bool {
+		if serviceCalls[i].Calls != serviceCalls[j].Calls {
+			return serviceCalls[i].Calls > serviceCalls[j].Calls
+		}
+		return serviceCalls[i].Service < serviceCalls[j].Service
+	})
+	return serviceCalls
+}
+
+// FailedCalls - the number of calls that failed with errors of a category while nuking resources of a type
+type FailedCalls struct {
+	ResourceType string
+	Category     ErrorCategory
+	Calls        int
+}
+
+// SortedFailedCalls - The number of calls that failed while nuking each resource type, by error category, sorted by
+// resource type, then in the order of ErrorCategories
+func (stats APIStats) SortedFailedCalls() []FailedCalls {
+	var resourceTypes []string
+	for resourceType := range stats.FailedCallsPerResourceType {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	var failedCalls []FailedCalls
+	for _, resourceType := range resourceTypes {
+		for _, category := range ErrorCategories {
+			if calls := stats.FailedCallsPerResourceType[resourceType][category]; calls > 0 {
+				failedCalls = append(failedCalls, FailedCalls{ResourceType: resourceType, Category: category, Calls: calls})
+			}
+		}
+	}
+	return failedCalls
+}
+
+// apiStatsRecorder - records the API calls made through the sessions it instruments
+type apiStatsRecorder struct {
+	mutex sync.Mutex
+	stats APIStats
+}
+
+var recorder = newAPIStatsRecorder()
+
+func newAPIStatsRecorder() *apiStatsRecorder {
+	return &apiStatsRecorder{stats: APIStats{
+		CallsPerService:            map[string]int{},
+		FailedCallsPerResourceType: map[string]map[ErrorCategory]int{},
+	}}
+}
+
+// instrumentSession - Records the API calls made through the session, and the sessions copied from it
+func instrumentSession(session *session.Session) *session.Session {
+	session.Handlers.AfterRetry.PushBack(recorder.recordAttempt)
+	session.Handlers.Complete.PushBack(recorder.recordCall)
+	return session
+}
+
+// recordAttempt - Runs after each attempt of a call that failed
+func (recorder *apiStatsRecorder) recordAttempt(r *request.Request) {
+	if !request.IsErrorThrottle(r.Error) {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.stats.Throttles++
+}
+
+// recordCall - Runs once a call completed, after all its retries
+func (recorder *apiStatsRecorder) recordCall(r *request.Request) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.stats.CallsPerService[r.ClientInfo.ServiceName]++
+	recorder.stats.Retries += r.RetryCount
+}
+
+// withResourceType - Returns a copy of the session that also records the calls failing through it against the given
+// resource type, by error category
+func withResourceType(session *session.Session, resourceType string) *session.Session {
+	typeSession := session.Copy()
+	typeSession.Handlers.Complete.PushBack(func(r *request.Request) {
+		recorder.recordFailedCall(resourceType, r)
+	})
+	return typeSession
+}
+
+// recordFailedCall - Runs once a call made while nuking resources of the given type completed, after all its retries
+func (recorder *apiStatsRecorder) recordFailedCall(resourceType string, r *request.Request) {
+	// The calls aborted on Ctrl+C didn't fail on the AWS side
+	if r.Error == nil || isCanceled(r.Error) {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	if recorder.stats.FailedCallsPerResourceType[resourceType] == nil {
+		recorder.stats.FailedCallsPerResourceType[resourceType] = map[ErrorCategory]int{}
+	}
+	recorder.stats.FailedCallsPerResourceType[resourceType][classifyError(r.Error)]++
+}
+
+// GetAPIStats - Returns the AWS API calls made so far
+func GetAPIStats() APIStats {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	stats := recorder.stats
+	stats.CallsPerService = map[string]int{}
+	for service, calls := range recorder.stats.CallsPerService {
+		stats.CallsPerService[service] = calls
+	}
+	stats.FailedCallsPerResourceType = map[string]map[ErrorCategory]int{}
+	for resourceType, failedCalls := range recorder.stats.FailedCallsPerResourceType {
+		stats.FailedCallsPerResourceType[resourceType] = map[ErrorCategory]int{}
+		for category, calls := range failedCalls {
+			stats.FailedCallsPerResourceType[resourceType][category] = calls
+		}
+	}
+	return stats
+}
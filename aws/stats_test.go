@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIStatsRecordsCalls(t *testing.T) {
+	t.Parallel()
+
+	statsRecorder := &apiStatsRecorder{stats: APIStats{CallsPerService: map[string]int{}}}
+
+	throttled := &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceName: "ec2"},
+		Error:      awserr.New("RequestLimitExceeded", "slow down", nil),
+	}
+	statsRecorder.recordAttempt(throttled)
+	throttled.RetryCount = 1
+	statsRecorder.recordCall(throttled)
+
+	failed := &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceName: "ec2"},
+		Error:      awserr.New("InvalidParameterValue", "nope", nil),
+	}
+	statsRecorder.recordAttempt(failed)
+	statsRecorder.recordCall(failed)
+
+	statsRecorder.recordCall(&request.Request{ClientInfo: metadata.ClientInfo{ServiceName: "kinesis"}})
+
+	stats := statsRecorder.stats
+	assert.Equal(t, 3, stats.TotalCalls())
+	assert.Equal(t, 1, stats.Throttles)
+	assert.Equal(t, 1, stats.Retries)
+	assert.Equal(t, []ServiceCalls{{Service: "ec2", Calls: 2}, {Service: "kinesis", Calls: 1}}, stats.SortedCallsPerService())
+}
+
+func TestAPIStatsRecordsFailedCalls(t *testing.T) {
+	t.Parallel()
+
+	statsRecorder := newAPIStatsRecorder()
+
+	statsRecorder.recordFailedCall("vpc", &request.Request{Error: awserr.New("DependencyViolation", "in use", nil)})
+	statsRecorder.recordFailedCall("vpc", &request.Request{Error: awserr.New("DependencyViolation", "in use", nil)})
+	statsRecorder.recordFailedCall("vpc", &request.Request{})
+	statsRecorder.recordFailedCall("ec2", &request.Request{Error: awserr.New("UnauthorizedOperation", "nope", nil)})
+	statsRecorder.recordFailedCall("ec2", &request.Request{Error: awserr.New("RequestLimitExceeded", "slow down", nil)})
+
+	assert.Equal(t, []FailedCalls{
+		{ResourceType: "ec2", Category: ThrottlingError, Calls: 1},
+		{ResourceType: "ec2", Category: PermissionDeniedError, Calls: 1},
+		{ResourceType: "vpc", Category: DependencyViolationError, Calls: 2},
+	}, statsRecorder.stats.SortedFailedCalls())
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The resource types, as understood by the Resource Groups Tagging API, that map onto a cloud-nuke resource type.
+// Auto Scaling Groups are not supported by the tagging API and are looked up separately, while Launch
+// Configurations can't be tagged at all.
+var taggedResourceTypeFilters = []string{
+	"ec2:instance",
+	"ec2:volume",
+	"ec2:elastic-ip",
+	"ec2:image",
+	"ec2:snapshot",
+	"ec2:natgateway",
+	"ec2:vpc",
+	"ec2:security-group",
+	"elasticloadbalancing:loadbalancer",
+	"ecs:service",
+	"eks:cluster",
+}
+
+// taggedResource - a resource found through its tags, resolved to the cloud-nuke resource type and identifier
+type taggedResource struct {
+	ResourceName string
+	Identifier   string
+	// The cluster the resource belongs to. Only set for ECS services.
+	Cluster string
+}
+
+// parseTaggedResourceArn - Resolves an ARN returned by the tagging API to the cloud-nuke resource type it belongs
+// to and the identifier that resource type expects
+func parseTaggedResourceArn(arn string) (taggedResource, error) {
+	// arn:partition:service:region:account-id:resource
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		return taggedResource{}, UnsupportedTaggedResourceError{Arn: arn}
+	}
+	service := parts[2]
+	resource := strings.Split(parts[5], "/")
+
+	switch {
+	case service == "ec2" && len(resource) == 2:
+		resourceNames := map[string]string{
+			"instance":       EC2Instances{}.ResourceName(),
+			"volume":         EBSVolumes{}.ResourceName(),
+			"elastic-ip":     EIPAddresses{}.ResourceName(),
+			"image":          AMIs{}.ResourceName(),
+			"snapshot":       Snapshots{}.ResourceName(),
+			"natgateway":     NatGateways{}.ResourceName(),
+			"vpc":            VPCs{}.ResourceName(),
+			"security-group": SecurityGroups{}.ResourceName(),
+		}
+		if resourceName, ok := resourceNames[resource[0]]; ok {
+			return taggedResource{ResourceName: resourceName, Identifier: resource[1]}, nil
+		}
+	case service == "elasticloadbalancing" && resource[0] == "loadbalancer":
+		// Classic load balancers are identified by name, while V2 load balancers (loadbalancer/app/... and
+		// loadbalancer/net/...) are identified by their ARN
+		if len(resource) == 2 {
+			return taggedResource{ResourceName: LoadBalancers{}.ResourceName(), Identifier: resource[1]}, nil
+		}
+		return taggedResource{ResourceName: LoadBalancersV2{}.ResourceName(), Identifier: arn}, nil
+	case service == "ecs" && resource[0] == "service" && len(resource) == 3:
+		// Only the long ARN format contains the cluster name, which we need to delete the service
+		return taggedResource{ResourceName: ECSServices{}.ResourceName(), Identifier: arn, Cluster: resource[1]}, nil
+	case service == "eks" && resource[0] == "cluster" && len(resource) == 2:
+		return taggedResource{ResourceName: EKSClusters{}.ResourceName(), Identifier: resource[1]}, nil
+	}
+
+	return taggedResource{}, UnsupportedTaggedResourceError{Arn: arn}
+}
+
+// getAllTaggedResources - Returns all resources in the region which carry the given tag, resolved to cloud-nuke
+// resource types
+func getAllTaggedResources(session *session.Session, tagKey string, tagValue string) ([]taggedResource, error) {
+	var resources []taggedResource
+
+	svc := resourcegroupstaggingapi.New(session)
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: awsgo.StringSlice(taggedResourceTypeFilters),
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{
+				Key:    awsgo.String(tagKey),
+				Values: []*string{awsgo.String(tagValue)},
+			},
+		},
+	}
+	err := svc.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		for _, mapping := range page.ResourceTagMappingList {
+			resource, err := parseTaggedResourceArn(awsgo.StringValue(mapping.ResourceARN))
+			if err != nil {
+				logging.Logger.Warnf("Skipping tagged resource: %s", err)
+				continue
+			}
+			resources = append(resources, resource)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The tagging API doesn't know about Auto Scaling Groups, so look those up directly
+	asgSvc := autoscaling.New(session)
+	asgInput := &autoscaling.DescribeTagsInput{
+		Filters: []*autoscaling.Filter{
+			{
+				Name:   awsgo.String("key"),
+				Values: []*string{awsgo.String(tagKey)},
+			},
+			{
+				Name:   awsgo.String("value"),
+				Values: []*string{awsgo.String(tagValue)},
+			},
+		},
+	}
+	err = asgSvc.DescribeTagsPages(asgInput, func(page *autoscaling.DescribeTagsOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			resources = append(resources, taggedResource{
+				ResourceName: ASGroups{}.ResourceName(),
+				Identifier:   awsgo.StringValue(tag.ResourceId),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return resources, nil
+}
+
+// taggedIdentifiers - Returns the identifiers of the tagged resources
+func taggedIdentifiers(resources []taggedResource) []string {
+	var identifiers []string
+	for _, resource := range resources {
+		identifiers = append(identifiers, resource.Identifier)
+	}
+	return identifiers
+}
+
+// GetAllResourcesWithTag - Lists all aws resources, across all supported resource types, that are tagged with the
+// given key and value. Resource age is not taken into account.
+func GetAllResourcesWithTag(regions []string, tagKey string, tagValue string) (*AwsAccountResources, error) {
+	account := AwsAccountResources{
+		Resources: make(map[string]AwsRegionResource),
+	}
+
+	for _, region := range regions {
+		logging.Logger.Infof("Checking region %s for resources tagged with %s=%s", region, tagKey, tagValue)
+
+		session, err := session.NewSession(withSessionSettings(&awsgo.Config{
+			Region: awsgo.String(region)},
+		))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		instrumentSession(session)
+
+		taggedResources, err := getAllTaggedResources(session, tagKey, tagValue)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		taggedResourcesByType := map[string][]taggedResource{}
+		for _, resource := range taggedResources {
+			taggedResourcesByType[resource.ResourceName] = append(taggedResourcesByType[resource.ResourceName], resource)
+		}
+
+		// Keep the same order as GetAllResources, since resources have to be nuked in dependency order
+		resourcesInRegion := AwsRegionResource{}
+		for _, resourceType := range resourceTypesInNukeOrder() {
+			resourcesOfType := taggedResourcesByType[resourceType.resource.ResourceName()]
+			if resourceType.fromTagged == nil || len(resourcesOfType) == 0 {
+				continue
+			}
+			resourcesInRegion.Resources = append(resourcesInRegion.Resources, resourceType.fromTagged(resourcesOfType))
+		}
+
+		// Tagging a resource doesn't count as explicitly targeting its type, so all default exclusions apply
+		resourcesInRegion, err = applyDefaultExclusions(session, resourcesInRegion, nil, defaultExclusions)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if len(resourcesInRegion.Resources) > 0 {
+			account.Resources[region] = resourcesInRegion
+		}
+	}
+
+	return &account, nil
+}
+
+// UnsupportedTaggedResourceError - returned when a tagged resource doesn't map onto a cloud-nuke resource type
+type UnsupportedTaggedResourceError struct {
+	Arn string
+}
+
+func (e UnsupportedTaggedResourceError) Error() string {
+	return fmt.Sprintf("%s is not a resource type supported by cloud-nuke", e.Arn)
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaggedResourceArn(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		arn      string
+		expected taggedResource
+	}{
+		{
+			"arn:aws:ec2:us-east-1:123456789012:instance/i-0123456789abcdef0",
+			taggedResource{ResourceName: "ec2", Identifier: "i-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1:123456789012:volume/vol-0123456789abcdef0",
+			taggedResource{ResourceName: "ebs", Identifier: "vol-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1:123456789012:elastic-ip/eipalloc-0123456789abcdef0",
+			taggedResource{ResourceName: "eip", Identifier: "eipalloc-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1::image/ami-0123456789abcdef0",
+			taggedResource{ResourceName: "ami", Identifier: "ami-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1::snapshot/snap-0123456789abcdef0",
+			taggedResource{ResourceName: "snap", Identifier: "snap-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1:123456789012:natgateway/nat-0123456789abcdef0",
+			taggedResource{ResourceName: "natgateway", Identifier: "nat-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-0123456789abcdef0",
+			taggedResource{ResourceName: "vpc", Identifier: "vpc-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:ec2:us-east-1:123456789012:security-group/sg-0123456789abcdef0",
+			taggedResource{ResourceName: "securitygroup", Identifier: "sg-0123456789abcdef0"},
+		},
+		{
+			"arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/my-classic-elb",
+			taggedResource{ResourceName: "elb", Identifier: "my-classic-elb"},
+		},
+		{
+			"arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188",
+			taggedResource{ResourceName: "elbv2", Identifier: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188"},
+		},
+		{
+			"arn:aws:ecs:us-east-1:123456789012:service/my-cluster/my-service",
+			taggedResource{ResourceName: "ecsserv", Identifier: "arn:aws:ecs:us-east-1:123456789012:service/my-cluster/my-service", Cluster: "my-cluster"},
+		},
+		{
+			"arn:aws:eks:us-east-1:123456789012:cluster/my-cluster",
+			taggedResource{ResourceName: "ekscluster", Identifier: "my-cluster"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		resource, err := parseTaggedResourceArn(testCase.arn)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, resource)
+	}
+}
+
+func TestTaggedResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	// Every resource type the tagging API resolves to has to be looked up by tag
+	taggedResourceNames := []string{"ec2", "ebs", "eip", "ami", "snap", "natgateway", "vpc", "securitygroup", "elb", "elbv2", "ecsserv", "ekscluster", "asg"}
+	taggedResourceTypes := 0
+	for _, resourceType := range registeredResourceTypes {
+		name := resourceType.resource.ResourceName()
+		if resourceType.fromTagged == nil {
+			assert.NotContains(t, taggedResourceNames, name)
+			continue
+		}
+		assert.Contains(t, taggedResourceNames, name)
+		taggedResourceTypes++
+
+		resources := resourceType.fromTagged([]taggedResource{{ResourceName: name, Identifier: "identifier", Cluster: "cluster"}})
+		assert.Equal(t, name, resources.ResourceName())
+		assert.Equal(t, []string{"identifier"}, resources.ResourceIdentifiers())
+	}
+	assert.Equal(t, len(taggedResourceNames), taggedResourceTypes)
+}
+
+func TestParseTaggedResourceArnUnsupported(t *testing.T) {
+	t.Parallel()
+
+	unsupportedArns := []string{
+		"not-an-arn",
+		"arn:aws:ec2:us-east-1:123456789012:subnet/subnet-0123456789abcdef0",
+		"arn:aws:ecs:us-east-1:123456789012:service/my-service",
+		"arn:aws:s3:::my-bucket",
+	}
+
+	for _, arn := range unsupportedArns {
+		_, err := parseTaggedResourceArn(arn)
+		assert.IsType(t, UnsupportedTaggedResourceError{}, err)
+	}
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// TimeFilter - the window of creation times a resource has to fall in to be nuked
+type TimeFilter struct {
+	// Only resources created before this time are nuked
+	ExcludeAfter time.Time
+	// Only resources created after this time are nuked. Ignored when zero.
+	IncludeAfter time.Time
+	// Where the creation times of the resources checked against the filter are recorded, keyed by identifier.
+	// Nothing is recorded when nil.
+	creationTimes map[string]time.Time
+}
+
+// Includes - Checks if a resource created at the given time falls within the window
+func (filter TimeFilter) Includes(createdAt time.Time) bool {
+	if !filter.IncludeAfter.IsZero() && !createdAt.After(filter.IncludeAfter) {
+		return false
+	}
+	return filter.ExcludeAfter.After(createdAt)
+}
+
+// IncludesResource - Checks if the resource with the given identifier, created at the given time, falls within the
+// window, and records its creation time
+func (filter TimeFilter) IncludesResource(identifier *string, createdAt time.Time) bool {
+	if filter.creationTimes != nil {
+		filter.creationTimes[awsgo.StringValue(identifier)] = createdAt
+	}
+	return filter.Includes(createdAt)
+}
+
+// IncludesFirstSeenResource - Checks if the resource with the given identifier, whose creation time is unknown, falls
+// within the window, given when cloud-nuke first saw it, and records that time. Any earlier run tags the resources it
+// sees, whoever created them, so the time they were first seen says nothing of whether they were created after
+// IncludeAfter, and they are left alone when it is set.
+func (filter TimeFilter) IncludesFirstSeenResource(identifier *string, firstSeen time.Time) bool {
+	if !filter.IncludeAfter.IsZero() {
+		if filter.creationTimes != nil {
+			filter.creationTimes[awsgo.StringValue(identifier)] = firstSeen
+		}
+		logging.Logger.Debugf("Skipping %s: its creation time is unknown, so it can't be told whether it's newer than --newer-than", awsgo.StringValue(identifier))
+		return false
+	}
+	return filter.IncludesResource(identifier, firstSeen)
+}
+
+// recordingCreationTimes - Returns a copy of the filter that records the creation times of the resources it's checked
+// against
+func (filter TimeFilter) recordingCreationTimes() TimeFilter {
+	filter.creationTimes = make(map[string]time.Time)
+	return filter
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFilterIncludes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	olderThanOneHour := TimeFilter{ExcludeAfter: now.Add(-1 * time.Hour)}
+	assert.True(t, olderThanOneHour.Includes(now.Add(-2*time.Hour)))
+	assert.False(t, olderThanOneHour.Includes(now.Add(-30*time.Minute)))
+
+	newerThanTwoHours := TimeFilter{ExcludeAfter: now, IncludeAfter: now.Add(-2 * time.Hour)}
+	assert.True(t, newerThanTwoHours.Includes(now.Add(-1*time.Hour)))
+	assert.False(t, newerThanTwoHours.Includes(now.Add(-3*time.Hour)))
+	assert.False(t, newerThanTwoHours.Includes(now.Add(time.Minute)))
+
+	window := TimeFilter{ExcludeAfter: now.Add(-1 * time.Hour), IncludeAfter: now.Add(-2 * time.Hour)}
+	assert.True(t, window.Includes(now.Add(-90*time.Minute)))
+	assert.False(t, window.Includes(now.Add(-30*time.Minute)))
+	assert.False(t, window.Includes(now.Add(-3*time.Hour)))
+}
+
+func TestTimeFilterIncludesResourceRecordsCreationTimes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	olderThanOneHour := TimeFilter{ExcludeAfter: now.Add(-1 * time.Hour)}
+
+	// Nothing is recorded unless asked for
+	assert.True(t, olderThanOneHour.IncludesResource(awsgo.String("old"), now.Add(-2*time.Hour)))
+	assert.Nil(t, olderThanOneHour.creationTimes)
+
+	recording := olderThanOneHour.recordingCreationTimes()
+	assert.True(t, recording.IncludesResource(awsgo.String("old"), now.Add(-2*time.Hour)))
+	assert.False(t, recording.IncludesResource(awsgo.String("new"), now.Add(-30*time.Minute)))
+	assert.Equal(t, map[string]time.Time{
+		"old": now.Add(-2 * time.Hour),
+		"new": now.Add(-30 * time.Minute),
+	}, recording.creationTimes)
+}
+
+func TestTimeFilterIncludesFirstSeenResource(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	olderThanOneHour := TimeFilter{ExcludeAfter: now.Add(-1 * time.Hour)}
+	assert.True(t, olderThanOneHour.IncludesFirstSeenResource(awsgo.String("old"), now.Add(-2*time.Hour)))
+	assert.False(t, olderThanOneHour.IncludesFirstSeenResource(awsgo.String("new"), now.Add(-30*time.Minute)))
+
+	// A years-old resource first seen by a run an hour ago can't be told apart from one created an hour ago
+	newerThanTwoHours := TimeFilter{ExcludeAfter: now, IncludeAfter: now.Add(-2 * time.Hour)}.recordingCreationTimes()
+	assert.False(t, newerThanTwoHours.IncludesFirstSeenResource(awsgo.String("eipalloc-1"), now.Add(-1*time.Hour)))
+	assert.Equal(t, map[string]time.Time{"eipalloc-1": now.Add(-1 * time.Hour)}, newerThanTwoHours.creationTimes)
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// NukeTimeouts - how long nuking the resources of a type in a region can take at most, e.g. so that an RDS deletion
+// stuck for an hour can't block the whole run. Once out of time, the resources left are recorded as failed and the
+// run moves on to the next resource type. Zero means no timeout.
+type NukeTimeouts struct {
+	// The timeout of the resource types without one of their own
+	Default time.Duration
+	// The timeouts of some resource types, keyed by resource type
+	PerResourceType map[string]time.Duration
+}
+
+// For - Returns the timeout of the given resource type
+func (timeouts NukeTimeouts) For(resourceType string) time.Duration {
+	if timeout, hasTimeout := timeouts.PerResourceType[resourceType]; hasTimeout {
+		return timeout
+	}
+	return timeouts.Default
+}
+
+// nukeBefore - Nukes the batch, waiting for its resources to be gone when wait is set, and gives up on it at the
+// deadline, or as soon as the context is cancelled. The batch gets its own session, bound to a context cancelled at the
+// deadline, so that the nuking of a batch given up on stops making AWS API calls rather than carrying on in the
+// background. A zero deadline means no timeout.
+func nukeBefore(ctx context.Context, resources AwsResources, session *session.Session, batch []string, region string, deadline time.Time, wait bool) error {
+	timeout := ResourceTypeTimeoutError{ResourceType: resources.ResourceName(), Region: region}
+	batchCtx := ctx
+	if !deadline.IsZero() {
+		if time.Until(deadline) <= 0 {
+			return timeout
+		}
+		var cancel context.CancelFunc
+		batchCtx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+	if session != nil {
+		session = withContext(session.Copy(), batchCtx)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- nukeAndWait(resources, session, batch, region, wait)
+	}()
+
+	// Once cancelled, the calls of the batch fail right away, but don't wait for the resource type to notice
+	var err error
+	select {
+	case err = <-done:
+	case <-batchCtx.Done():
+	}
+	if batchCtx.Err() == nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return interrupted(ctx)
+	}
+	return timeout
+}
+
+// ResourceTypeTimeoutError - returned when nuking the resources of a type in a region ran out of time
+type ResourceTypeTimeoutError struct {
+	ResourceType string
+	Region       string
+}
+
+func (e ResourceTypeTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out nuking %s resources in %s", e.ResourceType, e.Region)
+}
+
+// NukeTimedOutError - returned by NukeAllResources when nuking some resource types ran out of time
+type NukeTimedOutError struct {
+	// The resource types that ran out of time, along with the region, e.g. rdssnapshot in us-east-1
+	ResourceTypes []string
+}
+
+func (e NukeTimedOutError) Error() string {
+	return fmt.Sprintf("Timed out nuking these resource types, whose resources left were recorded as failed: %s", strings.Join(e.ResourceTypes, ", "))
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowResources - resources taking the given time to nuke
+type slowResources struct {
+	EC2Instances
+	nukeTime time.Duration
+}
+
+func (resources slowResources) Nuke(session *session.Session, identifiers []string) error {
+	time.Sleep(resources.nukeTime)
+	return nil
+}
+
+func TestNukeTimeoutsFor(t *testing.T) {
+	t.Parallel()
+
+	timeouts := NukeTimeouts{Default: 30 * time.Minute, PerResourceType: map[string]time.Duration{"rdssnapshot": time.Hour}}
+	assert.Equal(t, time.Hour, timeouts.For("rdssnapshot"))
+	assert.Equal(t, 30*time.Minute, timeouts.For("ec2"))
+	assert.Equal(t, time.Duration(0), NukeTimeouts{}.For("ec2"))
+}
+
+func TestNukeBefore(t *testing.T) {
+	t.Parallel()
+
+	timeout := ResourceTypeTimeoutError{ResourceType: "ec2", Region: "us-east-1"}
+	batch := []string{"i-0123456789abcdef0"}
+
+	assert.NoError(t, nukeBefore(context.Background(), slowResources{nukeTime: time.Millisecond}, nil, batch, "us-east-1", time.Time{}, false))
+	assert.NoError(t, nukeBefore(context.Background(), slowResources{nukeTime: time.Millisecond}, nil, batch, "us-east-1", time.Now().Add(time.Minute), false))
+	assert.Equal(t, timeout, nukeBefore(context.Background(), slowResources{nukeTime: time.Minute}, nil, batch, "us-east-1", time.Now().Add(10*time.Millisecond), false))
+	assert.Equal(t, timeout, nukeBefore(context.Background(), slowResources{nukeTime: time.Millisecond}, nil, batch, "us-east-1", time.Now().Add(-time.Second), false))
+}
+
+// callingResources - resources making an AWS API call once the given time has passed
+type callingResources struct {
+	EC2Instances
+	callAfter time.Duration
+	calls     chan error
+}
+
+func (resources callingResources) Nuke(session *session.Session, identifiers []string) error {
+	time.Sleep(resources.callAfter)
+	_, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	resources.calls <- err
+	return err
+}
+
+func TestNukeBeforeCancelsAbandonedBatch(t *testing.T) {
+	t.Parallel()
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region:      awsgo.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	require.NoError(t, err)
+	sent := false
+	session.Handlers.Send.PushFront(func(*request.Request) { sent = true })
+
+	resources := callingResources{callAfter: 50 * time.Millisecond, calls: make(chan error, 1)}
+	batch := []string{"i-0123456789abcdef0"}
+	timeout := ResourceTypeTimeoutError{ResourceType: "ec2", Region: "us-east-1"}
+	assert.Equal(t, timeout, nukeBefore(context.Background(), resources, session, batch, "us-east-1", time.Now().Add(10*time.Millisecond), false))
+
+	// The batch given up on carries on, but its calls fail right away instead of being sent
+	assert.True(t, isCanceled(<-resources.calls))
+	assert.False(t, sent)
+}
+
+func TestRunStopsAtMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	// Each resource type would take a minute in each region, well within its own timeout, but not within the run's
+	account := &AwsAccountResources{Resources: map[string]AwsRegionResource{}}
+	regions := []string{"us-east-1", "us-west-2", "eu-west-1"}
+	for _, region := range regions {
+		account.Resources[region] = AwsRegionResource{Resources: []AwsResources{
+			slowResources{EC2Instances: EC2Instances{InstanceIds: []string{"i-0123456789abcdef0"}}, nukeTime: time.Minute},
+			slowResources{EC2Instances: EC2Instances{InstanceIds: []string{"i-0fedcba9876543210"}}, nukeTime: time.Minute},
+		}}
+	}
+	timeouts := NukeTimeouts{Default: 30 * time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	startedAt := time.Now()
+	err := NukeAllResources(ctx, account, regions, false, timeouts, FailurePolicies{}, nil)
+	assert.Equal(t, InterruptedError{OutOfTime: true}, err)
+	assert.Less(t, int64(time.Since(startedAt)), int64(time.Second))
+}
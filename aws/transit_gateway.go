@@ -0,0 +1,372 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The transit gateway attachments cloud-nuke can delete. VPN and Direct Connect attachments go away along with the
+// VPN connection or Direct Connect gateway association they belong to.
+var deletableTransitGatewayAttachmentTypes = []string{
+	ec2.TransitGatewayAttachmentResourceTypeVpc,
+	ec2.TransitGatewayAttachmentResourceTypePeering,
+	ec2.TransitGatewayAttachmentResourceTypeConnect,
+}
+
+// Returns a formatted string of the ids of the transit gateways owned by the account. Gateways shared with the
+// account by others are left alone.
+func getAllTransitGateways(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	params := &ec2.DescribeTransitGatewaysInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String("owner-id"),
+				Values: []*string{accountId},
+			},
+			{
+				Name: awsgo.String("state"),
+				Values: awsgo.StringSlice([]string{
+					ec2.TransitGatewayStatePending,
+					ec2.TransitGatewayStateAvailable,
+					ec2.TransitGatewayStateModifying,
+				}),
+			},
+		},
+	}
+
+	var transitGatewayIds []*string
+	err = svc.DescribeTransitGatewaysPages(params, func(page *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool {
+		for _, transitGateway := range page.TransitGateways {
+			if timeFilter.IncludesResource(transitGateway.TransitGatewayId, *transitGateway.CreationTime) {
+				transitGatewayIds = append(transitGatewayIds, transitGateway.TransitGatewayId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return transitGatewayIds, nil
+}
+
+// Returns a formatted string of the ids of the transit gateway route tables. The default route table of a transit
+// gateway can't be deleted on its own, and goes away along with the gateway.
+func getAllTransitGatewayRouteTables(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	params := &ec2.DescribeTransitGatewayRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String("default-association-route-table"),
+				Values: []*string{awsgo.String("false")},
+			},
+			{
+				Name: awsgo.String("state"),
+				Values: awsgo.StringSlice([]string{
+					ec2.TransitGatewayRouteTableStatePending,
+					ec2.TransitGatewayRouteTableStateAvailable,
+				}),
+			},
+		},
+	}
+
+	var routeTableIds []*string
+	err := svc.DescribeTransitGatewayRouteTablesPages(params, func(page *ec2.DescribeTransitGatewayRouteTablesOutput, lastPage bool) bool {
+		for _, routeTable := range page.TransitGatewayRouteTables {
+			if timeFilter.IncludesResource(routeTable.TransitGatewayRouteTableId, *routeTable.CreationTime) {
+				routeTableIds = append(routeTableIds, routeTable.TransitGatewayRouteTableId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return routeTableIds, nil
+}
+
+// Returns a formatted string of the ids of the transit gateway attachments of the account's resources, for the
+// attachment types that can be deleted
+func getAllTransitGatewayAttachments(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	accountId, err := getAccountId(session)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	params := &ec2.DescribeTransitGatewayAttachmentsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String("resource-owner-id"),
+				Values: []*string{accountId},
+			},
+			{
+				Name:   awsgo.String("resource-type"),
+				Values: awsgo.StringSlice(deletableTransitGatewayAttachmentTypes),
+			},
+			{
+				Name: awsgo.String("state"),
+				Values: awsgo.StringSlice([]string{
+					ec2.TransitGatewayAttachmentStatePendingAcceptance,
+					ec2.TransitGatewayAttachmentStatePending,
+					ec2.TransitGatewayAttachmentStateAvailable,
+					ec2.TransitGatewayAttachmentStateModifying,
+					ec2.TransitGatewayAttachmentStateFailed,
+					ec2.TransitGatewayAttachmentStateRejected,
+				}),
+			},
+		},
+	}
+
+	var attachmentIds []*string
+	err = svc.DescribeTransitGatewayAttachmentsPages(params, func(page *ec2.DescribeTransitGatewayAttachmentsOutput, lastPage bool) bool {
+		for _, attachment := range page.TransitGatewayAttachments {
+			if timeFilter.IncludesResource(attachment.TransitGatewayAttachmentId, *attachment.CreationTime) {
+				attachmentIds = append(attachmentIds, attachment.TransitGatewayAttachmentId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return attachmentIds, nil
+}
+
+// deleteTransitGatewayAttachment deletes the attachment through the API matching its type
+func deleteTransitGatewayAttachment(svc *ec2.EC2, attachment *ec2.TransitGatewayAttachment) error {
+	var err error
+	switch awsgo.StringValue(attachment.ResourceType) {
+	case ec2.TransitGatewayAttachmentResourceTypeVpc:
+		_, err = svc.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+			TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+		})
+	case ec2.TransitGatewayAttachmentResourceTypePeering:
+		_, err = svc.DeleteTransitGatewayPeeringAttachment(&ec2.DeleteTransitGatewayPeeringAttachmentInput{
+			TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+		})
+	case ec2.TransitGatewayAttachmentResourceTypeConnect:
+		_, err = svc.DeleteTransitGatewayConnect(&ec2.DeleteTransitGatewayConnectInput{
+			TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+		})
+	}
+	return err
+}
+
+// transitGatewayAttachmentExists checks if the attachment is still around, i.e. not deleted yet
+func transitGatewayAttachmentExists(svc *ec2.EC2, attachmentId string) (bool, error) {
+	output, err := svc.DescribeTransitGatewayAttachments(&ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: []*string{awsgo.String(attachmentId)},
+	})
+	if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidTransitGatewayAttachmentID.NotFound" {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	for _, attachment := range output.TransitGatewayAttachments {
+		if awsgo.StringValue(attachment.State) != ec2.TransitGatewayAttachmentStateDeleted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Deletes all transit gateway attachments, and waits for them to be gone, as neither the route tables they are
+// associated with nor their transit gateway can be deleted before
+func nukeAllTransitGatewayAttachments(session *session.Session, attachmentIds []*string) error {
+	svc := ec2.New(session)
+
+	if len(attachmentIds) == 0 {
+		logging.Logger.Infof("No transit gateway attachments to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all transit gateway attachments in region %s", *session.Config.Region)
+
+	output, err := svc.DescribeTransitGatewayAttachments(&ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: attachmentIds,
+	})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	var deletedAttachmentIds []*string
+	for _, attachment := range output.TransitGatewayAttachments {
+		err := deleteTransitGatewayAttachment(svc, attachment)
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidTransitGatewayAttachmentID.NotFound" {
+				logging.Logger.Infof("Transit gateway attachment %s has already been deleted", *attachment.TransitGatewayAttachmentId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedAttachmentIds = append(deletedAttachmentIds, attachment.TransitGatewayAttachmentId)
+		}
+	}
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(deletedAttachmentIds), func(attachmentId string) (bool, error) {
+		return transitGatewayAttachmentExists(svc, attachmentId)
+	})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	for _, attachmentId := range awsgo.StringValueSlice(deletedAttachmentIds) {
+		if collections.ListContainsElement(remaining, attachmentId) {
+			logging.Logger.Errorf("[Failed] Timed out waiting for transit gateway attachment %s to be deleted", attachmentId)
+		} else {
+			logging.Logger.Infof("Deleted transit gateway attachment: %s", attachmentId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d of %d transit gateway attachment(s) deleted in %s", len(deletedAttachmentIds)-len(remaining), len(attachmentIds), *session.Config.Region)
+	return nil
+}
+
+// transitGatewayRouteTableExists checks if the route table is still around, i.e. not deleted yet
+func transitGatewayRouteTableExists(svc *ec2.EC2, routeTableId string) (bool, error) {
+	output, err := svc.DescribeTransitGatewayRouteTables(&ec2.DescribeTransitGatewayRouteTablesInput{
+		TransitGatewayRouteTableIds: []*string{awsgo.String(routeTableId)},
+	})
+	if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidRouteTableID.NotFound" {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	for _, routeTable := range output.TransitGatewayRouteTables {
+		if awsgo.StringValue(routeTable.State) != ec2.TransitGatewayRouteTableStateDeleted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Deletes all transit gateway route tables, and waits for them to be gone, as their transit gateway can't be deleted
+// before
+func nukeAllTransitGatewayRouteTables(session *session.Session, routeTableIds []*string) error {
+	svc := ec2.New(session)
+
+	if len(routeTableIds) == 0 {
+		logging.Logger.Infof("No transit gateway route tables to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all transit gateway route tables in region %s", *session.Config.Region)
+
+	var deletedRouteTableIds []*string
+	for _, routeTableId := range routeTableIds {
+		_, err := svc.DeleteTransitGatewayRouteTable(&ec2.DeleteTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: routeTableId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidRouteTableID.NotFound" {
+				logging.Logger.Infof("Transit gateway route table %s has already been deleted", *routeTableId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedRouteTableIds = append(deletedRouteTableIds, routeTableId)
+		}
+	}
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(deletedRouteTableIds), func(routeTableId string) (bool, error) {
+		return transitGatewayRouteTableExists(svc, routeTableId)
+	})
+	if err != nil {
+		logging.Logger.Errorf("[Failed] %s", err)
+		return errors.WithStackTrace(err)
+	}
+
+	for _, routeTableId := range awsgo.StringValueSlice(deletedRouteTableIds) {
+		if collections.ListContainsElement(remaining, routeTableId) {
+			logging.Logger.Errorf("[Failed] Timed out waiting for transit gateway route table %s to be deleted", routeTableId)
+		} else {
+			logging.Logger.Infof("Deleted transit gateway route table: %s", routeTableId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d of %d transit gateway route table(s) deleted in %s", len(deletedRouteTableIds)-len(remaining), len(routeTableIds), *session.Config.Region)
+	return nil
+}
+
+// Deletes all transit gateways. Their attachments and route tables have to be deleted first.
+func nukeAllTransitGateways(session *session.Session, transitGatewayIds []*string) error {
+	svc := ec2.New(session)
+
+	if len(transitGatewayIds) == 0 {
+		logging.Logger.Infof("No transit gateways to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all transit gateways in region %s", *session.Config.Region)
+	var deletedTransitGatewayIds []*string
+
+	for _, transitGatewayId := range transitGatewayIds {
+		_, err := svc.DeleteTransitGateway(&ec2.DeleteTransitGatewayInput{
+			TransitGatewayId: transitGatewayId,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidTransitGatewayID.NotFound" {
+				logging.Logger.Infof("Transit gateway %s has already been deleted", *transitGatewayId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedTransitGatewayIds = append(deletedTransitGatewayIds, transitGatewayId)
+			logging.Logger.Infof("Deleted transit gateway: %s", *transitGatewayId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d transit gateway(s) deleted in %s", len(deletedTransitGatewayIds), *session.Config.Region)
+	return nil
+}
+
+// Waits until the given transit gateways have actually been deleted
+func waitUntilTransitGatewaysDeleted(session *session.Session, transitGatewayIds []*string) error {
+	svc := ec2.New(session)
+
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(transitGatewayIds), func(transitGatewayId string) (bool, error) {
+		output, err := svc.DescribeTransitGateways(&ec2.DescribeTransitGatewaysInput{
+			TransitGatewayIds: []*string{awsgo.String(transitGatewayId)},
+		})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidTransitGatewayID.NotFound" {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		for _, transitGateway := range output.TransitGateways {
+			if awsgo.StringValue(transitGateway.State) != ec2.TransitGatewayStateDeleted {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(remaining) > 0 {
+		return ResourcesNotDeletedError{ResourceType: TransitGateways{}.ResourceName(), Identifiers: remaining}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestTransitGateway creates a transit gateway with a route table, and attaches a default subnet to it
+func createTestTransitGateway(t *testing.T, session *session.Session, name string) (string, string, string) {
+	svc := ec2.New(session)
+
+	result, err := svc.CreateTransitGateway(&ec2.CreateTransitGatewayInput{
+		Description: awsgo.String(name),
+	})
+	require.NoError(t, err)
+	transitGatewayId := result.TransitGateway.TransitGatewayId
+
+	// The gateway can only be attached to, and given route tables, once it is available
+	for i := 0; ; i++ {
+		output, err := svc.DescribeTransitGateways(&ec2.DescribeTransitGatewaysInput{
+			TransitGatewayIds: []*string{transitGatewayId},
+		})
+		require.NoError(t, err)
+		if awsgo.StringValue(output.TransitGateways[0].State) == ec2.TransitGatewayStateAvailable {
+			break
+		}
+		require.True(t, i < 60, "Timed out waiting for transit gateway %s to be available", *transitGatewayId)
+		time.Sleep(10 * time.Second)
+	}
+
+	routeTable, err := svc.CreateTransitGatewayRouteTable(&ec2.CreateTransitGatewayRouteTableInput{
+		TransitGatewayId: transitGatewayId,
+	})
+	require.NoError(t, err)
+
+	subnet, _ := getSubnetsInDifferentAZs(t, session)
+	attachment, err := svc.CreateTransitGatewayVpcAttachment(&ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: transitGatewayId,
+		VpcId:            subnet.VpcId,
+		SubnetIds:        []*string{subnet.SubnetId},
+	})
+	require.NoError(t, err)
+
+	return *transitGatewayId, *routeTable.TransitGatewayRouteTable.TransitGatewayRouteTableId, *attachment.TransitGatewayVpcAttachment.TransitGatewayAttachmentId
+}
+
+// nukeTestTransitGateway deletes the transit gateway along with its attachment and route table, in dependency order
+func nukeTestTransitGateway(session *session.Session, transitGatewayId string, routeTableId string, attachmentId string) error {
+	if err := nukeAllTransitGatewayAttachments(session, []*string{awsgo.String(attachmentId)}); err != nil {
+		return err
+	}
+	if err := nukeAllTransitGatewayRouteTables(session, []*string{awsgo.String(routeTableId)}); err != nil {
+		return err
+	}
+	return nukeAllTransitGateways(session, []*string{awsgo.String(transitGatewayId)})
+}
+
+func TestListTransitGateways(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	transitGatewayId, routeTableId, attachmentId := createTestTransitGateway(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeTestTransitGateway(session, transitGatewayId, routeTableId, attachmentId)
+
+	olderThan := TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)}
+	newerThan := TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}
+
+	transitGatewayIds, err := getAllTransitGateways(session, olderThan)
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(transitGatewayIds), transitGatewayId)
+	transitGatewayIds, err = getAllTransitGateways(session, newerThan)
+	require.NoError(t, err)
+	assert.Contains(t, awsgo.StringValueSlice(transitGatewayIds), transitGatewayId)
+
+	routeTableIds, err := getAllTransitGatewayRouteTables(session, olderThan)
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(routeTableIds), routeTableId)
+	routeTableIds, err = getAllTransitGatewayRouteTables(session, newerThan)
+	require.NoError(t, err)
+	assert.Contains(t, awsgo.StringValueSlice(routeTableIds), routeTableId)
+
+	attachmentIds, err := getAllTransitGatewayAttachments(session, olderThan)
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(attachmentIds), attachmentId)
+	attachmentIds, err = getAllTransitGatewayAttachments(session, newerThan)
+	require.NoError(t, err)
+	assert.Contains(t, awsgo.StringValueSlice(attachmentIds), attachmentId)
+}
+
+func TestNukeTransitGateways(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	transitGatewayId, routeTableId, attachmentId := createTestTransitGateway(t, session, uniqueTestID)
+
+	if err := nukeTestTransitGateway(session, transitGatewayId, routeTableId, attachmentId); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+	require.NoError(t, waitUntilTransitGatewaysDeleted(session, []*string{awsgo.String(transitGatewayId)}))
+
+	newerThan := TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)}
+
+	attachmentIds, err := getAllTransitGatewayAttachments(session, newerThan)
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(attachmentIds), attachmentId)
+
+	routeTableIds, err := getAllTransitGatewayRouteTables(session, newerThan)
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(routeTableIds), routeTableId)
+
+	transitGatewayIds, err := getAllTransitGateways(session, newerThan)
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(transitGatewayIds), transitGatewayId)
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked before the route tables they are associated with, the transit gateways, and the VPCs they live in
+	registerResourceType(awsResourceType{
+		resource: TransitGatewayAttachments{},
+		weight:   6400,
+		actions: []string{
+			"ec2:DeleteTransitGatewayVpcAttachment",
+			"ec2:DeleteTransitGatewayPeeringAttachment",
+			"ec2:DeleteTransitGatewayConnect",
+		},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			attachmentIds, err := getAllTransitGatewayAttachments(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return TransitGatewayAttachments{AttachmentIds: awsgo.StringValueSlice(attachmentIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: TransitGatewayRouteTables{},
+		weight:   6500,
+		actions:  []string{"ec2:DeleteTransitGatewayRouteTable"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			routeTableIds, err := getAllTransitGatewayRouteTables(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return TransitGatewayRouteTables{RouteTableIds: awsgo.StringValueSlice(routeTableIds)}, nil
+		},
+	})
+
+	registerResourceType(awsResourceType{
+		resource: TransitGateways{},
+		weight:   6600,
+		actions:  []string{"ec2:DeleteTransitGateway"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			transitGatewayIds, err := getAllTransitGateways(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return TransitGateways{TransitGatewayIds: awsgo.StringValueSlice(transitGatewayIds)}, nil
+		},
+	})
+}
+
+// TransitGatewayAttachments - represents all transit gateway attachments
+type TransitGatewayAttachments struct {
+	AttachmentIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (attachments TransitGatewayAttachments) ResourceName() string {
+	return "transitgatewayattachment"
+}
+
+// ResourceIdentifiers - The ids of the transit gateway attachments
+func (attachments TransitGatewayAttachments) ResourceIdentifiers() []string {
+	return attachments.AttachmentIds
+}
+
+func (attachments TransitGatewayAttachments) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (attachments TransitGatewayAttachments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllTransitGatewayAttachments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// TransitGatewayRouteTables - represents all transit gateway route tables
+type TransitGatewayRouteTables struct {
+	RouteTableIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (routeTables TransitGatewayRouteTables) ResourceName() string {
+	return "transitgatewayroutetable"
+}
+
+// ResourceIdentifiers - The ids of the transit gateway route tables
+func (routeTables TransitGatewayRouteTables) ResourceIdentifiers() []string {
+	return routeTables.RouteTableIds
+}
+
+func (routeTables TransitGatewayRouteTables) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (routeTables TransitGatewayRouteTables) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllTransitGatewayRouteTables(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// TransitGateways - represents all transit gateways
+type TransitGateways struct {
+	TransitGatewayIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (transitGateways TransitGateways) ResourceName() string {
+	return "transitgateway"
+}
+
+// ResourceIdentifiers - The ids of the transit gateways
+func (transitGateways TransitGateways) ResourceIdentifiers() []string {
+	return transitGateways.TransitGatewayIds
+}
+
+func (transitGateways TransitGateways) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (transitGateways TransitGateways) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllTransitGateways(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WaitUntilNuked - the deletion completes asynchronously, so wait for it
+func (transitGateways TransitGateways) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	if err := waitUntilTransitGatewaysDeleted(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
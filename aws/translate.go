@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The regions that support Translate. Refer to
+// https://docs.aws.amazon.com/general/latest/gr/translate-service.html
+var translateRegions = []string{
+	"ap-east-1",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-north-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+}
+
+// translateSupportedRegion returns true if the provided region supports Translate
+func translateSupportedRegion(region string) bool {
+	return collections.ListContainsElement(translateRegions, region)
+}
+
+// Returns a formatted string of Translate custom terminology names
+func getAllTranslateTerminologies(session *session.Session, timeFilter TimeFilter) ([]*string, error) {
+	svc := translate.New(session)
+
+	var names []*string
+	err := svc.ListTerminologiesPages(
+		&translate.ListTerminologiesInput{},
+		func(page *translate.ListTerminologiesOutput, lastPage bool) bool {
+			for _, terminology := range page.TerminologyPropertiesList {
+				if timeFilter.IncludesResource(terminology.Name, *terminology.CreatedAt) {
+					names = append(names, terminology.Name)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return names, nil
+}
+
+// Deletes all Translate custom terminologies
+func nukeAllTranslateTerminologies(session *session.Session, names []*string) error {
+	svc := translate.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Infof("No Translate terminologies to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all Translate terminologies in region %s", *session.Config.Region)
+	var deletedNames []*string
+
+	for _, name := range names {
+		_, err := svc.DeleteTerminology(&translate.DeleteTerminologyInput{
+			Name: name,
+		})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == translate.ErrCodeResourceNotFoundException {
+				logging.Logger.Infof("Translate terminology %s has already been deleted", *name)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Infof("Deleted Translate terminology: %s", *name)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Translate terminology(ies) deleted in %s", len(deletedNames), *session.Config.Region)
+	return nil
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	terraAws "github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTranslateTerminology(t *testing.T, session *session.Session, name string) string {
+	svc := translate.New(session)
+	_, err := svc.ImportTerminology(&translate.ImportTerminologyInput{
+		Name:          awsgo.String(name),
+		MergeStrategy: awsgo.String(translate.MergeStrategyOverwrite),
+		TerminologyData: &translate.TerminologyData{
+			File:   []byte("en,fr\ncloud-nuke,cloud-nuke\n"),
+			Format: awsgo.String(translate.TerminologyDataFormatCsv),
+		},
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestListTranslateTerminologies(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, translateRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestTranslateTerminology(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllTranslateTerminologies(session, []*string{awsgo.String(name)})
+
+	names, err := getAllTranslateTerminologies(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Translate terminologies")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+
+	names, err = getAllTranslateTerminologies(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Translate terminologies")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(names), name)
+}
+
+func TestNukeTranslateTerminologies(t *testing.T) {
+	t.Parallel()
+
+	region := terraAws.GetRandomRegion(t, translateRegions, []string{})
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	name := createTestTranslateTerminology(t, session, uniqueTestID)
+
+	if err := nukeAllTranslateTerminologies(session, []*string{awsgo.String(name)}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	names, err := getAllTranslateTerminologies(session, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Translate terminologies")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(names), name)
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	registerResourceType(awsResourceType{
+		resource: TranslateTerminologies{},
+		weight:   5600,
+		actions:  []string{"translate:DeleteTerminology"},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			if !translateSupportedRegion(discovery.region) {
+				return nil, nil
+			}
+			names, err := getAllTranslateTerminologies(discovery.session, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return TranslateTerminologies{Names: awsgo.StringValueSlice(names)}, nil
+		},
+	})
+}
+
+// TranslateTerminologies - represents all Translate custom terminologies
+type TranslateTerminologies struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (terminologies TranslateTerminologies) ResourceName() string {
+	return "translateterminology"
+}
+
+// ResourceIdentifiers - The names of the Translate custom terminologies
+func (terminologies TranslateTerminologies) ResourceIdentifiers() []string {
+	return terminologies.Names
+}
+
+func (terminologies TranslateTerminologies) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (terminologies TranslateTerminologies) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllTranslateTerminologies(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 )
 
@@ -15,6 +17,37 @@ type AwsResources interface {
 	Nuke(session *session.Session, identifiers []string) error
 }
 
+// AsyncAwsResources - resource types whose Nuke only requests the deletion of the resources, and returns before they
+// are actually gone. Resource types that don't implement it wait for their deletions to complete in Nuke.
+type AsyncAwsResources interface {
+	AwsResources
+	// WaitUntilNuked - Polls until the resources with the given identifiers are gone
+	WaitUntilNuked(session *session.Session, identifiers []string) error
+}
+
+// SkippedResource - a resource found in a region but left alone, along with why, e.g. an EC2 instance with termination
+// protection enabled
+type SkippedResource struct {
+	ResourceType string
+	Identifier   string
+	Reason       string
+}
+
+// skippingAwsResources - resource types that leave some of the resources they find alone, which are recorded in the
+// report of the run rather than only logged
+type skippingAwsResources interface {
+	AwsResources
+	// SkippedResources - The resources found but left out of the ones to nuke
+	SkippedResources() []SkippedResource
+}
+
 type AwsRegionResource struct {
 	Resources []AwsResources
+	// The resources found but left alone, as they can't be nuked as things stand
+	Skipped []SkippedResource
+	// The creation times of the resources, keyed by identifier. Resource types that don't know when their resources
+	// were created leave them out.
+	CreationTimes map[string]time.Time
+	// How long discovering the resources of each type in the region took, keyed by resource type
+	DiscoveryTimes map[string]time.Duration
 }
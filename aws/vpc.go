@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns a formatted string of VPC ids. Default VPCs are included, and left out by the default exclusions unless
+// they are explicitly targeted.
+func getAllVpcs(session *session.Session, region string, timeFilter TimeFilter) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var vpcs []*ec2.Vpc
+	err := svc.DescribeVpcsPages(&ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		vpcs = append(vpcs, page.Vpcs...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var vpcIds []*string
+	for _, vpc := range vpcs {
+		// A VPC doesn't contain an attribute that gives us it's creation time, so we rely on a first seen tag
+		firstSeenTime, err := getFirstSeenTimeFromTags(vpc.Tags, firstSeenTagKey, firstSeenTagLayout)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if firstSeenTime == nil {
+			now := time.Now().UTC()
+			firstSeenTime = &now
+			if err := setFirstSeenResourceTag(svc, vpc.VpcId, firstSeenTagKey, *firstSeenTime, firstSeenTagLayout); err != nil {
+				return nil, err
+			}
+		}
+
+		if timeFilter.IncludesFirstSeenResource(vpc.VpcId, *firstSeenTime) {
+			vpcIds = append(vpcIds, vpc.VpcId)
+		}
+	}
+
+	return vpcIds, nil
+}
+
+// Deletes all VPCs along with the resources they contain
+func nukeAllVpcs(session *session.Session, vpcIds []*string) error {
+	svc := ec2.New(session)
+	lambdaSvc := lambda.New(session)
+
+	if len(vpcIds) == 0 {
+		logging.Logger.Infof("No VPCs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Infof("Deleting all VPCs in region %s", *session.Config.Region)
+	var deletedVpcIds []*string
+
+	for _, vpcId := range vpcIds {
+		vpc := Vpc{
+			Region:    *session.Config.Region,
+			VpcId:     awsgo.StringValue(vpcId),
+			svc:       svc,
+			lambdaSvc: lambdaSvc,
+		}
+
+		if err := vpc.nukeNonDefault(); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedVpcIds = append(deletedVpcIds, vpcId)
+			logging.Logger.Infof("Deleted VPC: %s", *vpcId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d VPC(s) deleted in %s", len(deletedVpcIds), *session.Config.Region)
+	return nil
+}
+
+func (v Vpc) nukePeeringConnections() error {
+	// A VPC can be on either side of a peering connection, and filters are ANDed, so look up both sides separately
+	var peeringConnectionIds []*string
+	seen := map[string]bool{}
+	for _, filterName := range []string{"requester-vpc-info.vpc-id", "accepter-vpc-info.vpc-id"} {
+		result, err := v.svc.DescribeVpcPeeringConnections(
+			&ec2.DescribeVpcPeeringConnectionsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   awsgo.String(filterName),
+						Values: []*string{awsgo.String(v.VpcId)},
+					},
+					{
+						Name:   awsgo.String("status-code"),
+						Values: awsgo.StringSlice([]string{"pending-acceptance", "provisioning", "active"}),
+					},
+				},
+			},
+		)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, peeringConnection := range result.VpcPeeringConnections {
+			peeringConnectionId := awsgo.StringValue(peeringConnection.VpcPeeringConnectionId)
+			if !seen[peeringConnectionId] {
+				seen[peeringConnectionId] = true
+				peeringConnectionIds = append(peeringConnectionIds, peeringConnection.VpcPeeringConnectionId)
+			}
+		}
+	}
+
+	for _, peeringConnectionId := range peeringConnectionIds {
+		logging.Logger.Infof("...deleting VPC peering connection %s", awsgo.StringValue(peeringConnectionId))
+		_, err := v.svc.DeleteVpcPeeringConnection(
+			&ec2.DeleteVpcPeeringConnectionInput{
+				VpcPeeringConnectionId: peeringConnectionId,
+			},
+		)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
+
+func (v Vpc) describeVpcEndpointIds() ([]*string, error) {
+	result, err := v.svc.DescribeVpcEndpoints(
+		&ec2.DescribeVpcEndpointsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   awsgo.String("vpc-id"),
+					Values: []*string{awsgo.String(v.VpcId)},
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var endpointIds []*string
+	for _, endpoint := range result.VpcEndpoints {
+		if awsgo.StringValue(endpoint.State) != "deleted" {
+			endpointIds = append(endpointIds, endpoint.VpcEndpointId)
+		}
+	}
+	return endpointIds, nil
+}
+
+func (v Vpc) nukeEndpoints() error {
+	endpointIds, err := v.describeVpcEndpointIds()
+	if err != nil {
+		return err
+	}
+	if len(endpointIds) == 0 {
+		return nil
+	}
+
+	for _, endpointId := range endpointIds {
+		logging.Logger.Infof("...deleting VPC endpoint %s", awsgo.StringValue(endpointId))
+	}
+	_, err = v.svc.DeleteVpcEndpoints(
+		&ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: endpointIds,
+		},
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	// Interface endpoints hold on to network interfaces in the subnets until they are fully deleted
+	remaining, err := waitUntilDeleted(awsgo.StringValueSlice(endpointIds), func(endpointId string) (bool, error) {
+		result, err := v.svc.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			VpcEndpointIds: []*string{awsgo.String(endpointId)},
+		})
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "InvalidVpcEndpointId.NotFound" {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return len(result.VpcEndpoints) > 0 && awsgo.StringValue(result.VpcEndpoints[0].State) != "deleted", nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		return errors.WithStackTrace(VpcEndpointsNotDeletedError{VpcId: v.VpcId})
+	}
+	return nil
+}
+
+// remainingDependents returns the resources left in the VPC that block deleting it, and that nuking the VPC doesn't
+// remove: the ones cloud-nuke kept, e.g. because they are protected, excluded, filtered out or of a resource type that
+// isn't selected, along with the ones it doesn't manage. They all hold network interfaces in the VPC, other than the
+// interfaces of its endpoints, deleted along with it, and of the Lambda functions that are gone, which AWS reclaims.
+func (v Vpc) remainingDependents() ([]string, error) {
+	var networkInterfaces []*ec2.NetworkInterface
+	err := v.svc.DescribeNetworkInterfacesPages(
+		&ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   awsgo.String("vpc-id"),
+					Values: []*string{awsgo.String(v.VpcId)},
+				},
+			},
+		},
+		func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			networkInterfaces = append(networkInterfaces, page.NetworkInterfaces...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var dependents []string
+	for _, networkInterface := range networkInterfaces {
+		if awsgo.StringValue(networkInterface.InterfaceType) == ec2.NetworkInterfaceTypeVpcEndpoint {
+			continue
+		}
+		if lambdaFunctionName(networkInterface) != "" {
+			if err := checkLambdaFunctionDetached(v.lambdaSvc, networkInterface, "VPC "+v.VpcId); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		dependents = append(dependents, vpcDependent(networkInterface))
+	}
+	return dependents, nil
+}
+
+// vpcDependent - Describes the resource holding the network interface, for as far as the network interface tells
+func vpcDependent(networkInterface *ec2.NetworkInterface) string {
+	networkInterfaceId := awsgo.StringValue(networkInterface.NetworkInterfaceId)
+	if networkInterface.Attachment != nil && networkInterface.Attachment.InstanceId != nil {
+		return fmt.Sprintf("instance %s (network interface %s)", awsgo.StringValue(networkInterface.Attachment.InstanceId), networkInterfaceId)
+	}
+	if description := awsgo.StringValue(networkInterface.Description); description != "" {
+		return fmt.Sprintf("%s (network interface %s)", description, networkInterfaceId)
+	}
+	return "network interface " + networkInterfaceId
+}
+
+// waitForLambdaNetworkInterfaces waits for AWS to reclaim the network interfaces of the Lambda functions that were
+// attached to the VPC, which block deleting its subnets and security groups
+func (v Vpc) waitForLambdaNetworkInterfaces() error {
+	filter := &ec2.Filter{Name: awsgo.String("vpc-id"), Values: []*string{awsgo.String(v.VpcId)}}
+	_, err := waitForLambdaNetworkInterfaces(v.svc, v.lambdaSvc, filter, "VPC "+v.VpcId)
+	return err
+}
+
+// nukeNonDefault tears down the resources that default VPCs don't have, but that block deleting any other VPC,
+// before nuking the VPC itself the same way as a default VPC. Nothing is touched when the VPC still holds resources
+// that it can't be deleted with, as they'd keep it around anyway. The NAT gateways and unattached network interfaces
+// in it are left to their own resource types, along with their filters and exclusions.
+func (v Vpc) nukeNonDefault() error {
+	dependents, err := v.remainingDependents()
+	if err != nil {
+		logging.Logger.Errorf("Error looking up the resources left in VPC %s: %s", v.VpcId, err.Error())
+		return err
+	}
+	if len(dependents) > 0 {
+		return errors.WithStackTrace(VpcHasDependentsError{VpcId: v.VpcId, Dependents: dependents})
+	}
+
+	err = v.nukePeeringConnections()
+	if err != nil {
+		logging.Logger.Errorf("Error cleaning up Peering Connections for VPC %s: %s", v.VpcId, err.Error())
+		return err
+	}
+
+	err = v.nukeEndpoints()
+	if err != nil {
+		logging.Logger.Errorf("Error cleaning up Endpoints for VPC %s: %s", v.VpcId, err.Error())
+		return err
+	}
+
+	err = v.waitForLambdaNetworkInterfaces()
+	if err != nil {
+		logging.Logger.Errorf("Error waiting for the Lambda network interfaces of VPC %s: %s", v.VpcId, err.Error())
+		return err
+	}
+
+	return v.nuke()
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestVpc creates a VPC with a subnet, a route table and an attached Internet Gateway, so that nuking it
+// exercises the dependency order
+func createTestVpc(t *testing.T, session *session.Session, name string) ec2.Vpc {
+	svc := ec2.New(session)
+
+	vpcResult, err := svc.CreateVpc(&ec2.CreateVpcInput{
+		CidrBlock: awsgo.String("10.0.0.0/16"),
+	})
+	require.NoError(t, err)
+	vpcId := vpcResult.Vpc.VpcId
+
+	err = svc.WaitUntilVpcAvailable(&ec2.DescribeVpcsInput{
+		VpcIds: []*string{vpcId},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{vpcId},
+		Tags: []*ec2.Tag{
+			{
+				Key:   awsgo.String("Name"),
+				Value: awsgo.String(name),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateSubnet(&ec2.CreateSubnetInput{
+		CidrBlock: awsgo.String("10.0.0.0/24"),
+		VpcId:     vpcId,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateRouteTable(&ec2.CreateRouteTableInput{
+		VpcId: vpcId,
+	})
+	require.NoError(t, err)
+
+	igwResult, err := svc.CreateInternetGateway(&ec2.CreateInternetGatewayInput{})
+	require.NoError(t, err)
+
+	_, err = svc.AttachInternetGateway(&ec2.AttachInternetGatewayInput{
+		InternetGatewayId: igwResult.InternetGateway.InternetGatewayId,
+		VpcId:             vpcId,
+	})
+	require.NoError(t, err)
+
+	return *vpcResult.Vpc
+}
+
+func TestListNonDefaultVpcs(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	vpc := createTestVpc(t, session, uniqueTestID)
+	// clean up after this test
+	defer nukeAllVpcs(session, []*string{vpc.VpcId})
+
+	vpcIds, err := getAllVpcs(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour * -1)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of VPCs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(vpcIds), awsgo.StringValue(vpc.VpcId))
+
+	vpcIds, err = getAllVpcs(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of VPCs")
+	}
+
+	assert.Contains(t, awsgo.StringValueSlice(vpcIds), awsgo.StringValue(vpc.VpcId))
+}
+
+func TestNukeNonDefaultVpcs(t *testing.T) {
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	session, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+
+	if err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	uniqueTestID := "cloud-nuke-test-" + util.UniqueID()
+	vpc := createTestVpc(t, session, uniqueTestID)
+
+	if err := nukeAllVpcs(session, []*string{vpc.VpcId}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	vpcIds, err := getAllVpcs(session, region, TimeFilter{ExcludeAfter: time.Now().Add(1 * time.Hour)})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of VPCs")
+	}
+
+	assert.NotContains(t, awsgo.StringValueSlice(vpcIds), awsgo.StringValue(vpc.VpcId))
+}
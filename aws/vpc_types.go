@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+func init() {
+	// Nuked last, as everything else that lives in a VPC has to be gone before the VPC can be deleted
+	registerResourceType(awsResourceType{
+		resource: VPCs{},
+		weight:   6900,
+		actions: []string{
+			"ec2:CreateTags",
+			"ec2:RevokeSecurityGroupIngress",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:DeleteSecurityGroup",
+			"ec2:DeleteVpcEndpoints",
+			"ec2:DeleteVpcPeeringConnection",
+			"ec2:DetachInternetGateway",
+			"ec2:DeleteInternetGateway",
+			"ec2:DeleteSubnet",
+			"ec2:DeleteRouteTable",
+			"ec2:DeleteNetworkAcl",
+			"ec2:DeleteVpc",
+		},
+		getAll: func(discovery regionDiscovery) (AwsResources, error) {
+			vpcIds, err := getAllVpcs(discovery.session, discovery.region, discovery.timeFilter)
+			if err != nil {
+				return nil, err
+			}
+			return VPCs{VpcIds: awsgo.StringValueSlice(vpcIds)}, nil
+		},
+		fromTagged: func(resources []taggedResource) AwsResources {
+			return VPCs{VpcIds: taggedIdentifiers(resources)}
+		},
+	})
+}
+
+// VPCs - represents all VPCs
+type VPCs struct {
+	VpcIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (vpc VPCs) ResourceName() string {
+	return "vpc"
+}
+
+// ResourceIdentifiers - The ids of the VPCs
+func (vpc VPCs) ResourceIdentifiers() []string {
+	return vpc.VpcIds
+}
+
+func (vpc VPCs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 200
+}
+
+// Nuke - nuke 'em all!!!
+func (vpc VPCs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllVpcs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// VpcEndpointsNotDeletedError - returned when the endpoints of a VPC are still around after waiting for them
+type VpcEndpointsNotDeletedError struct {
+	VpcId string
+}
+
+func (e VpcEndpointsNotDeletedError) Error() string {
+	return fmt.Sprintf("Timed out waiting for the endpoints of VPC %s to be deleted", e.VpcId)
+}
+
+// VpcHasDependentsError - returned when a VPC still holds resources that nuking it doesn't remove
+type VpcHasDependentsError struct {
+	VpcId      string
+	Dependents []string
+}
+
+func (e VpcHasDependentsError) Error() string {
+	return fmt.Sprintf("VPC %s is left alone, as it still holds resources that cloud-nuke isn't nuking: %s", e.VpcId, strings.Join(e.Dependents, ", "))
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How often, and how many times, to check whether asynchronous deletions have completed. Gives up after an hour.
+var waitPollInterval = 15 * time.Second
+
+const waitMaxAttempts = 240
+
+// asAsync - Returns the resources as AsyncAwsResources, when their resource type deletes them asynchronously
+func asAsync(resources AwsResources) (AsyncAwsResources, bool) {
+	// The default exclusions wrap the resources they filter, hiding the methods that aren't part of AwsResources
+	if excluded, isExcluded := resources.(excludedResources); isExcluded {
+		resources = excluded.AwsResources
+	}
+	asyncResources, isAsync := resources.(AsyncAwsResources)
+	return asyncResources, isAsync
+}
+
+// waitUntilDeleted - Polls until none of the resources with the given identifiers exist anymore, as told by exists.
+// Returns the identifiers of the resources that are still around when giving up.
+func waitUntilDeleted(identifiers []string, exists func(identifier string) (bool, error)) ([]string, error) {
+	remaining := identifiers
+	for attempt := 0; attempt < waitMaxAttempts; attempt++ {
+		var stillExisting []string
+		for _, identifier := range remaining {
+			found, err := exists(identifier)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if found {
+				stillExisting = append(stillExisting, identifier)
+			}
+		}
+
+		remaining = stillExisting
+		if len(remaining) == 0 {
+			return nil, nil
+		}
+		time.Sleep(waitPollInterval)
+	}
+
+	return remaining, nil
+}
+
+// nukeAndWait - Nukes the batch and, when wait is set and the resource type deletes its resources asynchronously, waits
+// for them to actually be gone, so that the resource types nuked next don't trip over them
+func nukeAndWait(resources AwsResources, session *session.Session, batch []string, region string, wait bool) error {
+	if err := resources.Nuke(session, batch); err != nil {
+		return err
+	}
+	asyncResources, isAsync := asAsync(resources)
+	if !wait || !isAsync {
+		return nil
+	}
+
+	logging.Logger.Infof("Waiting for %d %s resource(s) to be deleted in %s", len(batch), resources.ResourceName(), region)
+	if err := asyncResources.WaitUntilNuked(session, batch); err != nil {
+		return err
+	}
+	logging.Logger.Infof("[OK] %d %s resource(s) gone in %s", len(batch), resources.ResourceName(), region)
+	return nil
+}
+
+// logAsyncResourceTypes - Lists the resource types among the given resources whose deletions may still be in progress
+func logAsyncResourceTypes(account *AwsAccountResources) {
+	asyncResourceTypes := map[string]bool{}
+	for _, resourcesInRegion := range account.Resources {
+		for _, resources := range resourcesInRegion.Resources {
+			if _, isAsync := asAsync(resources); isAsync && len(resources.ResourceIdentifiers()) > 0 {
+				asyncResourceTypes[resources.ResourceName()] = true
+			}
+		}
+	}
+	if len(asyncResourceTypes) == 0 {
+		return
+	}
+
+	var resourceTypes []string
+	for resourceType := range asyncResourceTypes {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	logging.Logger.Infof("The deletion of these resource types completes asynchronously, and may still be in progress, as --no-wait is set: %s", strings.Join(resourceTypes, ", "))
+}
+
+// ResourcesNotDeletedError - returned when resources are still around after waiting for their deletion to complete
+type ResourcesNotDeletedError struct {
+	ResourceType string
+	Identifiers  []string
+}
+
+func (e ResourcesNotDeletedError) Error() string {
+	return fmt.Sprintf("Timed out waiting for %s resources to be deleted: %s", e.ResourceType, strings.Join(e.Identifiers, ", "))
+}
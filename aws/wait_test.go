@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitUntilDeletedPollsUntilGone(t *testing.T) {
+	waitPollInterval = 0
+
+	polls := map[string]int{}
+	remaining, err := waitUntilDeleted([]string{"a", "b"}, func(identifier string) (bool, error) {
+		polls[identifier]++
+		// a is gone right away, b only on the third poll
+		return identifier == "b" && polls[identifier] < 3, nil
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, remaining)
+	assert.Equal(t, 1, polls["a"])
+	assert.Equal(t, 3, polls["b"])
+}
+
+func TestWaitUntilDeletedGivesUp(t *testing.T) {
+	waitPollInterval = 0
+
+	remaining, err := waitUntilDeleted([]string{"a", "b"}, func(identifier string) (bool, error) {
+		return identifier == "b", nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b"}, remaining)
+}
+
+func TestAsyncResourceTypes(t *testing.T) {
+	_, isAsync := asAsync(KinesisStreams{})
+	assert.True(t, isAsync)
+
+	_, isAsync = asAsync(excludedResources{AwsResources: GrafanaWorkspaces{}})
+	assert.True(t, isAsync)
+
+	_, isAsync = asAsync(TransitGateways{})
+	assert.True(t, isAsync)
+
+	_, isAsync = asAsync(EKSClusters{})
+	assert.False(t, isAsync)
+}
+
+// asyncResources - resources deleted asynchronously, recording the batches waited for
+type asyncResources struct {
+	KinesisStreams
+	waitedFor *[][]string
+}
+
+func (resources asyncResources) Nuke(session *session.Session, identifiers []string) error {
+	return nil
+}
+
+func (resources asyncResources) WaitUntilNuked(session *session.Session, identifiers []string) error {
+	*resources.waitedFor = append(*resources.waitedFor, identifiers)
+	return nil
+}
+
+func TestNukeAndWait(t *testing.T) {
+	var waitedFor [][]string
+	resources := asyncResources{waitedFor: &waitedFor}
+
+	require.NoError(t, nukeAndWait(resources, nil, []string{"a", "b"}, "us-east-1", true))
+	assert.Equal(t, [][]string{{"a", "b"}}, waitedFor)
+
+	// Without waiting, the deletions are only requested
+	require.NoError(t, nukeAndWait(resources, nil, []string{"c"}, "us-east-1", false))
+	assert.Equal(t, [][]string{{"a", "b"}}, waitedFor)
+
+	// The resources deleted synchronously are gone once nuked
+	require.NoError(t, nukeAndWait(slowResources{}, nil, []string{"d"}, "us-east-1", true))
+}
@@ -0,0 +1,193 @@
+package azure
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/progress"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Session - the credentials and subscription all Azure API calls are made with
+type Session struct {
+	SubscriptionID string
+	Credential     azcore.TokenCredential
+}
+
+// NewSession - Creates a session for the subscription set in AZURE_SUBSCRIPTION_ID, using the default Azure credential
+// chain (environment variables, managed identity, Azure CLI login)
+func NewSession() (*Session, error) {
+	subscriptionID := os.Getenv("AZURE_SUBSCRIPTION_ID")
+	if subscriptionID == "" {
+		return nil, errors.WithStackTrace(MissingSubscriptionError{})
+	}
+
+	credential, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return &Session{
+		SubscriptionID: subscriptionID,
+		Credential:     credential,
+	}, nil
+}
+
+// Azure reports locations in different forms depending on the API (e.g. "West Europe" or "westeurope"), so compare
+// them in their canonical lower case form without spaces
+func normalizeLocation(location string) string {
+	return strings.ToLower(strings.Replace(location, " ", "", -1))
+}
+
+func split(identifiers []string, limit int) [][]string {
+	if limit < 0 {
+		limit = -1 * limit
+	} else if limit == 0 {
+		return [][]string{identifiers}
+	}
+
+	var chunk []string
+	chunks := make([][]string, 0, len(identifiers)/limit+1)
+	for len(identifiers) >= limit {
+		chunk, identifiers = identifiers[:limit], identifiers[limit:]
+		chunks = append(chunks, chunk)
+	}
+	if len(identifiers) > 0 {
+		chunks = append(chunks, identifiers[:len(identifiers)])
+	}
+
+	return chunks
+}
+
+// GetAllResources - Lists all azure resources, grouped by location
+func GetAllResources(session *Session, excludedLocations []string, excludeAfter time.Time, resourceTypes []string, excludeResourceTypes []string) (*AzureAccountResources, error) {
+	account := AzureAccountResources{
+		Resources: make(map[string]AzureLocationResource),
+	}
+
+	var normalizedExcludedLocations []string
+	for _, location := range excludedLocations {
+		normalizedExcludedLocations = append(normalizedExcludedLocations, normalizeLocation(location))
+	}
+
+	// The Azure APIs list resources across the whole subscription, so we list each resource type once and group the
+	// results by location afterwards
+	// The order in which resources are nuked is important
+	// because of dependencies between resources
+
+	// Virtual Machines
+	if IsNukeable(VirtualMachines{}.ResourceName(), resourceTypes, excludeResourceTypes) {
+		vmIdsByLocation, err := getAllVirtualMachines(session, excludeAfter)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for location, vmIds := range vmIdsByLocation {
+			if collections.ListContainsElement(normalizedExcludedLocations, location) {
+				continue
+			}
+			resourcesInLocation := account.Resources[location]
+			resourcesInLocation.Resources = append(resourcesInLocation.Resources, VirtualMachines{Ids: vmIds})
+			account.Resources[location] = resourcesInLocation
+		}
+	}
+	// End Virtual Machines
+
+	// Resource Groups
+	// Nuked last, since deleting a resource group deletes everything in it
+	if IsNukeable(ResourceGroups{}.ResourceName(), resourceTypes, excludeResourceTypes) {
+		groupNamesByLocation, err := getAllResourceGroups(session, excludeAfter)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for location, groupNames := range groupNamesByLocation {
+			if collections.ListContainsElement(normalizedExcludedLocations, location) {
+				continue
+			}
+			resourcesInLocation := account.Resources[location]
+			resourcesInLocation.Resources = append(resourcesInLocation.Resources, ResourceGroups{Names: groupNames})
+			account.Resources[location] = resourcesInLocation
+		}
+	}
+	// End Resource Groups
+
+	for _, location := range excludedLocations {
+		logging.Logger.Infoln("Skipping location: " + location)
+	}
+
+	return &account, nil
+}
+
+// ListResourceTypes - Returns list of resources which can be passed to --resource-type
+func ListResourceTypes() []string {
+	resourceTypes := []string{
+		VirtualMachines{}.ResourceName(),
+		ResourceGroups{}.ResourceName(),
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+// IsValidResourceType - Checks if a resourceType is valid or not
+func IsValidResourceType(resourceType string, allResourceTypes []string) bool {
+	return collections.ListContainsElement(allResourceTypes, resourceType)
+}
+
+// IsNukeable - Checks if we should nuke a resource or not. Resource types in excludeResourceTypes are never nuked,
+// even when they are selected in resourceTypes or with "all".
+func IsNukeable(resourceType string, resourceTypes []string, excludeResourceTypes []string) bool {
+	if collections.ListContainsElement(excludeResourceTypes, resourceType) {
+		return false
+	}
+	if len(resourceTypes) == 0 ||
+		collections.ListContainsElement(resourceTypes, "all") ||
+		collections.ListContainsElement(resourceTypes, resourceType) {
+		return true
+	}
+	return false
+}
+
+// NukeAllResources - Nukes all azure resources
+func NukeAllResources(session *Session, account *AzureAccountResources, reporter *progress.Reporter) error {
+	total := 0
+	for _, resourcesInLocation := range account.Resources {
+		for _, resources := range resourcesInLocation.Resources {
+			total += len(resources.ResourceIdentifiers())
+		}
+	}
+	reporter.Start(total)
+
+	for location, resourcesInLocation := range account.Resources {
+		logging.Logger.Infoln("Nuking location: " + location)
+
+		for _, resources := range resourcesInLocation.Resources {
+			length := len(resources.ResourceIdentifiers())
+
+			// Split api calls into batches
+			logging.Logger.Infof("Terminating %d resources in batches", length)
+			batches := split(resources.ResourceIdentifiers(), resources.MaxBatchSize())
+
+			for i := 0; i < len(batches); i++ {
+				batch := batches[i]
+				if err := resources.Nuke(session, batch); err != nil {
+					// The nuking stops at the first failure, so which resources of the batch are gone is unknown
+					reporter.Advance(resources.ResourceName(), location, len(batch), len(batch))
+					return errors.WithStackTrace(err)
+				}
+				reporter.Advance(resources.ResourceName(), location, len(batch), 0)
+
+				if i != len(batches)-1 {
+					logging.Logger.Info("Sleeping for 10 seconds before processing next batch...")
+					time.Sleep(10 * time.Second)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package azure
+
+// MissingSubscriptionError - returned when no subscription to nuke has been configured
+type MissingSubscriptionError struct{}
+
+func (err MissingSubscriptionError) Error() string {
+	return "No Azure subscription specified. Set the AZURE_SUBSCRIPTION_ID environment variable to the subscription to nuke."
+}
@@ -0,0 +1,142 @@
+package azure
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+const firstSeenTagKey = "cloud-nuke-first-seen"
+const firstSeenTagLayout = "2006-01-02 15:04:05"
+
+// Returns the names of all resource groups in the subscription, grouped by location
+func getAllResourceGroups(session *Session, excludeAfter time.Time) (map[string][]string, error) {
+	client, err := armresources.NewResourceGroupsClient(session.SubscriptionID, session.Credential, nil)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	tagsClient, err := armresources.NewTagsClient(session.SubscriptionID, session.Credential, nil)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	groupNames := map[string][]string{}
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, group := range page.Value {
+			// Managed resource groups (e.g. the node resource group of an AKS cluster) can't be deleted directly,
+			// they go away along with the resource that manages them
+			if group.ManagedBy != nil {
+				continue
+			}
+			if group.Properties != nil && group.Properties.ProvisioningState != nil && *group.Properties.ProvisioningState == "Deleting" {
+				continue
+			}
+
+			// A resource group doesn't contain an attribute that gives us its creation time, so we rely on a
+			// first seen tag
+			firstSeenTime, err := getFirstSeenTime(group.Tags)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			if firstSeenTime == nil {
+				now := time.Now().UTC()
+				firstSeenTime = &now
+				if err := setFirstSeenTag(tagsClient, *group.ID, now); err != nil {
+					return nil, err
+				}
+			}
+
+			if excludeAfter.After(*firstSeenTime) {
+				location := normalizeLocation(*group.Location)
+				groupNames[location] = append(groupNames[location], *group.Name)
+			}
+		}
+	}
+
+	return groupNames, nil
+}
+
+func getFirstSeenTime(tags map[string]*string) (*time.Time, error) {
+	value, ok := tags[firstSeenTagKey]
+	if !ok || value == nil {
+		return nil, nil
+	}
+
+	firstSeenTime, err := time.Parse(firstSeenTagLayout, *value)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &firstSeenTime, nil
+}
+
+func setFirstSeenTag(tagsClient *armresources.TagsClient, resourceId string, value time.Time) error {
+	// Merge, so that the other tags on the resource are kept
+	_, err := tagsClient.UpdateAtScope(context.Background(), resourceId, armresources.TagsPatchResource{
+		Operation: to.Ptr(armresources.TagsPatchOperationMerge),
+		Properties: &armresources.Tags{
+			Tags: map[string]*string{
+				firstSeenTagKey: to.Ptr(value.Format(firstSeenTagLayout)),
+			},
+		},
+	}, nil)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// Deletes all resource groups, along with all the resources in them
+func nukeAllResourceGroups(session *Session, groupNames []string) error {
+	if len(groupNames) == 0 {
+		logging.Logger.Infof("No resource groups to nuke in subscription %s", session.SubscriptionID)
+		return nil
+	}
+
+	client, err := armresources.NewResourceGroupsClient(session.SubscriptionID, session.Credential, nil)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Deleting all resource groups in subscription %s", session.SubscriptionID)
+
+	// Deleting a resource group is a long running operation, so start deleting the whole batch before waiting on any
+	// of them
+	pollers := map[string]*runtime.Poller[armresources.ResourceGroupsClientDeleteResponse]{}
+	for _, groupName := range groupNames {
+		poller, err := client.BeginDelete(context.Background(), groupName, nil)
+		if err != nil {
+			if isNotFoundErr(err) {
+				logging.Logger.Infof("Resource group %s has already been deleted", groupName)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+			continue
+		}
+		pollers[groupName] = poller
+	}
+
+	var deletedGroupNames []string
+	for groupName, poller := range pollers {
+		if _, err := poller.PollUntilDone(context.Background(), nil); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedGroupNames = append(deletedGroupNames, groupName)
+			logging.Logger.Infof("Deleted resource group: %s", groupName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d resource group(s) deleted in subscription %s", len(deletedGroupNames), session.SubscriptionID)
+	return nil
+}
@@ -0,0 +1,64 @@
+package azure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLocation = "westeurope"
+
+func createTestResourceGroup(t *testing.T, session *Session, name string) {
+	client, err := armresources.NewResourceGroupsClient(session.SubscriptionID, session.Credential, nil)
+	require.NoError(t, err)
+
+	_, err = client.CreateOrUpdate(context.Background(), name, armresources.ResourceGroup{
+		Location: to.Ptr(testLocation),
+	}, nil)
+	require.NoError(t, err)
+}
+
+func TestListResourceGroups(t *testing.T) {
+	t.Parallel()
+
+	session, err := NewSession()
+	require.NoError(t, err)
+
+	groupName := "cloud-nuke-test-" + util.UniqueID()
+	createTestResourceGroup(t, session, groupName)
+	// clean up after this test
+	defer nukeAllResourceGroups(session, []string{groupName})
+
+	groupNames, err := getAllResourceGroups(session, time.Now().Add(1*time.Hour*-1))
+	require.NoError(t, err)
+
+	assert.NotContains(t, groupNames[testLocation], groupName)
+
+	groupNames, err = getAllResourceGroups(session, time.Now().Add(1*time.Hour))
+	require.NoError(t, err)
+
+	assert.Contains(t, groupNames[testLocation], groupName)
+}
+
+func TestNukeResourceGroups(t *testing.T) {
+	t.Parallel()
+
+	session, err := NewSession()
+	require.NoError(t, err)
+
+	groupName := "cloud-nuke-test-" + util.UniqueID()
+	createTestResourceGroup(t, session, groupName)
+
+	require.NoError(t, nukeAllResourceGroups(session, []string{groupName}))
+
+	groupNames, err := getAllResourceGroups(session, time.Now().Add(1*time.Hour))
+	require.NoError(t, err)
+
+	assert.NotContains(t, groupNames[testLocation], groupName)
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// ResourceGroups - represents all resource groups
+type ResourceGroups struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the azure resource
+func (groups ResourceGroups) ResourceName() string {
+	return "resourcegroup"
+}
+
+// ResourceIdentifiers - The names of the resource groups
+func (groups ResourceGroups) ResourceIdentifiers() []string {
+	return groups.Names
+}
+
+func (groups ResourceGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure Azure doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (groups ResourceGroups) Nuke(session *Session, identifiers []string) error {
+	if err := nukeAllResourceGroups(session, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
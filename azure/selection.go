@@ -0,0 +1,43 @@
+package azure
+
+// selectedResources - the resources of a type that are left after deselecting some of them
+type selectedResources struct {
+	AzureResources
+	identifiers []string
+}
+
+// ResourceIdentifiers - The identifiers that are still selected
+func (resources selectedResources) ResourceIdentifiers() []string {
+	return resources.identifiers
+}
+
+// FilterResources - Returns the resources for which keep returns true, e.g. to leave out the resources deselected
+// before nuking. Resource types without any resource left are dropped, as are locations without any resource type
+// left.
+func FilterResources(account *AzureAccountResources, keep func(location string, resourceType string, identifier string) bool) *AzureAccountResources {
+	filtered := AzureAccountResources{Resources: map[string]AzureLocationResource{}}
+
+	for location, resourcesInLocation := range account.Resources {
+		filteredLocation := AzureLocationResource{}
+		for _, resources := range resourcesInLocation.Resources {
+			var identifiers []string
+			for _, identifier := range resources.ResourceIdentifiers() {
+				if keep(location, resources.ResourceName(), identifier) {
+					identifiers = append(identifiers, identifier)
+				}
+			}
+			if len(identifiers) == 0 {
+				continue
+			}
+			if len(identifiers) < len(resources.ResourceIdentifiers()) {
+				resources = selectedResources{AzureResources: resources, identifiers: identifiers}
+			}
+			filteredLocation.Resources = append(filteredLocation.Resources, resources)
+		}
+		if len(filteredLocation.Resources) > 0 {
+			filtered.Resources[location] = filteredLocation
+		}
+	}
+
+	return &filtered
+}
@@ -0,0 +1,29 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterResources(t *testing.T) {
+	t.Parallel()
+
+	account := &AzureAccountResources{
+		Resources: map[string]AzureLocationResource{
+			"westeurope": {Resources: []AzureResources{ResourceGroups{Names: []string{"rg-1", "rg-2"}}, VirtualMachines{Ids: []string{"vm-1"}}}},
+			"eastus":     {Resources: []AzureResources{ResourceGroups{Names: []string{"rg-3"}}}},
+		},
+	}
+
+	filtered := FilterResources(account, func(location string, resourceType string, identifier string) bool {
+		return identifier == "rg-1"
+	})
+
+	require.Len(t, filtered.Resources, 1)
+	selected := filtered.Resources["westeurope"].Resources
+	require.Len(t, selected, 1)
+	assert.Equal(t, ResourceGroups{}.ResourceName(), selected[0].ResourceName())
+	assert.Equal(t, []string{"rg-1"}, selected[0].ResourceIdentifiers())
+}
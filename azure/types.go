@@ -0,0 +1,16 @@
+package azure
+
+type AzureAccountResources struct {
+	Resources map[string]AzureLocationResource
+}
+
+type AzureResources interface {
+	ResourceName() string
+	ResourceIdentifiers() []string
+	MaxBatchSize() int
+	Nuke(session *Session, identifiers []string) error
+}
+
+type AzureLocationResource struct {
+	Resources []AzureResources
+}
@@ -0,0 +1,103 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Returns the resource ids of all virtual machines in the subscription, grouped by location
+func getAllVirtualMachines(session *Session, excludeAfter time.Time) (map[string][]string, error) {
+	client, err := armcompute.NewVirtualMachinesClient(session.SubscriptionID, session.Credential, nil)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	vmIds := map[string][]string{}
+	pager := client.NewListAllPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, vm := range page.Value {
+			// VMs created before Azure started recording the creation time don't have one, and we can't tell how
+			// old they are
+			if vm.Properties == nil || vm.Properties.TimeCreated == nil {
+				logging.Logger.Debugf("Skipping virtual machine %s, its creation time is unknown", *vm.ID)
+				continue
+			}
+
+			if excludeAfter.After(*vm.Properties.TimeCreated) {
+				location := normalizeLocation(*vm.Location)
+				vmIds[location] = append(vmIds[location], *vm.ID)
+			}
+		}
+	}
+
+	return vmIds, nil
+}
+
+// Deletes all virtual machines
+func nukeAllVirtualMachines(session *Session, vmIds []string) error {
+	if len(vmIds) == 0 {
+		logging.Logger.Infof("No virtual machines to nuke in subscription %s", session.SubscriptionID)
+		return nil
+	}
+
+	client, err := armcompute.NewVirtualMachinesClient(session.SubscriptionID, session.Credential, nil)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Deleting all virtual machines in subscription %s", session.SubscriptionID)
+
+	// Deleting a VM is a long running operation, so start deleting the whole batch before waiting on any of them
+	pollers := map[string]*runtime.Poller[armcompute.VirtualMachinesClientDeleteResponse]{}
+	for _, vmId := range vmIds {
+		resourceId, err := arm.ParseResourceID(vmId)
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			continue
+		}
+
+		poller, err := client.BeginDelete(context.Background(), resourceId.ResourceGroupName, resourceId.Name, nil)
+		if err != nil {
+			if isNotFoundErr(err) {
+				logging.Logger.Infof("Virtual machine %s has already been deleted", vmId)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+			}
+			continue
+		}
+		pollers[vmId] = poller
+	}
+
+	var deletedVmIds []string
+	for vmId, poller := range pollers {
+		if _, err := poller.PollUntilDone(context.Background(), nil); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+		} else {
+			deletedVmIds = append(deletedVmIds, vmId)
+			logging.Logger.Infof("Deleted virtual machine: %s", vmId)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d virtual machine(s) deleted in subscription %s", len(deletedVmIds), session.SubscriptionID)
+	return nil
+}
+
+func isNotFoundErr(err error) bool {
+	if responseErr, isResponseErr := err.(*azcore.ResponseError); isResponseErr {
+		return responseErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
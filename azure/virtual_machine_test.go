@@ -0,0 +1,128 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The API version of the network resources the test virtual machines are attached to, which are created as generic
+// resources
+const testNetworkAPIVersion = "2022-07-01"
+
+// createTestNetworkResource - Creates the network resource of the given type and name in the resource group, and
+// returns its id
+func createTestNetworkResource(t *testing.T, session *Session, groupName string, resourceType string, name string, properties map[string]interface{}) string {
+	client, err := armresources.NewClient(session.SubscriptionID, session.Credential, nil)
+	require.NoError(t, err)
+
+	resourceId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/%s/%s", session.SubscriptionID, groupName, resourceType, name)
+	poller, err := client.BeginCreateOrUpdateByID(context.Background(), resourceId, testNetworkAPIVersion, armresources.GenericResource{
+		Location:   to.Ptr(testLocation),
+		Properties: properties,
+	}, nil)
+	require.NoError(t, err)
+	_, err = poller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	return resourceId
+}
+
+// createTestVirtualMachine - Creates the smallest Linux virtual machine, along with the network it is attached to, in
+// the resource group, and returns its id
+func createTestVirtualMachine(t *testing.T, session *Session, groupName string, name string) string {
+	networkId := createTestNetworkResource(t, session, groupName, "virtualNetworks", name, map[string]interface{}{
+		"addressSpace": map[string]interface{}{"addressPrefixes": []string{"10.0.0.0/16"}},
+		"subnets": []map[string]interface{}{
+			{"name": "default", "properties": map[string]interface{}{"addressPrefix": "10.0.0.0/24"}},
+		},
+	})
+	networkInterfaceId := createTestNetworkResource(t, session, groupName, "networkInterfaces", name, map[string]interface{}{
+		"ipConfigurations": []map[string]interface{}{
+			{"name": "default", "properties": map[string]interface{}{"subnet": map[string]interface{}{"id": networkId + "/subnets/default"}}},
+		},
+	})
+
+	client, err := armcompute.NewVirtualMachinesClient(session.SubscriptionID, session.Credential, nil)
+	require.NoError(t, err)
+
+	poller, err := client.BeginCreateOrUpdate(context.Background(), groupName, name, armcompute.VirtualMachine{
+		Location: to.Ptr(testLocation),
+		Properties: &armcompute.VirtualMachineProperties{
+			HardwareProfile: &armcompute.HardwareProfile{
+				VMSize: to.Ptr(armcompute.VirtualMachineSizeTypesStandardB1S),
+			},
+			StorageProfile: &armcompute.StorageProfile{
+				ImageReference: &armcompute.ImageReference{
+					Publisher: to.Ptr("Canonical"),
+					Offer:     to.Ptr("0001-com-ubuntu-server-jammy"),
+					SKU:       to.Ptr("22_04-lts-gen2"),
+					Version:   to.Ptr("latest"),
+				},
+				OSDisk: &armcompute.OSDisk{
+					CreateOption: to.Ptr(armcompute.DiskCreateOptionTypesFromImage),
+					DeleteOption: to.Ptr(armcompute.DiskDeleteOptionTypesDelete),
+				},
+			},
+			OSProfile: &armcompute.OSProfile{
+				ComputerName:  to.Ptr(name),
+				AdminUsername: to.Ptr("cloudnuke"),
+				AdminPassword: to.Ptr("Cn-" + util.UniqueID() + "-7x"),
+			},
+			NetworkProfile: &armcompute.NetworkProfile{
+				NetworkInterfaces: []*armcompute.NetworkInterfaceReference{{ID: to.Ptr(networkInterfaceId)}},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	vm, err := poller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	return *vm.ID
+}
+
+func TestListVirtualMachines(t *testing.T) {
+	t.Parallel()
+
+	session, err := NewSession()
+	require.NoError(t, err)
+
+	groupName := "cloud-nuke-test-" + util.UniqueID()
+	createTestResourceGroup(t, session, groupName)
+	// clean up after this test
+	defer nukeAllResourceGroups(session, []string{groupName})
+	vmId := createTestVirtualMachine(t, session, groupName, "cloud-nuke-test-"+util.UniqueID())
+
+	vmIds, err := getAllVirtualMachines(session, time.Now().Add(1*time.Hour*-1))
+	require.NoError(t, err)
+	assert.NotContains(t, vmIds[testLocation], vmId)
+
+	vmIds, err = getAllVirtualMachines(session, time.Now().Add(1*time.Hour))
+	require.NoError(t, err)
+	assert.Contains(t, vmIds[testLocation], vmId)
+}
+
+func TestNukeVirtualMachines(t *testing.T) {
+	t.Parallel()
+
+	session, err := NewSession()
+	require.NoError(t, err)
+
+	groupName := "cloud-nuke-test-" + util.UniqueID()
+	createTestResourceGroup(t, session, groupName)
+	// clean up the network the virtual machine was attached to
+	defer nukeAllResourceGroups(session, []string{groupName})
+	vmId := createTestVirtualMachine(t, session, groupName, "cloud-nuke-test-"+util.UniqueID())
+
+	require.NoError(t, nukeAllVirtualMachines(session, []string{vmId}))
+
+	vmIds, err := getAllVirtualMachines(session, time.Now().Add(1*time.Hour))
+	require.NoError(t, err)
+	assert.NotContains(t, vmIds[testLocation], vmId)
+}
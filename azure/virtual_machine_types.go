@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// VirtualMachines - represents all virtual machines
+type VirtualMachines struct {
+	Ids []string
+}
+
+// ResourceName - the simple name of the azure resource
+func (vms VirtualMachines) ResourceName() string {
+	return "vm"
+}
+
+// ResourceIdentifiers - The resource ids of the virtual machines
+func (vms VirtualMachines) ResourceIdentifiers() []string {
+	return vms.Ids
+}
+
+func (vms VirtualMachines) MaxBatchSize() int {
+	// Tentative batch size to ensure Azure doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (vms VirtualMachines) Nuke(session *Session, identifiers []string) error {
+	if err := nukeAllVirtualMachines(session, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/metrics"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// nukeAwsAccounts - Nukes the accounts reached by assuming each of the roles in turn. A failure in one account doesn't
+// stop the others from being nuked; the accounts that failed are returned as an error once all of them are done. The
+// resources found in each account are written to --output-json as a single combined report. The deadline is shared by
+// all the accounts.
+func nukeAwsAccounts(c *cli.Context, cfg config.AWS, resourceTypes []string, excludeResourceTypes []string, host *aws.HostResources, run *metrics.Run, deadline time.Time) error {
+	combined := report.NewCombined("aws")
+	var failedRoleArns []string
+	for _, roleArn := range cfg.RoleArns {
+		logging.Logger.Infof("Assuming role %s", roleArn)
+
+		var accountReport *report.Report
+		err := aws.AssumeRole(roleArn)
+		if err == nil {
+			err = nukeAwsAccount(c, cfg, resourceTypes, excludeResourceTypes, host, run, deadline, func(runReport *report.Report) error {
+				accountReport = runReport
+				return nil
+			})
+		}
+		combined.AddAccount(roleArn, accountReport, err)
+
+		if err != nil {
+			logging.Logger.Errorf("[Failed] role %s: %s", roleArn, err)
+			failedRoleArns = append(failedRoleArns, roleArn)
+			continue
+		}
+		logging.Logger.Infof("[OK] role %s", roleArn)
+	}
+
+	// Go back to the credentials cloud-nuke was started with
+	if err := aws.AssumeRole(""); err != nil {
+		return err
+	}
+
+	logging.Logger.Infof("%d of %d account(s) nuked", len(cfg.RoleArns)-len(failedRoleArns), len(cfg.RoleArns))
+	if c.IsSet("output-json") {
+		if err := combined.WriteJSON(c.String("output-json")); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	if len(failedRoleArns) > 0 {
+		return errors.WithStackTrace(AccountsNotNukedError{RoleArns: failedRoleArns})
+	}
+	return nil
+}
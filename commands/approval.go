@@ -0,0 +1,226 @@
+package commands
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ed25519"
+)
+
+// plannedResource - a resource of the plan to approve. Scope is the account, subscription or project it belongs to.
+type plannedResource struct {
+	Scope        string `json:"scope"`
+	Location     string `json:"location"`
+	ResourceType string `json:"resource_type"`
+	Identifier   string `json:"identifier"`
+}
+
+// planDigest - Returns the digest of a plan, i.e. a hash over the cloud and the resources it nukes, which is what the
+// approvers sign. The digest changes as soon as the plan does, so an approval can't be reused for another plan, nor
+// for the same resources in another account.
+func planDigest(cloud string, plan []plannedResource) string {
+	sortedPlan := append([]plannedResource{}, plan...)
+	sort.Slice(sortedPlan, func(i, j int) bool {
+		return fmt.Sprint(sortedPlan[i]) < fmt.Sprint(sortedPlan[j])
+	})
+
+	// Encoded as JSON, so that the fields can't run into each other whatever characters they hold
+	contents, _ := json.Marshal(struct {
+		Cloud     string            `json:"cloud"`
+		Resources []plannedResource `json:"resources"`
+	}{cloud, sortedPlan})
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:])
+}
+
+// planReport - Returns the plan to approve the way --output-plan writes it, for the clouds whose runs don't write a
+// report of their own. Each resource records its subscription or project, as the run can span several.
+func planReport(cloud string, plan []plannedResource) *report.Report {
+	planReport := report.New(cloud)
+	for _, resource := range plan {
+		planReport.Resources = append(planReport.Resources, report.Resource{
+			Scope:        resource.Scope,
+			ResourceType: resource.ResourceType,
+			Identifier:   resource.Identifier,
+			Region:       resource.Location,
+		})
+	}
+	return planReport
+}
+
+// plannedResourcesOf - Returns the resources of the plan written with --output-plan at the given path, the way their
+// digest is computed. The resources of an AWS plan belong to the account of the plan.
+func plannedResourcesOf(path string, planReport *report.Report) ([]plannedResource, error) {
+	var plan []plannedResource
+	for _, resource := range planReport.Resources {
+		scope := resource.Scope
+		if scope == "" && planReport.Account != nil {
+			scope = planReport.Account.ID
+		}
+		if scope == "" {
+			return nil, InvalidPlanError{Path: path, Reason: fmt.Sprintf("it doesn't tell which account, subscription or project %s %s belongs to", resource.ResourceType, resource.Identifier)}
+		}
+		plan = append(plan, plannedResource{Scope: scope, Location: resource.Region, ResourceType: resource.ResourceType, Identifier: resource.Identifier})
+	}
+	return plan, nil
+}
+
+// approvalToken - Returns the approval token of the plan with the given digest, i.e. its signature with the private
+// key of an approver
+func approvalToken(privateKey ed25519.PrivateKey, digest string) string {
+	return hex.EncodeToString(ed25519.Sign(privateKey, []byte(digest)))
+}
+
+// isApproved - Checks if the token is the signature of the plan with the given digest by one of the approvers
+func isApproved(publicKeys []ed25519.PublicKey, digest string, token string) bool {
+	signature, err := hex.DecodeString(token)
+	if err != nil {
+		return false
+	}
+	for _, publicKey := range publicKeys {
+		if ed25519.Verify(publicKey, []byte(digest), signature) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseApproverPublicKeys - Decodes the public keys of the approvers given with --approver-public-key
+func parseApproverPublicKeys(values []string) ([]ed25519.PublicKey, error) {
+	var publicKeys []ed25519.PublicKey
+	for _, value := range values {
+		publicKey, err := hex.DecodeString(strings.TrimSpace(value))
+		if err != nil || len(publicKey) != ed25519.PublicKeySize {
+			return nil, InvalidFlagError{Name: "approver-public-key", Value: value}
+		}
+		publicKeys = append(publicKeys, ed25519.PublicKey(publicKey))
+	}
+	return publicKeys, nil
+}
+
+// readApprovalKey - Reads the private key of an approver, as written by generate-approval-key
+func readApprovalKey(path string) (ed25519.PrivateKey, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	privateKey, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil || len(privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.WithStackTrace(InvalidApprovalKeyError{Path: path})
+	}
+	return ed25519.PrivateKey(privateKey), nil
+}
+
+// readApprovalToken - Returns the approval token given with --approval-token or --approval-token-file, or an empty
+// string when neither is set
+func readApprovalToken(c *cli.Context) (string, error) {
+	if c.IsSet("approval-token") {
+		return strings.TrimSpace(c.String("approval-token")), nil
+	}
+	if c.IsSet("approval-token-file") {
+		contents, err := ioutil.ReadFile(c.String("approval-token-file"))
+		if err != nil {
+			return "", errors.WithStackTrace(err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+	return "", nil
+}
+
+// checkApproval - Enforces the two-person rule: when approval is required, the plan is only nuked once an approver has
+// signed its digest with a private key the operator running cloud-nuke doesn't hold. Returns whether nuking can
+// proceed.
+func checkApproval(c *cli.Context, cloud string, plan []plannedResource) (bool, error) {
+	if !c.Bool("require-approval") && !c.IsSet("approval-token") && !c.IsSet("approval-token-file") {
+		return true, nil
+	}
+	if !c.IsSet("approver-public-key") {
+		return false, MissingFlagError{Name: "approver-public-key", RequiredBy: "require-approval"}
+	}
+	publicKeys, err := parseApproverPublicKeys(c.StringSlice("approver-public-key"))
+	if err != nil {
+		return false, err
+	}
+
+	digest := planDigest(cloud, plan)
+	token, err := readApprovalToken(c)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	if token == "" {
+		logging.Logger.Infof("This plan, of digest %s, requires approval. Write it to a file with --output-plan, and have an approver review and sign it with cloud-nuke approve-plan --plan <file>. Then rerun with the approval token they hand over, with --approval-token or --approval-token-file, to nuke it.", digest)
+		return false, nil
+	}
+	if !isApproved(publicKeys, digest, token) {
+		return false, ApprovalTokenMismatchError{}
+	}
+
+	logging.Logger.Infoln("The plan has been approved")
+	return true, nil
+}
+
+// generateApprovalKey - Generates the key pair of an approver, writing the private key to --output and printing the
+// public key to configure with --approver-public-key
+func generateApprovalKey(c *cli.Context) error {
+	if !c.IsSet("output") {
+		return MissingFlagError{Name: "output", RequiredBy: "generate-approval-key"}
+	}
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(c.String("output"), []byte(hex.EncodeToString(privateKey)+"\n"), 0600); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	logging.Logger.Infof("The private key has been written to %s. Keep it to yourself, and have the runs requiring approval pass the public key with --approver-public-key:", c.String("output"))
+	fmt.Println(hex.EncodeToString(publicKey))
+	return nil
+}
+
+// approvePlan - Shows the plan written with --output-plan, and signs the digest recomputed from it with the private key
+// of the approver, printing the approval token. The approver signs the plan they see, not a digest handed over by the
+// operator.
+func approvePlan(c *cli.Context) error {
+	for _, flagName := range []string{"approval-key-file", "plan"} {
+		if !c.IsSet(flagName) {
+			return MissingFlagError{Name: flagName, RequiredBy: "approve-plan"}
+		}
+	}
+	privateKey, err := readApprovalKey(c.String("approval-key-file"))
+	if err != nil {
+		return err
+	}
+
+	path := c.String("plan")
+	planReport, err := report.ReadJSON(path)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	plan, err := plannedResourcesOf(path, planReport)
+	if err != nil {
+		return err
+	}
+	if len(plan) == 0 {
+		return InvalidPlanError{Path: path, Reason: "it holds no resources"}
+	}
+
+	logging.Logger.Infof("Plan %s nukes the following %s resources: ", path, planReport.Cloud)
+	for _, resource := range plan {
+		logging.Logger.Infof("* %s-%s-%s-%s", resource.Scope, resource.ResourceType, resource.Identifier, resource.Location)
+	}
+	digest := planDigest(planReport.Cloud, plan)
+	logging.Logger.Infof("Signing the plan, of digest %s", digest)
+	fmt.Println(approvalToken(privateKey, digest))
+	return nil
+}
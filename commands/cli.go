@@ -2,6 +2,9 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -9,7 +12,15 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/azure"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
 	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/metrics"
+	"github.com/gruntwork-io/cloud-nuke/progress"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/cloud-nuke/tuning"
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 	"github.com/gruntwork-io/gruntwork-cli/shell"
 	"github.com/urfave/cli"
@@ -27,9 +38,51 @@ func CreateCli(version string) *cli.App {
 	app.Commands = []cli.Command{
 		{
 			Name:   "aws",
-			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes AWS resources (ASG, ELB, ELBv2, EBS, EC2, AMI, Snapshots, Elastic IP).",
+			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes AWS resources (CloudFormation stack set/stack, Batch job queue/compute environment, Elastic Beanstalk environment/application, ASG, ELB, ELBv2, EBS, EC2, AMI, Snapshots, RDS snapshot/automated backup, Elastic IP, NAT Gateway, Transit Gateway/attachment/route table, network interface, security group, VPC, ECR repository, EFS file system, OpenSearch domain, API Gateway/API Gateway V2 API, ElastiCache replication group/cluster, Kinesis stream, Secrets Manager secret, KMS key, SageMaker notebook instance/endpoint/endpoint config/model, FIS experiment template, Resilience Hub application, Cloud9 environment, Managed Grafana workspace, Managed Prometheus workspace, QuickSight analysis/dashboard/dataset/subscription, Pinpoint application/campaign/segment, Rekognition collection/stream processor, Comprehend endpoint, Translate terminology, EC2 key pair, CloudWatch log stream/alarm/dashboard, Bedrock provisioned throughput/custom model/customization job, Step Functions state machine, EventBridge rule/event bus, RAM resource share, organizational unit, service control policy, delegated administrator).",
 			Action: errors.WithPanicHandling(awsNuke),
 			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "Path to a YAML config file holding the settings of the run. The flags take precedence over the settings in the file.",
+				},
+				cli.StringFlag{
+					Name:  "statsd-address",
+					Usage: "The host:port of a StatsD server to send the counts of discovered, nuked and failed resources, and the duration of the run to.",
+				},
+				cli.StringFlag{
+					Name:  "pushgateway-url",
+					Usage: "The URL of a Prometheus Pushgateway to push the counts of discovered, nuked and failed resources, and the duration of the run to.",
+				},
+				cli.StringFlag{
+					Name:  "notify-webhook",
+					Usage: "The URL of a webhook to post a summary of the run to once it is over: the numbers of discovered, nuked and failed resources, and the duration of the run.",
+				},
+				cli.StringFlag{
+					Name:  "notify-webhook-format",
+					Value: string(metrics.WebhookJSONFormat),
+					Usage: "The format of the summary posted to --notify-webhook: json, or slack for Slack incoming webhooks.",
+				},
+				cli.StringFlag{
+					Name:  "telemetry-endpoint",
+					Usage: "Opt in to posting anonymized, aggregate telemetry of the run to the given URL once it is over, to help find the flakiest resource types: the resource types, their counts, error categories and durations. Never the identifiers of the resources, the regions, nor account or project ids.",
+				},
+				cli.StringFlag{
+					Name:  "telemetry-file",
+					Usage: "Path to a file to write the same anonymized telemetry to, without sending it anywhere.",
+				},
+				cli.StringFlag{
+					Name:  "throughput-file",
+					Usage: "Path to the file keeping the throughput of previous runs, to estimate how long nuking takes. Defaults to ~/.cloud-nuke/throughput.json.",
+				},
+				cli.BoolFlag{
+					Name:  "print-config",
+					Usage: "Print the settings of the run, as merged from the flags, the config file and the environment, before running. Secrets are redacted.",
+				},
+				cli.StringFlag{
+					Name:  "progress-format",
+					Value: string(progress.TextFormat),
+					Usage: "How to report the progress of the nuking: text, logged along with the other log lines, or json, written to stdout as a JSON object per line.",
+				},
 				cli.StringSliceFlag{
 					Name:  "exclude-region",
 					Usage: "regions to exclude",
@@ -38,66 +91,1428 @@ func CreateCli(version string) *cli.App {
 					Name:  "resource-type",
 					Usage: "Resource types to nuke",
 				},
+				cli.StringSliceFlag{
+					Name:  "exclude-resource-type",
+					Usage: "Resource types to leave alone, e.g. to nuke all resource types but these. Can't be combined with --resource-type for the same type.",
+				},
+				cli.StringFlag{
+					Name:  "exclude-ids-file",
+					Usage: "Path to a file listing the identifiers or ARNs of the resources to never nuke, whatever their resource type, one per line. Lines starting with # are ignored.",
+				},
+				cli.StringSliceFlag{
+					Name:  "include-name-regex",
+					Usage: "Only nuke the resources whose identifier or name matches one of these regular expressions, whatever their resource type",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-name-regex",
+					Usage: "Never nuke the resources whose identifier or name matches one of these regular expressions, whatever their resource type",
+				},
 				cli.BoolFlag{
 					Name:  "list-resource-types",
 					Usage: "List available resource types",
 				},
+				cli.StringSliceFlag{
+					Name:  "az",
+					Usage: "Only nuke the resources in these availability zones. Limits nuking to the resource types scoped to availability zones (" + strings.Join(aws.AZScopedResourceTypes(), ", ") + ").",
+				},
 				cli.StringFlag{
 					Name:  "older-than",
 					Usage: "Only delete resources older than this specified value. Can be any valid Go duration, such as 10m or 8h.",
 					Value: "0s",
 				},
+				cli.StringFlag{
+					Name:  "newer-than",
+					Usage: "Only delete resources newer than this specified value. Can be any valid Go duration, such as 10m or 8h. Can be combined with --older-than to only delete resources created within a window.",
+				},
 				cli.BoolFlag{
 					Name:  "force",
 					Usage: "Skip nuke confirmation prompt. WARNING: this will automatically delete all resources without any confirmation",
 				},
+				cli.BoolFlag{
+					Name:  "interactive",
+					Usage: "Before the confirmation prompt, list the resources to nuke numbered, so that some of them can be deselected. Can't be used along with --force.",
+				},
+				cli.BoolFlag{
+					Name:  "require-approval",
+					Usage: "Two-person rule: only nuke once an approver has signed the plan. Without an approval token, the plan and its digest are printed and nothing is nuked. Requires --approver-public-key.",
+				},
+				cli.StringSliceFlag{
+					Name:  "approver-public-key",
+					Usage: "The public key of an approver, as printed by cloud-nuke generate-approval-key, to check approval tokens against. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "approval-token",
+					Usage: "The approval token of the plan, as printed by cloud-nuke approve-plan for an approver. Implies --require-approval.",
+				},
+				cli.StringFlag{
+					Name:  "approval-token-file",
+					Usage: "Path to a file containing the approval token of the plan, as printed by cloud-nuke approve-plan for an approver. Implies --require-approval.",
+				},
+				cli.Float64Flag{
+					Name:  "spend-threshold",
+					Usage: "Only nuke when the month-to-date spend of the account, in USD, exceeds this value. Spend is looked up in Cost Explorer unless --budget-event is set.",
+				},
+				cli.StringFlag{
+					Name:  "budget-event",
+					Usage: "Path to an AWS Budgets notification (as delivered over SNS) to read the month-to-date spend from, instead of querying Cost Explorer. Requires --spend-threshold.",
+				},
+				cli.BoolFlag{
+					Name:  "estimate",
+					Usage: "Before discovering the resources to nuke, log roughly how many EC2 instances, EBS volumes and snapshots, AMIs, Elastic IPs and VPCs the account holds, from a single API call per resource type and region.",
+				},
+				cli.BoolFlag{
+					Name:  "no-wait",
+					Usage: "Don't wait for the resources that are deleted asynchronously to actually be gone before moving on to the next batch. By default, the batches whose resources aren't gone in time fail.",
+				},
+				cli.BoolFlag{
+					Name:  "wait",
+					Usage: "Deprecated, as waiting for the resources that are deleted asynchronously to be gone is now the default.",
+				},
+				cli.StringFlag{
+					Name:  "timeout",
+					Usage: "How long nuking the resources of a type in a region can take at most, as a Go duration such as 30m. Once out of time, the resources left are recorded as failed and the run moves on. No timeout when not set.",
+				},
+				cli.StringFlag{
+					Name:  "max-duration",
+					Usage: "How long the whole run can take at most, discovery included, as a Go duration such as 14m. Once out of time, no more AWS API calls are made, the resources not nuked yet are left alone, and the report is written. No limit when not set.",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type-timeout",
+					Usage: "The timeout of a resource type, overriding --timeout, as <resource type>=<duration>, e.g. rdssnapshot=1h. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "failure-policy",
+					Usage: "What to do when nuking the resources of a type fails: abort-run stops the run, abort-type moves on to the next resource type, and continue moves on to the next batch. The resources not nuked are recorded as failed, and the run fails once everything else is nuked. Defaults to abort-run.",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type-failure-policy",
+					Usage: "The failure policy of a resource type, overriding --failure-policy, as <resource type>=<policy>, e.g. cloudwatchlogstream=continue. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "output-json",
+					Usage: "Write the resources that are going to be nuked, along with their creation times, to this file as JSON.",
+				},
+				cli.StringFlag{
+					Name:  "output-plan",
+					Usage: "Write the resources that are going to be nuked to this file as a plan, and exit without nuking anything. Once reviewed, the plan can be nuked with --plan.",
+				},
+				cli.StringFlag{
+					Name:  "plan",
+					Usage: "Nuke exactly the resources in this plan, as written with --output-plan. The resources that no longer exist are skipped. Can't be used along with the flags selecting the resources to nuke.",
+				},
+				cli.StringFlag{
+					Name:  "state-file",
+					Usage: "Record which resources were nuked so far in this file, updated after each batch, so that a run interrupted by throttling, timeouts or Ctrl+C can be resumed with --resume.",
+				},
+				cli.StringFlag{
+					Name:  "resume",
+					Usage: "Resume the run whose progress was recorded in this state file, as written with --state-file. Only the resources it didn't nuke yet are looked up again and nuked, and the progress keeps being recorded in the file. Can't be used along with --plan or the flags selecting the resources to nuke.",
+				},
+				cli.StringFlag{
+					Name:  "lock-table",
+					Usage: "The name or ARN of a DynamoDB table to hold a lock in while the run is in progress, so that two runs against the same account can't interleave. The table needs a string partition key named LockID.",
+				},
+				cli.StringFlag{
+					Name:  "lock-region",
+					Value: "us-east-1",
+					Usage: "The region of the DynamoDB table given with --lock-table.",
+				},
+				cli.StringFlag{
+					Name:  "lock-ttl",
+					Value: "6h",
+					Usage: "How long the lock taken with --lock-table is held at most, so that a run that crashed doesn't hold it forever. Set it longer than the longest runs.",
+				},
+				cli.StringFlag{
+					Name:  "archive-role-arn",
+					Usage: "ARN of a role in an archive account. AMIs and snapshots are copied to that account before they are nuked, and are left alone when the copy fails.",
+				},
+				cli.StringFlag{
+					Name:  "archive-tag",
+					Usage: "Only archive the AMIs and snapshots carrying this tag, given as KEY or KEY=VALUE. Requires --archive-role-arn.",
+				},
+				cli.BoolFlag{
+					Name:  "secrets-force-delete-without-recovery",
+					Usage: "Delete Secrets Manager secrets right away. By default, their deletion is scheduled, and they can be restored until the recovery window ends.",
+				},
+				cli.Int64Flag{
+					Name:  "secrets-recovery-window",
+					Usage: "How many days Secrets Manager secrets scheduled for deletion can be restored for, from 7 to 30. Defaults to 30.",
+				},
+				cli.BoolFlag{
+					Name:  "stop-only",
+					Usage: "Stop the running EC2 instances rather than terminate them, keeping their data. Only EC2 instances are nuked then, unless other resource types are selected with --resource-type.",
+				},
+				cli.BoolFlag{
+					Name:  "ec2-disable-termination-protection",
+					Usage: "Disable the termination protection of the EC2 instances having it enabled right before terminating them. They are skipped otherwise.",
+				},
+				cli.BoolFlag{
+					Name:  "ebs-final-snapshot",
+					Usage: "Take a snapshot of each EBS volume right before deleting it. Volumes that can't be snapshotted are left alone.",
+				},
+				cli.IntFlag{
+					Name:  "ebs-final-snapshot-retention",
+					Usage: "How many days to keep the final snapshots of EBS volumes for. Until then, they are left alone by cloud-nuke.",
+					Value: 30,
+				},
+				cli.BoolFlag{
+					Name:  "ami-delete-snapshots",
+					Usage: "Delete the EBS snapshots backing each AMI once it's deregistered, so they aren't left behind.",
+				},
+				cli.Int64Flag{
+					Name:  "kms-pending-window",
+					Usage: "How many days to wait before the KMS keys scheduled for deletion are actually deleted, from 7 to 30. Defaults to 30.",
+				},
+				cli.StringFlag{
+					Name:  "output-sharing-report",
+					Usage: "Before nuking, write which AMIs, snapshots and ECR repositories are shared with other accounts, or publicly, to this file as JSON.",
+				},
+				cli.StringFlag{
+					Name:  "notify-sharing-topic-arn",
+					Usage: "Right before nuking, publish a message to this SNS topic for each account that AMIs, snapshots or ECR repositories are shared with, carrying the account id as the account_id message attribute.",
+				},
+				cli.StringSliceFlag{
+					Name:  "log-group-name",
+					Usage: "Only prune the log streams of the CloudWatch log groups whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-log-group-name",
+					Usage: "Never prune the log streams of the CloudWatch log groups whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "organizations-name",
+					Usage: "Only nuke the organizational units and service control policies whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-organizations-name",
+					Usage: "Never nuke the organizational units and service control policies whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "key-pair-name",
+					Usage: "Only nuke the EC2 key pairs whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-key-pair-name",
+					Usage: "Never nuke the EC2 key pairs whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "role-arn",
+					Usage: "The ARN of a role to assume to nuke the account it belongs to. Can be repeated to nuke several accounts in turn, carrying on with the others when one fails. Can't be used along with --plan or --output-plan.",
+				},
+				cli.BoolFlag{
+					Name:  "read-only-verify",
+					Usage: "Before doing anything, verify through the IAM policy simulator that the credentials can't perform any destructive action, and exit with an error otherwise.",
+				},
+				cli.StringSliceFlag{
+					Name:  "read-only-allowed-action",
+					Usage: "An action the credentials are expected to be allowed to perform despite --read-only-verify, e.g. s3:PutObject or s3:*. Can be repeated.",
+				},
+				cli.IntFlag{
+					Name:  "retry-max-attempts",
+					Usage: "How many times an AWS API call that is throttled, or fails with a transient error, is attempted at most. Retries wait exponentially longer, up to a minute.",
+					Value: aws.DefaultRetryConfig.MaxAttempts,
+				},
+				cli.BoolFlag{
+					Name:  "retry-without-jitter",
+					Usage: "Wait exactly the exponential delay between retries of AWS API calls, rather than a random part of it.",
+				},
+				cli.IntFlag{
+					Name:  "concurrency",
+					Usage: "How many regions to look for resources in at the same time.",
+					Value: 1,
+				},
+				cli.BoolFlag{
+					Name:  "auto-tune",
+					Usage: "Pick how many regions to look for resources in at the same time from the CPUs and memory available, e.g. in a small Lambda function or Fargate task.",
+				},
 			},
 		}, {
-			Name:   "defaults-aws",
-			Usage:  "Nukes unused AWS defaults (VPCs, permissive security group rules) across all regions enabled for this account.",
-			Action: errors.WithPanicHandling(awsDefaults),
+			Name:   "azure",
+			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes Azure resources (Virtual machines, Resource groups) in the subscription set in AZURE_SUBSCRIPTION_ID.",
+			Action: errors.WithPanicHandling(azureNuke),
 			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "Path to a YAML config file holding the settings of the run. The flags take precedence over the settings in the file.",
+				},
+				cli.StringFlag{
+					Name:  "statsd-address",
+					Usage: "The host:port of a StatsD server to send the counts of discovered, nuked and failed resources, and the duration of the run to.",
+				},
+				cli.StringFlag{
+					Name:  "pushgateway-url",
+					Usage: "The URL of a Prometheus Pushgateway to push the counts of discovered, nuked and failed resources, and the duration of the run to.",
+				},
+				cli.StringFlag{
+					Name:  "notify-webhook",
+					Usage: "The URL of a webhook to post a summary of the run to once it is over: the numbers of discovered, nuked and failed resources, and the duration of the run.",
+				},
+				cli.StringFlag{
+					Name:  "notify-webhook-format",
+					Value: string(metrics.WebhookJSONFormat),
+					Usage: "The format of the summary posted to --notify-webhook: json, or slack for Slack incoming webhooks.",
+				},
+				cli.StringFlag{
+					Name:  "telemetry-endpoint",
+					Usage: "Opt in to posting anonymized, aggregate telemetry of the run to the given URL once it is over, to help find the flakiest resource types: the resource types, their counts, error categories and durations. Never the identifiers of the resources, the regions, nor account or project ids.",
+				},
+				cli.StringFlag{
+					Name:  "telemetry-file",
+					Usage: "Path to a file to write the same anonymized telemetry to, without sending it anywhere.",
+				},
+				cli.StringFlag{
+					Name:  "throughput-file",
+					Usage: "Path to the file keeping the throughput of previous runs, to estimate how long nuking takes. Defaults to ~/.cloud-nuke/throughput.json.",
+				},
+				cli.BoolFlag{
+					Name:  "print-config",
+					Usage: "Print the settings of the run, as merged from the flags, the config file and the environment, before running. Secrets are redacted.",
+				},
+				cli.StringFlag{
+					Name:  "progress-format",
+					Value: string(progress.TextFormat),
+					Usage: "How to report the progress of the nuking: text, logged along with the other log lines, or json, written to stdout as a JSON object per line.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-location",
+					Usage: "locations to exclude",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Resource types to nuke",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-resource-type",
+					Usage: "Resource types to leave alone, e.g. to nuke all resource types but these. Can't be combined with --resource-type for the same type.",
+				},
+				cli.StringFlag{
+					Name:  "exclude-ids-file",
+					Usage: "Path to a file listing the identifiers or ARNs of the resources to never nuke, whatever their resource type, one per line. Lines starting with # are ignored.",
+				},
+				cli.StringSliceFlag{
+					Name:  "include-name-regex",
+					Usage: "Only nuke the resources whose identifier or name matches one of these regular expressions, whatever their resource type",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-name-regex",
+					Usage: "Never nuke the resources whose identifier or name matches one of these regular expressions, whatever their resource type",
+				},
+				cli.BoolFlag{
+					Name:  "list-resource-types",
+					Usage: "List available resource types",
+				},
+				cli.StringFlag{
+					Name:  "older-than",
+					Usage: "Only delete resources older than this specified value. Can be any valid Go duration, such as 10m or 8h.",
+					Value: "0s",
+				},
 				cli.BoolFlag{
 					Name:  "force",
-					Usage: "Skip confirmation prompt. WARNING: this will automatically delete defaults without any confirmation",
+					Usage: "Skip nuke confirmation prompt. WARNING: this will automatically delete all resources without any confirmation",
+				},
+				cli.BoolFlag{
+					Name:  "interactive",
+					Usage: "Before the confirmation prompt, list the resources to nuke numbered, so that some of them can be deselected. Can't be used along with --force.",
+				},
+				cli.StringFlag{
+					Name:  "output-plan",
+					Usage: "Write the resources that are going to be nuked to this file as a plan, and exit without nuking anything, e.g. to have the plan approved with cloud-nuke approve-plan.",
+				},
+				cli.BoolFlag{
+					Name:  "require-approval",
+					Usage: "Two-person rule: only nuke once an approver has signed the plan. Without an approval token, the plan and its digest are printed and nothing is nuked. Requires --approver-public-key.",
+				},
+				cli.StringSliceFlag{
+					Name:  "approver-public-key",
+					Usage: "The public key of an approver, as printed by cloud-nuke generate-approval-key, to check approval tokens against. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "approval-token",
+					Usage: "The approval token of the plan, as printed by cloud-nuke approve-plan for an approver. Implies --require-approval.",
+				},
+				cli.StringFlag{
+					Name:  "approval-token-file",
+					Usage: "Path to a file containing the approval token of the plan, as printed by cloud-nuke approve-plan for an approver. Implies --require-approval.",
 				},
 			},
-		},
+		}, {
+			Name:   "gcp",
+			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes GCP resources (GCE disk, GCE target pool, GCE health check, GCE managed instance group, GCE instance template, GCE static address, GCE firewall rule, GCE subnet, GCE VPC network, GCS bucket, GKE cluster, Cloud DNS managed zone, Artifact Registry repository, GCR image, Cloud KMS key, Filestore instance, Spanner instance/database, Cloud SQL instance, Cloud Function) in one or more projects.",
+			Action: errors.WithPanicHandling(gcpNuke),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "Path to a YAML config file holding the settings of the run. The flags take precedence over the settings in the file.",
+				},
+				cli.StringFlag{
+					Name:  "statsd-address",
+					Usage: "The host:port of a StatsD server to send the counts of discovered, nuked and failed resources, and the duration of the run to.",
+				},
+				cli.StringFlag{
+					Name:  "pushgateway-url",
+					Usage: "The URL of a Prometheus Pushgateway to push the counts of discovered, nuked and failed resources, and the duration of the run to.",
+				},
+				cli.StringFlag{
+					Name:  "notify-webhook",
+					Usage: "The URL of a webhook to post a summary of the run to once it is over: the numbers of discovered, nuked and failed resources, and the duration of the run.",
+				},
+				cli.StringFlag{
+					Name:  "notify-webhook-format",
+					Value: string(metrics.WebhookJSONFormat),
+					Usage: "The format of the summary posted to --notify-webhook: json, or slack for Slack incoming webhooks.",
+				},
+				cli.StringFlag{
+					Name:  "telemetry-endpoint",
+					Usage: "Opt in to posting anonymized, aggregate telemetry of the run to the given URL once it is over, to help find the flakiest resource types: the resource types, their counts, error categories and durations. Never the identifiers of the resources, the regions, nor account or project ids.",
+				},
+				cli.StringFlag{
+					Name:  "telemetry-file",
+					Usage: "Path to a file to write the same anonymized telemetry to, without sending it anywhere.",
+				},
+				cli.StringFlag{
+					Name:  "throughput-file",
+					Usage: "Path to the file keeping the throughput of previous runs, to estimate how long nuking takes. Defaults to ~/.cloud-nuke/throughput.json.",
+				},
+				cli.BoolFlag{
+					Name:  "print-config",
+					Usage: "Print the settings of the run, as merged from the flags, the config file and the environment, before running. Secrets are redacted.",
+				},
+				cli.StringFlag{
+					Name:  "progress-format",
+					Value: string(progress.TextFormat),
+					Usage: "How to report the progress of the nuking: text, logged along with the other log lines, or json, written to stdout as a JSON object per line.",
+				},
+				cli.StringSliceFlag{
+					Name:   "project, project-id",
+					Usage:  "The id of a project to nuke. Can be repeated to nuke several projects.",
+					EnvVar: "GOOGLE_CLOUD_PROJECT",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-region",
+					Usage: "regions to exclude",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Resource types to nuke",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-resource-type",
+					Usage: "Resource types to leave alone, e.g. to nuke all resource types but these. Can't be combined with --resource-type for the same type.",
+				},
+				cli.StringFlag{
+					Name:  "exclude-ids-file",
+					Usage: "Path to a file listing the identifiers or ARNs of the resources to never nuke, whatever their resource type, one per line. Lines starting with # are ignored.",
+				},
+				cli.StringSliceFlag{
+					Name:  "include-name-regex",
+					Usage: "Only nuke the resources whose identifier or name matches one of these regular expressions, whatever their resource type",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-name-regex",
+					Usage: "Never nuke the resources whose identifier or name matches one of these regular expressions, whatever their resource type",
+				},
+				cli.BoolFlag{
+					Name:  "list-resource-types",
+					Usage: "List available resource types",
+				},
+				cli.StringFlag{
+					Name:  "older-than",
+					Usage: "Only delete resources older than this specified value. Can be any valid Go duration, such as 10m or 8h.",
+					Value: "0s",
+				},
+				cli.BoolFlag{
+					Name:  "include-attached-disks",
+					Usage: "Also nuke GCE disks that are attached to an instance, detaching them first. By default only unattached disks are nuked.",
+				},
+				cli.BoolFlag{
+					Name:  "include-default-network",
+					Usage: "Also nuke the default VPC network, along with its subnets and firewall rules. By default they are left alone.",
+				},
+				cli.StringSliceFlag{
+					Name:  "dns-zone-name",
+					Usage: "Only nuke the Cloud DNS managed zones whose name matches this regular expression. Can be repeated.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-dns-zone-name",
+					Usage: "Never nuke the Cloud DNS managed zones whose name matches this regular expression. Can be repeated.",
+				},
+				cli.IntFlag{
+					Name:  "keep-latest-gcr-images",
+					Usage: "How many of the most recently uploaded tagged images to keep for each GCR image, whatever their age.",
+				},
+				cli.BoolFlag{
+					Name:  "force",
+					Usage: "Skip nuke confirmation prompt. WARNING: this will automatically delete all resources without any confirmation",
+				},
+				cli.BoolFlag{
+					Name:  "interactive",
+					Usage: "Before the confirmation prompt, list the resources to nuke numbered, so that some of them can be deselected. Can't be used along with --force.",
+				},
+				cli.StringFlag{
+					Name:  "output-plan",
+					Usage: "Write the resources that are going to be nuked to this file as a plan, and exit without nuking anything, e.g. to have the plan approved with cloud-nuke approve-plan.",
+				},
+				cli.BoolFlag{
+					Name:  "require-approval",
+					Usage: "Two-person rule: only nuke once an approver has signed the plan. Without an approval token, the plan and its digest are printed and nothing is nuked. Requires --approver-public-key.",
+				},
+				cli.StringSliceFlag{
+					Name:  "approver-public-key",
+					Usage: "The public key of an approver, as printed by cloud-nuke generate-approval-key, to check approval tokens against. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "approval-token",
+					Usage: "The approval token of the plan, as printed by cloud-nuke approve-plan for an approver. Implies --require-approval.",
+				},
+				cli.StringFlag{
+					Name:  "approval-token-file",
+					Usage: "Path to a file containing the approval token of the plan, as printed by cloud-nuke approve-plan for an approver. Implies --require-approval.",
+				},
+			},
+		}, {
+			Name:   "generate-approval-key",
+			Usage:  "Generates the key pair of an approver of the runs requiring approval, writing the private key to a file and printing the public key to pass with --approver-public-key.",
+			Action: errors.WithPanicHandling(generateApprovalKey),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "output",
+					Usage: "Path to write the private key to. Only the approver should be able to read it.",
+				},
+			},
+		}, {
+			Name:   "approve-plan",
+			Usage:  "Shows a plan requiring approval, as written with --output-plan, signs it, and prints the approval token to nuke it with.",
+			Action: errors.WithPanicHandling(approvePlan),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "approval-key-file",
+					Usage: "Path to the private key of the approver, as written by cloud-nuke generate-approval-key",
+				},
+				cli.StringFlag{
+					Name:  "plan",
+					Usage: "Path to the plan to approve, as written with --output-plan by the run requiring approval. The plan is shown, and its digest recomputed from it.",
+				},
+			},
+		}, {
+			Name:   "validate-config",
+			Usage:  "Checks a config file the way the runs would, without talking to any cloud, and prints it normalized. Fails when it's invalid, e.g. to check config changes in CI.",
+			Action: errors.WithPanicHandling(validateConfigFile),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "Path to the YAML config file to check",
+				},
+			},
+		}, {
+			Name:   "detect-leaks",
+			Usage:  "Records how many AWS resources of each type there are, and flags the resource types whose count grew in each of the last runs, pointing at test suites that leak. Nothing is nuked.",
+			Action: errors.WithPanicHandling(detectLeaks),
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "read-only-verify",
+					Usage: "Before doing anything, verify through the IAM policy simulator that the credentials can't perform any destructive action, and exit with an error otherwise.",
+				},
+				cli.StringSliceFlag{
+					Name:  "read-only-allowed-action",
+					Usage: "An action the credentials are expected to be allowed to perform despite --read-only-verify, e.g. s3:PutObject or s3:*. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "history-file",
+					Usage: "Path to the file keeping the resource counts of the previous runs. Created on the first run.",
+				},
+				cli.IntFlag{
+					Name:  "runs",
+					Usage: "How many runs in a row the count of a resource type has to grow for to be flagged.",
+					Value: 3,
+				},
+				cli.BoolFlag{
+					Name:  "fail-on-leaks",
+					Usage: "Exit with an error when resource types are flagged.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-region",
+					Usage: "regions to exclude",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Resource types to count",
+				},
+			},
+		}, {
+			Name:   "quota-report",
+			Usage:  "Counts the AWS resources of the types that have a quota on their count (" + strings.Join(aws.QuotaResourceTypes(), ", ") + "), and flags the ones near their quota in each region, to prioritize cleanups. Nothing is nuked.",
+			Action: errors.WithPanicHandling(quotaReport),
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "read-only-verify",
+					Usage: "Before doing anything, verify through the IAM policy simulator that the credentials can't perform any destructive action, and exit with an error otherwise.",
+				},
+				cli.StringSliceFlag{
+					Name:  "read-only-allowed-action",
+					Usage: "An action the credentials are expected to be allowed to perform despite --read-only-verify, e.g. s3:PutObject or s3:*. Can be repeated.",
+				},
+				cli.Float64Flag{
+					Name:  "threshold",
+					Usage: "The share of a quota, in percent, from which a resource type is flagged as near its quota.",
+					Value: 80,
+				},
+				cli.BoolFlag{
+					Name:  "fail-on-near-quota",
+					Usage: "Exit with an error when resource types are flagged.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-region",
+					Usage: "regions to exclude",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Resource types to check against their quota",
+				},
+			},
+		}, {
+			Name:   "ownership-report",
+			Usage:  "Reports how many AWS resources, and how much month-to-date spend, each team owns according to the owner and team tags. Nothing is nuked.",
+			Action: errors.WithPanicHandling(ownershipReport),
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "read-only-verify",
+					Usage: "Before doing anything, verify through the IAM policy simulator that the credentials can't perform any destructive action, and exit with an error otherwise.",
+				},
+				cli.StringSliceFlag{
+					Name:  "read-only-allowed-action",
+					Usage: "An action the credentials are expected to be allowed to perform despite --read-only-verify, e.g. s3:PutObject or s3:*. Can be repeated.",
+				},
+				cli.StringFlag{
+					Name:  "owner-tag-key",
+					Usage: "The tag holding the owner of a resource.",
+					Value: "owner",
+				},
+				cli.StringFlag{
+					Name:  "team-tag-key",
+					Usage: "The tag holding the team owning a resource. Resources and spend are broken down by its value.",
+					Value: "team",
+				},
+				cli.BoolFlag{
+					Name:  "skip-costs",
+					Usage: "Don't look up the spend of each team in Cost Explorer, which charges for each request.",
+				},
+				cli.StringFlag{
+					Name:  "output-json",
+					Usage: "Write the ownership report, along with each resource and its owner, to this file as JSON.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-region",
+					Usage: "regions to exclude",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Resource types to report on",
+				},
+			},
+		}, {
+			Name:   "defaults-aws",
+			Usage:  "Nukes unused AWS defaults (VPCs, permissive security group rules) across all regions enabled for this account.",
+			Action: errors.WithPanicHandling(awsDefaults),
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "force",
+					Usage: "Skip confirmation prompt. WARNING: this will automatically delete defaults without any confirmation",
+				},
+			},
+		},
+	}
+
+	return app
+}
+
+// verifyReadOnly - With --read-only-verify, checks that the credentials can't perform any destructive action, apart
+// from the ones allowed with --read-only-allowed-action
+func verifyReadOnly(c *cli.Context) error {
+	if !c.Bool("read-only-verify") {
+		return nil
+	}
+	return aws.VerifyReadOnly(c.StringSlice("read-only-allowed-action"))
+}
+
+// getProgressReporter - Returns the reporter of the progress of the nuking, in the format given with --progress-format
+func getProgressReporter(c *cli.Context) (*progress.Reporter, error) {
+	format := progress.Format(c.String("progress-format"))
+	for _, supportedFormat := range progress.Formats {
+		if format == supportedFormat {
+			return progress.NewReporter(format, os.Stdout), nil
+		}
+	}
+	return nil, InvalidFlagError{Name: "progress-format", Value: string(format)}
+}
+
+func parseDurationParam(paramValue string) (*time.Time, error) {
+	duration, err := time.ParseDuration(paramValue)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// make it negative so it goes back in time
+	duration = -1 * duration
+
+	excludeAfter := time.Now().Add(duration)
+	return &excludeAfter, nil
+}
+
+// formatAgeSuffix - Returns the age of a resource created at the given time, to show along with it, e.g. " (3d4h old)".
+// Returns an empty string when the creation time is unknown.
+func formatAgeSuffix(createdAt time.Time) string {
+	if createdAt.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(" (%s old)", report.FormatAge(time.Since(createdAt)))
+}
+
+// scannedRegions - Returns the regions resources are looked for in, i.e. the given regions but the excluded ones
+func scannedRegions(regions []string, excludedRegions []string) []string {
+	var scanned []string
+	for _, region := range regions {
+		if !collections.ListContainsElement(excludedRegions, region) {
+			scanned = append(scanned, region)
+		}
+	}
+	return scanned
+}
+
+// logManualActions - Lists the commitments to physical hardware found, which can't be nuked and require manual action
+func logManualActions(manualActions []aws.ManualAction) {
+	if len(manualActions) == 0 {
+		return
+	}
+	logging.Logger.Warnln("The following hardware can't be nuked, and requires manual action: ")
+	for _, action := range manualActions {
+		logging.Logger.Warnf("* %s\n", action)
+	}
+}
+
+// availabilityZoneRegions - Returns the regions the availability zones belong to, checking that they are among the
+// enabled regions
+func availabilityZoneRegions(availabilityZones []string, enabledRegions []string) ([]string, error) {
+	var regions []string
+	for _, availabilityZone := range availabilityZones {
+		region := aws.RegionOfAvailabilityZone(availabilityZone)
+		if !collections.ListContainsElement(enabledRegions, region) {
+			return nil, InvalidFlagError{Name: "az", Value: availabilityZone}
+		}
+		if !collections.ListContainsElement(regions, region) {
+			regions = append(regions, region)
+		}
+	}
+	return regions, nil
+}
+
+// azScopedResourceTypes - Limits the resource types to nuke to the ones scoped to availability zones. All of them are
+// nuked when no resource type, or all, is selected, and selecting a resource type that isn't scoped to availability
+// zones is an error.
+func azScopedResourceTypes(resourceTypes []string) ([]string, error) {
+	if len(resourceTypes) == 0 || collections.ListContainsElement(resourceTypes, "all") {
+		return aws.AZScopedResourceTypes(), nil
+	}
+	for _, resourceType := range resourceTypes {
+		if !collections.ListContainsElement(aws.AZScopedResourceTypes(), resourceType) {
+			return nil, ConflictingFlagsError{Name: "resource-type " + resourceType, ConflictsWith: "az"}
+		}
+	}
+	return resourceTypes, nil
+}
+
+// validateExcludeResourceTypes - Checks the resource types given to --exclude-resource-type exist, and that none of
+// them is also given to --resource-type
+func validateExcludeResourceTypes(excludeResourceTypes []string, resourceTypes []string, allResourceTypes []string) error {
+	for _, resourceType := range excludeResourceTypes {
+		if !collections.ListContainsElement(allResourceTypes, resourceType) {
+			return InvalidFlagError{Name: "exclude-resource-type", Value: resourceType}
+		}
+		if collections.ListContainsElement(resourceTypes, resourceType) {
+			return ConflictingFlagsError{Name: "exclude-resource-type " + resourceType, ConflictsWith: "resource-type " + resourceType}
+		}
+	}
+	return nil
+}
+
+// parseTagParam - Splits a tag given as KEY=VALUE into its key and value. The value is empty when only a key is given.
+func parseTagParam(paramValue string) (string, string) {
+	parts := strings.SplitN(paramValue, "=", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// parseTimeouts - Parses the timeout of the run, and the ones of the resource types, checking that the resource types
+// exist
+func parseTimeouts(cfg config.AWS, allResourceTypes []string) (aws.NukeTimeouts, error) {
+	timeouts := aws.NukeTimeouts{PerResourceType: map[string]time.Duration{}}
+	if cfg.Timeout != "" {
+		timeout, err := time.ParseDuration(cfg.Timeout)
+		if err != nil || timeout < 0 {
+			return aws.NukeTimeouts{}, InvalidFlagError{Name: "timeout", Value: cfg.Timeout}
+		}
+		timeouts.Default = timeout
+	}
+
+	for resourceType, value := range cfg.ResourceTypeTimeouts {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 || !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			return aws.NukeTimeouts{}, InvalidFlagError{Name: "resource-type-timeout", Value: resourceType + "=" + value}
+		}
+		timeouts.PerResourceType[resourceType] = timeout
+	}
+	return timeouts, nil
+}
+
+// parseFailurePolicies - Parses the failure policy of the run, and the ones of the resource types, checking that the
+// resource types exist
+func parseFailurePolicies(cfg config.AWS, allResourceTypes []string) (aws.FailurePolicies, error) {
+	policies := aws.FailurePolicies{PerResourceType: map[string]aws.FailurePolicy{}}
+	if cfg.FailurePolicy != "" {
+		if !aws.IsValidFailurePolicy(cfg.FailurePolicy) {
+			return aws.FailurePolicies{}, InvalidFlagError{Name: "failure-policy", Value: cfg.FailurePolicy}
+		}
+		policies.Default = aws.FailurePolicy(cfg.FailurePolicy)
+	}
+
+	for resourceType, value := range cfg.ResourceTypeFailurePolicies {
+		if !aws.IsValidFailurePolicy(value) || !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			return aws.FailurePolicies{}, InvalidFlagError{Name: "resource-type-failure-policy", Value: resourceType + "=" + value}
+		}
+		policies.PerResourceType[resourceType] = aws.FailurePolicy(value)
+	}
+	return policies, nil
+}
+
+// parseRegexpParams - Compiles the regular expressions given to the flag with the given name
+func parseRegexpParams(flagName string, paramValues []string) ([]*regexp.Regexp, error) {
+	var expressions []*regexp.Regexp
+	for _, paramValue := range paramValues {
+		expression, err := regexp.Compile(paramValue)
+		if err != nil {
+			return nil, InvalidFlagError{Name: flagName, Value: paramValue}
+		}
+		expressions = append(expressions, expression)
+	}
+	return expressions, nil
+}
+
+func awsNuke(c *cli.Context) error {
+	allResourceTypes := aws.ListResourceTypes()
+
+	if c.Bool("list-resource-types") {
+		for _, resourceType := range aws.ListResourceTypes() {
+			fmt.Println(resourceType)
+		}
+		return nil
+	}
+
+	defer logRunStats()
+
+	// Unlike --timeout, which bounds each resource type in each region, the deadline bounds the whole run
+	var deadline time.Time
+	if c.IsSet("max-duration") {
+		maxDuration, err := time.ParseDuration(c.String("max-duration"))
+		if err != nil || maxDuration <= 0 {
+			return InvalidFlagError{Name: "max-duration", Value: c.String("max-duration")}
+		}
+		deadline = time.Now().Add(maxDuration)
+	}
+
+	if c.IsSet("plan") {
+		if err := validatePlanFlags(c, "plan"); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("resume") {
+		if c.IsSet("plan") {
+			return ConflictingFlagsError{Name: "resume", ConflictsWith: "plan"}
+		}
+		if err := validatePlanFlags(c, "resume"); err != nil {
+			return err
+		}
+	}
+
+	// Only a run that nukes nothing can be made with read-only credentials
+	if c.Bool("read-only-verify") && !c.IsSet("output-plan") {
+		return MissingFlagError{Name: "output-plan", RequiredBy: "read-only-verify"}
+	}
+	if err := verifyReadOnly(c); err != nil {
+		return err
+	}
+
+	fileConfig, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+	cfg := effectiveAwsConfig(c, fileConfig.AWS)
+	if c.Bool("print-config") {
+		if err := printConfig(config.Config{AWS: cfg}, awsEnvVars); err != nil {
+			return err
+		}
+	}
+
+	// Each account gets its own reporter, the format is only checked upfront
+	if _, err := getProgressReporter(c); err != nil {
+		return err
+	}
+
+	run := metrics.NewRun("aws")
+	defer logRunTimings(run)
+	sinks, err := getMetricsSinks(c)
+	if err != nil {
+		return err
+	}
+	if len(sinks) > 0 {
+		defer metrics.Emit(run, sinks)
+	}
+	// Deferred after metrics.Emit, so that it runs before
+	defer recordFailedCalls(run)
+
+	resourceTypes := cfg.Filters.ResourceTypes
+	var invalidresourceTypes []string
+	for _, resourceType := range resourceTypes {
+		if resourceType == "all" {
+			continue
+		}
+		if !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			invalidresourceTypes = append(invalidresourceTypes, resourceType)
+		}
+	}
+
+	if len(invalidresourceTypes) > 0 {
+		msg := "Try --list-resource-types to get list of valid resource types."
+		return fmt.Errorf("Invalid resourceTypes %s specified: %s", invalidresourceTypes, msg)
+	}
+
+	excludeResourceTypes := cfg.Filters.ExcludeResourceTypes
+	if err := validateExcludeResourceTypes(excludeResourceTypes, resourceTypes, allResourceTypes); err != nil {
+		return err
+	}
+
+	// A run meant to keep the data around shouldn't nuke everything else by default
+	if cfg.ResourceOptions.EC2.StopOnly && len(resourceTypes) == 0 {
+		resourceTypes = []string{aws.EC2Instances{}.ResourceName()}
+		logging.Logger.Infoln("Only stopping EC2 instances, as --stop-only is set and no resource type is selected")
+	}
+
+	if c.Bool("interactive") && c.Bool("force") {
+		return ConflictingFlagsError{Name: "interactive", ConflictsWith: "force"}
+	}
+	if c.Bool("wait") && c.Bool("no-wait") {
+		return ConflictingFlagsError{Name: "wait", ConflictsWith: "no-wait"}
+	}
+
+	if c.Int("retry-max-attempts") < 1 {
+		return InvalidFlagError{
+			Name:  "retry-max-attempts",
+			Value: c.String("retry-max-attempts"),
+		}
+	}
+	retryConfig := aws.DefaultRetryConfig
+	retryConfig.MaxAttempts = c.Int("retry-max-attempts")
+	retryConfig.Jitter = !c.Bool("retry-without-jitter")
+	aws.SetRetryConfig(retryConfig)
+
+	if c.Bool("auto-tune") {
+		if c.IsSet("concurrency") {
+			return ConflictingFlagsError{Name: "auto-tune", ConflictsWith: "concurrency"}
+		}
+		resources := tuning.Detect()
+		cfg.Concurrency = tuning.Concurrency(resources)
+		logging.Logger.Infof("Auto-tuned to look for resources in %d regions at the same time (%s)", cfg.Concurrency, resources)
+	}
+	if cfg.Concurrency < 1 {
+		return InvalidFlagError{
+			Name:  "concurrency",
+			Value: fmt.Sprint(cfg.Concurrency),
+		}
+	}
+
+	// Looked up with the credentials cloud-nuke was started with, in the account it runs in, before assuming any role
+	host, err := aws.GetHostResources()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if len(cfg.RoleArns) > 0 {
+		// Each account has a plan of its own, so a single approval token can't approve them all
+		for _, flagName := range []string{"plan", "output-plan", "output-sharing-report", "state-file", "resume", "require-approval", "approval-token", "approval-token-file"} {
+			if c.IsSet(flagName) {
+				return ConflictingFlagsError{Name: "role-arn", ConflictsWith: flagName}
+			}
+		}
+		return nukeAwsAccounts(c, cfg, resourceTypes, excludeResourceTypes, host, run, deadline)
+	}
+
+	return nukeAwsAccount(c, cfg, resourceTypes, excludeResourceTypes, host, run, deadline, func(runReport *report.Report) error {
+		if !c.IsSet("output-json") {
+			return nil
+		}
+		if err := runReport.WriteJSON(c.String("output-json")); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		return nil
+	})
+}
+
+// nukeAwsAccount - Nukes the resources of the account the current credentials belong to, but the ones cloud-nuke runs
+// on. The report of the resources found is handed to onReport before anything is nuked. No more AWS API calls are made
+// once the deadline passes, unless it is zero.
+func nukeAwsAccount(c *cli.Context, cfg config.AWS, resourceTypes []string, excludeResourceTypes []string, host *aws.HostResources, run *metrics.Run, deadline time.Time, onReport func(runReport *report.Report) error) error {
+	reporter, err := getProgressReporter(c)
+	if err != nil {
+		return err
+	}
+
+	regions, err := aws.GetEnabledRegions()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	excludedRegions := cfg.Filters.ExcludeRegions
+
+	for _, excludedRegion := range excludedRegions {
+		if !collections.ListContainsElement(regions, excludedRegion) {
+			return InvalidFlagError{
+				Name:  "exclude-regions",
+				Value: excludedRegion,
+			}
+		}
+	}
+
+	availabilityZones := c.StringSlice("az")
+	if len(availabilityZones) > 0 {
+		if regions, err = availabilityZoneRegions(availabilityZones, regions); err != nil {
+			return err
+		}
+		if resourceTypes, err = azScopedResourceTypes(resourceTypes); err != nil {
+			return err
+		}
+	}
+
+	excludeAfter, err := parseDurationParam(cfg.Retention.OlderThan)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	timeFilter := aws.TimeFilter{ExcludeAfter: *excludeAfter}
+
+	if newerThan := cfg.Retention.NewerThan; newerThan != "" {
+		includeAfter, err := parseDurationParam(newerThan)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if !includeAfter.Before(*excludeAfter) {
+			return InvalidFlagError{
+				Name:  "newer-than",
+				Value: newerThan,
+			}
+		}
+		timeFilter.IncludeAfter = *includeAfter
+	}
+
+	if c.IsSet("budget-event") && !c.IsSet("spend-threshold") {
+		return MissingFlagError{Name: "spend-threshold", RequiredBy: "budget-event"}
+	}
+
+	if c.IsSet("spend-threshold") {
+		exceeded, err := spendExceedsThreshold(c.Float64("spend-threshold"), c.String("budget-event"))
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if !exceeded {
+			return nil
+		}
+	}
+
+	if c.IsSet("archive-tag") && !c.IsSet("archive-role-arn") {
+		return MissingFlagError{Name: "archive-role-arn", RequiredBy: "archive-tag"}
+	}
+
+	options := aws.ResourceOptions{AvailabilityZones: availabilityZones, RegionConcurrency: cfg.Concurrency}
+	if c.IsSet("archive-role-arn") {
+		tagKey, tagValue := parseTagParam(c.String("archive-tag"))
+		options.Archive = &aws.ArchiveConfig{
+			RoleArn:  c.String("archive-role-arn"),
+			TagKey:   tagKey,
+			TagValue: tagValue,
+		}
+	}
+
+	forceDeleteWithoutRecovery := cfg.ResourceOptions.SecretsManager.ForceDeleteWithoutRecovery
+	if recoveryWindow := cfg.ResourceOptions.SecretsManager.RecoveryWindow; recoveryWindow != 0 {
+		if forceDeleteWithoutRecovery {
+			return ConflictingFlagsError{Name: "secrets-recovery-window", ConflictsWith: "secrets-force-delete-without-recovery"}
+		}
+		if recoveryWindow < 7 || recoveryWindow > 30 {
+			return InvalidFlagError{
+				Name:  "secrets-recovery-window",
+				Value: fmt.Sprint(recoveryWindow),
+			}
+		}
+		options.SecretsManager.RecoveryWindowInDays = recoveryWindow
+	}
+	options.SecretsManager.ForceDeleteWithoutRecovery = forceDeleteWithoutRecovery
+
+	if cfg.ResourceOptions.EBS.FinalSnapshot {
+		retentionDays := cfg.ResourceOptions.EBS.FinalSnapshotRetention
+		if retentionDays < 1 {
+			return InvalidFlagError{
+				Name:  "ebs-final-snapshot-retention",
+				Value: fmt.Sprint(retentionDays),
+			}
+		}
+		runID := util.UniqueID()
+		logging.Logger.Infof("Final snapshots of EBS volumes are tagged with run ID %s", runID)
+		options.EBS = aws.EBSConfig{
+			FinalSnapshot: true,
+			RunID:         runID,
+			RetentionDays: retentionDays,
+		}
+	}
+
+	options.EC2.StopOnly = cfg.ResourceOptions.EC2.StopOnly
+	options.EC2.DisableTerminationProtection = cfg.ResourceOptions.EC2.DisableTerminationProtection
+	options.AMI.DeleteSnapshots = cfg.ResourceOptions.AMI.DeleteSnapshots
+
+	if pendingWindow := cfg.ResourceOptions.KMSKey.PendingWindow; pendingWindow != 0 {
+		if pendingWindow < 7 || pendingWindow > 30 {
+			return InvalidFlagError{
+				Name:  "kms-pending-window",
+				Value: fmt.Sprint(pendingWindow),
+			}
+		}
+		options.KMS.PendingWindowInDays = pendingWindow
+	}
+
+	if options.LogGroupNames.Include, err = parseRegexpParams("log-group-name", cfg.ResourceOptions.CloudWatchLogStream.LogGroupNames); err != nil {
+		return err
+	}
+	if options.LogGroupNames.Exclude, err = parseRegexpParams("exclude-log-group-name", cfg.ResourceOptions.CloudWatchLogStream.ExcludeLogGroupNames); err != nil {
+		return err
+	}
+	if options.OrganizationsNames.Include, err = parseRegexpParams("organizations-name", cfg.ResourceOptions.Organizations.Names); err != nil {
+		return err
+	}
+	if options.OrganizationsNames.Exclude, err = parseRegexpParams("exclude-organizations-name", cfg.ResourceOptions.Organizations.ExcludeNames); err != nil {
+		return err
+	}
+	if options.KeyPairNames.Include, err = parseRegexpParams("key-pair-name", cfg.ResourceOptions.EC2KeyPair.Names); err != nil {
+		return err
+	}
+	if options.KeyPairNames.Exclude, err = parseRegexpParams("exclude-key-pair-name", cfg.ResourceOptions.EC2KeyPair.ExcludeNames); err != nil {
+		return err
+	}
+
+	timeouts, err := parseTimeouts(cfg, aws.ListResourceTypes())
+	if err != nil {
+		return err
+	}
+	policies, err := parseFailurePolicies(cfg, aws.ListResourceTypes())
+	if err != nil {
+		return err
+	}
+
+	excluded, err := readExcludedIds(cfg.Filters.ExcludeIdsFile)
+	if err != nil {
+		return err
+	}
+	names, err := parseNameFilter(cfg.Filters)
+	if err != nil {
+		return err
+	}
+
+	accountInfo, err := aws.GetAccountInfo()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := checkNotProtected("account", accountInfo.Id, cfg.ProtectedAccounts); err != nil {
+		return err
+	}
+
+	// Two runs against the same account would trip over each other's deletions
+	lock, err := acquireRunLock(c, accountInfo.Id)
+	if err != nil {
+		return err
+	}
+	defer releaseRunLock(lock)
+
+	reportAccount := report.Account{
+		ID:     accountInfo.Id,
+		Alias:  accountInfo.Alias,
+		Email:  accountInfo.Email,
+		OUPath: accountInfo.OUPath,
+	}
+	logging.Logger.Infof("Nuking account %s", reportAccount.Summary())
+
+	var account *aws.AwsAccountResources
+	var savedState *report.Report
+	var savedPlan *report.Report
+	if c.IsSet("resume") {
+		if savedState, err = readAwsPlan(c.String("resume"), aws.ListResourceTypes(), regions); err != nil {
+			return err
+		}
+		logging.Logger.Infof("Resuming the run of state file %s: retrieving the AWS resources it didn't nuke yet that still exist", c.String("resume"))
+		ctx, stop := interruptContext(deadline)
+		account, regions, err = getPlannedAwsResources(ctx, savedState.Remaining(), accountInfo.Id, options)
+		stop()
+		if err != nil {
+			return err
+		}
+	} else if c.IsSet("plan") {
+		if savedPlan, err = readAwsPlan(c.String("plan"), aws.ListResourceTypes(), regions); err != nil {
+			return err
+		}
+		logging.Logger.Infof("Retrieving the AWS resources of plan %s that still exist", c.String("plan"))
+		ctx, stop := interruptContext(deadline)
+		account, regions, err = getPlannedAwsResources(ctx, savedPlan, accountInfo.Id, options)
+		stop()
+		if err != nil {
+			return err
+		}
+	} else {
+		if c.Bool("estimate") {
+			logEstimates(aws.EstimateResources(regions, excludedRegions, resourceTypes, excludeResourceTypes))
+		}
+		logging.Logger.Infoln("Retrieving all active AWS resources")
+		ctx, stop := interruptContext(deadline)
+		account, err = aws.GetAllResources(ctx, regions, excludedRegions, timeFilter, resourceTypes, excludeResourceTypes, options)
+		stop()
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
 	}
 
-	return app
-}
+	// When cloud-nuke runs inside AWS, leave alone the resources it runs on, so that it doesn't kill itself mid-run
+	if host != nil {
+		account = aws.FilterResources(account, func(region string, resourceType string, identifier string) bool {
+			if host.Contains(accountInfo.Id, region, resourceType, identifier) {
+				logging.Logger.Infof("Skipping %s %s: cloud-nuke runs on it", resourceType, identifier)
+				return false
+			}
+			return true
+		})
+	}
+	if excluded != nil {
+		account = aws.FilterResources(account, func(region string, resourceType string, identifier string) bool {
+			return excluded.keeps(resourceType, identifier)
+		})
+	}
+	if names != nil {
+		account = aws.FilterResources(account, func(region string, resourceType string, identifier string) bool {
+			return names.keeps(resourceType, identifier)
+		})
+	}
 
-func parseDurationParam(paramValue string) (*time.Time, error) {
-	duration, err := time.ParseDuration(paramValue)
+	// Commitments to physical hardware can't be nuked, but an account holding some isn't clean either
+	manualActions := aws.GetManualActions(scannedRegions(regions, excludedRegions))
+	logManualActions(manualActions)
+
+	if len(account.Resources) == 0 {
+		if len(manualActions) > 0 {
+			logging.Logger.Infoln("Nothing to nuke, but the hardware listed above requires manual action")
+			return nil
+		}
+		logging.Logger.Infoln("Nothing to nuke, you're all good!")
+		return nil
+	}
+
+	logging.Logger.Infoln("The following AWS resources are going to be nuked: ")
+
+	var plan []plannedResource
+	runReport := report.New("aws")
+	runReport.Account = &reportAccount
+	for _, action := range manualActions {
+		runReport.ManualActions = append(runReport.ManualActions, report.ManualAction(action))
+	}
+	for region, resourcesInRegion := range account.Resources {
+		for _, resources := range resourcesInRegion.Resources {
+			run.AddDiscovered(resources.ResourceName(), region, len(resources.ResourceIdentifiers()))
+			run.AddDiscoveryTime(resources.ResourceName(), region, resourcesInRegion.DiscoveryTimes[resources.ResourceName()])
+			for _, identifier := range resources.ResourceIdentifiers() {
+				createdAt := resourcesInRegion.CreationTimes[identifier]
+				plan = append(plan, plannedResource{Scope: accountInfo.Id, Location: region, ResourceType: resources.ResourceName(), Identifier: identifier})
+				runReport.AddResource(resources.ResourceName(), identifier, region, createdAt)
+				logging.Logger.Infof("* %s-%s-%s%s\n", resources.ResourceName(), identifier, region, formatAgeSuffix(createdAt))
+			}
+		}
+		for _, skipped := range resourcesInRegion.Skipped {
+			runReport.AddSkipped(skipped.ResourceType, skipped.Identifier, region, skipped.Reason)
+		}
+	}
+
+	if err := onReport(runReport); err != nil {
+		return err
+	}
+
+	if c.IsSet("output-plan") {
+		if err := runReport.WriteJSON(c.String("output-plan")); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("The plan has been written to %s, and nothing was nuked. Once it is reviewed, nuke it with cloud-nuke aws --plan %s", c.String("output-plan"), c.String("output-plan"))
+		return nil
+	}
+
+	// The approver signed the plan file they reviewed, of which the run nukes the resources that still exist
+	approvedPlan := plan
+	if savedPlan != nil {
+		if approvedPlan, err = plannedResourcesOf(c.String("plan"), savedPlan); err != nil {
+			return err
+		}
+	}
+	approved, err := checkApproval(c, "aws", approvedPlan)
+	if err != nil || !approved {
+		return err
+	}
+
+	if c.Bool("interactive") {
+		selected, err := selectResources(awsSelectableResources(account))
+		if err != nil {
+			return err
+		}
+		account = aws.FilterResources(account, func(region string, resourceType string, identifier string) bool {
+			return selected[selectableResource{Region: region, ResourceType: resourceType, Identifier: identifier}]
+		})
+		if len(account.Resources) == 0 {
+			logging.Logger.Infoln("No resources selected, nothing to nuke")
+			return nil
+		}
+	}
+
+	shared, err := reportSharedResources(c, account, reportAccount)
 	if err != nil {
-		return nil, errors.WithStackTrace(err)
+		return err
+	}
+	nuke := func() error {
+		if err := notifySharingAccounts(c, reportAccount.ID, shared); err != nil {
+			return err
+		}
+		if err := recordRunState(c, savedState, runReport, account, reporter); err != nil {
+			return err
+		}
+		ctx, stop := interruptContext(deadline)
+		defer stop()
+		return aws.NukeAllResources(ctx, account, regions, !c.Bool("no-wait"), timeouts, policies, reporter)
 	}
 
-	// make it negative so it goes back in time
-	duration = -1 * duration
+	history, historyPath := loadThroughputHistory(c)
+	logNukeSummary("aws", countByResourceType(awsSelectableResources(account)), history)
+	defer saveThroughput("aws", reporter, history, historyPath)
+	defer recordNukedResources(run, reporter)
 
-	excludeAfter := time.Now().Add(duration)
-	return &excludeAfter, nil
+	if !c.Bool("force") {
+		prompt := "\nAre you sure you want to nuke all listed resources? Enter 'nuke' to confirm: "
+		proceed, err := confirmationPrompt(prompt)
+		if err != nil {
+			return err
+		}
+		if proceed {
+			if err := nuke(); err != nil {
+				return err
+			}
+		}
+	} else {
+		logging.Logger.Infoln("The --force flag is set, so waiting for 10 seconds before proceeding to nuke everything in your account. If you don't want to proceed, hit CTRL+C now!!")
+		for i := 10; i > 0; i-- {
+			fmt.Printf("%d...", i)
+			time.Sleep(1 * time.Second)
+		}
+
+		fmt.Println()
+		if err := nuke(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func awsNuke(c *cli.Context) error {
-	allResourceTypes := aws.ListResourceTypes()
+// logGcpNukeReport - Logs how many gcp resources were nuked or neutralized, along with the ones that couldn't be nuked
+// and why
+func logGcpNukeReport(nukeReport *gcp.NukeReport) {
+	logging.Logger.Infof("%d of %d GCP resource(s) nuked", nukeReport.Count(gcp.NukeOutcomeNuked), len(nukeReport.Results))
+	if neutralized := nukeReport.Count(gcp.NukeOutcomeNeutralized); neutralized > 0 {
+		logging.Logger.Infof("%d GCP resource(s) that can't be deleted, e.g. Cloud KMS keys, neutralized instead", neutralized)
+	}
+	for _, failure := range nukeReport.Failures() {
+		logging.Logger.Errorf("* %s-%s-%s-%s: %s", failure.ProjectID, failure.ResourceType, failure.Identifier, failure.Region, failure.Err)
+	}
+}
+
+// spendExceedsThreshold checks whether the month-to-date spend of the account exceeds the given threshold. The spend
+// is read from the budget notification at budgetEventPath when set, and looked up in Cost Explorer otherwise.
+func spendExceedsThreshold(threshold float64, budgetEventPath string) (bool, error) {
+	var spend float64
+	if budgetEventPath != "" {
+		payload, err := ioutil.ReadFile(budgetEventPath)
+		if err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+		spend, err = aws.ParseBudgetNotification(payload)
+		if err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+	} else {
+		logging.Logger.Infoln("Retrieving month-to-date spend from Cost Explorer")
+		var err error
+		spend, err = aws.GetMonthToDateSpend()
+		if err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+	}
+
+	if spend <= threshold {
+		logging.Logger.Infof("Month-to-date spend of $%.2f does not exceed the threshold of $%.2f, not nuking anything", spend, threshold)
+		return false, nil
+	}
+
+	logging.Logger.Infof("Month-to-date spend of $%.2f exceeds the threshold of $%.2f", spend, threshold)
+	return true, nil
+}
+
+func azureNuke(c *cli.Context) error {
+	allResourceTypes := azure.ListResourceTypes()
 
 	if c.Bool("list-resource-types") {
-		for _, resourceType := range aws.ListResourceTypes() {
+		for _, resourceType := range allResourceTypes {
 			fmt.Println(resourceType)
 		}
 		return nil
 	}
 
-	resourceTypes := c.StringSlice("resource-type")
+	fileConfig, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+	cfg := effectiveAzureConfig(c, fileConfig.Azure)
+	if c.Bool("print-config") {
+		if err := printConfig(config.Config{Azure: cfg}, azureEnvVars); err != nil {
+			return err
+		}
+	}
+
+	reporter, err := getProgressReporter(c)
+	if err != nil {
+		return err
+	}
+
+	run := metrics.NewRun("azure")
+	defer logRunTimings(run)
+	sinks, err := getMetricsSinks(c)
+	if err != nil {
+		return err
+	}
+	if len(sinks) > 0 {
+		defer metrics.Emit(run, sinks)
+	}
+
+	resourceTypes := cfg.Filters.ResourceTypes
 	var invalidresourceTypes []string
 	for _, resourceType := range resourceTypes {
 		if resourceType == "all" {
 			continue
 		}
-		if !aws.IsValidResourceType(resourceType, allResourceTypes) {
+		if !azure.IsValidResourceType(resourceType, allResourceTypes) {
 			invalidresourceTypes = append(invalidresourceTypes, resourceType)
 		}
 	}
@@ -107,48 +1522,301 @@ func awsNuke(c *cli.Context) error {
 		return fmt.Errorf("Invalid resourceTypes %s specified: %s", invalidresourceTypes, msg)
 	}
 
-	regions, err := aws.GetEnabledRegions()
+	excludeResourceTypes := cfg.Filters.ExcludeResourceTypes
+	if err := validateExcludeResourceTypes(excludeResourceTypes, resourceTypes, allResourceTypes); err != nil {
+		return err
+	}
+
+	if c.Bool("interactive") && c.Bool("force") {
+		return ConflictingFlagsError{Name: "interactive", ConflictsWith: "force"}
+	}
+
+	excludeAfter, err := parseDurationParam(cfg.Retention.OlderThan)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
-	excludedRegions := c.StringSlice("exclude-region")
 
-	for _, excludedRegion := range excludedRegions {
-		if !collections.ListContainsElement(regions, excludedRegion) {
-			return InvalidFlagError{
-				Name:  "exclude-regions",
-				Value: excludedRegion,
+	excluded, err := readExcludedIds(cfg.Filters.ExcludeIdsFile)
+	if err != nil {
+		return err
+	}
+	names, err := parseNameFilter(cfg.Filters)
+	if err != nil {
+		return err
+	}
+
+	session, err := azure.NewSession()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := checkNotProtected("subscription", session.SubscriptionID, cfg.ProtectedSubscriptions); err != nil {
+		return err
+	}
+
+	logging.Logger.Infoln("Retrieving all active Azure resources")
+	account, err := azure.GetAllResources(session, cfg.Filters.ExcludeRegions, *excludeAfter, resourceTypes, excludeResourceTypes)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if excluded != nil {
+		account = azure.FilterResources(account, func(location string, resourceType string, identifier string) bool {
+			return excluded.keeps(resourceType, identifier)
+		})
+	}
+	if names != nil {
+		account = azure.FilterResources(account, func(location string, resourceType string, identifier string) bool {
+			return names.keeps(resourceType, identifier)
+		})
+	}
+
+	if len(account.Resources) == 0 {
+		logging.Logger.Infoln("Nothing to nuke, you're all good!")
+		return nil
+	}
+
+	logging.Logger.Infoln("The following Azure resources are going to be nuked: ")
+
+	var plan []plannedResource
+	for location, resourcesInLocation := range account.Resources {
+		for _, resources := range resourcesInLocation.Resources {
+			run.AddDiscovered(resources.ResourceName(), location, len(resources.ResourceIdentifiers()))
+			for _, identifier := range resources.ResourceIdentifiers() {
+				plan = append(plan, plannedResource{Scope: session.SubscriptionID, Location: location, ResourceType: resources.ResourceName(), Identifier: identifier})
+				logging.Logger.Infof("* %s-%s-%s\n", resources.ResourceName(), identifier, location)
+			}
+		}
+	}
+
+	if c.IsSet("output-plan") {
+		if err := planReport("azure", plan).WriteJSON(c.String("output-plan")); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("The plan has been written to %s, and nothing was nuked. Have it approved with cloud-nuke approve-plan --plan %s", c.String("output-plan"), c.String("output-plan"))
+		return nil
+	}
+
+	approved, err := checkApproval(c, "azure", plan)
+	if err != nil || !approved {
+		return err
+	}
+
+	if c.Bool("interactive") {
+		selected, err := selectResources(azureSelectableResources(account))
+		if err != nil {
+			return err
+		}
+		account = azure.FilterResources(account, func(location string, resourceType string, identifier string) bool {
+			return selected[selectableResource{Region: location, ResourceType: resourceType, Identifier: identifier}]
+		})
+		if len(account.Resources) == 0 {
+			logging.Logger.Infoln("No resources selected, nothing to nuke")
+			return nil
+		}
+	}
+
+	history, historyPath := loadThroughputHistory(c)
+	logNukeSummary("azure", countByResourceType(azureSelectableResources(account)), history)
+	defer saveThroughput("azure", reporter, history, historyPath)
+	defer recordNukedResources(run, reporter)
+
+	if !c.Bool("force") {
+		prompt := "\nAre you sure you want to nuke all listed resources? Enter 'nuke' to confirm: "
+		proceed, err := confirmationPrompt(prompt)
+		if err != nil {
+			return err
+		}
+		if proceed {
+			if err := azure.NukeAllResources(session, account, reporter); err != nil {
+				return err
 			}
 		}
+	} else {
+		logging.Logger.Infoln("The --force flag is set, so waiting for 10 seconds before proceeding to nuke everything in your subscription. If you don't want to proceed, hit CTRL+C now!!")
+		for i := 10; i > 0; i-- {
+			fmt.Printf("%d...", i)
+			time.Sleep(1 * time.Second)
+		}
+
+		fmt.Println()
+		if err := azure.NukeAllResources(session, account, reporter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func gcpNuke(c *cli.Context) error {
+	allResourceTypes := gcp.ListResourceTypes()
+
+	if c.Bool("list-resource-types") {
+		for _, resourceType := range allResourceTypes {
+			fmt.Println(resourceType)
+		}
+		return nil
+	}
+
+	fileConfig, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+	cfg := effectiveGcpConfig(c, fileConfig.GCP)
+	if c.Bool("print-config") {
+		if err := printConfig(config.Config{GCP: cfg}, gcpEnvVars); err != nil {
+			return err
+		}
+	}
+
+	reporter, err := getProgressReporter(c)
+	if err != nil {
+		return err
+	}
+
+	run := metrics.NewRun("gcp")
+	defer logRunTimings(run)
+	sinks, err := getMetricsSinks(c)
+	if err != nil {
+		return err
+	}
+	if len(sinks) > 0 {
+		defer metrics.Emit(run, sinks)
+	}
+
+	resourceTypes := cfg.Filters.ResourceTypes
+	var invalidresourceTypes []string
+	for _, resourceType := range resourceTypes {
+		if resourceType == "all" {
+			continue
+		}
+		if !gcp.IsValidResourceType(resourceType, allResourceTypes) {
+			invalidresourceTypes = append(invalidresourceTypes, resourceType)
+		}
+	}
+
+	if len(invalidresourceTypes) > 0 {
+		msg := "Try --list-resource-types to get list of valid resource types."
+		return fmt.Errorf("Invalid resourceTypes %s specified: %s", invalidresourceTypes, msg)
+	}
+
+	excludeResourceTypes := cfg.Filters.ExcludeResourceTypes
+	if err := validateExcludeResourceTypes(excludeResourceTypes, resourceTypes, allResourceTypes); err != nil {
+		return err
+	}
+
+	if c.Bool("interactive") && c.Bool("force") {
+		return ConflictingFlagsError{Name: "interactive", ConflictsWith: "force"}
+	}
+
+	projectIDs := cfg.Projects
+	if len(projectIDs) == 0 {
+		return MissingFlagError{Name: "project", RequiredBy: "gcp"}
+	}
+	for _, projectID := range projectIDs {
+		if err := checkNotProtected("project", projectID, cfg.ProtectedProjects); err != nil {
+			return err
+		}
 	}
 
-	excludeAfter, err := parseDurationParam(c.String("older-than"))
+	excludeAfter, err := parseDurationParam(cfg.Retention.OlderThan)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	logging.Logger.Infoln("Retrieving all active AWS resources")
-	account, err := aws.GetAllResources(regions, excludedRegions, *excludeAfter, resourceTypes)
+	options := gcp.ResourceOptions{
+		IncludeAttachedDisks:  cfg.ResourceOptions.GceDisk.IncludeAttached,
+		IncludeDefaultNetwork: cfg.ResourceOptions.GceNetwork.IncludeDefault,
+	}
+	if options.ManagedZoneNames.Include, err = parseRegexpParams("dns-zone-name", cfg.ResourceOptions.CloudDnsZone.Names); err != nil {
+		return err
+	}
+	if options.ManagedZoneNames.Exclude, err = parseRegexpParams("exclude-dns-zone-name", cfg.ResourceOptions.CloudDnsZone.ExcludeNames); err != nil {
+		return err
+	}
+	options.KeepLatestGcrImages = cfg.ResourceOptions.GcrImage.KeepLatest
+	if options.KeepLatestGcrImages < 0 {
+		return InvalidFlagError{
+			Name:  "keep-latest-gcr-images",
+			Value: fmt.Sprint(options.KeepLatestGcrImages),
+		}
+	}
+
+	excluded, err := readExcludedIds(cfg.Filters.ExcludeIdsFile)
+	if err != nil {
+		return err
+	}
+	names, err := parseNameFilter(cfg.Filters)
+	if err != nil {
+		return err
+	}
 
+	logging.Logger.Infoln("Retrieving all active GCP resources")
+	resources, err := gcp.GetAllResources(projectIDs, cfg.Filters.ExcludeRegions, *excludeAfter, resourceTypes, excludeResourceTypes, options)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
+	if excluded != nil {
+		resources = gcp.FilterResources(resources, func(projectID string, region string, resourceType string, identifier string) bool {
+			return excluded.keeps(resourceType, identifier)
+		})
+	}
+	if names != nil {
+		resources = gcp.FilterResources(resources, func(projectID string, region string, resourceType string, identifier string) bool {
+			return names.keeps(resourceType, identifier)
+		})
+	}
 
-	if len(account.Resources) == 0 {
+	if len(resources.Projects) == 0 {
 		logging.Logger.Infoln("Nothing to nuke, you're all good!")
 		return nil
 	}
 
-	logging.Logger.Infoln("The following AWS resources are going to be nuked: ")
+	logging.Logger.Infoln("The following GCP resources are going to be nuked: ")
 
-	for region, resourcesInRegion := range account.Resources {
-		for _, resources := range resourcesInRegion.Resources {
-			for _, identifier := range resources.ResourceIdentifiers() {
-				logging.Logger.Infof("* %s-%s-%s\n", resources.ResourceName(), identifier, region)
+	var plan []plannedResource
+	for projectID, project := range resources.Projects {
+		for region, resourcesInRegion := range project.Resources {
+			for _, resources := range resourcesInRegion.Resources {
+				run.AddDiscovered(resources.ResourceName(), region, len(resources.ResourceIdentifiers()))
+				for _, identifier := range resources.ResourceIdentifiers() {
+					plan = append(plan, plannedResource{Scope: projectID, Location: region, ResourceType: resources.ResourceName(), Identifier: identifier})
+					logging.Logger.Infof("* %s-%s-%s-%s\n", projectID, resources.ResourceName(), identifier, region)
+				}
 			}
 		}
 	}
 
+	if c.IsSet("output-plan") {
+		if err := planReport("gcp", plan).WriteJSON(c.String("output-plan")); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("The plan has been written to %s, and nothing was nuked. Have it approved with cloud-nuke approve-plan --plan %s", c.String("output-plan"), c.String("output-plan"))
+		return nil
+	}
+
+	approved, err := checkApproval(c, "gcp", plan)
+	if err != nil || !approved {
+		return err
+	}
+
+	if c.Bool("interactive") {
+		selected, err := selectResources(gcpSelectableResources(resources))
+		if err != nil {
+			return err
+		}
+		resources = gcp.FilterResources(resources, func(projectID string, region string, resourceType string, identifier string) bool {
+			return selected[selectableResource{Project: projectID, Region: region, ResourceType: resourceType, Identifier: identifier}]
+		})
+		if len(resources.Projects) == 0 {
+			logging.Logger.Infoln("No resources selected, nothing to nuke")
+			return nil
+		}
+	}
+
+	history, historyPath := loadThroughputHistory(c)
+	logNukeSummary("gcp", countByResourceType(gcpSelectableResources(resources)), history)
+	defer saveThroughput("gcp", reporter, history, historyPath)
+	defer recordNukedResources(run, reporter)
+
 	if !c.Bool("force") {
 		prompt := "\nAre you sure you want to nuke all listed resources? Enter 'nuke' to confirm: "
 		proceed, err := confirmationPrompt(prompt)
@@ -156,19 +1824,23 @@ func awsNuke(c *cli.Context) error {
 			return err
 		}
 		if proceed {
-			if err := aws.NukeAllResources(account, regions); err != nil {
+			nukeReport, err := gcp.NukeAllResources(resources, reporter)
+			logGcpNukeReport(nukeReport)
+			if err != nil {
 				return err
 			}
 		}
 	} else {
-		logging.Logger.Infoln("The --force flag is set, so waiting for 10 seconds before proceeding to nuke everything in your account. If you don't want to proceed, hit CTRL+C now!!")
+		logging.Logger.Infoln("The --force flag is set, so waiting for 10 seconds before proceeding to nuke everything in your projects. If you don't want to proceed, hit CTRL+C now!!")
 		for i := 10; i > 0; i-- {
 			fmt.Printf("%d...", i)
 			time.Sleep(1 * time.Second)
 		}
 
 		fmt.Println()
-		if err := aws.NukeAllResources(account, regions); err != nil {
+		nukeReport, err := gcp.NukeAllResources(resources, reporter)
+		logGcpNukeReport(nukeReport)
+		if err != nil {
 			return err
 		}
 	}
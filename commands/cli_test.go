@@ -1,12 +1,27 @@
 package commands
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/azure"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/metrics"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/cloud-nuke/throughput"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ed25519"
 )
 
 func TestParseDuration(t *testing.T) {
@@ -35,6 +50,20 @@ func TestParseDurationInvalidFormat(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestParseTag(t *testing.T) {
+	key, value := parseTagParam("Archive=true")
+	assert.Equal(t, "Archive", key)
+	assert.Equal(t, "true", value)
+
+	key, value = parseTagParam("Archive")
+	assert.Equal(t, "Archive", key)
+	assert.Equal(t, "", value)
+
+	key, value = parseTagParam("Expression=a=b")
+	assert.Equal(t, "Expression", key)
+	assert.Equal(t, "a=b", value)
+}
+
 func TestListResourceTypes(t *testing.T) {
 	allAWSResourceTypes := aws.ListResourceTypes()
 	assert.Greater(t, len(allAWSResourceTypes), 0)
@@ -52,8 +81,579 @@ func TestIsNukeable(t *testing.T) {
 	ec2ResourceName := aws.EC2Instances{}.ResourceName()
 	amiResourceName := aws.AMIs{}.ResourceName()
 
-	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{ec2ResourceName}), true)
-	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{"all"}), true)
-	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{}), true)
-	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{amiResourceName}), false)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{ec2ResourceName}, nil), true)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{"all"}, nil), true)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{}, nil), true)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{amiResourceName}, nil), false)
+
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{}, []string{ec2ResourceName}), false)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{"all"}, []string{ec2ResourceName}), false)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{}, []string{amiResourceName}), true)
+}
+
+func TestValidateExcludeResourceTypes(t *testing.T) {
+	allAWSResourceTypes := aws.ListResourceTypes()
+	ec2ResourceName := aws.EC2Instances{}.ResourceName()
+	amiResourceName := aws.AMIs{}.ResourceName()
+
+	assert.NoError(t, validateExcludeResourceTypes([]string{amiResourceName}, []string{}, allAWSResourceTypes))
+	assert.NoError(t, validateExcludeResourceTypes([]string{amiResourceName}, []string{"all"}, allAWSResourceTypes))
+	assert.NoError(t, validateExcludeResourceTypes(nil, []string{ec2ResourceName}, allAWSResourceTypes))
+
+	err := validateExcludeResourceTypes([]string{"xyz"}, []string{}, allAWSResourceTypes)
+	assert.Equal(t, InvalidFlagError{Name: "exclude-resource-type", Value: "xyz"}, err)
+
+	err = validateExcludeResourceTypes([]string{ec2ResourceName}, []string{ec2ResourceName}, allAWSResourceTypes)
+	assert.Equal(t, ConflictingFlagsError{Name: "exclude-resource-type ec2", ConflictsWith: "resource-type ec2"}, err)
+}
+
+func TestListAzureResourceTypes(t *testing.T) {
+	allAzureResourceTypes := azure.ListResourceTypes()
+	assert.Greater(t, len(allAzureResourceTypes), 0)
+	assert.Contains(t, allAzureResourceTypes, azure.VirtualMachines{}.ResourceName())
+}
+
+func TestIsValidAzureResourceType(t *testing.T) {
+	allAzureResourceTypes := azure.ListResourceTypes()
+	vmResourceName := azure.VirtualMachines{}.ResourceName()
+	assert.Equal(t, azure.IsValidResourceType(vmResourceName, allAzureResourceTypes), true)
+	assert.Equal(t, azure.IsValidResourceType("xyz", allAzureResourceTypes), false)
+}
+
+func TestIsExplicitlyNukeable(t *testing.T) {
+	subscriptionResourceName := aws.QuickSightSubscription{}.ResourceName()
+	amiResourceName := aws.AMIs{}.ResourceName()
+
+	assert.Equal(t, aws.IsExplicitlyNukeable(subscriptionResourceName, []string{subscriptionResourceName}), true)
+	assert.Equal(t, aws.IsExplicitlyNukeable(subscriptionResourceName, []string{"all"}), false)
+	assert.Equal(t, aws.IsExplicitlyNukeable(subscriptionResourceName, []string{}), false)
+	assert.Equal(t, aws.IsExplicitlyNukeable(subscriptionResourceName, []string{amiResourceName}), false)
+}
+
+func TestListGcpResourceTypes(t *testing.T) {
+	allGcpResourceTypes := gcp.ListResourceTypes()
+	assert.Greater(t, len(allGcpResourceTypes), 0)
+	assert.Contains(t, allGcpResourceTypes, gcp.GcsBucketResource{}.ResourceName())
+}
+
+func TestIsValidGcpResourceType(t *testing.T) {
+	allGcpResourceTypes := gcp.ListResourceTypes()
+	bucketResourceName := gcp.GcsBucketResource{}.ResourceName()
+	assert.Equal(t, gcp.IsValidResourceType(bucketResourceName, allGcpResourceTypes), true)
+	assert.Equal(t, gcp.IsValidResourceType("xyz", allGcpResourceTypes), false)
+}
+
+func TestPlanDigest(t *testing.T) {
+	instance := plannedResource{Scope: "123456789012", Location: "us-east-1", ResourceType: "ec2", Identifier: "i-1"}
+	image := plannedResource{Scope: "123456789012", Location: "us-east-1", ResourceType: "ami", Identifier: "ami-1"}
+	plan := []plannedResource{instance, image}
+
+	// The order the resources were found in doesn't matter
+	assert.Equal(t, planDigest("aws", plan), planDigest("aws", []plannedResource{image, instance}))
+	assert.Equal(t, plan, []plannedResource{instance, image})
+
+	assert.NotEqual(t, planDigest("aws", plan), planDigest("aws", []plannedResource{instance}))
+	assert.NotEqual(t, planDigest("aws", plan), planDigest("gcp", plan))
+
+	otherAccount := instance
+	otherAccount.Scope = "210987654321"
+	assert.NotEqual(t, planDigest("aws", []plannedResource{instance}), planDigest("aws", []plannedResource{otherAccount}))
+
+	// The fields can't run into each other, although identifiers and locations hold hyphens
+	shifted := plannedResource{Scope: "123456789012", Location: "east-1", ResourceType: "ec2", Identifier: "i-1-us"}
+	assert.NotEqual(t, planDigest("aws", []plannedResource{instance}), planDigest("aws", []plannedResource{shifted}))
+}
+
+func TestPlanDigestOfPlanFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "cloud-nuke-plan-*.json")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	// The resources of an AWS plan belong to its account, so the approver signs the digest of the run
+	awsPlan := []plannedResource{{Scope: "123456789012", Location: "us-east-1", ResourceType: "ec2", Identifier: "i-1"}}
+	awsReport := report.New("aws")
+	awsReport.Account = &report.Account{ID: "123456789012"}
+	awsReport.AddResource("ec2", "i-1", "us-east-1", time.Time{})
+	require.NoError(t, awsReport.WriteJSON(file.Name()))
+	savedPlan, err := report.ReadJSON(file.Name())
+	require.NoError(t, err)
+	plan, err := plannedResourcesOf(file.Name(), savedPlan)
+	require.NoError(t, err)
+	assert.Equal(t, planDigest("aws", awsPlan), planDigest(savedPlan.Cloud, plan))
+
+	// GCP and Azure plans record the project or subscription of each resource
+	gcpPlan := []plannedResource{
+		{Scope: "my-project", Location: "us", ResourceType: "gcsbucket", Identifier: "my-bucket"},
+		{Scope: "other-project", Location: "us-central1-a", ResourceType: "gceinstance", Identifier: "my-instance"},
+	}
+	require.NoError(t, planReport("gcp", gcpPlan).WriteJSON(file.Name()))
+	savedPlan, err = report.ReadJSON(file.Name())
+	require.NoError(t, err)
+	plan, err = plannedResourcesOf(file.Name(), savedPlan)
+	require.NoError(t, err)
+	assert.Equal(t, gcpPlan, plan)
+	assert.Equal(t, planDigest("gcp", gcpPlan), planDigest(savedPlan.Cloud, plan))
+
+	// Without an account, the resources of the plan can't be tied to one
+	awsReport.Account = nil
+	_, err = plannedResourcesOf(file.Name(), awsReport)
+	assert.Equal(t, InvalidPlanError{Path: file.Name(), Reason: "it doesn't tell which account, subscription or project ec2 i-1 belongs to"}, err)
+}
+
+func TestIsApproved(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPublicKey, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	digest := planDigest("aws", []plannedResource{{Scope: "123456789012", Location: "us-east-1", ResourceType: "ec2", Identifier: "i-1"}})
+	token := approvalToken(privateKey, digest)
+
+	assert.True(t, isApproved([]ed25519.PublicKey{otherPublicKey, publicKey}, digest, token))
+	assert.False(t, isApproved([]ed25519.PublicKey{otherPublicKey}, digest, token))
+	assert.False(t, isApproved([]ed25519.PublicKey{publicKey}, digest, approvalToken(otherPrivateKey, digest)))
+	assert.False(t, isApproved([]ed25519.PublicKey{publicKey}, planDigest("gcp", nil), token))
+
+	// The digest alone, as printed to the requester, isn't a valid token
+	assert.False(t, isApproved([]ed25519.PublicKey{publicKey}, digest, digest))
+	assert.False(t, isApproved([]ed25519.PublicKey{publicKey}, digest, "not hex"))
+}
+
+func TestParseApproverPublicKeys(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	publicKeys, err := parseApproverPublicKeys([]string{hex.EncodeToString(publicKey)})
+	require.NoError(t, err)
+	assert.Equal(t, []ed25519.PublicKey{publicKey}, publicKeys)
+
+	_, err = parseApproverPublicKeys([]string{"abcd"})
+	assert.Error(t, err)
+}
+
+func TestFormatAgeSuffix(t *testing.T) {
+	assert.Equal(t, "", formatAgeSuffix(time.Time{}))
+	assert.Equal(t, " (2h0m old)", formatAgeSuffix(time.Now().Add(-2*time.Hour)))
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "3.5 KiB", formatBytes(3584))
+	assert.Equal(t, "42.0 MiB", formatBytes(42<<20))
+	assert.Equal(t, "1.5 GiB", formatBytes(3<<29))
+}
+
+func TestAvailabilityZoneRegions(t *testing.T) {
+	enabledRegions := []string{"us-east-1", "us-west-2", "eu-west-1"}
+
+	regions, err := availabilityZoneRegions([]string{"us-east-1a", "us-east-1b", "us-west-2-lax-1a"}, enabledRegions)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"us-east-1", "us-west-2"}, regions)
+
+	_, err = availabilityZoneRegions([]string{"ap-south-1a"}, enabledRegions)
+	assert.Error(t, err)
+}
+
+func TestAZScopedResourceTypes(t *testing.T) {
+	resourceTypes, err := azScopedResourceTypes(nil)
+	require.NoError(t, err)
+	assert.Equal(t, aws.AZScopedResourceTypes(), resourceTypes)
+
+	resourceTypes, err = azScopedResourceTypes([]string{"all"})
+	require.NoError(t, err)
+	assert.Equal(t, aws.AZScopedResourceTypes(), resourceTypes)
+
+	resourceTypes, err = azScopedResourceTypes([]string{"ebs"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ebs"}, resourceTypes)
+
+	_, err = azScopedResourceTypes([]string{"ebs", "vpc"})
+	assert.Error(t, err)
+}
+
+func TestFormatTeamOwnership(t *testing.T) {
+	cost := 120.5
+	team := report.TeamOwnership{
+		Team:            "platform",
+		Owners:          []string{"alice", "bob"},
+		ResourceCounts:  map[string]int{"ec2": 2, "ebs": 1},
+		TotalResources:  3,
+		MonthToDateCost: &cost,
+	}
+	assert.Equal(t, "3 resource(s) (ebs: 1, ec2: 2), owned by alice, bob, $120.50 month-to-date", formatTeamOwnership(team))
+
+	team = report.TeamOwnership{Team: "security", Owners: []string{}, ResourceCounts: map[string]int{}}
+	assert.Equal(t, "0 resource(s)", formatTeamOwnership(team))
+}
+
+func TestQuotaResourceTypes(t *testing.T) {
+	resourceTypes, err := quotaResourceTypes(nil)
+	require.NoError(t, err)
+	assert.Equal(t, aws.QuotaResourceTypes(), resourceTypes)
+
+	resourceTypes, err = quotaResourceTypes([]string{"all"})
+	require.NoError(t, err)
+	assert.Equal(t, aws.QuotaResourceTypes(), resourceTypes)
+
+	resourceTypes, err = quotaResourceTypes([]string{"vpc", "eip"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vpc", "eip"}, resourceTypes)
+
+	_, err = quotaResourceTypes([]string{"kmskey"})
+	assert.Error(t, err)
+}
+
+func TestFormatQuotaUsage(t *testing.T) {
+	usage := aws.QuotaUsage{ResourceType: "vpc", Region: "us-east-1", QuotaName: "VPCs per Region", Count: 4, Quota: 5}
+	assert.Equal(t, "vpc in us-east-1: 4 of 5 (80%, VPCs per Region)", formatQuotaUsage(usage))
+}
+
+func TestParseRegexpParams(t *testing.T) {
+	expressions, err := parseRegexpParams("dns-zone-name", []string{"^test-", "-ci$"})
+	require.NoError(t, err)
+	require.Len(t, expressions, 2)
+	assert.True(t, expressions[0].MatchString("test-zone"))
+	assert.True(t, expressions[1].MatchString("zone-ci"))
+
+	expressions, err = parseRegexpParams("dns-zone-name", nil)
+	require.NoError(t, err)
+	assert.Empty(t, expressions)
+
+	_, err = parseRegexpParams("dns-zone-name", []string{"(unclosed"})
+	assert.Equal(t, InvalidFlagError{Name: "dns-zone-name", Value: "(unclosed"}, err)
+}
+
+func TestParseSelection(t *testing.T) {
+	indexes, err := parseSelection("2 5-7,9", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 4, 5, 6, 8}, indexes)
+
+	indexes, err = parseSelection("", 10)
+	require.NoError(t, err)
+	assert.Empty(t, indexes)
+
+	for _, input := range []string{"0", "11", "7-5", "a", "2-b", "1-11"} {
+		_, err = parseSelection(input, 10)
+		assert.Equal(t, InvalidSelectionError{Input: input}, err)
+	}
+}
+
+func TestSelectableResourceString(t *testing.T) {
+	assert.Equal(t, "ec2-i-123-us-east-1", selectableResource{Region: "us-east-1", ResourceType: "ec2", Identifier: "i-123"}.String())
+	assert.Equal(t, "my-project-gcsbucket-my-bucket-us", selectableResource{Project: "my-project", Region: "us", ResourceType: "gcsbucket", Identifier: "my-bucket"}.String())
+}
+
+func TestReadAwsPlan(t *testing.T) {
+	file, err := ioutil.TempFile("", "cloud-nuke-plan-*.json")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	allResourceTypes := []string{"ec2", "vpc"}
+	enabledRegions := []string{"us-east-1", "eu-west-1"}
+
+	savedPlan := report.New("aws")
+	savedPlan.AddResource("ec2", "i-123", "us-east-1", time.Time{})
+	require.NoError(t, savedPlan.WriteJSON(file.Name()))
+	plan, err := readAwsPlan(file.Name(), allResourceTypes, enabledRegions)
+	require.NoError(t, err)
+	assert.Equal(t, savedPlan.Resources, plan.Resources)
+
+	savedPlan.AddResource("ebs", "vol-123", "us-east-1", time.Time{})
+	require.NoError(t, savedPlan.WriteJSON(file.Name()))
+	_, err = readAwsPlan(file.Name(), allResourceTypes, enabledRegions)
+	assert.Equal(t, InvalidPlanError{Path: file.Name(), Reason: "unknown resource type ebs"}, err)
+
+	savedPlan = report.New("aws")
+	savedPlan.AddResource("vpc", "vpc-123", "ap-east-1", time.Time{})
+	require.NoError(t, savedPlan.WriteJSON(file.Name()))
+	_, err = readAwsPlan(file.Name(), allResourceTypes, enabledRegions)
+	assert.Equal(t, InvalidPlanError{Path: file.Name(), Reason: "region ap-east-1 isn't enabled"}, err)
+
+	require.NoError(t, report.New("gcp").WriteJSON(file.Name()))
+	_, err = readAwsPlan(file.Name(), allResourceTypes, enabledRegions)
+	assert.Equal(t, InvalidPlanError{Path: file.Name(), Reason: "it was made for gcp, not aws"}, err)
+}
+
+func TestPlanScope(t *testing.T) {
+	plan := report.New("aws")
+	plan.AddResource("vpc", "vpc-123", "us-east-1", time.Time{})
+	plan.AddResource("ec2", "i-123", "us-east-1", time.Time{})
+	plan.AddResource("ec2", "i-456", "eu-west-1", time.Time{})
+
+	regions, resourceTypes := planScope(plan)
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, regions)
+	assert.Equal(t, []string{"ec2", "vpc"}, resourceTypes)
+
+	resources := plannedResources(plan)
+	assert.Len(t, resources, 3)
+	assert.True(t, resources[selectableResource{Region: "eu-west-1", ResourceType: "ec2", Identifier: "i-456"}])
+	assert.False(t, resources[selectableResource{Region: "us-east-1", ResourceType: "ec2", Identifier: "i-456"}])
+}
+
+func TestGetPlannedAwsResourcesAccountMismatch(t *testing.T) {
+	plan := report.New("aws")
+	plan.Account = &report.Account{ID: "123456789012"}
+
+	_, _, err := getPlannedAwsResources(context.Background(), plan, "210987654321", aws.ResourceOptions{})
+	assert.Equal(t, PlanAccountMismatchError{PlanAccountID: "123456789012", AccountID: "210987654321"}, err)
+}
+
+func TestSettings(t *testing.T) {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	set.Var(&cli.StringSlice{}, "resource-type", "")
+	set.Var(&cli.StringSlice{}, "exclude-region", "")
+	set.String("older-than", "0s", "")
+	set.Bool("force", false, "")
+	set.Int("keep-latest-gcr-images", 0, "")
+	set.Int64("kms-pending-window", 0, "")
+	require.NoError(t, set.Parse([]string{"--resource-type", "ec2", "--keep-latest-gcr-images", "2"}))
+	c := cli.NewContext(nil, set, nil)
+
+	// The flags that are set take precedence over the config file
+	assert.Equal(t, []string{"ec2"}, stringSliceSetting(c, "resource-type", []string{"ebs"}))
+	assert.Equal(t, 2, intSetting(c, "keep-latest-gcr-images", 5))
+
+	// The config file applies to the flags that aren't set
+	assert.Equal(t, []string{"us-west-1"}, stringSliceSetting(c, "exclude-region", []string{"us-west-1"}))
+	assert.Equal(t, "24h", stringSetting(c, "older-than", "24h"))
+	assert.True(t, boolSetting(c, "force", true))
+	assert.Equal(t, int64(7), int64Setting(c, "kms-pending-window", 7))
+
+	// The flags' defaults apply when neither is set
+	assert.Empty(t, stringSliceSetting(c, "exclude-region", nil))
+	assert.Equal(t, "0s", stringSetting(c, "older-than", ""))
+	assert.False(t, boolSetting(c, "force", false))
+	assert.Equal(t, int64(0), int64Setting(c, "kms-pending-window", 0))
+}
+
+func TestCheckNotProtected(t *testing.T) {
+	assert.NoError(t, checkNotProtected("account", "123456789012", nil))
+	assert.NoError(t, checkNotProtected("account", "123456789012", []string{"210987654321"}))
+	assert.Equal(t, ProtectedError{Kind: "account", ID: "123456789012"}, checkNotProtected("account", "123456789012", []string{"123456789012"}))
+}
+
+func TestGetProgressReporter(t *testing.T) {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	set.String("progress-format", "text", "")
+	c := cli.NewContext(nil, set, nil)
+
+	reporter, err := getProgressReporter(c)
+	require.NoError(t, err)
+	assert.NotNil(t, reporter)
+
+	require.NoError(t, set.Set("progress-format", "yaml"))
+	_, err = getProgressReporter(c)
+	assert.Equal(t, InvalidFlagError{Name: "progress-format", Value: "yaml"}, err)
+}
+
+func TestRenderConfig(t *testing.T) {
+	effective := config.Config{}
+	effective.AWS.Filters.ResourceTypes = []string{"ec2"}
+	effective.AWS.Retention.OlderThan = "24h"
+	env := map[string]string{
+		"AWS_PROFILE":           "sandbox",
+		"AWS_SECRET_ACCESS_KEY": "do-not-print-me",
+	}
+	lookupEnv := func(name string) (string, bool) {
+		value, isSet := env[name]
+		return value, isSet
+	}
+
+	rendered, err := renderConfig(effective, awsEnvVars, lookupEnv)
+	require.NoError(t, err)
+
+	assert.Contains(t, rendered, "resource_types:\n    - ec2")
+	assert.Contains(t, rendered, "older_than: 24h")
+	assert.Contains(t, rendered, "AWS_PROFILE: sandbox")
+	assert.Contains(t, rendered, "AWS_SECRET_ACCESS_KEY: <redacted>")
+	assert.NotContains(t, rendered, "do-not-print-me")
+	assert.NotContains(t, rendered, "AWS_SESSION_TOKEN")
+	// Only the section of the cloud being nuked is printed
+	assert.NotContains(t, rendered, "gcp:")
+}
+
+func TestFormatNukeSummary(t *testing.T) {
+	history := throughput.NewHistory()
+	counts := map[string]int{"ec2": 100, "ebs": 20}
+	assert.Equal(t, "120 resource(s) to nuke (ebs: 20, ec2: 100), no estimate of the duration yet as these resource types were never nuked before", formatNukeSummary("aws", counts, history))
+
+	history.Record("aws", "ec2", 10, 4*time.Minute)
+	assert.Equal(t, "120 resource(s) to nuke (ebs: 20, ec2: 100), estimated to take ~40 minutes plus the time to nuke ebs, never nuked before", formatNukeSummary("aws", counts, history))
+
+	history.Record("aws", "ebs", 10, time.Minute)
+	assert.Equal(t, "120 resource(s) to nuke (ebs: 20, ec2: 100), estimated to take ~42 minutes", formatNukeSummary("aws", counts, history))
+}
+
+func TestFormatEstimate(t *testing.T) {
+	assert.Equal(t, "less than a minute", formatEstimate(30*time.Second))
+	assert.Equal(t, "~1 minute", formatEstimate(90*time.Second))
+	assert.Equal(t, "~42 minutes", formatEstimate(42*time.Minute+10*time.Second))
+	assert.Equal(t, "~1.5 hours", formatEstimate(90*time.Minute))
+}
+
+func TestParseExcludedIds(t *testing.T) {
+	excluded := parseExcludedIds(`# Shared by the whole team
+i-0123456789abcdef0
+
+  arn:aws:ec2:us-east-1:123456789012:vpc/vpc-0123456789abcdef0
+arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf
+`)
+
+	assert.True(t, excluded.keeps("ec2", "i-0fedcba9876543210"))
+	assert.False(t, excluded.keeps("ec2", "i-0123456789abcdef0"))
+	assert.False(t, excluded.keeps("vpc", "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-0123456789abcdef0"))
+	assert.False(t, excluded.keeps("vpc", "vpc-0123456789abcdef0"))
+	assert.False(t, excluded.keeps("secretsmanager", "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf"))
+	assert.True(t, excluded.keeps("ec2", "# Shared by the whole team"))
+	assert.True(t, excluded.keeps("ec2", ""))
+}
+
+func TestNameFilterKeeps(t *testing.T) {
+	names, err := parseNameFilter(config.Filters{})
+	require.NoError(t, err)
+	assert.Nil(t, names)
+
+	_, err = parseNameFilter(config.Filters{ExcludeNameRegex: []string{"("}})
+	assert.Equal(t, InvalidFlagError{Name: "exclude-name-regex", Value: "("}, err)
+
+	names, err = parseNameFilter(config.Filters{IncludeNameRegex: []string{"^test-", "-tmp$"}, ExcludeNameRegex: []string{"keep"}})
+	require.NoError(t, err)
+	assert.True(t, names.keeps("ec2", "test-bastion"))
+	assert.True(t, names.keeps("s3", "build-cache-tmp"))
+	assert.False(t, names.keeps("ec2", "prod-bastion"))
+	assert.False(t, names.keeps("s3", "test-keep-me"))
+
+	names, err = parseNameFilter(config.Filters{ExcludeNameRegex: []string{"^prod-"}})
+	require.NoError(t, err)
+	assert.True(t, names.keeps("ec2", "test-bastion"))
+	assert.False(t, names.keeps("ec2", "prod-bastion"))
+}
+
+func TestParseTimeouts(t *testing.T) {
+	allResourceTypes := aws.ListResourceTypes()
+
+	timeouts, err := parseTimeouts(config.AWS{Timeout: "30m", ResourceTypeTimeouts: map[string]string{"rdssnapshot": "1h"}}, allResourceTypes)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeouts.Default)
+	assert.Equal(t, time.Hour, timeouts.For("rdssnapshot"))
+
+	timeouts, err = parseTimeouts(config.AWS{}, allResourceTypes)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeouts.For("ec2"))
+
+	_, err = parseTimeouts(config.AWS{Timeout: "-5m"}, allResourceTypes)
+	assert.Equal(t, InvalidFlagError{Name: "timeout", Value: "-5m"}, err)
+	_, err = parseTimeouts(config.AWS{ResourceTypeTimeouts: map[string]string{"rds": "1h"}}, allResourceTypes)
+	assert.Equal(t, InvalidFlagError{Name: "resource-type-timeout", Value: "rds=1h"}, err)
+	_, err = parseTimeouts(config.AWS{ResourceTypeTimeouts: map[string]string{"rdssnapshot": ""}}, allResourceTypes)
+	assert.Equal(t, InvalidFlagError{Name: "resource-type-timeout", Value: "rdssnapshot="}, err)
+}
+
+func TestValidateConfig(t *testing.T) {
+	valid, err := config.Parse([]byte(`
+aws:
+  filters:
+    resource_types: [ec2, ebs]
+  retention:
+    older_than: 24h
+    newer_than: 720h
+  resource_type_timeouts:
+    ec2: 30m
+  resource_options:
+    ec2keypair:
+      names: ["^terratest-"]
+gcp:
+  filters:
+    exclude_resource_types: [cloudkmskey]
+`))
+	require.NoError(t, err)
+	assert.NoError(t, validateConfig(valid))
+
+	invalid := []struct {
+		contents string
+		err      error
+	}{
+		{"aws:\n  filters:\n    resource_types: [ec3]\n", config.InvalidConfigError{Field: "aws.filters.resource_types", Value: "ec3"}},
+		{"aws:\n  filters:\n    resource_types: [ec2]\n    exclude_resource_types: [ec2]\n", config.ConflictingConfigError{Field: "aws.filters.exclude_resource_types ec2", ConflictsWith: "aws.filters.resource_types ec2"}},
+		{"aws:\n  retention:\n    older_than: 48h\n    newer_than: 24h\n", config.InvalidConfigError{Field: "aws.retention.newer_than", Value: "24h"}},
+		{"aws:\n  resource_type_timeouts:\n    rds: 1h\n", config.InvalidConfigError{Field: "aws.resource_type_timeouts.rds", Value: "rds"}},
+		{"aws:\n  failure_policy: ignore\n", config.InvalidConfigError{Field: "aws.failure_policy", Value: "ignore"}},
+		{"aws:\n  resource_type_failure_policies:\n    cloudwatchlogstream: skip\n", config.InvalidConfigError{Field: "aws.resource_type_failure_policies.cloudwatchlogstream", Value: "skip"}},
+		{"aws:\n  resource_options:\n    ec2keypair:\n      names: [\"[\"]\n", config.InvalidConfigError{Field: "aws.resource_options.ec2keypair.names", Value: "["}},
+		{"aws:\n  resource_options:\n    secretsmanager:\n      force_delete_without_recovery: true\n      recovery_window: 7\n", config.ConflictingConfigError{Field: "aws.resource_options.secretsmanager.recovery_window", ConflictsWith: "aws.resource_options.secretsmanager.force_delete_without_recovery"}},
+		{"aws:\n  resource_options:\n    ebs:\n      final_snapshot: true\n", config.InvalidConfigError{Field: "aws.resource_options.ebs.final_snapshot_retention", Value: "0"}},
+		{"gcp:\n  resource_options:\n    clouddnszone:\n      exclude_names: [\"(\"]\n", config.InvalidConfigError{Field: "gcp.resource_options.clouddnszone.exclude_names", Value: "("}},
+		{"azure:\n  filters:\n    exclude_ids_file: does-not-exist.txt\n", config.InvalidConfigError{Field: "azure.filters.exclude_ids_file", Value: "does-not-exist.txt"}},
+		{"gcp:\n  filters:\n    include_name_regex: [\"[\"]\n", config.InvalidConfigError{Field: "gcp.filters.include_name_regex", Value: "["}},
+	}
+	for _, testCase := range invalid {
+		cfg, err := config.Parse([]byte(testCase.contents))
+		require.NoError(t, err)
+		assert.Equal(t, testCase.err, validateConfig(cfg), testCase.contents)
+	}
+}
+
+func TestRunState(t *testing.T) {
+	file, err := ioutil.TempFile("", "cloud-nuke-state-*.json")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	state := report.New("aws")
+	state.AddResource("ec2", "i-123", "us-east-1", time.Time{})
+	state.AddResource("ec2", "i-456", "us-east-1", time.Time{})
+	state.AddResource("ebs", "vol-123", "us-east-1", time.Time{})
+	state.AddResource("vpc", "vpc-123", "us-east-1", time.Time{})
+	state.SetStatus("vpc", "us-east-1", []string{"vpc-123"}, report.StatusNuked)
+
+	runState, err := startRunState(file.Name(), state, []selectableResource{
+		{Region: "us-east-1", ResourceType: "ec2", Identifier: "i-123"},
+		{Region: "us-east-1", ResourceType: "ec2", Identifier: "i-456"},
+	})
+	require.NoError(t, err)
+	runState.record("ec2", "us-east-1", []string{"i-123"}, false)
+	runState.record("ec2", "us-east-1", []string{"i-456"}, true)
+
+	saved, err := report.ReadJSON(file.Name())
+	require.NoError(t, err)
+	var statuses []string
+	for _, resource := range saved.Resources {
+		statuses = append(statuses, resource.Identifier+" "+resource.Status)
+	}
+	assert.Equal(t, []string{"i-123 nuked", "i-456 failed", "vol-123 skipped", "vpc-123 nuked"}, statuses)
+	assert.Len(t, saved.Remaining().Resources, 1)
+}
+
+func TestParseFailurePolicies(t *testing.T) {
+	allResourceTypes := aws.ListResourceTypes()
+
+	policies, err := parseFailurePolicies(config.AWS{FailurePolicy: "abort-type", ResourceTypeFailurePolicies: map[string]string{"cloudwatchlogstream": "continue"}}, allResourceTypes)
+	require.NoError(t, err)
+	assert.Equal(t, aws.FailurePolicyAbortType, policies.For("natgateway"))
+	assert.Equal(t, aws.FailurePolicyContinue, policies.For("cloudwatchlogstream"))
+
+	policies, err = parseFailurePolicies(config.AWS{}, allResourceTypes)
+	require.NoError(t, err)
+	assert.Equal(t, aws.FailurePolicyAbortRun, policies.For("ec2"))
+
+	_, err = parseFailurePolicies(config.AWS{FailurePolicy: "ignore"}, allResourceTypes)
+	assert.Equal(t, InvalidFlagError{Name: "failure-policy", Value: "ignore"}, err)
+	_, err = parseFailurePolicies(config.AWS{ResourceTypeFailurePolicies: map[string]string{"loggroup": "continue"}}, allResourceTypes)
+	assert.Equal(t, InvalidFlagError{Name: "resource-type-failure-policy", Value: "loggroup=continue"}, err)
+}
+
+func TestFormatRunTimings(t *testing.T) {
+	counts := []metrics.Count{
+		{ResourceType: "ebs", Region: "us-east-1", Discovered: 10, Nuked: 10, DiscoveryTime: 2 * time.Second, NukeTime: 30 * time.Second},
+		{ResourceType: "ec2", Region: "us-east-1", Discovered: 4, Nuked: 3, Failed: 1, DiscoveryTime: 1500 * time.Millisecond, NukeTime: 2 * time.Minute},
+		{ResourceType: "vpc", Region: "us-west-2", Discovered: 1, DiscoveryTime: 340 * time.Millisecond},
+	}
+	expected := "" +
+		"RESOURCE TYPE  REGION     DISCOVERED  NUKED  FAILED  DISCOVERY  NUKE  NUKED/MIN\n" +
+		"ec2            us-east-1  4           3      1       1.5s       2m0s  1.5\n" +
+		"ebs            us-east-1  10          10     0       2s         30s   20.0\n" +
+		"vpc            us-west-2  1           0      0       300ms      -     -\n"
+	assert.Equal(t, expected, formatRunTimings(counts))
 }
@@ -0,0 +1,225 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// loadConfig - Reads the config file given with --config. Returns an empty config when there is none, so that only
+// the flags apply.
+func loadConfig(c *cli.Context) (*config.Config, error) {
+	if !c.IsSet("config") {
+		return &config.Config{}, nil
+	}
+	cfg, err := config.Load(c.String("config"))
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return cfg, nil
+}
+
+// The settings below are read from the flag when it is set, and from the config file otherwise. The flag's default
+// applies when the config file doesn't hold the setting either.
+
+func stringSliceSetting(c *cli.Context, flagName string, configValue []string) []string {
+	if c.IsSet(flagName) || len(configValue) == 0 {
+		return c.StringSlice(flagName)
+	}
+	return configValue
+}
+
+func stringSetting(c *cli.Context, flagName string, configValue string) string {
+	if c.IsSet(flagName) || configValue == "" {
+		return c.String(flagName)
+	}
+	return configValue
+}
+
+func boolSetting(c *cli.Context, flagName string, configValue bool) bool {
+	if c.IsSet(flagName) {
+		return c.Bool(flagName)
+	}
+	return configValue
+}
+
+func intSetting(c *cli.Context, flagName string, configValue int) int {
+	if c.IsSet(flagName) || configValue == 0 {
+		return c.Int(flagName)
+	}
+	return configValue
+}
+
+func int64Setting(c *cli.Context, flagName string, configValue int64) int64 {
+	if c.IsSet(flagName) || configValue == 0 {
+		return c.Int64(flagName)
+	}
+	return configValue
+}
+
+// effectiveAwsConfig - Returns the aws settings of the run, merging the flags into the config file
+func effectiveAwsConfig(c *cli.Context, fileConfig config.AWS) config.AWS {
+	effective := fileConfig
+	effective.Filters.ResourceTypes = stringSliceSetting(c, "resource-type", fileConfig.Filters.ResourceTypes)
+	effective.Filters.ExcludeResourceTypes = stringSliceSetting(c, "exclude-resource-type", fileConfig.Filters.ExcludeResourceTypes)
+	effective.Filters.ExcludeRegions = stringSliceSetting(c, "exclude-region", fileConfig.Filters.ExcludeRegions)
+	effective.Filters.ExcludeIdsFile = stringSetting(c, "exclude-ids-file", fileConfig.Filters.ExcludeIdsFile)
+	effective.Filters.IncludeNameRegex = stringSliceSetting(c, "include-name-regex", fileConfig.Filters.IncludeNameRegex)
+	effective.Filters.ExcludeNameRegex = stringSliceSetting(c, "exclude-name-regex", fileConfig.Filters.ExcludeNameRegex)
+	effective.Retention.OlderThan = stringSetting(c, "older-than", fileConfig.Retention.OlderThan)
+	effective.Retention.NewerThan = stringSetting(c, "newer-than", fileConfig.Retention.NewerThan)
+	effective.RoleArns = stringSliceSetting(c, "role-arn", fileConfig.RoleArns)
+	effective.Concurrency = intSetting(c, "concurrency", fileConfig.Concurrency)
+	effective.Timeout = stringSetting(c, "timeout", fileConfig.Timeout)
+	if c.IsSet("resource-type-timeout") {
+		effective.ResourceTypeTimeouts = map[string]string{}
+		for _, param := range c.StringSlice("resource-type-timeout") {
+			resourceType, timeout := parseTagParam(param)
+			effective.ResourceTypeTimeouts[resourceType] = timeout
+		}
+	}
+	effective.FailurePolicy = stringSetting(c, "failure-policy", fileConfig.FailurePolicy)
+	if c.IsSet("resource-type-failure-policy") {
+		effective.ResourceTypeFailurePolicies = map[string]string{}
+		for _, param := range c.StringSlice("resource-type-failure-policy") {
+			resourceType, policy := parseTagParam(param)
+			effective.ResourceTypeFailurePolicies[resourceType] = policy
+		}
+	}
+
+	options := &effective.ResourceOptions
+	options.SecretsManager.ForceDeleteWithoutRecovery = boolSetting(c, "secrets-force-delete-without-recovery", fileConfig.ResourceOptions.SecretsManager.ForceDeleteWithoutRecovery)
+	options.SecretsManager.RecoveryWindow = int64Setting(c, "secrets-recovery-window", fileConfig.ResourceOptions.SecretsManager.RecoveryWindow)
+	options.KMSKey.PendingWindow = int64Setting(c, "kms-pending-window", fileConfig.ResourceOptions.KMSKey.PendingWindow)
+	options.EC2.StopOnly = boolSetting(c, "stop-only", fileConfig.ResourceOptions.EC2.StopOnly)
+	options.EC2.DisableTerminationProtection = boolSetting(c, "ec2-disable-termination-protection", fileConfig.ResourceOptions.EC2.DisableTerminationProtection)
+	options.EBS.FinalSnapshot = boolSetting(c, "ebs-final-snapshot", fileConfig.ResourceOptions.EBS.FinalSnapshot)
+	options.EBS.FinalSnapshotRetention = intSetting(c, "ebs-final-snapshot-retention", fileConfig.ResourceOptions.EBS.FinalSnapshotRetention)
+	options.AMI.DeleteSnapshots = boolSetting(c, "ami-delete-snapshots", fileConfig.ResourceOptions.AMI.DeleteSnapshots)
+	options.EC2KeyPair.Names = stringSliceSetting(c, "key-pair-name", fileConfig.ResourceOptions.EC2KeyPair.Names)
+	options.EC2KeyPair.ExcludeNames = stringSliceSetting(c, "exclude-key-pair-name", fileConfig.ResourceOptions.EC2KeyPair.ExcludeNames)
+	options.CloudWatchLogStream.LogGroupNames = stringSliceSetting(c, "log-group-name", fileConfig.ResourceOptions.CloudWatchLogStream.LogGroupNames)
+	options.CloudWatchLogStream.ExcludeLogGroupNames = stringSliceSetting(c, "exclude-log-group-name", fileConfig.ResourceOptions.CloudWatchLogStream.ExcludeLogGroupNames)
+	options.Organizations.Names = stringSliceSetting(c, "organizations-name", fileConfig.ResourceOptions.Organizations.Names)
+	options.Organizations.ExcludeNames = stringSliceSetting(c, "exclude-organizations-name", fileConfig.ResourceOptions.Organizations.ExcludeNames)
+	return effective
+}
+
+// effectiveGcpConfig - Returns the gcp settings of the run, merging the flags into the config file
+func effectiveGcpConfig(c *cli.Context, fileConfig config.GCP) config.GCP {
+	effective := fileConfig
+	effective.Projects = stringSliceSetting(c, "project", fileConfig.Projects)
+	effective.Filters.ResourceTypes = stringSliceSetting(c, "resource-type", fileConfig.Filters.ResourceTypes)
+	effective.Filters.ExcludeResourceTypes = stringSliceSetting(c, "exclude-resource-type", fileConfig.Filters.ExcludeResourceTypes)
+	effective.Filters.ExcludeRegions = stringSliceSetting(c, "exclude-region", fileConfig.Filters.ExcludeRegions)
+	effective.Filters.ExcludeIdsFile = stringSetting(c, "exclude-ids-file", fileConfig.Filters.ExcludeIdsFile)
+	effective.Filters.IncludeNameRegex = stringSliceSetting(c, "include-name-regex", fileConfig.Filters.IncludeNameRegex)
+	effective.Filters.ExcludeNameRegex = stringSliceSetting(c, "exclude-name-regex", fileConfig.Filters.ExcludeNameRegex)
+	effective.Retention.OlderThan = stringSetting(c, "older-than", fileConfig.Retention.OlderThan)
+
+	options := &effective.ResourceOptions
+	options.GceDisk.IncludeAttached = boolSetting(c, "include-attached-disks", fileConfig.ResourceOptions.GceDisk.IncludeAttached)
+	options.GceNetwork.IncludeDefault = boolSetting(c, "include-default-network", fileConfig.ResourceOptions.GceNetwork.IncludeDefault)
+	options.CloudDnsZone.Names = stringSliceSetting(c, "dns-zone-name", fileConfig.ResourceOptions.CloudDnsZone.Names)
+	options.CloudDnsZone.ExcludeNames = stringSliceSetting(c, "exclude-dns-zone-name", fileConfig.ResourceOptions.CloudDnsZone.ExcludeNames)
+	options.GcrImage.KeepLatest = intSetting(c, "keep-latest-gcr-images", fileConfig.ResourceOptions.GcrImage.KeepLatest)
+	return effective
+}
+
+// effectiveAzureConfig - Returns the azure settings of the run, merging the flags into the config file
+func effectiveAzureConfig(c *cli.Context, fileConfig config.Azure) config.Azure {
+	effective := fileConfig
+	effective.Filters.ResourceTypes = stringSliceSetting(c, "resource-type", fileConfig.Filters.ResourceTypes)
+	effective.Filters.ExcludeResourceTypes = stringSliceSetting(c, "exclude-resource-type", fileConfig.Filters.ExcludeResourceTypes)
+	effective.Filters.ExcludeRegions = stringSliceSetting(c, "exclude-location", fileConfig.Filters.ExcludeRegions)
+	effective.Filters.ExcludeIdsFile = stringSetting(c, "exclude-ids-file", fileConfig.Filters.ExcludeIdsFile)
+	effective.Filters.IncludeNameRegex = stringSliceSetting(c, "include-name-regex", fileConfig.Filters.IncludeNameRegex)
+	effective.Filters.ExcludeNameRegex = stringSliceSetting(c, "exclude-name-regex", fileConfig.Filters.ExcludeNameRegex)
+	effective.Retention.OlderThan = stringSetting(c, "older-than", fileConfig.Retention.OlderThan)
+	return effective
+}
+
+// envVar - an environment variable affecting a run
+type envVar struct {
+	name string
+	// Whether the variable holds a secret, which must never be printed
+	secret bool
+}
+
+// The environment variables affecting each command, as printed with --print-config
+var awsEnvVars = []envVar{
+	{name: "AWS_PROFILE"},
+	{name: "AWS_REGION"},
+	{name: "AWS_DEFAULT_REGION"},
+	{name: "AWS_ACCESS_KEY_ID"},
+	{name: "AWS_SECRET_ACCESS_KEY", secret: true},
+	{name: "AWS_SESSION_TOKEN", secret: true},
+}
+
+var gcpEnvVars = []envVar{
+	{name: "GOOGLE_APPLICATION_CREDENTIALS"},
+	{name: "GOOGLE_CLOUD_PROJECT"},
+}
+
+var azureEnvVars = []envVar{
+	{name: "AZURE_SUBSCRIPTION_ID"},
+	{name: "AZURE_TENANT_ID"},
+	{name: "AZURE_CLIENT_ID"},
+	{name: "AZURE_CLIENT_SECRET", secret: true},
+	{name: "AZURE_CLIENT_CERTIFICATE_PASSWORD", secret: true},
+	{name: "AZURE_PASSWORD", secret: true},
+}
+
+const redacted = "<redacted>"
+
+// printedConfig - the settings of a run, as printed with --print-config
+type printedConfig struct {
+	config.Config `yaml:",inline"`
+	// The environment variables that are set, among the ones affecting the run
+	Environment map[string]string `yaml:"environment,omitempty"`
+}
+
+// renderConfig - Renders the settings of a run as YAML, along with the given environment variables that are set. The
+// values of the secrets are redacted.
+func renderConfig(effective config.Config, envVars []envVar, lookupEnv func(string) (string, bool)) (string, error) {
+	printed := printedConfig{Config: effective, Environment: map[string]string{}}
+	for _, envVar := range envVars {
+		value, isSet := lookupEnv(envVar.name)
+		if !isSet {
+			continue
+		}
+		if envVar.secret {
+			value = redacted
+		}
+		printed.Environment[envVar.name] = value
+	}
+
+	contents, err := yaml.Marshal(printed)
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return string(contents), nil
+}
+
+// printConfig - Prints the settings of a run to stdout, so they can be checked before anything is nuked
+func printConfig(effective config.Config, envVars []envVar) error {
+	rendered, err := renderConfig(effective, envVars, os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	fmt.Println(rendered)
+	return nil
+}
+
+// checkNotProtected - Refuses to nuke an account, project or subscription that the config file protects
+func checkNotProtected(kind string, id string, protectedIDs []string) error {
+	if collections.ListContainsElement(protectedIDs, id) {
+		return ProtectedError{Kind: kind, ID: id}
+	}
+	return nil
+}
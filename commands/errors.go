@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 )
 
 type InvalidFlagError struct {
@@ -12,3 +13,94 @@ type InvalidFlagError struct {
 func (e InvalidFlagError) Error() string {
 	return fmt.Sprintf("Invalid value %s for flag %s", e.Value, e.Name)
 }
+
+type MissingFlagError struct {
+	Name       string
+	RequiredBy string
+}
+
+func (e MissingFlagError) Error() string {
+	return fmt.Sprintf("Flag %s is required when using %s", e.Name, e.RequiredBy)
+}
+
+type ConflictingFlagsError struct {
+	Name          string
+	ConflictsWith string
+}
+
+func (e ConflictingFlagsError) Error() string {
+	return fmt.Sprintf("Flag %s can't be used along with %s", e.Name, e.ConflictsWith)
+}
+
+type ApprovalTokenMismatchError struct{}
+
+func (e ApprovalTokenMismatchError) Error() string {
+	return "The approval token isn't an approver's signature of the plan. The resources to nuke may have changed since the plan was approved, so it has to be approved again."
+}
+
+type InvalidApprovalKeyError struct {
+	Path string
+}
+
+func (e InvalidApprovalKeyError) Error() string {
+	return fmt.Sprintf("The file %s doesn't hold a private key as written by cloud-nuke generate-approval-key", e.Path)
+}
+
+type InvalidPlanError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidPlanError) Error() string {
+	return fmt.Sprintf("Invalid plan %s: %s", e.Path, e.Reason)
+}
+
+type PlanAccountMismatchError struct {
+	PlanAccountID string
+	AccountID     string
+}
+
+func (e PlanAccountMismatchError) Error() string {
+	return fmt.Sprintf("The plan was made for account %s, but the current credentials are for account %s", e.PlanAccountID, e.AccountID)
+}
+
+type ProtectedError struct {
+	Kind string
+	ID   string
+}
+
+func (e ProtectedError) Error() string {
+	return fmt.Sprintf("The %s %s is protected in the config file, so it can't be nuked", e.Kind, e.ID)
+}
+
+type LeaksDetectedError struct {
+	ResourceTypes []string
+}
+
+func (e LeaksDetectedError) Error() string {
+	return fmt.Sprintf("Resource types likely leaked: %s", strings.Join(e.ResourceTypes, ", "))
+}
+
+type NearQuotaError struct {
+	Usages []string
+}
+
+func (e NearQuotaError) Error() string {
+	return fmt.Sprintf("Resource types near quota: %s", strings.Join(e.Usages, ", "))
+}
+
+type InvalidSelectionError struct {
+	Input string
+}
+
+func (e InvalidSelectionError) Error() string {
+	return fmt.Sprintf("Invalid selection %s, expected a number or a range of numbers, e.g. 5-7, among the listed resources", e.Input)
+}
+
+type AccountsNotNukedError struct {
+	RoleArns []string
+}
+
+func (e AccountsNotNukedError) Error() string {
+	return fmt.Sprintf("The accounts reached through these roles couldn't be nuked: %s", strings.Join(e.RoleArns, ", "))
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// excludedIds - the identifiers of the resources that are never nuked, as read from --exclude-ids-file
+type excludedIds map[string]bool
+
+// parseExcludedIds - Parses a list of identifiers or ARNs, one per line. Blank lines and lines starting with # are
+// ignored, so the list can be commented. The resources listed by ARN are also matched by the id or name their ARN
+// ends with, for the resource types that aren't identified by ARN, e.g.
+// arn:aws:ec2:us-east-1:123456789012:instance/i-0123456789abcdef0 leaves EC2 instance i-0123456789abcdef0 alone.
+func parseExcludedIds(contents string) excludedIds {
+	excluded := excludedIds{}
+	for _, line := range strings.Split(contents, "\n") {
+		identifier := strings.TrimSpace(line)
+		if identifier == "" || strings.HasPrefix(identifier, "#") {
+			continue
+		}
+		excluded[identifier] = true
+
+		if strings.HasPrefix(identifier, "arn:") {
+			resourceId := identifier[strings.LastIndexAny(identifier, ":/")+1:]
+			if resourceId != "" {
+				excluded[resourceId] = true
+			}
+		}
+	}
+	return excluded
+}
+
+// readExcludedIds - Reads the identifiers of the resources that are never nuked from the file at the given path.
+// Returns nil when there is no file.
+func readExcludedIds(path string) (excludedIds, error) {
+	if path == "" {
+		return nil, nil
+	}
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	excluded := parseExcludedIds(string(contents))
+	logging.Logger.Infof("Leaving alone the resources listed in %s", path)
+	return excluded, nil
+}
+
+// keeps - Checks if the resource is to be nuked, logging the ones that are left alone
+func (excluded excludedIds) keeps(resourceType string, identifier string) bool {
+	if excluded[identifier] {
+		logging.Logger.Infof("Skipping %s %s: listed in --exclude-ids-file", resourceType, identifier)
+		return false
+	}
+	return true
+}
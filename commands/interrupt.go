@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// interruptContext - Returns a context cancelled on the first Ctrl+C, so that cloud-nuke stops making API calls and
+// exits cleanly instead of carrying on deleting resources mid-abort. A second Ctrl+C exits right away. Call the
+// returned function once done, to restore the default handling of Ctrl+C, e.g. at the confirmation prompt. Unless the
+// deadline is zero, the context is also done once the deadline passes, e.g. the one of --max-duration.
+func interruptContext(deadline time.Time) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if !deadline.IsZero() {
+		ctx, cancel = context.WithDeadline(context.Background(), deadline)
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-signals:
+			signal.Stop(signals)
+			logging.Logger.Warnln("Interrupted, stopping once the API calls in flight are aborted. Hit CTRL+C again to exit right away.")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+	}
+}
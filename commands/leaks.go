@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/leaks"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// detectLeaks - Takes a snapshot of how many AWS resources of each type there are, adds it to the history of the
+// previous runs, and flags the resource types whose count kept growing. Nothing is nuked.
+func detectLeaks(c *cli.Context) error {
+	if !c.IsSet("history-file") {
+		return MissingFlagError{Name: "history-file", RequiredBy: "detect-leaks"}
+	}
+	runs := c.Int("runs")
+	if runs < 1 {
+		return InvalidFlagError{Name: "runs", Value: c.String("runs")}
+	}
+
+	if err := verifyReadOnly(c); err != nil {
+		return err
+	}
+
+	allResourceTypes := aws.ListResourceTypes()
+	resourceTypes := c.StringSlice("resource-type")
+	for _, resourceType := range resourceTypes {
+		if resourceType != "all" && !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			return InvalidFlagError{Name: "resource-type", Value: resourceType}
+		}
+	}
+
+	regions, err := aws.GetEnabledRegions()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	excludedRegions := c.StringSlice("exclude-region")
+	for _, excludedRegion := range excludedRegions {
+		if !collections.ListContainsElement(regions, excludedRegion) {
+			return InvalidFlagError{
+				Name:  "exclude-regions",
+				Value: excludedRegion,
+			}
+		}
+	}
+
+	history, err := leaks.Load(c.String("history-file"))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infoln("Retrieving all active AWS resources")
+	takenAt := time.Now()
+	account, err := aws.GetAllResources(context.Background(), regions, excludedRegions, aws.TimeFilter{ExcludeAfter: takenAt}, resourceTypes, nil, aws.ResourceOptions{})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	counts := map[string]int{}
+	for _, resourcesInRegion := range account.Resources {
+		for _, resources := range resourcesInRegion.Resources {
+			counts[resources.ResourceName()] += len(resources.ResourceIdentifiers())
+		}
+	}
+
+	history.Add(leaks.Snapshot{TakenAt: takenAt.UTC(), Counts: counts})
+	if err := history.Save(c.String("history-file")); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if len(history.Snapshots) < runs+1 {
+		logging.Logger.Infof("Only %d run(s) recorded so far, at least %d are needed to detect leaks", len(history.Snapshots), runs+1)
+		return nil
+	}
+
+	growing := history.GrowingResourceTypes(runs)
+	if len(growing) == 0 {
+		logging.Logger.Infof("No resource type grew in each of the last %d runs, you're all good!", runs)
+		return nil
+	}
+
+	logging.Logger.Infof("The following resource types grew in each of the last %d runs, and are likely leaked:", runs)
+	for _, resourceType := range growing {
+		var trend []string
+		for _, count := range history.Trend(resourceType, runs) {
+			trend = append(trend, fmt.Sprint(count))
+		}
+		logging.Logger.Infof("* %s: %s", resourceType, strings.Join(trend, " -> "))
+	}
+
+	if c.Bool("fail-on-leaks") {
+		return LeaksDetectedError{ResourceTypes: growing}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/urfave/cli"
+)
+
+// acquireRunLock - Acquires the lock of the account in the DynamoDB table given with --lock-table, so that no other
+// run against the account can start until this one is over. Returns nil when there is no table.
+func acquireRunLock(c *cli.Context, accountID string) (*aws.RunLock, error) {
+	if !c.IsSet("lock-table") {
+		return nil, nil
+	}
+	ttl, err := time.ParseDuration(c.String("lock-ttl"))
+	if err != nil || ttl <= 0 {
+		return nil, InvalidFlagError{Name: "lock-ttl", Value: c.String("lock-ttl")}
+	}
+	return aws.AcquireRunLock(c.String("lock-table"), c.String("lock-region"), accountID, ttl)
+}
+
+// releaseRunLock - Releases the lock of the run, if any. Failing to release it doesn't fail the run, since it expires
+// anyway.
+func releaseRunLock(lock *aws.RunLock) {
+	if lock == nil {
+		return
+	}
+	if err := lock.Release(); err != nil {
+		logging.Logger.Errorf("Failed to release the lock of the run, which is left to expire: %s", err)
+	}
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"regexp"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// nameFilter - the regular expressions the identifiers or names of the resources are matched against, whatever their
+// resource type, as given with --include-name-regex and --exclude-name-regex
+type nameFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// parseNameFilter - Compiles the regular expressions of the filters. Returns nil when there are none.
+func parseNameFilter(filters config.Filters) (*nameFilter, error) {
+	include, err := parseRegexpParams("include-name-regex", filters.IncludeNameRegex)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := parseRegexpParams("exclude-name-regex", filters.ExcludeNameRegex)
+	if err != nil {
+		return nil, err
+	}
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+	return &nameFilter{include: include, exclude: exclude}, nil
+}
+
+// keeps - Checks if the resource is to be nuked, logging the ones that are left alone. A resource is nuked when its
+// identifier matches none of the expressions to exclude, and one of the expressions to include, if any.
+func (filter *nameFilter) keeps(resourceType string, identifier string) bool {
+	for _, exclude := range filter.exclude {
+		if exclude.MatchString(identifier) {
+			logging.Logger.Infof("Skipping %s %s: matches --exclude-name-regex %s", resourceType, identifier, exclude)
+			return false
+		}
+	}
+	if len(filter.include) == 0 {
+		return true
+	}
+	for _, include := range filter.include {
+		if include.MatchString(identifier) {
+			return true
+		}
+	}
+	logging.Logger.Infof("Skipping %s %s: doesn't match --include-name-regex", resourceType, identifier)
+	return false
+}
@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// ownershipReport - Finds all AWS resources, and reports how many of them, and how much spend, each team owns
+// according to the owner and team tags. Nothing is nuked.
+func ownershipReport(c *cli.Context) error {
+	ownerTagKey := c.String("owner-tag-key")
+	if ownerTagKey == "" {
+		return InvalidFlagError{Name: "owner-tag-key", Value: ownerTagKey}
+	}
+	teamTagKey := c.String("team-tag-key")
+	if teamTagKey == "" {
+		return InvalidFlagError{Name: "team-tag-key", Value: teamTagKey}
+	}
+
+	if err := verifyReadOnly(c); err != nil {
+		return err
+	}
+
+	allResourceTypes := aws.ListResourceTypes()
+	resourceTypes := c.StringSlice("resource-type")
+	for _, resourceType := range resourceTypes {
+		if resourceType != "all" && !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			return InvalidFlagError{Name: "resource-type", Value: resourceType}
+		}
+	}
+
+	regions, err := aws.GetEnabledRegions()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	excludedRegions := c.StringSlice("exclude-region")
+	for _, excludedRegion := range excludedRegions {
+		if !collections.ListContainsElement(regions, excludedRegion) {
+			return InvalidFlagError{
+				Name:  "exclude-regions",
+				Value: excludedRegion,
+			}
+		}
+	}
+
+	logging.Logger.Infoln("Retrieving all active AWS resources")
+	account, err := aws.GetAllResources(context.Background(), regions, excludedRegions, aws.TimeFilter{ExcludeAfter: time.Now()}, resourceTypes, nil, aws.ResourceOptions{})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	owners, err := aws.GetResourceOwners(account, ownerTagKey, teamTagKey)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	var resources []report.OwnedResource
+	for _, owner := range owners {
+		resources = append(resources, report.OwnedResource{
+			ResourceType: owner.ResourceType,
+			Identifier:   owner.Identifier,
+			Region:       owner.Region,
+			Owner:        owner.Owner,
+			Team:         owner.Team,
+		})
+	}
+
+	// Cost Explorer charges for each request, so costs can be left out
+	var costs map[string]float64
+	if !c.Bool("skip-costs") {
+		costs, err = aws.GetMonthToDateSpendByTag(teamTagKey)
+		if err != nil {
+			logging.Logger.Warnf("Unable to look up costs by team, leaving them out of the report: %s", err)
+			costs = nil
+		}
+	}
+
+	ownership := report.NewOwnership("aws", ownerTagKey, teamTagKey, resources, costs)
+	if accountInfo, err := aws.GetAccountInfo(); err == nil {
+		ownership.Account = &report.Account{
+			ID:     accountInfo.Id,
+			Alias:  accountInfo.Alias,
+			Email:  accountInfo.Email,
+			OUPath: accountInfo.OUPath,
+		}
+		logging.Logger.Infof("Ownership of the resources in account %s", ownership.Account.Summary())
+	}
+
+	if len(ownership.Teams) == 0 {
+		logging.Logger.Infoln("No resources found")
+	}
+	for _, team := range ownership.Teams {
+		logging.Logger.Infof("* %s: %s", team.Team, formatTeamOwnership(team))
+	}
+
+	if c.IsSet("output-json") {
+		if err := ownership.WriteJSON(c.String("output-json")); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("Wrote the ownership report to %s", c.String("output-json"))
+	}
+
+	return nil
+}
+
+// formatTeamOwnership - Renders what a team owns on a single line, e.g.
+// 3 resource(s) (ebs: 1, ec2: 2), owned by alice, bob, $120.50 month-to-date
+func formatTeamOwnership(team report.TeamOwnership) string {
+	var resourceTypes []string
+	for resourceType := range team.ResourceCounts {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	var counts []string
+	for _, resourceType := range resourceTypes {
+		counts = append(counts, fmt.Sprintf("%s: %d", resourceType, team.ResourceCounts[resourceType]))
+	}
+
+	line := fmt.Sprintf("%d resource(s)", team.TotalResources)
+	if len(counts) > 0 {
+		line += fmt.Sprintf(" (%s)", strings.Join(counts, ", "))
+	}
+	if len(team.Owners) > 0 {
+		line += fmt.Sprintf(", owned by %s", strings.Join(team.Owners, ", "))
+	}
+	if team.MonthToDateCost != nil {
+		line += fmt.Sprintf(", $%.2f month-to-date", *team.MonthToDateCost)
+	}
+	return line
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// The flags that select the resources to nuke. A plan already holds the resources to nuke, so they can't be used
+// along with --plan.
+var planScopeFlags = []string{"resource-type", "exclude-resource-type", "exclude-region", "az", "older-than", "newer-than", "output-plan"}
+
+// validatePlanFlags - Checks no flag selecting the resources to nuke is used along with the given flag, --plan or
+// --resume, which already selects them
+func validatePlanFlags(c *cli.Context, planFlag string) error {
+	for _, flag := range planScopeFlags {
+		if c.IsSet(flag) {
+			return ConflictingFlagsError{Name: flag, ConflictsWith: planFlag}
+		}
+	}
+	return nil
+}
+
+// readAwsPlan - Reads the plan written with --output-plan at the given path, checking it is an aws plan that only
+// holds valid resource types in enabled regions
+func readAwsPlan(path string, allResourceTypes []string, enabledRegions []string) (*report.Report, error) {
+	plan, err := report.ReadJSON(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	if plan.Cloud != "aws" {
+		return nil, InvalidPlanError{Path: path, Reason: fmt.Sprintf("it was made for %s, not aws", plan.Cloud)}
+	}
+	for _, resource := range plan.Resources {
+		if !aws.IsValidResourceType(resource.ResourceType, allResourceTypes) {
+			return nil, InvalidPlanError{Path: path, Reason: fmt.Sprintf("unknown resource type %s", resource.ResourceType)}
+		}
+		if !collections.ListContainsElement(enabledRegions, resource.Region) {
+			return nil, InvalidPlanError{Path: path, Reason: fmt.Sprintf("region %s isn't enabled", resource.Region)}
+		}
+	}
+	return plan, nil
+}
+
+// planScope - Returns the regions and resource types of the resources in the plan, sorted
+func planScope(plan *report.Report) ([]string, []string) {
+	var regions []string
+	var resourceTypes []string
+	for _, resource := range plan.Resources {
+		if !collections.ListContainsElement(regions, resource.Region) {
+			regions = append(regions, resource.Region)
+		}
+		if !collections.ListContainsElement(resourceTypes, resource.ResourceType) {
+			resourceTypes = append(resourceTypes, resource.ResourceType)
+		}
+	}
+	sort.Strings(regions)
+	sort.Strings(resourceTypes)
+	return regions, resourceTypes
+}
+
+// plannedResources - Returns the resources in the plan, indexed the way resources are selected interactively
+func plannedResources(plan *report.Report) map[selectableResource]bool {
+	resources := map[selectableResource]bool{}
+	for _, resource := range plan.Resources {
+		resources[selectableResource{Region: resource.Region, ResourceType: resource.ResourceType, Identifier: resource.Identifier}] = true
+	}
+	return resources
+}
+
+// getPlannedAwsResources - Looks up the resources of the plan that still exist and can still be nuked. Resources
+// are looked up regardless of their age: the plan already decided which ones to nuke. Returns the resources along
+// with the regions they are in.
+func getPlannedAwsResources(ctx context.Context, plan *report.Report, accountID string, options aws.ResourceOptions) (*aws.AwsAccountResources, []string, error) {
+	if plan.Account != nil && plan.Account.ID != accountID {
+		return nil, nil, PlanAccountMismatchError{PlanAccountID: plan.Account.ID, AccountID: accountID}
+	}
+
+	regions, resourceTypes := planScope(plan)
+	planned := plannedResources(plan)
+
+	account, err := aws.GetAllResources(ctx, regions, nil, aws.TimeFilter{ExcludeAfter: time.Now()}, resourceTypes, nil, options)
+	if err != nil {
+		return nil, nil, errors.WithStackTrace(err)
+	}
+	account = aws.FilterResources(account, func(region string, resourceType string, identifier string) bool {
+		return planned[selectableResource{Region: region, ResourceType: resourceType, Identifier: identifier}]
+	})
+
+	existing := map[selectableResource]bool{}
+	for _, resource := range awsSelectableResources(account) {
+		existing[resource] = true
+	}
+	for _, resource := range plan.Resources {
+		key := selectableResource{Region: resource.Region, ResourceType: resource.ResourceType, Identifier: resource.Identifier}
+		if !existing[key] {
+			logging.Logger.Infof("Skipping %s, which no longer exists or can't be nuked anymore", key)
+		}
+	}
+
+	return account, regions, nil
+}
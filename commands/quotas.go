@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// quotaReport - Counts the AWS resources of the types that have a quota on their count, and flags the ones that are
+// near that quota, so the cleanups that matter most can be prioritized. Nothing is nuked.
+func quotaReport(c *cli.Context) error {
+	threshold := c.Float64("threshold")
+	if threshold <= 0 || threshold > 100 {
+		return InvalidFlagError{Name: "threshold", Value: c.String("threshold")}
+	}
+
+	resourceTypes, err := quotaResourceTypes(c.StringSlice("resource-type"))
+	if err != nil {
+		return err
+	}
+
+	if err := verifyReadOnly(c); err != nil {
+		return err
+	}
+
+	regions, err := aws.GetEnabledRegions()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	excludedRegions := c.StringSlice("exclude-region")
+	for _, excludedRegion := range excludedRegions {
+		if !collections.ListContainsElement(regions, excludedRegion) {
+			return InvalidFlagError{
+				Name:  "exclude-regions",
+				Value: excludedRegion,
+			}
+		}
+	}
+
+	logging.Logger.Infoln("Retrieving all active AWS resources with a quota")
+	account, err := aws.GetAllResources(context.Background(), regions, excludedRegions, aws.TimeFilter{ExcludeAfter: time.Now()}, resourceTypes, nil, aws.ResourceOptions{})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	usages, err := aws.GetQuotaUsage(account)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	var nearQuota []string
+	for _, usage := range usages {
+		marker := " "
+		if usage.Utilization()*100 >= threshold {
+			marker = "!"
+			nearQuota = append(nearQuota, fmt.Sprintf("%s in %s", usage.ResourceType, usage.Region))
+		}
+		logging.Logger.Infof("%s %s", marker, formatQuotaUsage(usage))
+	}
+
+	if len(nearQuota) == 0 {
+		logging.Logger.Infof("No resource type is at %.0f%% of its quota or more, you're all good!", threshold)
+		return nil
+	}
+	logging.Logger.Infof("%d resource type(s) are at %.0f%% of their quota or more, clean those up first", len(nearQuota), threshold)
+
+	if c.Bool("fail-on-near-quota") {
+		return NearQuotaError{Usages: nearQuota}
+	}
+	return nil
+}
+
+// quotaResourceTypes - Returns the resource types to check against their quota: all the ones with a quota, unless
+// narrowed down with --resource-type
+func quotaResourceTypes(selected []string) ([]string, error) {
+	if len(selected) == 0 || collections.ListContainsElement(selected, "all") {
+		return aws.QuotaResourceTypes(), nil
+	}
+	for _, resourceType := range selected {
+		if !collections.ListContainsElement(aws.QuotaResourceTypes(), resourceType) {
+			return nil, InvalidFlagError{Name: "resource-type", Value: resourceType}
+		}
+	}
+	return selected, nil
+}
+
+// formatQuotaUsage - Renders a quota usage on a single line, e.g. vpc in us-east-1: 4 of 5 (80%, VPCs per Region)
+func formatQuotaUsage(usage aws.QuotaUsage) string {
+	details := []string{fmt.Sprintf("%.0f%%", usage.Utilization()*100)}
+	if usage.QuotaName != "" {
+		details = append(details, usage.QuotaName)
+	}
+	return fmt.Sprintf("%s in %s: %d of %.0f (%s)", usage.ResourceType, usage.Region, usage.Count, usage.Quota, strings.Join(details, ", "))
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/azure"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/gruntwork-io/gruntwork-cli/shell"
+)
+
+// selectableResource - a resource listed for interactive selection. Project is only set for gcp resources, and Region
+// holds the location of azure resources.
+type selectableResource struct {
+	Project      string
+	Region       string
+	ResourceType string
+	Identifier   string
+}
+
+// String - Formats the resource the way the resources to nuke are listed
+func (resource selectableResource) String() string {
+	if resource.Project != "" {
+		return fmt.Sprintf("%s-%s-%s-%s", resource.Project, resource.ResourceType, resource.Identifier, resource.Region)
+	}
+	return fmt.Sprintf("%s-%s-%s", resource.ResourceType, resource.Identifier, resource.Region)
+}
+
+func awsSelectableResources(account *aws.AwsAccountResources) []selectableResource {
+	var resources []selectableResource
+	for region, resourcesInRegion := range account.Resources {
+		for _, resourcesOfType := range resourcesInRegion.Resources {
+			for _, identifier := range resourcesOfType.ResourceIdentifiers() {
+				resources = append(resources, selectableResource{Region: region, ResourceType: resourcesOfType.ResourceName(), Identifier: identifier})
+			}
+		}
+	}
+	return resources
+}
+
+func azureSelectableResources(account *azure.AzureAccountResources) []selectableResource {
+	var resources []selectableResource
+	for location, resourcesInLocation := range account.Resources {
+		for _, resourcesOfType := range resourcesInLocation.Resources {
+			for _, identifier := range resourcesOfType.ResourceIdentifiers() {
+				resources = append(resources, selectableResource{Region: location, ResourceType: resourcesOfType.ResourceName(), Identifier: identifier})
+			}
+		}
+	}
+	return resources
+}
+
+func gcpSelectableResources(gcpResources *gcp.GcpResources) []selectableResource {
+	var resources []selectableResource
+	for projectID, project := range gcpResources.Projects {
+		for region, resourcesInRegion := range project.Resources {
+			for _, resourcesOfType := range resourcesInRegion.Resources {
+				for _, identifier := range resourcesOfType.ResourceIdentifiers() {
+					resources = append(resources, selectableResource{Project: projectID, Region: region, ResourceType: resourcesOfType.ResourceName(), Identifier: identifier})
+				}
+			}
+		}
+	}
+	return resources
+}
+
+// parseSelection - Parses the numbers, and ranges of numbers, entered to toggle resources, e.g. "2 5-7" or "2,5-7".
+// Numbers go from 1 to count. Returns the matching zero-based indexes.
+func parseSelection(input string, count int) ([]int, error) {
+	var indexes []int
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ' ' || r == ',' })
+	for _, field := range fields {
+		bounds := strings.SplitN(field, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, InvalidSelectionError{Input: field}
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, InvalidSelectionError{Input: field}
+			}
+		}
+		if first < 1 || last > count || first > last {
+			return nil, InvalidSelectionError{Input: field}
+		}
+		for number := first; number <= last; number++ {
+			indexes = append(indexes, number-1)
+		}
+	}
+	return indexes, nil
+}
+
+// selectResources - Lists the resources to nuke, numbered, and lets the user toggle them until an empty line is
+// entered. All resources are selected to begin with. Returns the resources that are still selected.
+func selectResources(resources []selectableResource) (map[selectableResource]bool, error) {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+	selected := make([]bool, len(resources))
+	for i := range selected {
+		selected[i] = true
+	}
+
+	shellOptions := shell.ShellOptions{Logger: logging.Logger}
+	for {
+		fmt.Println()
+		for i, resource := range resources {
+			mark := " "
+			if selected[i] {
+				mark = "x"
+			}
+			fmt.Printf("%4d [%s] %s\n", i+1, mark, resource)
+		}
+
+		input, err := shell.PromptUserForInput("\nEnter the numbers of the resources to deselect or select again, e.g. 2 5-7, or press ENTER when done: ", &shellOptions)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if strings.TrimSpace(input) == "" {
+			break
+		}
+
+		indexes, err := parseSelection(input, len(resources))
+		if err != nil {
+			logging.Logger.Warnln(err)
+			continue
+		}
+		for _, index := range indexes {
+			selected[index] = !selected[index]
+		}
+	}
+
+	selectedResources := map[selectableResource]bool{}
+	for i, resource := range resources {
+		if selected[i] {
+			selectedResources[resource] = true
+		}
+	}
+	logging.Logger.Infof("%d of %d resources selected", len(selectedResources), len(resources))
+	return selectedResources, nil
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// reportSharedResources - Looks up which of the resources about to be nuked are shared with other accounts, logs them
+// and writes them to --output-sharing-report. Sharing is only looked up along with --output-sharing-report or
+// --notify-sharing-topic-arn.
+func reportSharedResources(c *cli.Context, account *aws.AwsAccountResources, reportAccount report.Account) ([]aws.SharedResource, error) {
+	if !c.IsSet("output-sharing-report") && !c.IsSet("notify-sharing-topic-arn") {
+		return nil, nil
+	}
+
+	logging.Logger.Infoln("Looking up which AMIs, snapshots and ECR repositories are shared with other accounts")
+	shared, err := aws.GetSharedResources(account)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var resources []report.SharedResource
+	for _, resource := range shared {
+		resources = append(resources, report.SharedResource{
+			ResourceType: resource.ResourceType,
+			Identifier:   resource.Identifier,
+			Region:       resource.Region,
+			AccountIds:   resource.AccountIds,
+			Public:       resource.Public,
+		})
+		if resource.Public {
+			logging.Logger.Warnf("* %s-%s-%s is public", resource.ResourceType, resource.Identifier, resource.Region)
+		}
+		if len(resource.AccountIds) > 0 {
+			logging.Logger.Warnf("* %s-%s-%s is shared with %v", resource.ResourceType, resource.Identifier, resource.Region, resource.AccountIds)
+		}
+	}
+	if len(shared) == 0 {
+		logging.Logger.Infoln("None of the resources to nuke are shared with other accounts")
+	}
+
+	if c.IsSet("output-sharing-report") {
+		sharing := report.NewSharing("aws", resources)
+		sharing.Account = &reportAccount
+		if err := sharing.WriteJSON(c.String("output-sharing-report")); err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		logging.Logger.Infof("Wrote the sharing report to %s", c.String("output-sharing-report"))
+	}
+
+	return shared, nil
+}
+
+// notifySharingAccounts - Tells the accounts the resources are shared with that they are about to lose access to
+// them, through the SNS topic given with --notify-sharing-topic-arn
+func notifySharingAccounts(c *cli.Context, ownerAccountId string, shared []aws.SharedResource) error {
+	if !c.IsSet("notify-sharing-topic-arn") || len(shared) == 0 {
+		return nil
+	}
+	if err := aws.NotifySharingAccounts(c.String("notify-sharing-topic-arn"), ownerAccountId, shared); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"os"
+	"sync"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/progress"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+)
+
+// runState - the state file of a run, recording which of its resources were nuked so far, so that a run interrupted
+// by throttling, timeouts or Ctrl+C can pick up where it left off with --resume
+type runState struct {
+	path  string
+	mutex sync.Mutex
+	state *report.Report
+}
+
+// startRunState - Starts recording the nuking of the given resources in the state file at the given path. The
+// resources of the state that aren't among them, e.g. because they no longer exist or weren't selected, are recorded
+// as skipped, unless they were nuked already.
+func startRunState(path string, state *report.Report, resources []selectableResource) (*runState, error) {
+	toNuke := map[selectableResource]bool{}
+	for _, resource := range resources {
+		toNuke[resource] = true
+	}
+	for i, resource := range state.Resources {
+		if resource.Status == report.StatusNuked {
+			continue
+		}
+		if toNuke[selectableResource{Region: resource.Region, ResourceType: resource.ResourceType, Identifier: resource.Identifier}] {
+			state.Resources[i].Status = report.StatusPending
+		} else {
+			state.Resources[i].Status = report.StatusSkipped
+		}
+	}
+
+	runState := &runState{path: path, state: state}
+	if err := runState.save(); err != nil {
+		return nil, err
+	}
+	return runState, nil
+}
+
+// record - Records a batch of resources as nuked or failed. Failing to record it doesn't stop the run, the batch is
+// only retried when resuming it.
+func (runState *runState) record(resourceType string, region string, identifiers []string, failed bool) {
+	status := report.StatusNuked
+	if failed {
+		status = report.StatusFailed
+	}
+
+	runState.mutex.Lock()
+	defer runState.mutex.Unlock()
+	runState.state.SetStatus(resourceType, region, identifiers, status)
+	if err := runState.save(); err != nil {
+		logging.Logger.Errorf("Failed to record the progress of the run in %s: %s", runState.path, err)
+	}
+}
+
+// save - Writes the state file through a temporary file, so that an interrupted write can't leave it half written
+func (runState *runState) save() error {
+	temporaryPath := runState.path + ".tmp"
+	if err := runState.state.WriteJSON(temporaryPath); err != nil {
+		return err
+	}
+	if err := os.Rename(temporaryPath, runState.path); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// recordRunState - Records the progress of the nuking of the resources of the account in the state file given with
+// --state-file, or else in the one given with --resume. A resumed run carries on with the state it was resumed from,
+// while a new run starts from the report of the resources it found.
+func recordRunState(c *cli.Context, savedState *report.Report, runReport *report.Report, account *aws.AwsAccountResources, reporter *progress.Reporter) error {
+	path := c.String("state-file")
+	if path == "" {
+		path = c.String("resume")
+	}
+	if path == "" {
+		return nil
+	}
+
+	state := savedState
+	if state == nil {
+		newState := *runReport
+		newState.Resources = append([]report.Resource{}, runReport.Resources...)
+		state = &newState
+	}
+
+	runState, err := startRunState(path, state, awsSelectableResources(account))
+	if err != nil {
+		return err
+	}
+	reporter.OnBatch(runState.record)
+	logging.Logger.Infof("Recording the progress of the run in %s. If the run is interrupted, pick up where it left off with --resume %s", path, path)
+	return nil
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/metrics"
+	"github.com/gruntwork-io/cloud-nuke/progress"
+	"github.com/urfave/cli"
+)
+
+// logRunStats - Logs the AWS API calls made during the run, and the memory it used, to help tuning the batch settings
+// on very large accounts
+func logRunStats() {
+	stats := aws.GetAPIStats()
+	logging.Logger.Infof("AWS API calls: %d", stats.TotalCalls())
+	for _, serviceCalls := range stats.SortedCallsPerService() {
+		logging.Logger.Infof("  %s: %d", serviceCalls.Service, serviceCalls.Calls)
+	}
+	logging.Logger.Infof("Throttled requests: %d", stats.Throttles)
+	logging.Logger.Infof("Retries: %d", stats.Retries)
+
+	// Tells apart the failures that need IAM fixes from the ones that only need a re-run
+	if failedCalls := stats.SortedFailedCalls(); len(failedCalls) > 0 {
+		logging.Logger.Infoln("Failed AWS API calls while nuking, by error category:")
+		for _, failed := range failedCalls {
+			logging.Logger.Infof("  %s: %d %s", failed.ResourceType, failed.Calls, failed.Category)
+		}
+	}
+
+	// The Go runtime doesn't keep track of the peak heap size, but it only rarely returns memory obtained from the OS
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	logging.Logger.Infof("Peak memory: %s", formatBytes(memStats.Sys))
+}
+
+// formatBytes - Renders a size in bytes in a human friendly way, e.g. 512 B, 3.5 KiB or 42.0 MiB
+func formatBytes(bytes uint64) string {
+	switch {
+	case bytes >= 1<<30:
+		return fmt.Sprintf("%.1f GiB", float64(bytes)/(1<<30))
+	case bytes >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(bytes)/(1<<20))
+	case bytes >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(bytes)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", bytes)
+	}
+}
+
+// getMetricsSinks - Returns the sinks the metrics of the run are sent to, as given with --statsd-address,
+// --pushgateway-url, --notify-webhook, --telemetry-endpoint and --telemetry-file
+func getMetricsSinks(c *cli.Context) ([]metrics.Sink, error) {
+	var sinks []metrics.Sink
+	if c.IsSet("statsd-address") {
+		sinks = append(sinks, metrics.StatsDSink{Address: c.String("statsd-address"), Prefix: "cloud_nuke"})
+	}
+	if c.IsSet("pushgateway-url") {
+		sinks = append(sinks, metrics.PushgatewaySink{URL: c.String("pushgateway-url")})
+	}
+
+	format := metrics.WebhookFormat(c.String("notify-webhook-format"))
+	if !isWebhookFormat(format) {
+		return nil, InvalidFlagError{Name: "notify-webhook-format", Value: string(format)}
+	}
+	if c.IsSet("notify-webhook") {
+		sinks = append(sinks, metrics.WebhookSink{URL: c.String("notify-webhook"), Format: format})
+	}
+	if c.IsSet("telemetry-endpoint") {
+		sinks = append(sinks, metrics.TelemetrySink{URL: c.String("telemetry-endpoint"), Version: c.App.Version})
+	}
+	if c.IsSet("telemetry-file") {
+		sinks = append(sinks, metrics.TelemetrySink{Path: c.String("telemetry-file"), Version: c.App.Version})
+	}
+	return sinks, nil
+}
+
+func isWebhookFormat(format metrics.WebhookFormat) bool {
+	for _, supportedFormat := range metrics.WebhookFormats {
+		if format == supportedFormat {
+			return true
+		}
+	}
+	return false
+}
+
+// recordNukedResources - Records in the metrics of the run what became of the resources processed by the reporter
+func recordNukedResources(run *metrics.Run, reporter *progress.Reporter) {
+	for _, count := range reporter.Counts() {
+		run.AddNuked(count.ResourceType, count.Region, count.Processed-count.Failed, count.Failed)
+		run.AddNukeTime(count.ResourceType, count.Region, count.Elapsed)
+	}
+}
+
+// recordFailedCalls - Records in the metrics of the run the AWS API calls that failed while nuking, by resource type and
+// error category
+func recordFailedCalls(run *metrics.Run) {
+	for _, failed := range aws.GetAPIStats().SortedFailedCalls() {
+		run.AddErrors(failed.ResourceType, string(failed.Category), failed.Calls)
+	}
+}
+
+// logRunTimings - Logs how long discovering and nuking each resource type took in each region, the slowest first, so
+// that the slowest parts of a run can be found and tuned
+func logRunTimings(run *metrics.Run) {
+	counts := run.Counts()
+	if len(counts) == 0 {
+		return
+	}
+	logging.Logger.Infoln("Time spent per resource type and region, the slowest first:")
+	for _, line := range strings.Split(strings.TrimRight(formatRunTimings(counts), "\n"), "\n") {
+		logging.Logger.Infof("  %s", line)
+	}
+}
+
+// formatRunTimings - Renders the timings of the run as a table, the slowest resource types first
+func formatRunTimings(counts []metrics.Count) string {
+	sorted := append([]metrics.Count{}, counts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DiscoveryTime+sorted[i].NukeTime > sorted[j].DiscoveryTime+sorted[j].NukeTime
+	})
+
+	var table bytes.Buffer
+	writer := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "RESOURCE TYPE\tREGION\tDISCOVERED\tNUKED\tFAILED\tDISCOVERY\tNUKE\tNUKED/MIN")
+	for _, count := range sorted {
+		throughput := "-"
+		if count.NukeTime > 0 && count.Nuked > 0 {
+			throughput = fmt.Sprintf("%.1f", float64(count.Nuked)/count.NukeTime.Minutes())
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n", count.ResourceType, count.Region, count.Discovered,
+			count.Nuked, count.Failed, formatTiming(count.DiscoveryTime), formatTiming(count.NukeTime), throughput)
+	}
+	writer.Flush()
+	return table.String()
+}
+
+// formatTiming - Renders a duration of the timing table, to the tenth of a second, or - when it wasn't measured
+func formatTiming(elapsed time.Duration) string {
+	if elapsed == 0 {
+		return "-"
+	}
+	return elapsed.Round(100 * time.Millisecond).String()
+}
+
+// logEstimates - Logs roughly how many resources the account holds, before the discovery of all of them
+func logEstimates(estimates []aws.ResourceEstimate) {
+	if len(estimates) == 0 {
+		return
+	}
+	logging.Logger.Infoln(aws.FormatEstimates(estimates))
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/progress"
+	"github.com/gruntwork-io/cloud-nuke/throughput"
+	"github.com/urfave/cli"
+)
+
+// loadThroughputHistory - Reads the throughput of previous runs from the file given with --throughput-file, or the
+// default one. Returns the path to save the throughput of the run to, empty when it can't be saved. The history is only
+// used for estimates, so a history that can't be read is logged and replaced with an empty one rather than failing the
+// run.
+func loadThroughputHistory(c *cli.Context) (*throughput.History, string) {
+	path := c.String("throughput-file")
+	if path == "" {
+		defaultPath, err := throughput.DefaultPath()
+		if err != nil {
+			logging.Logger.Warnf("Failed to locate the throughput of previous runs: %s", err)
+			return throughput.NewHistory(), ""
+		}
+		path = defaultPath
+	}
+
+	history, err := throughput.Load(path)
+	if err != nil {
+		logging.Logger.Warnf("Failed to read the throughput of previous runs from %s: %s", path, err)
+		return throughput.NewHistory(), path
+	}
+	return history, path
+}
+
+// countByResourceType - Returns how many of the resources are of each type
+func countByResourceType(resources []selectableResource) map[string]int {
+	counts := map[string]int{}
+	for _, resource := range resources {
+		counts[resource.ResourceType]++
+	}
+	return counts
+}
+
+// logNukeSummary - Logs how many resources are about to be nuked, along with how long it should take based on the
+// throughput of previous runs, e.g. 120 resource(s) to nuke (ebs: 20, ec2: 100), estimated to take ~42 minutes
+func logNukeSummary(cloud string, counts map[string]int, history *throughput.History) {
+	logging.Logger.Infoln(formatNukeSummary(cloud, counts, history))
+}
+
+func formatNukeSummary(cloud string, counts map[string]int, history *throughput.History) string {
+	var resourceTypes []string
+	total := 0
+	for resourceType, count := range counts {
+		resourceTypes = append(resourceTypes, resourceType)
+		total += count
+	}
+	sort.Strings(resourceTypes)
+
+	var perType []string
+	for _, resourceType := range resourceTypes {
+		perType = append(perType, fmt.Sprintf("%s: %d", resourceType, counts[resourceType]))
+	}
+	summary := fmt.Sprintf("%d resource(s) to nuke (%s)", total, strings.Join(perType, ", "))
+
+	estimate, unknown := history.Estimate(cloud, counts)
+	switch {
+	case len(unknown) == len(resourceTypes):
+		return summary + ", no estimate of the duration yet as these resource types were never nuked before"
+	case len(unknown) > 0:
+		return fmt.Sprintf("%s, estimated to take %s plus the time to nuke %s, never nuked before", summary, formatEstimate(estimate), strings.Join(unknown, ", "))
+	default:
+		return fmt.Sprintf("%s, estimated to take %s", summary, formatEstimate(estimate))
+	}
+}
+
+// formatEstimate - Renders an estimated duration roughly, e.g. ~42 minutes
+func formatEstimate(estimate time.Duration) string {
+	switch {
+	case estimate < time.Minute:
+		return "less than a minute"
+	case estimate < 2*time.Minute:
+		return "~1 minute"
+	case estimate < time.Hour:
+		return fmt.Sprintf("~%d minutes", int(estimate.Round(time.Minute)/time.Minute))
+	default:
+		return fmt.Sprintf("~%.1f hours", estimate.Hours())
+	}
+}
+
+// saveThroughput - Records the throughput of the resource types nuked during the run, for the estimates of the next
+// runs. Failing to save it doesn't fail the run, so errors are only logged.
+func saveThroughput(cloud string, reporter *progress.Reporter, history *throughput.History, path string) {
+	counts := reporter.Counts()
+	if len(counts) == 0 || path == "" {
+		return
+	}
+
+	// A resource type is nuked region by region, so its throughput is measured across all of them
+	processed := map[string]int{}
+	elapsed := map[string]time.Duration{}
+	for _, count := range counts {
+		processed[count.ResourceType] += count.Processed
+		elapsed[count.ResourceType] += count.Elapsed
+	}
+	for resourceType := range processed {
+		history.Record(cloud, resourceType, processed[resourceType], elapsed[resourceType])
+	}
+
+	if err := history.Save(path); err != nil {
+		logging.Logger.Warnf("Failed to save the throughput of the run to %s: %s", path, err)
+	}
+}
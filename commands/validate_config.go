@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/azure"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// validateConfigFile - Checks the config file given with --config the way the runs would, without talking to any
+// cloud, and prints it normalized, so that config changes can be checked in CI before a run picks them up
+func validateConfigFile(c *cli.Context) error {
+	if !c.IsSet("config") {
+		return MissingFlagError{Name: "config", RequiredBy: "validate-config"}
+	}
+	cfg, err := config.Load(c.String("config"))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := validateConfig(cfg); err != nil {
+		return err
+	}
+
+	normalized, err := yaml.Marshal(cfg)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	fmt.Print(string(normalized))
+	logging.Logger.Infof("The config file %s is valid", c.String("config"))
+	return nil
+}
+
+// validateConfig - Checks the settings the config file parser can't check on its own: the resource types, the regular
+// expressions, and the settings that depend on one another
+func validateConfig(cfg *config.Config) error {
+	if err := validateAwsConfig(cfg.AWS); err != nil {
+		return err
+	}
+	if err := validateFilters("gcp", cfg.GCP.Filters, gcp.ListResourceTypes()); err != nil {
+		return err
+	}
+	if err := validateRegexps("gcp.resource_options.clouddnszone.names", cfg.GCP.ResourceOptions.CloudDnsZone.Names); err != nil {
+		return err
+	}
+	if err := validateRegexps("gcp.resource_options.clouddnszone.exclude_names", cfg.GCP.ResourceOptions.CloudDnsZone.ExcludeNames); err != nil {
+		return err
+	}
+	return validateFilters("azure", cfg.Azure.Filters, azure.ListResourceTypes())
+}
+
+func validateAwsConfig(cfg config.AWS) error {
+	allResourceTypes := aws.ListResourceTypes()
+	if err := validateFilters("aws", cfg.Filters, allResourceTypes); err != nil {
+		return err
+	}
+
+	// Only the resources created within the window between the two are nuked, so it can't be empty
+	if cfg.Retention.NewerThan != "" {
+		olderThan := time.Duration(0)
+		if cfg.Retention.OlderThan != "" {
+			olderThan, _ = time.ParseDuration(cfg.Retention.OlderThan)
+		}
+		newerThan, _ := time.ParseDuration(cfg.Retention.NewerThan)
+		if newerThan <= olderThan {
+			return config.InvalidConfigError{Field: "aws.retention.newer_than", Value: cfg.Retention.NewerThan}
+		}
+	}
+
+	if cfg.Timeout != "" {
+		if timeout, _ := time.ParseDuration(cfg.Timeout); timeout < 0 {
+			return config.InvalidConfigError{Field: "aws.timeout", Value: cfg.Timeout}
+		}
+	}
+	for resourceType, value := range cfg.ResourceTypeTimeouts {
+		field := "aws.resource_type_timeouts." + resourceType
+		if !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			return config.InvalidConfigError{Field: field, Value: resourceType}
+		}
+		if timeout, err := time.ParseDuration(value); err != nil || timeout < 0 {
+			return config.InvalidConfigError{Field: field, Value: value}
+		}
+	}
+
+	if cfg.FailurePolicy != "" && !aws.IsValidFailurePolicy(cfg.FailurePolicy) {
+		return config.InvalidConfigError{Field: "aws.failure_policy", Value: cfg.FailurePolicy}
+	}
+	for resourceType, value := range cfg.ResourceTypeFailurePolicies {
+		field := "aws.resource_type_failure_policies." + resourceType
+		if !aws.IsValidResourceType(resourceType, allResourceTypes) {
+			return config.InvalidConfigError{Field: field, Value: resourceType}
+		}
+		if !aws.IsValidFailurePolicy(value) {
+			return config.InvalidConfigError{Field: field, Value: value}
+		}
+	}
+
+	options := cfg.ResourceOptions
+	if options.SecretsManager.RecoveryWindow != 0 {
+		if options.SecretsManager.ForceDeleteWithoutRecovery {
+			return config.ConflictingConfigError{Field: "aws.resource_options.secretsmanager.recovery_window", ConflictsWith: "aws.resource_options.secretsmanager.force_delete_without_recovery"}
+		}
+		if options.SecretsManager.RecoveryWindow < 7 || options.SecretsManager.RecoveryWindow > 30 {
+			return config.InvalidConfigError{Field: "aws.resource_options.secretsmanager.recovery_window", Value: fmt.Sprint(options.SecretsManager.RecoveryWindow)}
+		}
+	}
+	if pendingWindow := options.KMSKey.PendingWindow; pendingWindow != 0 && (pendingWindow < 7 || pendingWindow > 30) {
+		return config.InvalidConfigError{Field: "aws.resource_options.kmskey.pending_window", Value: fmt.Sprint(pendingWindow)}
+	}
+	if options.EBS.FinalSnapshot && options.EBS.FinalSnapshotRetention < 1 {
+		return config.InvalidConfigError{Field: "aws.resource_options.ebs.final_snapshot_retention", Value: fmt.Sprint(options.EBS.FinalSnapshotRetention)}
+	}
+
+	regexps := []struct {
+		field  string
+		values []string
+	}{
+		{"aws.resource_options.ec2keypair.names", options.EC2KeyPair.Names},
+		{"aws.resource_options.ec2keypair.exclude_names", options.EC2KeyPair.ExcludeNames},
+		{"aws.resource_options.cloudwatchlogstream.log_group_names", options.CloudWatchLogStream.LogGroupNames},
+		{"aws.resource_options.cloudwatchlogstream.exclude_log_group_names", options.CloudWatchLogStream.ExcludeLogGroupNames},
+		{"aws.resource_options.organizations.names", options.Organizations.Names},
+		{"aws.resource_options.organizations.exclude_names", options.Organizations.ExcludeNames},
+	}
+	for _, setting := range regexps {
+		if err := validateRegexps(setting.field, setting.values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateFilters - Checks that the resource types of the filters of a cloud exist, that none of them is both
+// selected and excluded, that the file of identifiers to exclude exists, and that the name regular expressions compile
+func validateFilters(cloud string, filters config.Filters, allResourceTypes []string) error {
+	for _, resourceType := range filters.ResourceTypes {
+		if !collections.ListContainsElement(allResourceTypes, resourceType) {
+			return config.InvalidConfigError{Field: cloud + ".filters.resource_types", Value: resourceType}
+		}
+	}
+	for _, resourceType := range filters.ExcludeResourceTypes {
+		if !collections.ListContainsElement(allResourceTypes, resourceType) {
+			return config.InvalidConfigError{Field: cloud + ".filters.exclude_resource_types", Value: resourceType}
+		}
+		if collections.ListContainsElement(filters.ResourceTypes, resourceType) {
+			return config.ConflictingConfigError{Field: cloud + ".filters.exclude_resource_types " + resourceType, ConflictsWith: cloud + ".filters.resource_types " + resourceType}
+		}
+	}
+	if filters.ExcludeIdsFile != "" {
+		if _, err := os.Stat(filters.ExcludeIdsFile); err != nil {
+			return config.InvalidConfigError{Field: cloud + ".filters.exclude_ids_file", Value: filters.ExcludeIdsFile}
+		}
+	}
+	if err := validateRegexps(cloud+".filters.include_name_regex", filters.IncludeNameRegex); err != nil {
+		return err
+	}
+	return validateRegexps(cloud+".filters.exclude_name_regex", filters.ExcludeNameRegex)
+}
+
+// validateRegexps - Checks that the regular expressions of a setting compile
+func validateRegexps(field string, values []string) error {
+	for _, value := range values {
+		if _, err := regexp.Compile(value); err != nil {
+			return config.InvalidConfigError{Field: field, Value: value}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Config - the settings of cloud-nuke, as read from a YAML config file with a section per cloud. The settings given
+// as flags take precedence over the ones in the file.
+type Config struct {
+	AWS   AWS   `yaml:"aws,omitempty"`
+	GCP   GCP   `yaml:"gcp,omitempty"`
+	Azure Azure `yaml:"azure,omitempty"`
+}
+
+// Filters - which resources are nuked, the same way in all clouds
+type Filters struct {
+	// The resource types to nuke. All of them when empty.
+	ResourceTypes []string `yaml:"resource_types"`
+	// The resource types to leave alone
+	ExcludeResourceTypes []string `yaml:"exclude_resource_types"`
+	// The regions to leave alone, called locations in azure
+	ExcludeRegions []string `yaml:"exclude_regions"`
+	// Path to a file listing the identifiers or ARNs of the resources to leave alone, one per line
+	ExcludeIdsFile string `yaml:"exclude_ids_file"`
+	// Regular expressions the identifiers or names of the resources to nuke have to match, whatever their resource
+	// type. All of them when empty.
+	IncludeNameRegex []string `yaml:"include_name_regex"`
+	// Regular expressions matching the identifiers or names of the resources to leave alone
+	ExcludeNameRegex []string `yaml:"exclude_name_regex"`
+}
+
+// Retention - how old resources have to be to get nuked, as Go durations, e.g. 24h
+type Retention struct {
+	// Only nuke the resources older than this
+	OlderThan string `yaml:"older_than"`
+	// Only nuke the resources newer than this. Only supported in aws.
+	NewerThan string `yaml:"newer_than"`
+}
+
+// AWS - the settings of cloud-nuke aws
+type AWS struct {
+	Filters   Filters   `yaml:"filters"`
+	Retention Retention `yaml:"retention"`
+	// The ids of the accounts that must never be nuked
+	ProtectedAccounts []string           `yaml:"protected_accounts"`
+	ResourceOptions   AWSResourceOptions `yaml:"resource_options"`
+	// The ARNs of the roles to assume in turn, to nuke several accounts in one run. Only the account of the current
+	// credentials is nuked when empty.
+	RoleArns []string `yaml:"role_arns"`
+	// How many regions to look for resources in at the same time
+	Concurrency int `yaml:"concurrency"`
+	// How long nuking the resources of a type in a region can take at most, as a Go duration. No timeout when empty.
+	Timeout string `yaml:"timeout"`
+	// The timeouts of some resource types, overriding Timeout, keyed by resource type
+	ResourceTypeTimeouts map[string]string `yaml:"resource_type_timeouts"`
+	// What to do when nuking the resources of a type fails: abort-run, abort-type or continue. abort-run when empty.
+	FailurePolicy string `yaml:"failure_policy"`
+	// The failure policies of some resource types, overriding FailurePolicy, keyed by resource type
+	ResourceTypeFailurePolicies map[string]string `yaml:"resource_type_failure_policies"`
+}
+
+// AWSResourceOptions - the options of the aws resource types, keyed by resource type
+type AWSResourceOptions struct {
+	SecretsManager struct {
+		ForceDeleteWithoutRecovery bool  `yaml:"force_delete_without_recovery"`
+		RecoveryWindow             int64 `yaml:"recovery_window"`
+	} `yaml:"secretsmanager"`
+	KMSKey struct {
+		PendingWindow int64 `yaml:"pending_window"`
+	} `yaml:"kmskey"`
+	EC2 struct {
+		// Stop the instances rather than terminate them
+		StopOnly bool `yaml:"stop_only"`
+		// Disable the termination protection of the instances having it enabled, rather than leaving them alone
+		DisableTerminationProtection bool `yaml:"disable_termination_protection"`
+	} `yaml:"ec2"`
+	EBS struct {
+		FinalSnapshot          bool `yaml:"final_snapshot"`
+		FinalSnapshotRetention int  `yaml:"final_snapshot_retention"`
+	} `yaml:"ebs"`
+	AMI struct {
+		DeleteSnapshots bool `yaml:"delete_snapshots"`
+	} `yaml:"ami"`
+	EC2KeyPair struct {
+		// Regular expressions on the names of the key pairs to nuke, and to leave alone
+		Names        []string `yaml:"names"`
+		ExcludeNames []string `yaml:"exclude_names"`
+	} `yaml:"ec2keypair"`
+	CloudWatchLogStream struct {
+		// Regular expressions on the names of the log groups to prune the log streams of, and to leave alone
+		LogGroupNames        []string `yaml:"log_group_names"`
+		ExcludeLogGroupNames []string `yaml:"exclude_log_group_names"`
+	} `yaml:"cloudwatchlogstream"`
+	Organizations struct {
+		// Regular expressions on the names of the organizational units and service control policies to nuke, and to
+		// leave alone
+		Names        []string `yaml:"names"`
+		ExcludeNames []string `yaml:"exclude_names"`
+	} `yaml:"organizations"`
+}
+
+// GCP - the settings of cloud-nuke gcp
+type GCP struct {
+	// The projects to nuke
+	Projects  []string  `yaml:"projects"`
+	Filters   Filters   `yaml:"filters"`
+	Retention Retention `yaml:"retention"`
+	// The ids of the projects that must never be nuked
+	ProtectedProjects []string           `yaml:"protected_projects"`
+	ResourceOptions   GCPResourceOptions `yaml:"resource_options"`
+}
+
+// GCPResourceOptions - the options of the gcp resource types, keyed by resource type
+type GCPResourceOptions struct {
+	GceDisk struct {
+		IncludeAttached bool `yaml:"include_attached"`
+	} `yaml:"gcedisk"`
+	GceNetwork struct {
+		IncludeDefault bool `yaml:"include_default"`
+	} `yaml:"gcenetwork"`
+	CloudDnsZone struct {
+		// Regular expressions on the names of the managed zones to nuke, and to leave alone
+		Names        []string `yaml:"names"`
+		ExcludeNames []string `yaml:"exclude_names"`
+	} `yaml:"clouddnszone"`
+	GcrImage struct {
+		KeepLatest int `yaml:"keep_latest"`
+	} `yaml:"gcrimage"`
+}
+
+// Azure - the settings of cloud-nuke azure
+type Azure struct {
+	Filters   Filters   `yaml:"filters"`
+	Retention Retention `yaml:"retention"`
+	// The ids of the subscriptions that must never be nuked
+	ProtectedSubscriptions []string `yaml:"protected_subscriptions"`
+}
+
+// Load - Reads and validates the config file at the given path. Unknown settings are rejected, so that a typo doesn't
+// silently widen what gets nuked.
+func Load(path string) (*Config, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return Parse(contents)
+}
+
+// Parse - Parses and validates a config, as YAML
+func Parse(contents []byte) (*Config, error) {
+	var config Config
+	if err := yaml.UnmarshalStrict(contents, &config); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Validate - Checks the settings that can be checked without talking to any cloud
+func (config *Config) Validate() error {
+	type durationSetting struct {
+		field string
+		value string
+	}
+	durations := []durationSetting{
+		{"aws.retention.older_than", config.AWS.Retention.OlderThan},
+		{"aws.retention.newer_than", config.AWS.Retention.NewerThan},
+		{"gcp.retention.older_than", config.GCP.Retention.OlderThan},
+		{"azure.retention.older_than", config.Azure.Retention.OlderThan},
+		{"aws.timeout", config.AWS.Timeout},
+	}
+	for resourceType, timeout := range config.AWS.ResourceTypeTimeouts {
+		durations = append(durations, durationSetting{"aws.resource_type_timeouts." + resourceType, timeout})
+	}
+	for _, duration := range durations {
+		if duration.value == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(duration.value); err != nil {
+			return InvalidConfigError{Field: duration.field, Value: duration.value}
+		}
+	}
+
+	if config.GCP.Retention.NewerThan != "" {
+		return UnsupportedConfigError{Field: "gcp.retention.newer_than"}
+	}
+	if config.Azure.Retention.NewerThan != "" {
+		return UnsupportedConfigError{Field: "azure.retention.newer_than"}
+	}
+
+	if config.AWS.Concurrency < 0 {
+		return InvalidConfigError{Field: "aws.concurrency", Value: fmt.Sprint(config.AWS.Concurrency)}
+	}
+
+	if config.GCP.ResourceOptions.GcrImage.KeepLatest < 0 {
+		return InvalidConfigError{Field: "gcp.resource_options.gcrimage.keep_latest", Value: fmt.Sprint(config.GCP.ResourceOptions.GcrImage.KeepLatest)}
+	}
+
+	return nil
+}
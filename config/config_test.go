@@ -0,0 +1,111 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+aws:
+  filters:
+    resource_types: [ec2, ebs]
+    exclude_regions: [us-west-1]
+  retention:
+    older_than: 24h
+  protected_accounts: ["123456789012"]
+  role_arns:
+    - arn:aws:iam::210987654321:role/cloud-nuke
+  resource_options:
+    secretsmanager:
+      recovery_window: 7
+    ebs:
+      final_snapshot: true
+    ami:
+      delete_snapshots: true
+    ec2keypair:
+      names: ["^terratest-"]
+gcp:
+  projects: [my-sandbox-project]
+  protected_projects: [my-production-project]
+  filters:
+    exclude_resource_types: [cloudkmskey]
+  resource_options:
+    clouddnszone:
+      exclude_names: ["^prod-"]
+    gcrimage:
+      keep_latest: 3
+azure:
+  retention:
+    older_than: 48h
+  protected_subscriptions: [00000000-0000-0000-0000-000000000000]
+`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	file, err := ioutil.TempFile("", "cloud-nuke-config-*.yaml")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(testConfig)
+	require.NoError(t, err)
+	file.Close()
+
+	config, err := Load(file.Name())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ec2", "ebs"}, config.AWS.Filters.ResourceTypes)
+	assert.Equal(t, []string{"us-west-1"}, config.AWS.Filters.ExcludeRegions)
+	assert.Equal(t, "24h", config.AWS.Retention.OlderThan)
+	assert.Equal(t, []string{"123456789012"}, config.AWS.ProtectedAccounts)
+	assert.Equal(t, []string{"arn:aws:iam::210987654321:role/cloud-nuke"}, config.AWS.RoleArns)
+	assert.Equal(t, int64(7), config.AWS.ResourceOptions.SecretsManager.RecoveryWindow)
+	assert.True(t, config.AWS.ResourceOptions.EBS.FinalSnapshot)
+	assert.True(t, config.AWS.ResourceOptions.AMI.DeleteSnapshots)
+	assert.Equal(t, []string{"^terratest-"}, config.AWS.ResourceOptions.EC2KeyPair.Names)
+
+	assert.Equal(t, []string{"my-sandbox-project"}, config.GCP.Projects)
+	assert.Equal(t, []string{"my-production-project"}, config.GCP.ProtectedProjects)
+	assert.Equal(t, []string{"cloudkmskey"}, config.GCP.Filters.ExcludeResourceTypes)
+	assert.Equal(t, []string{"^prod-"}, config.GCP.ResourceOptions.CloudDnsZone.ExcludeNames)
+	assert.Equal(t, 3, config.GCP.ResourceOptions.GcrImage.KeepLatest)
+
+	assert.Equal(t, "48h", config.Azure.Retention.OlderThan)
+	assert.Equal(t, []string{"00000000-0000-0000-0000-000000000000"}, config.Azure.ProtectedSubscriptions)
+}
+
+func TestParseEmpty(t *testing.T) {
+	t.Parallel()
+
+	config, err := Parse([]byte(""))
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, config)
+}
+
+func TestParseUnknownSetting(t *testing.T) {
+	t.Parallel()
+
+	// A typo must not be silently ignored, as it could widen what gets nuked
+	_, err := Parse([]byte("aws:\n  filters:\n    exclude_region: [us-west-1]\n"))
+	assert.Error(t, err)
+}
+
+func TestParseInvalidSettings(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse([]byte("gcp:\n  retention:\n    older_than: yesterday\n"))
+	assert.Equal(t, InvalidConfigError{Field: "gcp.retention.older_than", Value: "yesterday"}, errors.Unwrap(err))
+
+	_, err = Parse([]byte("azure:\n  retention:\n    newer_than: 1h\n"))
+	assert.Equal(t, UnsupportedConfigError{Field: "azure.retention.newer_than"}, errors.Unwrap(err))
+
+	_, err = Parse([]byte("gcp:\n  resource_options:\n    gcrimage:\n      keep_latest: -1\n"))
+	assert.Equal(t, InvalidConfigError{Field: "gcp.resource_options.gcrimage.keep_latest", Value: "-1"}, errors.Unwrap(err))
+
+	_, err = Parse([]byte("aws:\n  resource_type_timeouts:\n    rdssnapshot: an hour\n"))
+	assert.Equal(t, InvalidConfigError{Field: "aws.resource_type_timeouts.rdssnapshot", Value: "an hour"}, errors.Unwrap(err))
+}
@@ -0,0 +1,32 @@
+package config
+
+import "fmt"
+
+// InvalidConfigError - returned when a setting of the config file has an invalid value
+type InvalidConfigError struct {
+	Field string
+	Value string
+}
+
+func (err InvalidConfigError) Error() string {
+	return fmt.Sprintf("Invalid value %s for setting %s in the config file", err.Value, err.Field)
+}
+
+// UnsupportedConfigError - returned when the config file holds a setting that isn't supported in its section
+type UnsupportedConfigError struct {
+	Field string
+}
+
+func (err UnsupportedConfigError) Error() string {
+	return fmt.Sprintf("Setting %s isn't supported in the config file", err.Field)
+}
+
+// ConflictingConfigError - returned when two settings of the config file can't be used together
+type ConflictingConfigError struct {
+	Field         string
+	ConflictsWith string
+}
+
+func (err ConflictingConfigError) Error() string {
+	return fmt.Sprintf("Setting %s can't be used along with %s in the config file", err.Field, err.ConflictsWith)
+}
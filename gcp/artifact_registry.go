@@ -0,0 +1,307 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	artifactregistry "cloud.google.com/go/artifactregistry/apiv1"
+	"cloud.google.com/go/artifactregistry/apiv1/artifactregistrypb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/genproto/googleapis/cloud/location"
+)
+
+// Container Registry has been replaced by Artifact Registry, which now hosts the legacy GCR images in repositories
+// named after the GCR host they are pushed to
+var gcrRepositoryNames = []string{"gcr.io", "us.gcr.io", "eu.gcr.io", "asia.gcr.io"}
+
+func isGcrRepository(repositoryName string) bool {
+	return collections.ListContainsElement(gcrRepositoryNames, repositoryName)
+}
+
+// Artifact Registry repositories are identified by their location and name, separated by a slash, as repository
+// names are only unique within a location
+func artifactRegistryRepositoryResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", errors.WithStackTrace(InvalidArtifactRegistryRepositoryIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/repositories/%s", projectID, parts[0], parts[1]), nil
+}
+
+// GCR images are identified by the location and repository they live in, along with the image and its digest, e.g.
+// us/gcr.io/my-app@sha256:123. The image name is URL encoded, as in the Artifact Registry resource names.
+func gcrImageIdentifier(imageResourceName string) string {
+	parts := strings.SplitN(imageResourceName, "/", 8)
+	// projects/<project>/locations/<location>/repositories/<repository>/dockerImages/<image>@<digest>
+	if len(parts) != 8 {
+		return imageResourceName
+	}
+	return fmt.Sprintf("%s/%s/%s", parts[3], parts[5], parts[7])
+}
+
+// gcrImageVersionResourceName returns the resource name of the package version holding the image, which is what
+// gets deleted
+func gcrImageVersionResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.SplitN(identifier, "/", 3)
+	if len(parts) != 3 {
+		return "", errors.WithStackTrace(InvalidGcrImageIdentifierError{Identifier: identifier})
+	}
+	imageAndDigest := strings.SplitN(parts[2], "@", 2)
+	if len(imageAndDigest) != 2 {
+		return "", errors.WithStackTrace(InvalidGcrImageIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/repositories/%s/packages/%s/versions/%s", projectID, parts[0], parts[1], imageAndDigest[0], imageAndDigest[1]), nil
+}
+
+// getArtifactRegistryLocations returns the ids of the locations Artifact Registry is available in for the project
+func getArtifactRegistryLocations(ctx context.Context, client *artifactregistry.Client, projectID string) ([]string, error) {
+	var locations []string
+	it := client.ListLocations(ctx, &location.ListLocationsRequest{Name: "projects/" + projectID})
+	for {
+		loc, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		locations = append(locations, loc.GetLocationId())
+	}
+	return locations, nil
+}
+
+// getAllArtifactRegistryRepositoryResources returns all Artifact Registry repositories in the project, across all
+// locations
+func getAllArtifactRegistryRepositoryResources(ctx context.Context, client *artifactregistry.Client, projectID string) ([]*artifactregistrypb.Repository, error) {
+	locations, err := getArtifactRegistryLocations(ctx, client, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var repositories []*artifactregistrypb.Repository
+	for _, loc := range locations {
+		it := client.ListRepositories(ctx, &artifactregistrypb.ListRepositoriesRequest{
+			Parent: fmt.Sprintf("projects/%s/locations/%s", projectID, loc),
+		})
+		for {
+			repository, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			repositories = append(repositories, repository)
+		}
+	}
+	return repositories, nil
+}
+
+// Returns the location/name identifiers of all Artifact Registry repositories in the project, grouped by location.
+// The repositories hosting legacy GCR images are left out: their images are nuked as GCR images instead, so that the
+// retention rules apply.
+func getAllArtifactRegistryRepositories(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := artifactregistry.NewClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	repositories, err := getAllArtifactRegistryRepositoryResources(ctx, client, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	repositoryIdentifiers := map[string][]string{}
+	for _, repository := range repositories {
+		// projects/<project>/locations/<location>/repositories/<repository>
+		parts := strings.Split(repository.GetName(), "/")
+		loc, name := parts[3], parts[5]
+		if isGcrRepository(name) {
+			continue
+		}
+
+		if excludeAfter.After(repository.GetCreateTime().AsTime()) {
+			repositoryIdentifiers[loc] = append(repositoryIdentifiers[loc], fmt.Sprintf("%s/%s", loc, name))
+		}
+	}
+
+	return repositoryIdentifiers, nil
+}
+
+// Deletes all Artifact Registry repositories, along with all the artifacts in them
+func nukeAllArtifactRegistryRepositories(projectID string, repositoryIdentifiers []string) error {
+	if len(repositoryIdentifiers) == 0 {
+		logging.Logger.Infof("No Artifact Registry repositories to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := artifactregistry.NewClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all Artifact Registry repositories in project %s", projectID)
+	var deletedRepositoryIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, repositoryIdentifier := range repositoryIdentifiers {
+		name, err := artifactRegistryRepositoryResourceName(projectID, repositoryIdentifier)
+		if err == nil {
+			var operation *artifactregistry.DeleteRepositoryOperation
+			operation, err = client.DeleteRepository(ctx, &artifactregistrypb.DeleteRepositoryRequest{Name: name})
+			if err == nil {
+				err = operation.Wait(ctx)
+			}
+		}
+
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(repositoryIdentifier, err)
+		} else {
+			deletedRepositoryIdentifiers = append(deletedRepositoryIdentifiers, repositoryIdentifier)
+			logging.Logger.Infof("Deleted Artifact Registry repository: %s", repositoryIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Artifact Registry repository(s) deleted in project %s", len(deletedRepositoryIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+// gcrImage - a legacy GCR image, as needed to apply the retention rules
+type gcrImage struct {
+	Identifier string
+	// The image name, without the digest. The retention rules apply to each image name separately.
+	Image      string
+	Tags       []string
+	UploadedAt time.Time
+}
+
+// selectGcrImagesToNuke returns the identifiers of the images uploaded before excludeAfter, except for the
+// keepLatest most recently uploaded tagged images of each image name. Untagged images are never kept.
+func selectGcrImagesToNuke(images []gcrImage, excludeAfter time.Time, keepLatest int) []string {
+	sorted := make([]gcrImage, len(images))
+	copy(sorted, images)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].UploadedAt.After(sorted[j].UploadedAt)
+	})
+
+	keptPerImage := map[string]int{}
+	var identifiers []string
+	for _, image := range sorted {
+		if len(image.Tags) > 0 && keptPerImage[image.Image] < keepLatest {
+			keptPerImage[image.Image]++
+			continue
+		}
+		if excludeAfter.After(image.UploadedAt) {
+			identifiers = append(identifiers, image.Identifier)
+		}
+	}
+	return identifiers
+}
+
+// Returns the identifiers of the legacy GCR images in the project that were uploaded before excludeAfter, grouped by
+// location. The keepLatest most recently uploaded tagged images of each image name are kept.
+func getAllGcrImages(projectID string, excludeAfter time.Time, keepLatest int) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := artifactregistry.NewClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	repositories, err := getAllArtifactRegistryRepositoryResources(ctx, client, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	imageIdentifiers := map[string][]string{}
+	for _, repository := range repositories {
+		parts := strings.Split(repository.GetName(), "/")
+		loc, name := parts[3], parts[5]
+		if !isGcrRepository(name) {
+			continue
+		}
+
+		var images []gcrImage
+		it := client.ListDockerImages(ctx, &artifactregistrypb.ListDockerImagesRequest{Parent: repository.GetName()})
+		for {
+			image, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			identifier := gcrImageIdentifier(image.GetName())
+			images = append(images, gcrImage{
+				Identifier: identifier,
+				Image:      strings.SplitN(identifier, "@", 2)[0],
+				Tags:       image.GetTags(),
+				UploadedAt: image.GetUploadTime().AsTime(),
+			})
+		}
+
+		imageIdentifiers[loc] = append(imageIdentifiers[loc], selectGcrImagesToNuke(images, excludeAfter, keepLatest)...)
+	}
+
+	for loc, identifiers := range imageIdentifiers {
+		if len(identifiers) == 0 {
+			delete(imageIdentifiers, loc)
+		}
+	}
+
+	return imageIdentifiers, nil
+}
+
+// Deletes all legacy GCR images, along with their tags
+func nukeAllGcrImages(projectID string, imageIdentifiers []string) error {
+	if len(imageIdentifiers) == 0 {
+		logging.Logger.Infof("No GCR images to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := artifactregistry.NewClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GCR images in project %s", projectID)
+	var deletedImageIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, imageIdentifier := range imageIdentifiers {
+		name, err := gcrImageVersionResourceName(projectID, imageIdentifier)
+		if err == nil {
+			var operation *artifactregistry.DeleteVersionOperation
+			// Force deletes the tags pointing at the image along with it
+			operation, err = client.DeleteVersion(ctx, &artifactregistrypb.DeleteVersionRequest{Name: name, Force: true})
+			if err == nil {
+				err = operation.Wait(ctx)
+			}
+		}
+
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(imageIdentifier, err)
+		} else {
+			deletedImageIdentifiers = append(deletedImageIdentifiers, imageIdentifier)
+			logging.Logger.Infof("Deleted GCR image: %s", imageIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCR image(s) deleted in project %s", len(deletedImageIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
@@ -0,0 +1,127 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	artifactregistry "cloud.google.com/go/artifactregistry/apiv1"
+	"cloud.google.com/go/artifactregistry/apiv1/artifactregistrypb"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestArtifactRegistryRepository creates an empty docker repository
+func createTestArtifactRegistryRepository(t *testing.T, projectID string, name string) {
+	ctx := context.Background()
+	client, err := artifactregistry.NewClient(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	operation, err := client.CreateRepository(ctx, &artifactregistrypb.CreateRepositoryRequest{
+		Parent:       fmt.Sprintf("projects/%s/locations/%s", projectID, testRegion),
+		RepositoryId: name,
+		Repository: &artifactregistrypb.Repository{
+			Format:      artifactregistrypb.Repository_DOCKER,
+			Description: "Created by the cloud-nuke tests",
+		},
+	})
+	require.NoError(t, err)
+	_, err = operation.Wait(ctx)
+	require.NoError(t, err)
+}
+
+func TestGcrImageIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := gcrImageIdentifier("projects/my-project/locations/us/repositories/us.gcr.io/dockerImages/my-app@sha256:123")
+	assert.Equal(t, "us/us.gcr.io/my-app@sha256:123", identifier)
+
+	name, err := gcrImageVersionResourceName("my-project", identifier)
+	require.NoError(t, err)
+	assert.Equal(t, "projects/my-project/locations/us/repositories/us.gcr.io/packages/my-app/versions/sha256:123", name)
+
+	_, err = gcrImageVersionResourceName("my-project", "us/us.gcr.io/my-app")
+	assert.Equal(t, InvalidGcrImageIdentifierError{Identifier: "us/us.gcr.io/my-app"}, errors.Unwrap(err))
+}
+
+func TestSelectGcrImagesToNuke(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	images := []gcrImage{
+		{Identifier: "us/gcr.io/app@sha256:1", Image: "us/gcr.io/app", Tags: []string{"v1"}, UploadedAt: now.Add(-72 * time.Hour)},
+		{Identifier: "us/gcr.io/app@sha256:2", Image: "us/gcr.io/app", Tags: []string{"v2"}, UploadedAt: now.Add(-48 * time.Hour)},
+		{Identifier: "us/gcr.io/app@sha256:3", Image: "us/gcr.io/app", UploadedAt: now.Add(-36 * time.Hour)},
+		{Identifier: "us/gcr.io/app@sha256:4", Image: "us/gcr.io/app", Tags: []string{"v3", "latest"}, UploadedAt: now.Add(-24 * time.Hour)},
+		{Identifier: "us/gcr.io/app@sha256:5", Image: "us/gcr.io/app", Tags: []string{"v4"}, UploadedAt: now.Add(-1 * time.Hour)},
+		{Identifier: "us/gcr.io/other@sha256:6", Image: "us/gcr.io/other", Tags: []string{"v1"}, UploadedAt: now.Add(-72 * time.Hour)},
+	}
+	excludeAfter := now.Add(-12 * time.Hour)
+
+	assert.ElementsMatch(t, []string{
+		"us/gcr.io/app@sha256:1",
+		"us/gcr.io/app@sha256:2",
+		"us/gcr.io/app@sha256:3",
+		"us/gcr.io/app@sha256:4",
+		"us/gcr.io/other@sha256:6",
+	}, selectGcrImagesToNuke(images, excludeAfter, 0))
+
+	// The latest tagged images are kept even when they are older than the cutoff, and untagged images are never kept
+	assert.ElementsMatch(t, []string{
+		"us/gcr.io/app@sha256:1",
+		"us/gcr.io/app@sha256:3",
+	}, selectGcrImagesToNuke(images, excludeAfter, 3))
+}
+
+func TestListArtifactRegistryRepositories(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Repository names have to be lower case
+	repositoryName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	createTestArtifactRegistryRepository(t, projectID, repositoryName)
+	repositoryIdentifier := testRegion + "/" + repositoryName
+	// clean up after this test
+	defer nukeAllArtifactRegistryRepositories(projectID, []string{repositoryIdentifier})
+
+	repositoryIdentifiers, err := getAllArtifactRegistryRepositories(projectID, time.Now().Add(1*time.Hour*-1))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Artifact Registry repositories")
+	}
+
+	assert.NotContains(t, repositoryIdentifiers[testRegion], repositoryIdentifier)
+
+	repositoryIdentifiers, err = getAllArtifactRegistryRepositories(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Artifact Registry repositories")
+	}
+
+	assert.Contains(t, repositoryIdentifiers[testRegion], repositoryIdentifier)
+}
+
+func TestNukeArtifactRegistryRepositories(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Repository names have to be lower case
+	repositoryName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	createTestArtifactRegistryRepository(t, projectID, repositoryName)
+	repositoryIdentifier := testRegion + "/" + repositoryName
+
+	if err := nukeAllArtifactRegistryRepositories(projectID, []string{repositoryIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	repositoryIdentifiers, err := getAllArtifactRegistryRepositories(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Artifact Registry repositories")
+	}
+
+	assert.NotContains(t, repositoryIdentifiers[testRegion], repositoryIdentifier)
+}
@@ -0,0 +1,86 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// ArtifactRegistryRepositoryResource - represents all Artifact Registry repositories, other than the ones hosting
+// legacy GCR images
+type ArtifactRegistryRepositoryResource struct {
+	RepositoryIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (repositories ArtifactRegistryRepositoryResource) ResourceName() string {
+	return "artifactregistryrepo"
+}
+
+// ResourceIdentifiers - The location/name pairs of the Artifact Registry repositories
+func (repositories ArtifactRegistryRepositoryResource) ResourceIdentifiers() []string {
+	return repositories.RepositoryIdentifiers
+}
+
+func (repositories ArtifactRegistryRepositoryResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (repositories ArtifactRegistryRepositoryResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllArtifactRegistryRepositories(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// GcrImageResource - represents all legacy Container Registry images
+type GcrImageResource struct {
+	ImageIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (images GcrImageResource) ResourceName() string {
+	return "gcrimage"
+}
+
+// ResourceIdentifiers - The location/repository/image@digest identifiers of the GCR images
+func (images GcrImageResource) ResourceIdentifiers() []string {
+	return images.ImageIdentifiers
+}
+
+func (images GcrImageResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (images GcrImageResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGcrImages(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidArtifactRegistryRepositoryIdentifierError - returned when an Artifact Registry repository identifier isn't a
+// location/name pair
+type InvalidArtifactRegistryRepositoryIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidArtifactRegistryRepositoryIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid Artifact Registry repository identifier %s, expected <location>/<name>", e.Identifier)
+}
+
+// InvalidGcrImageIdentifierError - returned when a GCR image identifier isn't of the form
+// location/repository/image@digest
+type InvalidGcrImageIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGcrImageIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCR image identifier %s, expected <location>/<repository>/<image>@<digest>", e.Identifier)
+}
@@ -0,0 +1,144 @@
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// Cloud DNS managed zones aren't tied to a region, so they are grouped under this one
+const globalRegion = "global"
+
+// How many record sets to delete in a single change
+const cloudDnsChangeBatchSize = 100
+
+// isDeletableRecordSet - Cloud DNS manages the SOA and NS records at the apex of a zone itself. They can't be
+// deleted, and go away along with the zone. All other record sets have to be deleted before the zone can be.
+func isDeletableRecordSet(zoneDnsName string, recordSet *dns.ResourceRecordSet) bool {
+	if recordSet.Type == "SOA" {
+		return false
+	}
+	return !(recordSet.Type == "NS" && recordSet.Name == zoneDnsName)
+}
+
+// Returns the names of all Cloud DNS managed zones in the project that match the name filter
+func getAllCloudDnsZones(projectID string, excludeAfter time.Time, nameFilter NameFilter) (map[string][]string, error) {
+	ctx := context.Background()
+	svc, err := dns.NewService(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	zoneNames := map[string][]string{}
+	err = svc.ManagedZones.List(projectID).Pages(ctx, func(page *dns.ManagedZonesListResponse) error {
+		for _, zone := range page.ManagedZones {
+			if !nameFilter.Matches(zone.Name) {
+				continue
+			}
+
+			createdAt, err := time.Parse(time.RFC3339, zone.CreationTime)
+			if err != nil {
+				return err
+			}
+
+			if excludeAfter.After(createdAt) {
+				zoneNames[globalRegion] = append(zoneNames[globalRegion], zone.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return zoneNames, nil
+}
+
+// emptyCloudDnsZone deletes all the record sets of the zone that can be deleted, as a zone can only be deleted once
+// it is empty. Errors are returned as is, so that callers can check for a googleapi.Error.
+func emptyCloudDnsZone(ctx context.Context, svc *dns.Service, projectID string, zoneName string) error {
+	zone, err := svc.ManagedZones.Get(projectID, zoneName).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var recordSets []*dns.ResourceRecordSet
+	err = svc.ResourceRecordSets.List(projectID, zoneName).Pages(ctx, func(page *dns.ResourceRecordSetsListResponse) error {
+		for _, recordSet := range page.Rrsets {
+			if isDeletableRecordSet(zone.DnsName, recordSet) {
+				recordSets = append(recordSets, recordSet)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for len(recordSets) > 0 {
+		batch := recordSets
+		if len(batch) > cloudDnsChangeBatchSize {
+			batch = batch[:cloudDnsChangeBatchSize]
+		}
+		recordSets = recordSets[len(batch):]
+
+		logging.Logger.Infof("...deleting %d record set(s) of Cloud DNS managed zone %s", len(batch), zoneName)
+		_, err := svc.Changes.Create(projectID, zoneName, &dns.Change{Deletions: batch}).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isNotFound checks if the error is a 404 returned by a Google API
+func isNotFound(err error) bool {
+	apiErr, isApiErr := err.(*googleapi.Error)
+	return isApiErr && apiErr.Code == http.StatusNotFound
+}
+
+// Deletes all Cloud DNS managed zones, along with all the record sets in them
+func nukeAllCloudDnsZones(projectID string, zoneNames []string) error {
+	if len(zoneNames) == 0 {
+		logging.Logger.Infof("No Cloud DNS managed zones to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	svc, err := dns.NewService(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Deleting all Cloud DNS managed zones in project %s", projectID)
+	var deletedZoneNames []string
+	var nukeErrors NukeErrors
+
+	for _, zoneName := range zoneNames {
+		err := emptyCloudDnsZone(ctx, svc, projectID, zoneName)
+		if err == nil {
+			err = svc.ManagedZones.Delete(projectID, zoneName).Context(ctx).Do()
+		}
+
+		if err != nil {
+			if isNotFound(err) {
+				logging.Logger.Infof("Cloud DNS managed zone %s has already been deleted", zoneName)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+				nukeErrors.add(zoneName, err)
+			}
+		} else {
+			deletedZoneNames = append(deletedZoneNames, zoneName)
+			logging.Logger.Infof("Deleted Cloud DNS managed zone: %s", zoneName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Cloud DNS managed zone(s) deleted in project %s", len(deletedZoneNames), projectID)
+	return nukeErrors.errorOrNil()
+}
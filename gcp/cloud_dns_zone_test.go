@@ -0,0 +1,102 @@
+package gcp
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dns "google.golang.org/api/dns/v1"
+)
+
+// createTestCloudDnsZone creates a managed zone holding a record set, so that nuking it exercises emptying the zone
+func createTestCloudDnsZone(t *testing.T, projectID string, name string) {
+	ctx := context.Background()
+	svc, err := dns.NewService(ctx)
+	require.NoError(t, err)
+
+	dnsName := name + ".example.com."
+	_, err = svc.ManagedZones.Create(projectID, &dns.ManagedZone{
+		Name:        name,
+		DnsName:     dnsName,
+		Description: "Created by the cloud-nuke tests",
+		Visibility:  "private",
+	}).Context(ctx).Do()
+	require.NoError(t, err)
+
+	_, err = svc.Changes.Create(projectID, name, &dns.Change{
+		Additions: []*dns.ResourceRecordSet{
+			{Name: "www." + dnsName, Type: "A", Ttl: 300, Rrdatas: []string{"10.0.0.1"}},
+		},
+	}).Context(ctx).Do()
+	require.NoError(t, err)
+}
+
+func TestIsDeletableRecordSet(t *testing.T) {
+	t.Parallel()
+
+	zoneDnsName := "example.com."
+	assert.False(t, isDeletableRecordSet(zoneDnsName, &dns.ResourceRecordSet{Name: "example.com.", Type: "SOA"}))
+	assert.False(t, isDeletableRecordSet(zoneDnsName, &dns.ResourceRecordSet{Name: "example.com.", Type: "NS"}))
+	assert.True(t, isDeletableRecordSet(zoneDnsName, &dns.ResourceRecordSet{Name: "sub.example.com.", Type: "NS"}))
+	assert.True(t, isDeletableRecordSet(zoneDnsName, &dns.ResourceRecordSet{Name: "www.example.com.", Type: "A"}))
+}
+
+func TestListCloudDnsZones(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Zone names have to be lower case
+	zoneName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	createTestCloudDnsZone(t, projectID, zoneName)
+	// clean up after this test
+	defer nukeAllCloudDnsZones(projectID, []string{zoneName})
+
+	zoneNames, err := getAllCloudDnsZones(projectID, time.Now().Add(1*time.Hour*-1), NameFilter{})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud DNS managed zones")
+	}
+
+	assert.NotContains(t, zoneNames[globalRegion], zoneName)
+
+	zoneNames, err = getAllCloudDnsZones(projectID, time.Now().Add(1*time.Hour), NameFilter{})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud DNS managed zones")
+	}
+
+	assert.Contains(t, zoneNames[globalRegion], zoneName)
+
+	excludeTestZones := NameFilter{Exclude: []*regexp.Regexp{regexp.MustCompile(`^cloud-nuke-test-`)}}
+	zoneNames, err = getAllCloudDnsZones(projectID, time.Now().Add(1*time.Hour), excludeTestZones)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud DNS managed zones")
+	}
+
+	assert.NotContains(t, zoneNames[globalRegion], zoneName)
+}
+
+func TestNukeCloudDnsZones(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Zone names have to be lower case
+	zoneName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	createTestCloudDnsZone(t, projectID, zoneName)
+
+	if err := nukeAllCloudDnsZones(projectID, []string{zoneName}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	zoneNames, err := getAllCloudDnsZones(projectID, time.Now().Add(1*time.Hour), NameFilter{})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud DNS managed zones")
+	}
+
+	assert.NotContains(t, zoneNames[globalRegion], zoneName)
+}
@@ -0,0 +1,34 @@
+package gcp
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// CloudDnsZoneResource - represents all Cloud DNS managed zones
+type CloudDnsZoneResource struct {
+	ZoneNames []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (zones CloudDnsZoneResource) ResourceName() string {
+	return "clouddnszone"
+}
+
+// ResourceIdentifiers - The names of the Cloud DNS managed zones
+func (zones CloudDnsZoneResource) ResourceIdentifiers() []string {
+	return zones.ZoneNames
+}
+
+func (zones CloudDnsZoneResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (zones CloudDnsZoneResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllCloudDnsZones(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	cloudfunctions "google.golang.org/api/cloudfunctions/v2"
+)
+
+// How long to wait for Cloud Functions to finish deleting before giving up
+const gcfOperationMaxAttempts = 60
+const gcfOperationRetryInterval = 10 * time.Second
+
+// Cloud Functions are regional, and identified by their region and name, separated by a slash, as function names are
+// only unique within a region
+func gcfIdentifier(functionResourceName string) string {
+	// projects/<project>/locations/<region>/functions/<function>
+	parts := strings.Split(functionResourceName, "/")
+	if len(parts) != 6 {
+		return functionResourceName
+	}
+	return fmt.Sprintf("%s/%s", parts[3], parts[5])
+}
+
+func gcfResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", errors.WithStackTrace(InvalidGcfIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/functions/%s", projectID, parts[0], parts[1]), nil
+}
+
+// gcfCreateTime returns when the function was created. The functions deployed before the API reported it only have
+// the time of their last deployment, which is used instead.
+func gcfCreateTime(function *cloudfunctions.Function) (time.Time, error) {
+	createTime := function.CreateTime
+	if createTime == "" {
+		createTime = function.UpdateTime
+	}
+	return time.Parse(time.RFC3339, createTime)
+}
+
+// Returns the region/name identifiers of all Cloud Functions in the project, both 1st gen and 2nd gen, grouped by
+// region
+func getAllGcfs(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	svc, err := cloudfunctions.NewService(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	functionIdentifiers := map[string][]string{}
+	// The "-" location lists the functions of all regions at once
+	parent := fmt.Sprintf("projects/%s/locations/-", projectID)
+	err = svc.Projects.Locations.Functions.List(parent).Pages(ctx, func(page *cloudfunctions.ListFunctionsResponse) error {
+		for _, location := range page.Unreachable {
+			logging.Logger.Warnf("Unable to list Cloud Functions in %s, it is not reachable", location)
+		}
+
+		for _, function := range page.Functions {
+			if function.State == "DELETING" {
+				continue
+			}
+
+			createdAt, err := gcfCreateTime(function)
+			if err != nil {
+				return err
+			}
+
+			if excludeAfter.After(createdAt) {
+				identifier := gcfIdentifier(function.Name)
+				region := strings.SplitN(identifier, "/", 2)[0]
+				functionIdentifiers[region] = append(functionIdentifiers[region], identifier)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return functionIdentifiers, nil
+}
+
+// waitUntilGcfOperationDone polls the operation until it is done, as the Cloud Functions API has no waiters
+func waitUntilGcfOperationDone(ctx context.Context, svc *cloudfunctions.Service, operation *cloudfunctions.Operation) error {
+	for i := 0; i < gcfOperationMaxAttempts; i++ {
+		if operation.Done {
+			if operation.Error != nil {
+				return errors.WithStackTrace(GcfOperationError{OperationName: operation.Name, Message: operation.Error.Message})
+			}
+			return nil
+		}
+		time.Sleep(gcfOperationRetryInterval)
+
+		var err error
+		operation, err = svc.Projects.Locations.Operations.Get(operation.Name).Context(ctx).Do()
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return errors.WithStackTrace(GcfOperationNotDoneError{OperationName: operation.Name})
+}
+
+// Deletes all Cloud Functions
+func nukeAllGcfs(projectID string, functionIdentifiers []string) error {
+	if len(functionIdentifiers) == 0 {
+		logging.Logger.Infof("No Cloud Functions to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	svc, err := cloudfunctions.NewService(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Deleting all Cloud Functions in project %s", projectID)
+
+	// Deleting a function takes a while, so start deleting the whole batch before waiting on any of them
+	operations := map[string]*cloudfunctions.Operation{}
+	var deletedFunctionIdentifiers []string
+	var nukeErrors NukeErrors
+	for _, functionIdentifier := range functionIdentifiers {
+		name, err := gcfResourceName(projectID, functionIdentifier)
+		if err == nil {
+			var operation *cloudfunctions.Operation
+			operation, err = svc.Projects.Locations.Functions.Delete(name).Context(ctx).Do()
+			if err == nil {
+				operations[functionIdentifier] = operation
+				continue
+			}
+		}
+
+		if isNotFound(err) {
+			logging.Logger.Infof("Cloud Function %s has already been deleted", functionIdentifier)
+		} else {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(functionIdentifier, err)
+		}
+	}
+
+	for functionIdentifier, operation := range operations {
+		if err := waitUntilGcfOperationDone(ctx, svc, operation); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(functionIdentifier, err)
+		} else {
+			deletedFunctionIdentifiers = append(deletedFunctionIdentifiers, functionIdentifier)
+			logging.Logger.Infof("Deleted Cloud Function: %s", functionIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Cloud Function(s) deleted in project %s", len(deletedFunctionIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
@@ -0,0 +1,37 @@
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudfunctions "google.golang.org/api/cloudfunctions/v2"
+)
+
+func TestGcfIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := gcfIdentifier("projects/my-project/locations/us-central1/functions/my-function")
+	assert.Equal(t, "us-central1/my-function", identifier)
+
+	name, err := gcfResourceName("my-project", identifier)
+	require.NoError(t, err)
+	assert.Equal(t, "projects/my-project/locations/us-central1/functions/my-function", name)
+
+	_, err = gcfResourceName("my-project", "my-function")
+	assert.Equal(t, InvalidGcfIdentifierError{Identifier: "my-function"}, errors.Unwrap(err))
+}
+
+func TestGcfCreateTime(t *testing.T) {
+	t.Parallel()
+
+	createdAt, err := gcfCreateTime(&cloudfunctions.Function{CreateTime: "2024-01-02T03:04:05Z", UpdateTime: "2024-02-03T04:05:06Z"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), createdAt)
+
+	createdAt, err = gcfCreateTime(&cloudfunctions.Function{UpdateTime: "2024-02-03T04:05:06.789Z"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 2, 3, 4, 5, 6, 789000000, time.UTC), createdAt)
+}
@@ -0,0 +1,64 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GcfResource - represents all Cloud Functions, 1st gen and 2nd gen alike
+type GcfResource struct {
+	FunctionIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (functions GcfResource) ResourceName() string {
+	return "cloudfunction"
+}
+
+// ResourceIdentifiers - The region/name pairs of the Cloud Functions
+func (functions GcfResource) ResourceIdentifiers() []string {
+	return functions.FunctionIdentifiers
+}
+
+func (functions GcfResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 20
+}
+
+// Nuke - nuke 'em all!!!
+func (functions GcfResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGcfs(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGcfIdentifierError - returned when a Cloud Function identifier isn't a region/name pair
+type InvalidGcfIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGcfIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid Cloud Function identifier %s, expected <region>/<name>", e.Identifier)
+}
+
+// GcfOperationError - returned when a Cloud Functions operation failed
+type GcfOperationError struct {
+	OperationName string
+	Message       string
+}
+
+func (e GcfOperationError) Error() string {
+	return fmt.Sprintf("Cloud Functions operation %s failed: %s", e.OperationName, e.Message)
+}
+
+// GcfOperationNotDoneError - returned when a Cloud Functions operation is still running after waiting for it
+type GcfOperationNotDoneError struct {
+	OperationName string
+}
+
+func (e GcfOperationNotDoneError) Error() string {
+	return fmt.Sprintf("Timed out waiting for Cloud Functions operation %s to be done", e.OperationName)
+}
@@ -0,0 +1,221 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/genproto/googleapis/cloud/location"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Cloud KMS keys and key rings can't be deleted. Instead, keys are neutralized: all their key versions are scheduled
+// for destruction, and their rotation schedule is removed so that no new key version gets created. The key rings
+// are left alone.
+
+// Cloud KMS keys are identified by their location, key ring and name, separated by slashes, as key names are only
+// unique within a key ring
+func cloudKmsKeyIdentifier(keyResourceName string) string {
+	// projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key>
+	parts := strings.Split(keyResourceName, "/")
+	if len(parts) != 8 {
+		return keyResourceName
+	}
+	return fmt.Sprintf("%s/%s/%s", parts[3], parts[5], parts[7])
+}
+
+func cloudKmsKeyResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.Split(identifier, "/")
+	if len(parts) != 3 {
+		return "", errors.WithStackTrace(InvalidCloudKmsKeyIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/keyRings/%s/cryptoKeys/%s", projectID, parts[0], parts[1], parts[2]), nil
+}
+
+// Key versions that are already destroyed, or scheduled for destruction, are left alone, as are the ones still being
+// generated or imported
+func isDestroyableCryptoKeyVersion(version *kmspb.CryptoKeyVersion) bool {
+	return version.GetState() == kmspb.CryptoKeyVersion_ENABLED || version.GetState() == kmspb.CryptoKeyVersion_DISABLED
+}
+
+// isNeutralizedCloudKmsKey checks if the key can't be used anymore, nor will be usable again without someone acting on
+// it: it has no key version left to destroy, and no rotation schedule that would create a new one
+func isNeutralizedCloudKmsKey(key *kmspb.CryptoKey, versions []*kmspb.CryptoKeyVersion) bool {
+	if key.GetRotationPeriod() != nil || key.GetNextRotationTime() != nil {
+		return false
+	}
+	for _, version := range versions {
+		if isDestroyableCryptoKeyVersion(version) {
+			return false
+		}
+	}
+	return true
+}
+
+func getAllCryptoKeyVersions(ctx context.Context, client *kms.KeyManagementClient, keyResourceName string) ([]*kmspb.CryptoKeyVersion, error) {
+	var versions []*kmspb.CryptoKeyVersion
+	it := client.ListCryptoKeyVersions(ctx, &kmspb.ListCryptoKeyVersionsRequest{Parent: keyResourceName})
+	for {
+		version, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// getAllCloudKmsKeyResources returns all Cloud KMS keys in the project, across all locations and key rings
+func getAllCloudKmsKeyResources(ctx context.Context, client *kms.KeyManagementClient, projectID string) ([]*kmspb.CryptoKey, error) {
+	var keys []*kmspb.CryptoKey
+
+	locations := client.ListLocations(ctx, &location.ListLocationsRequest{Name: "projects/" + projectID})
+	for {
+		loc, err := locations.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		keyRings := client.ListKeyRings(ctx, &kmspb.ListKeyRingsRequest{
+			Parent: fmt.Sprintf("projects/%s/locations/%s", projectID, loc.GetLocationId()),
+		})
+		for {
+			keyRing, err := keyRings.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			it := client.ListCryptoKeys(ctx, &kmspb.ListCryptoKeysRequest{Parent: keyRing.GetName()})
+			for {
+				key, err := it.Next()
+				if err == iterator.Done {
+					break
+				}
+				if err != nil {
+					return nil, errors.WithStackTrace(err)
+				}
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// Returns the location/key ring/name identifiers of the Cloud KMS keys in the project created before excludeAfter,
+// grouped by location. Keys that are already neutralized are left out.
+func getAllCloudKmsKeys(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	keys, err := getAllCloudKmsKeyResources(ctx, client, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	keyIdentifiers := map[string][]string{}
+	for _, key := range keys {
+		if !excludeAfter.After(key.GetCreateTime().AsTime()) {
+			continue
+		}
+
+		versions, err := getAllCryptoKeyVersions(ctx, client, key.GetName())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if isNeutralizedCloudKmsKey(key, versions) {
+			continue
+		}
+
+		identifier := cloudKmsKeyIdentifier(key.GetName())
+		loc := strings.SplitN(identifier, "/", 2)[0]
+		keyIdentifiers[loc] = append(keyIdentifiers[loc], identifier)
+	}
+
+	return keyIdentifiers, nil
+}
+
+// Neutralizes all Cloud KMS keys, as they can't be deleted
+func nukeAllCloudKmsKeys(projectID string, keyIdentifiers []string) error {
+	if len(keyIdentifiers) == 0 {
+		logging.Logger.Infof("No Cloud KMS keys to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Neutralizing all Cloud KMS keys in project %s", projectID)
+	var neutralizedKeyIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, keyIdentifier := range keyIdentifiers {
+		if err := neutralizeCloudKmsKey(ctx, client, projectID, keyIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(keyIdentifier, err)
+		} else {
+			neutralizedKeyIdentifiers = append(neutralizedKeyIdentifiers, keyIdentifier)
+			logging.Logger.Infof("Neutralized Cloud KMS key: %s", keyIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Cloud KMS key(s) neutralized in project %s. Cloud KMS keys and key rings can't be deleted, so they remain listed.", len(neutralizedKeyIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+// neutralizeCloudKmsKey removes the rotation schedule of the key, then schedules the destruction of all its key
+// versions
+func neutralizeCloudKmsKey(ctx context.Context, client *kms.KeyManagementClient, projectID string, keyIdentifier string) error {
+	name, err := cloudKmsKeyResourceName(projectID, keyIdentifier)
+	if err != nil {
+		return err
+	}
+
+	// Clearing these fields removes the rotation schedule. Only keys used for symmetric encryption have one.
+	_, err = client.UpdateCryptoKey(ctx, &kmspb.UpdateCryptoKeyRequest{
+		CryptoKey:  &kmspb.CryptoKey{Name: name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"rotation_period", "next_rotation_time"}},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	versions, err := getAllCryptoKeyVersions(ctx, client, name)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, version := range versions {
+		if !isDestroyableCryptoKeyVersion(version) {
+			continue
+		}
+		logging.Logger.Infof("...scheduling the destruction of Cloud KMS key version %s", version.GetName())
+		if _, err := client.DestroyCryptoKeyVersion(ctx, &kmspb.DestroyCryptoKeyVersionRequest{Name: version.GetName()}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Key rings can't be deleted, so all tests share the same key ring rather than leaving one behind on each run
+const testKeyRingName = "cloud-nuke-test"
+
+// createTestCloudKmsKey creates a symmetric encryption key with a rotation schedule in the test key ring, creating
+// the key ring when it doesn't exist yet. Returns the identifier of the key.
+func createTestCloudKmsKey(t *testing.T, projectID string, name string) string {
+	ctx := context.Background()
+	client, err := kms.NewKeyManagementClient(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.CreateKeyRing(ctx, &kmspb.CreateKeyRingRequest{
+		Parent:    fmt.Sprintf("projects/%s/locations/%s", projectID, testRegion),
+		KeyRingId: testKeyRingName,
+		KeyRing:   &kmspb.KeyRing{},
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		require.NoError(t, err)
+	}
+
+	_, err = client.CreateCryptoKey(ctx, &kmspb.CreateCryptoKeyRequest{
+		Parent:      fmt.Sprintf("projects/%s/locations/%s/keyRings/%s", projectID, testRegion, testKeyRingName),
+		CryptoKeyId: name,
+		CryptoKey: &kmspb.CryptoKey{
+			Purpose:          kmspb.CryptoKey_ENCRYPT_DECRYPT,
+			NextRotationTime: timestamppb.New(time.Now().Add(24 * time.Hour)),
+			RotationSchedule: &kmspb.CryptoKey_RotationPeriod{RotationPeriod: durationpb.New(30 * 24 * time.Hour)},
+		},
+	})
+	require.NoError(t, err)
+
+	return fmt.Sprintf("%s/%s/%s", testRegion, testKeyRingName, name)
+}
+
+func TestCloudKmsKeyIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := cloudKmsKeyIdentifier("projects/my-project/locations/global/keyRings/my-key-ring/cryptoKeys/my-key")
+	assert.Equal(t, "global/my-key-ring/my-key", identifier)
+
+	name, err := cloudKmsKeyResourceName("my-project", identifier)
+	require.NoError(t, err)
+	assert.Equal(t, "projects/my-project/locations/global/keyRings/my-key-ring/cryptoKeys/my-key", name)
+
+	_, err = cloudKmsKeyResourceName("my-project", "global/my-key")
+	assert.Equal(t, InvalidCloudKmsKeyIdentifierError{Identifier: "global/my-key"}, errors.Unwrap(err))
+}
+
+func TestIsNeutralizedCloudKmsKey(t *testing.T) {
+	t.Parallel()
+
+	destroyed := []*kmspb.CryptoKeyVersion{
+		{State: kmspb.CryptoKeyVersion_DESTROYED},
+		{State: kmspb.CryptoKeyVersion_DESTROY_SCHEDULED},
+	}
+	assert.True(t, isNeutralizedCloudKmsKey(&kmspb.CryptoKey{}, destroyed))
+	assert.True(t, isNeutralizedCloudKmsKey(&kmspb.CryptoKey{}, nil))
+
+	assert.False(t, isNeutralizedCloudKmsKey(&kmspb.CryptoKey{}, append(destroyed, &kmspb.CryptoKeyVersion{State: kmspb.CryptoKeyVersion_ENABLED})))
+	assert.False(t, isNeutralizedCloudKmsKey(&kmspb.CryptoKey{}, append(destroyed, &kmspb.CryptoKeyVersion{State: kmspb.CryptoKeyVersion_DISABLED})))
+
+	rotated := &kmspb.CryptoKey{NextRotationTime: timestamppb.Now()}
+	assert.False(t, isNeutralizedCloudKmsKey(rotated, destroyed))
+}
+
+func TestListCloudKmsKeys(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Key names are kept lower case, like the other test resources
+	keyName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	keyIdentifier := createTestCloudKmsKey(t, projectID, keyName)
+	// clean up after this test
+	defer nukeAllCloudKmsKeys(projectID, []string{keyIdentifier})
+
+	keyIdentifiers, err := getAllCloudKmsKeys(projectID, time.Now().Add(1*time.Hour*-1))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud KMS keys")
+	}
+
+	assert.NotContains(t, keyIdentifiers[testRegion], keyIdentifier)
+
+	keyIdentifiers, err = getAllCloudKmsKeys(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud KMS keys")
+	}
+
+	assert.Contains(t, keyIdentifiers[testRegion], keyIdentifier)
+}
+
+func TestNukeCloudKmsKeys(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Key names are kept lower case, like the other test resources
+	keyName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	keyIdentifier := createTestCloudKmsKey(t, projectID, keyName)
+
+	if err := nukeAllCloudKmsKeys(projectID, []string{keyIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	// Neutralized keys aren't listed anymore
+	keyIdentifiers, err := getAllCloudKmsKeys(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Cloud KMS keys")
+	}
+
+	assert.NotContains(t, keyIdentifiers[testRegion], keyIdentifier)
+}
@@ -0,0 +1,49 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// CloudKmsKeyResource - represents all Cloud KMS keys that aren't neutralized yet
+type CloudKmsKeyResource struct {
+	KeyIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (keys CloudKmsKeyResource) ResourceName() string {
+	return "cloudkmskey"
+}
+
+// ResourceIdentifiers - The location/key ring/name identifiers of the Cloud KMS keys
+func (keys CloudKmsKeyResource) ResourceIdentifiers() []string {
+	return keys.KeyIdentifiers
+}
+
+func (keys CloudKmsKeyResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - neutralizes them all, as Cloud KMS keys can't be deleted
+func (keys CloudKmsKeyResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllCloudKmsKeys(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// neutralizes - Cloud KMS keys are reported as neutralized rather than nuked
+func (keys CloudKmsKeyResource) neutralizes() {}
+
+// InvalidCloudKmsKeyIdentifierError - returned when a Cloud KMS key identifier isn't of the form
+// location/keyring/name
+type InvalidCloudKmsKeyIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidCloudKmsKeyIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid Cloud KMS key identifier %s, expected <location>/<keyring>/<name>", e.Identifier)
+}
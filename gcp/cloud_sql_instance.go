@@ -0,0 +1,155 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+)
+
+// How long to wait for Cloud SQL operations to finish before giving up
+const cloudSqlOperationMaxAttempts = 60
+const cloudSqlOperationRetryInterval = 10 * time.Second
+
+// Returns the names of all Cloud SQL instances in the project, grouped by region. Read replicas are left out, as they
+// are deleted along with their primary instance, which can't be deleted while it has any.
+func getAllCloudSqlInstances(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	svc, err := sqladmin.NewService(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	instanceNames := map[string][]string{}
+	err = svc.Instances.List(projectID).Pages(ctx, func(page *sqladmin.InstancesListResponse) error {
+		for _, instance := range page.Items {
+			if instance.MasterInstanceName != "" {
+				continue
+			}
+
+			createdAt, err := time.Parse(time.RFC3339, instance.CreateTime)
+			if err != nil {
+				return err
+			}
+
+			if excludeAfter.After(createdAt) {
+				instanceNames[instance.Region] = append(instanceNames[instance.Region], instance.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return instanceNames, nil
+}
+
+// cloudSqlOperationError - Returns the error the operation failed with, if any
+func cloudSqlOperationError(operation *sqladmin.Operation) error {
+	if operation.Error == nil || len(operation.Error.Errors) == 0 {
+		return nil
+	}
+	operationErr := CloudSqlOperationError{OperationName: operation.Name}
+	for _, err := range operation.Error.Errors {
+		operationErr.Messages = append(operationErr.Messages, err.Code+": "+err.Message)
+	}
+	return operationErr
+}
+
+// waitUntilCloudSqlOperationDone polls the operation until it is done, as the Cloud SQL Admin API has no waiters.
+// Errors are returned as is, so that callers can check for a googleapi.Error.
+func waitUntilCloudSqlOperationDone(ctx context.Context, svc *sqladmin.Service, projectID string, operation *sqladmin.Operation) error {
+	for i := 0; i < cloudSqlOperationMaxAttempts; i++ {
+		if operation.Status == "DONE" {
+			return cloudSqlOperationError(operation)
+		}
+		time.Sleep(cloudSqlOperationRetryInterval)
+
+		var err error
+		operation, err = svc.Operations.Get(projectID, operation.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+	return CloudSqlOperationNotDoneError{OperationName: operation.Name}
+}
+
+// deleteCloudSqlInstance deletes the instance, after its read replicas and after disabling its deletion protection,
+// which would otherwise block its deletion. Errors are returned as is, so that callers can check for a
+// googleapi.Error.
+func deleteCloudSqlInstance(ctx context.Context, svc *sqladmin.Service, projectID string, instanceName string) error {
+	instance, err := svc.Instances.Get(projectID, instanceName).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	for _, replicaName := range instance.ReplicaNames {
+		logging.Logger.Infof("...deleting read replica %s of Cloud SQL instance %s", replicaName, instanceName)
+		if err := deleteCloudSqlInstance(ctx, svc, projectID, replicaName); err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	if instance.Settings != nil && instance.Settings.DeletionProtectionEnabled {
+		logging.Logger.Infof("...disabling the deletion protection of Cloud SQL instance %s", instanceName)
+		patch := &sqladmin.DatabaseInstance{
+			Settings: &sqladmin.Settings{
+				DeletionProtectionEnabled: false,
+				// Fields with their zero value are left out of the patch unless forced
+				ForceSendFields: []string{"DeletionProtectionEnabled"},
+			},
+		}
+		operation, err := svc.Instances.Patch(projectID, instanceName, patch).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		if err := waitUntilCloudSqlOperationDone(ctx, svc, projectID, operation); err != nil {
+			return err
+		}
+	}
+
+	operation, err := svc.Instances.Delete(projectID, instanceName).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return waitUntilCloudSqlOperationDone(ctx, svc, projectID, operation)
+}
+
+// Deletes all Cloud SQL instances, along with their read replicas
+func nukeAllCloudSqlInstances(projectID string, instanceNames []string) error {
+	if len(instanceNames) == 0 {
+		logging.Logger.Infof("No Cloud SQL instances to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	svc, err := sqladmin.NewService(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Deleting all Cloud SQL instances in project %s", projectID)
+	var deletedInstanceNames []string
+	var nukeErrors NukeErrors
+
+	for _, instanceName := range instanceNames {
+		err := deleteCloudSqlInstance(ctx, svc, projectID, instanceName)
+		if err != nil {
+			if isNotFound(err) {
+				logging.Logger.Infof("Cloud SQL instance %s has already been deleted", instanceName)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+				nukeErrors.add(instanceName, err)
+			}
+		} else {
+			deletedInstanceNames = append(deletedInstanceNames, instanceName)
+			logging.Logger.Infof("Deleted Cloud SQL instance: %s", instanceName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Cloud SQL instance(s) deleted in project %s", len(deletedInstanceNames), projectID)
+	return nukeErrors.errorOrNil()
+}
@@ -0,0 +1,25 @@
+package gcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+)
+
+// Cloud SQL instances take several minutes to create, so only how failed operations are reported is tested
+func TestCloudSqlOperationError(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, cloudSqlOperationError(&sqladmin.Operation{Name: "done", Status: "DONE"}))
+
+	err := cloudSqlOperationError(&sqladmin.Operation{
+		Name:   "op-1",
+		Status: "DONE",
+		Error: &sqladmin.OperationErrors{Errors: []*sqladmin.OperationError{
+			{Code: "INTERNAL_ERROR", Message: "something went wrong"},
+		}},
+	})
+	assert.Equal(t, CloudSqlOperationError{OperationName: "op-1", Messages: []string{"INTERNAL_ERROR: something went wrong"}}, err)
+	assert.Equal(t, "Cloud SQL operation op-1 failed: INTERNAL_ERROR: something went wrong", err.Error())
+}
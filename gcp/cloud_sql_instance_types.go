@@ -0,0 +1,56 @@
+package gcp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// CloudSqlResource - represents all Cloud SQL instances
+type CloudSqlResource struct {
+	InstanceNames []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (instances CloudSqlResource) ResourceName() string {
+	return "cloudsqlinstance"
+}
+
+// ResourceIdentifiers - The names of the Cloud SQL instances
+func (instances CloudSqlResource) ResourceIdentifiers() []string {
+	return instances.InstanceNames
+}
+
+func (instances CloudSqlResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (instances CloudSqlResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllCloudSqlInstances(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// CloudSqlOperationError - returned when a Cloud SQL operation failed
+type CloudSqlOperationError struct {
+	OperationName string
+	Messages      []string
+}
+
+func (e CloudSqlOperationError) Error() string {
+	return fmt.Sprintf("Cloud SQL operation %s failed: %s", e.OperationName, strings.Join(e.Messages, "; "))
+}
+
+// CloudSqlOperationNotDoneError - returned when a Cloud SQL operation is still running after waiting for it
+type CloudSqlOperationNotDoneError struct {
+	OperationName string
+}
+
+func (e CloudSqlOperationNotDoneError) Error() string {
+	return fmt.Sprintf("Timed out waiting for Cloud SQL operation %s to be done", e.OperationName)
+}
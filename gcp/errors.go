@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProjectsNotNukedError - returned when nuking the resources of one or more projects failed
+type ProjectsNotNukedError struct {
+	ProjectIDs []string
+}
+
+func (err ProjectsNotNukedError) Error() string {
+	return fmt.Sprintf("Failed to nuke all resources in project(s): %s", strings.Join(err.ProjectIDs, ", "))
+}
+
+// NukeErrors - returned by Nuke when some of the resources couldn't be nuked. The other resources were nuked.
+type NukeErrors struct {
+	// Why each resource couldn't be nuked, keyed by identifier
+	Errors map[string]error
+}
+
+// add records why the resource with the given identifier couldn't be nuked
+func (nukeErrors *NukeErrors) add(identifier string, err error) {
+	if nukeErrors.Errors == nil {
+		nukeErrors.Errors = map[string]error{}
+	}
+	nukeErrors.Errors[identifier] = err
+}
+
+// errorOrNil returns the errors, or nil when all resources were nuked
+func (nukeErrors NukeErrors) errorOrNil() error {
+	if len(nukeErrors.Errors) == 0 {
+		return nil
+	}
+	return nukeErrors
+}
+
+func (nukeErrors NukeErrors) Error() string {
+	var identifiers []string
+	for identifier := range nukeErrors.Errors {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	var messages []string
+	for _, identifier := range identifiers {
+		messages = append(messages, fmt.Sprintf("%s: %s", identifier, nukeErrors.Errors[identifier]))
+	}
+	return fmt.Sprintf("Failed to nuke %d resource(s): %s", len(identifiers), strings.Join(messages, "; "))
+}
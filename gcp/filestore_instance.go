@@ -0,0 +1,111 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	filestore "cloud.google.com/go/filestore/apiv1"
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Filestore instances are either zonal (e.g. "us-central1-a") or regional (e.g. "us-central1"). They are identified
+// by their location and name, separated by a slash, as instance names are only unique within a location.
+func filestoreInstanceIdentifier(instanceResourceName string) string {
+	// projects/<project>/locations/<location>/instances/<instance>
+	parts := strings.Split(instanceResourceName, "/")
+	if len(parts) != 6 {
+		return instanceResourceName
+	}
+	return fmt.Sprintf("%s/%s", parts[3], parts[5])
+}
+
+func filestoreInstanceResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", errors.WithStackTrace(InvalidFilestoreInstanceIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/instances/%s", projectID, parts[0], parts[1]), nil
+}
+
+// Returns the location/name identifiers of all Filestore instances in the project, grouped by region. Instances with
+// deletion protection enabled are left out.
+func getAllFilestoreInstances(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := filestore.NewCloudFilestoreManagerClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	instanceIdentifiers := map[string][]string{}
+	// The "-" location lists the instances of all locations at once
+	it := client.ListInstances(ctx, &filestorepb.ListInstancesRequest{Parent: fmt.Sprintf("projects/%s/locations/-", projectID)})
+	for {
+		instance, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if instance.GetDeletionProtectionEnabled() {
+			continue
+		}
+
+		if excludeAfter.After(instance.GetCreateTime().AsTime()) {
+			identifier := filestoreInstanceIdentifier(instance.GetName())
+			region := regionOfLocation(strings.SplitN(identifier, "/", 2)[0])
+			instanceIdentifiers[region] = append(instanceIdentifiers[region], identifier)
+		}
+	}
+
+	return instanceIdentifiers, nil
+}
+
+// Deletes all Filestore instances, along with their snapshots
+func nukeAllFilestoreInstances(projectID string, instanceIdentifiers []string) error {
+	if len(instanceIdentifiers) == 0 {
+		logging.Logger.Infof("No Filestore instances to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := filestore.NewCloudFilestoreManagerClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all Filestore instances in project %s", projectID)
+	var deletedInstanceIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, instanceIdentifier := range instanceIdentifiers {
+		name, err := filestoreInstanceResourceName(projectID, instanceIdentifier)
+		if err == nil {
+			var operation *filestore.DeleteInstanceOperation
+			// Force deletes the snapshots of the instance along with it, which would otherwise block its deletion
+			operation, err = client.DeleteInstance(ctx, &filestorepb.DeleteInstanceRequest{Name: name, Force: true})
+			if err == nil {
+				err = operation.Wait(ctx)
+			}
+		}
+
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(instanceIdentifier, err)
+		} else {
+			deletedInstanceIdentifiers = append(deletedInstanceIdentifiers, instanceIdentifier)
+			logging.Logger.Infof("Deleted Filestore instance: %s", instanceIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Filestore instance(s) deleted in project %s", len(deletedInstanceIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
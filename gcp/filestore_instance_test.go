@@ -0,0 +1,24 @@
+package gcp
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Filestore instances take long to create and are billed for at least 1 TiB, so only the identifiers are tested
+func TestFilestoreInstanceIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := filestoreInstanceIdentifier("projects/my-project/locations/us-central1-a/instances/my-instance")
+	assert.Equal(t, "us-central1-a/my-instance", identifier)
+
+	name, err := filestoreInstanceResourceName("my-project", identifier)
+	require.NoError(t, err)
+	assert.Equal(t, "projects/my-project/locations/us-central1-a/instances/my-instance", name)
+
+	_, err = filestoreInstanceResourceName("my-project", "my-instance")
+	assert.Equal(t, InvalidFilestoreInstanceIdentifierError{Identifier: "my-instance"}, errors.Unwrap(err))
+}
@@ -0,0 +1,45 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// FilestoreInstanceResource - represents all Filestore instances
+type FilestoreInstanceResource struct {
+	InstanceIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (instances FilestoreInstanceResource) ResourceName() string {
+	return "filestoreinstance"
+}
+
+// ResourceIdentifiers - The location/name pairs of the Filestore instances
+func (instances FilestoreInstanceResource) ResourceIdentifiers() []string {
+	return instances.InstanceIdentifiers
+}
+
+func (instances FilestoreInstanceResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (instances FilestoreInstanceResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllFilestoreInstances(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidFilestoreInstanceIdentifierError - returned when a Filestore instance identifier isn't a location/name pair
+type InvalidFilestoreInstanceIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidFilestoreInstanceIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid Filestore instance identifier %s, expected <location>/<name>", e.Identifier)
+}
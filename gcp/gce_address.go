@@ -0,0 +1,172 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Static addresses are identified by their location and name, separated by a slash, as address names are only unique
+// within a region, or among the global addresses, e.g. us-central1/my-address or global/my-address
+func gceAddressIdentifier(url string) string {
+	location, _, name := computeResourcePath(url)
+	return fmt.Sprintf("%s/%s", location, name)
+}
+
+func parseGceAddressIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", "", errors.WithStackTrace(InvalidGceAddressIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], nil
+}
+
+// isUnusedGceAddress checks if the static address is reserved without anything using it. The ranges allocated to
+// private services access, e.g. for Cloud SQL private IPs, are used by the peering with the service producer although
+// they are only reported as reserved, so they are never considered unused.
+func isUnusedGceAddress(address *computepb.Address) bool {
+	return address.GetStatus() == "RESERVED" && len(address.GetUsers()) == 0 && address.GetPurpose() != "VPC_PEERING"
+}
+
+// getAllGceAddressResources returns all static addresses in the project, regional and global
+func getAllGceAddressResources(ctx context.Context, projectID string) ([]*computepb.Address, error) {
+	client, err := compute.NewAddressesRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	var addresses []*computepb.Address
+	it := client.AggregatedList(ctx, &computepb.AggregatedListAddressesRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		addresses = append(addresses, pair.Value.GetAddresses()...)
+	}
+
+	globalClient, err := compute.NewGlobalAddressesRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer globalClient.Close()
+
+	globalIt := globalClient.List(ctx, &computepb.ListGlobalAddressesRequest{Project: projectID})
+	for {
+		address, err := globalIt.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// Returns the location/name identifiers of the static addresses in the project, external and internal, that are
+// reserved but not in use, grouped by region. Global addresses are grouped under the global region.
+func getAllGceAddresses(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	addresses, err := getAllGceAddressResources(context.Background(), projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	addressIdentifiers := map[string][]string{}
+	for _, address := range addresses {
+		if !isUnusedGceAddress(address) {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, address.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			region, _, _ := computeResourcePath(address.GetSelfLink())
+			addressIdentifiers[region] = append(addressIdentifiers[region], gceAddressIdentifier(address.GetSelfLink()))
+		}
+	}
+
+	return addressIdentifiers, nil
+}
+
+// Releases all static addresses
+func nukeAllGceAddresses(projectID string, addressIdentifiers []string) error {
+	if len(addressIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE static addresses to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewAddressesRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	globalClient, err := compute.NewGlobalAddressesRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer globalClient.Close()
+
+	logging.Logger.Infof("Releasing all GCE static addresses in project %s", projectID)
+	var releasedAddressIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, addressIdentifier := range addressIdentifiers {
+		if err := nukeGceAddress(ctx, client, globalClient, projectID, addressIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(addressIdentifier, err)
+		} else {
+			releasedAddressIdentifiers = append(releasedAddressIdentifiers, addressIdentifier)
+			logging.Logger.Infof("Released GCE static address: %s", addressIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE static address(es) released in project %s", len(releasedAddressIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceAddress(ctx context.Context, client *compute.AddressesClient, globalClient *compute.GlobalAddressesClient, projectID string, addressIdentifier string) error {
+	location, name, err := parseGceAddressIdentifier(addressIdentifier)
+	if err != nil {
+		return err
+	}
+
+	var operation *compute.Operation
+	if location == globalRegion {
+		operation, err = globalClient.Delete(ctx, &computepb.DeleteGlobalAddressRequest{
+			Project: projectID,
+			Address: name,
+		})
+	} else {
+		operation, err = client.Delete(ctx, &computepb.DeleteAddressRequest{
+			Project: projectID,
+			Region:  location,
+			Address: name,
+		})
+	}
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
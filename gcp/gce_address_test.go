@@ -0,0 +1,37 @@
+package gcp
+
+import (
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGceAddressIdentifier(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-central1/my-address", gceAddressIdentifier("https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/addresses/my-address"))
+	assert.Equal(t, "global/my-address", gceAddressIdentifier("https://www.googleapis.com/compute/v1/projects/my-project/global/addresses/my-address"))
+
+	location, name, err := parseGceAddressIdentifier("global/my-address")
+	require.NoError(t, err)
+	assert.Equal(t, "global", location)
+	assert.Equal(t, "my-address", name)
+
+	_, _, err = parseGceAddressIdentifier("my-address")
+	assert.Equal(t, InvalidGceAddressIdentifierError{Identifier: "my-address"}, errors.Unwrap(err))
+}
+
+func TestIsUnusedGceAddress(t *testing.T) {
+	t.Parallel()
+
+	reserved := "RESERVED"
+	inUse := "IN_USE"
+	peering := "VPC_PEERING"
+
+	assert.True(t, isUnusedGceAddress(&computepb.Address{Status: &reserved}))
+	assert.False(t, isUnusedGceAddress(&computepb.Address{Status: &inUse, Users: []string{"projects/my-project/zones/us-central1-a/instances/my-instance"}}))
+	assert.False(t, isUnusedGceAddress(&computepb.Address{Status: &reserved, Purpose: &peering}))
+}
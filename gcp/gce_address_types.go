@@ -0,0 +1,45 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceAddressResource - represents all GCE static addresses, external and internal, that are reserved but not in use
+type GceAddressResource struct {
+	AddressIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (addresses GceAddressResource) ResourceName() string {
+	return "gceaddress"
+}
+
+// ResourceIdentifiers - The location/name pairs of the GCE static addresses
+func (addresses GceAddressResource) ResourceIdentifiers() []string {
+	return addresses.AddressIdentifiers
+}
+
+func (addresses GceAddressResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (addresses GceAddressResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceAddresses(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceAddressIdentifierError - returned when a GCE static address identifier isn't a location/name pair
+type InvalidGceAddressIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceAddressIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE static address identifier %s, expected <location>/<name>", e.Identifier)
+}
@@ -0,0 +1,226 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Disks labeled with deletion-protection=true are never nuked. GCE has no deletion protection for disks, so this
+// label is the way to keep a disk around.
+const deletionProtectionLabel = "deletion-protection"
+
+// Disks are either zonal (e.g. "us-central1-a") or regional (e.g. "us-central1"). They are identified by their
+// location and name, separated by a slash, as disk names are only unique within a location.
+func gceDiskIdentifier(disk *computepb.Disk) string {
+	location := path.Base(disk.GetZone())
+	if disk.GetRegion() != "" {
+		location = path.Base(disk.GetRegion())
+	}
+	return fmt.Sprintf("%s/%s", location, disk.GetName())
+}
+
+func parseGceDiskIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", "", errors.WithStackTrace(InvalidGceDiskIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], nil
+}
+
+func isDeletionProtected(labels map[string]string) bool {
+	return strings.ToLower(labels[deletionProtectionLabel]) == "true"
+}
+
+// Returns the location/name identifiers of all GCE persistent disks in the project, grouped by region. Disks that are
+// attached to an instance are only included when includeAttached is set.
+func getAllGceDisks(projectID string, excludeAfter time.Time, includeAttached bool) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := compute.NewDisksRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	diskIdentifiers := map[string][]string{}
+	// The aggregated list returns the disks of all zones and regions at once
+	it := client.AggregatedList(ctx, &computepb.AggregatedListDisksRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, disk := range pair.Value.GetDisks() {
+			if isDeletionProtected(disk.GetLabels()) {
+				continue
+			}
+			if len(disk.GetUsers()) > 0 && !includeAttached {
+				continue
+			}
+
+			createdAt, err := time.Parse(time.RFC3339, disk.GetCreationTimestamp())
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			if excludeAfter.After(createdAt) {
+				identifier := gceDiskIdentifier(disk)
+				region := regionOfLocation(strings.SplitN(identifier, "/", 2)[0])
+				diskIdentifiers[region] = append(diskIdentifiers[region], identifier)
+			}
+		}
+	}
+
+	return diskIdentifiers, nil
+}
+
+// detachGceDisk detaches the disk from all the instances using it. Users are instance URLs of the form
+// https://www.googleapis.com/compute/v1/projects/<project>/zones/<zone>/instances/<instance>
+func detachGceDisk(ctx context.Context, client *compute.InstancesClient, projectID string, disk *computepb.Disk) error {
+	for _, user := range disk.GetUsers() {
+		instanceName := path.Base(user)
+		zone := path.Base(path.Dir(path.Dir(user)))
+
+		instance, err := client.Get(ctx, &computepb.GetInstanceRequest{
+			Project:  projectID,
+			Zone:     zone,
+			Instance: instanceName,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, attachedDisk := range instance.GetDisks() {
+			if attachedDisk.GetSource() != disk.GetSelfLink() {
+				continue
+			}
+
+			logging.Logger.Infof("...detaching GCE disk %s from instance %s", disk.GetName(), instanceName)
+			operation, err := client.DetachDisk(ctx, &computepb.DetachDiskInstanceRequest{
+				Project:    projectID,
+				Zone:       zone,
+				Instance:   instanceName,
+				DeviceName: attachedDisk.GetDeviceName(),
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+			if err := operation.Wait(ctx); err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+	}
+	return nil
+}
+
+// Deletes all GCE persistent disks, detaching them from their instances first
+func nukeAllGceDisks(projectID string, diskIdentifiers []string) error {
+	if len(diskIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE disks to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	disksClient, err := compute.NewDisksRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer disksClient.Close()
+
+	regionDisksClient, err := compute.NewRegionDisksRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer regionDisksClient.Close()
+
+	instancesClient, err := compute.NewInstancesRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer instancesClient.Close()
+
+	// Users of a disk are only known from the listing, so look the disks up again to find what to detach them from
+	disks := map[string]*computepb.Disk{}
+	it := disksClient.AggregatedList(ctx, &computepb.AggregatedListDisksRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, disk := range pair.Value.GetDisks() {
+			disks[gceDiskIdentifier(disk)] = disk
+		}
+	}
+
+	logging.Logger.Infof("Deleting all GCE disks in project %s", projectID)
+	var deletedDiskIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, diskIdentifier := range diskIdentifiers {
+		disk, exists := disks[diskIdentifier]
+		if !exists {
+			logging.Logger.Infof("GCE disk %s has already been deleted", diskIdentifier)
+			continue
+		}
+
+		if err := nukeGceDisk(ctx, disksClient, regionDisksClient, instancesClient, projectID, diskIdentifier, disk); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(diskIdentifier, err)
+		} else {
+			deletedDiskIdentifiers = append(deletedDiskIdentifiers, diskIdentifier)
+			logging.Logger.Infof("Deleted GCE disk: %s", diskIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE disk(s) deleted in project %s", len(deletedDiskIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceDisk(ctx context.Context, disksClient *compute.DisksClient, regionDisksClient *compute.RegionDisksClient, instancesClient *compute.InstancesClient, projectID string, diskIdentifier string, disk *computepb.Disk) error {
+	if err := detachGceDisk(ctx, instancesClient, projectID, disk); err != nil {
+		return err
+	}
+
+	location, name, err := parseGceDiskIdentifier(diskIdentifier)
+	if err != nil {
+		return err
+	}
+
+	var operation *compute.Operation
+	if disk.GetRegion() != "" {
+		operation, err = regionDisksClient.Delete(ctx, &computepb.DeleteRegionDiskRequest{
+			Project: projectID,
+			Region:  location,
+			Disk:    name,
+		})
+	} else {
+		operation, err = disksClient.Delete(ctx, &computepb.DeleteDiskRequest{
+			Project: projectID,
+			Zone:    location,
+			Disk:    name,
+		})
+	}
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package gcp
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGceDisk(t *testing.T, projectID string, name string, labels map[string]string) string {
+	ctx := context.Background()
+	client, err := compute.NewDisksRESTClient(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	sizeGb := int64(10)
+	operation, err := client.Insert(ctx, &computepb.InsertDiskRequest{
+		Project: projectID,
+		Zone:    testZone,
+		DiskResource: &computepb.Disk{
+			Name:   &name,
+			SizeGb: &sizeGb,
+			Labels: labels,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, operation.Wait(ctx))
+
+	return testZone + "/" + name
+}
+
+func TestListGceDisks(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Disk names have to be lower case
+	diskName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	diskIdentifier := createTestGceDisk(t, projectID, diskName, nil)
+	// clean up after this test
+	defer nukeAllGceDisks(projectID, []string{diskIdentifier})
+
+	diskIdentifiers, err := getAllGceDisks(projectID, time.Now().Add(1*time.Hour*-1), false)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE disks")
+	}
+
+	assert.NotContains(t, diskIdentifiers[testRegion], diskIdentifier)
+
+	diskIdentifiers, err = getAllGceDisks(projectID, time.Now().Add(1*time.Hour), false)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE disks")
+	}
+
+	assert.Contains(t, diskIdentifiers[testRegion], diskIdentifier)
+}
+
+func TestListGceDisksSkipsDeletionProtected(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Disk names have to be lower case
+	diskName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	diskIdentifier := createTestGceDisk(t, projectID, diskName, map[string]string{deletionProtectionLabel: "true"})
+	// clean up after this test
+	defer nukeAllGceDisks(projectID, []string{diskIdentifier})
+
+	diskIdentifiers, err := getAllGceDisks(projectID, time.Now().Add(1*time.Hour), true)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE disks")
+	}
+
+	assert.NotContains(t, diskIdentifiers[testRegion], diskIdentifier)
+}
+
+func TestNukeGceDisks(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Disk names have to be lower case
+	diskName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	diskIdentifier := createTestGceDisk(t, projectID, diskName, nil)
+
+	if err := nukeAllGceDisks(projectID, []string{diskIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	diskIdentifiers, err := getAllGceDisks(projectID, time.Now().Add(1*time.Hour), false)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE disks")
+	}
+
+	assert.NotContains(t, diskIdentifiers[testRegion], diskIdentifier)
+}
+
+func TestGceDiskIdentifier(t *testing.T) {
+	t.Parallel()
+
+	name := "my-disk"
+	zone := "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a"
+	region := "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1"
+
+	assert.Equal(t, "us-central1-a/my-disk", gceDiskIdentifier(&computepb.Disk{Name: &name, Zone: &zone}))
+	assert.Equal(t, "us-central1/my-disk", gceDiskIdentifier(&computepb.Disk{Name: &name, Region: &region}))
+
+	location, diskName, err := parseGceDiskIdentifier("us-central1-a/my-disk")
+	require.NoError(t, err)
+	assert.Equal(t, "us-central1-a", location)
+	assert.Equal(t, "my-disk", diskName)
+
+	_, _, err = parseGceDiskIdentifier("my-disk")
+	assert.Error(t, err)
+}
+
+func TestIsDeletionProtected(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isDeletionProtected(map[string]string{deletionProtectionLabel: "true"}))
+	assert.True(t, isDeletionProtected(map[string]string{deletionProtectionLabel: "TRUE"}))
+	assert.False(t, isDeletionProtected(map[string]string{deletionProtectionLabel: "false"}))
+	assert.False(t, isDeletionProtected(nil))
+}
@@ -0,0 +1,45 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceDiskResource - represents all GCE persistent disks
+type GceDiskResource struct {
+	DiskIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (disks GceDiskResource) ResourceName() string {
+	return "gcedisk"
+}
+
+// ResourceIdentifiers - The location/name pairs of the GCE disks
+func (disks GceDiskResource) ResourceIdentifiers() []string {
+	return disks.DiskIdentifiers
+}
+
+func (disks GceDiskResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (disks GceDiskResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceDisks(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceDiskIdentifierError - returned when a GCE disk identifier isn't a location/name pair
+type InvalidGceDiskIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceDiskIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE disk identifier %s, expected <location>/<name>", e.Identifier)
+}
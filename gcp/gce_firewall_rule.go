@@ -0,0 +1,109 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// getAllGceFirewallRuleResources returns all VPC firewall rules in the project
+func getAllGceFirewallRuleResources(ctx context.Context, projectID string) ([]*computepb.Firewall, error) {
+	client, err := compute.NewFirewallsRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	var firewallRules []*computepb.Firewall
+	it := client.List(ctx, &computepb.ListFirewallsRequest{Project: projectID})
+	for {
+		firewallRule, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		firewallRules = append(firewallRules, firewallRule)
+	}
+	return firewallRules, nil
+}
+
+// Returns the names of the VPC firewall rules in the project, grouped under the global region, as firewall rules are
+// global. The rules of the default network are only included when includeDefault is set.
+func getAllGceFirewallRules(projectID string, excludeAfter time.Time, includeDefault bool) (map[string][]string, error) {
+	firewallRules, err := getAllGceFirewallRuleResources(context.Background(), projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	firewallRuleNames := map[string][]string{}
+	for _, firewallRule := range firewallRules {
+		if gceNetworkName(firewallRule.GetNetwork()) == defaultGceNetwork && !includeDefault {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, firewallRule.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			firewallRuleNames[globalRegion] = append(firewallRuleNames[globalRegion], firewallRule.GetName())
+		}
+	}
+
+	return firewallRuleNames, nil
+}
+
+// Deletes all VPC firewall rules
+func nukeAllGceFirewallRules(projectID string, firewallRuleNames []string) error {
+	if len(firewallRuleNames) == 0 {
+		logging.Logger.Infof("No GCE firewall rules to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewFirewallsRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GCE firewall rules in project %s", projectID)
+	var deletedFirewallRuleNames []string
+	var nukeErrors NukeErrors
+
+	for _, firewallRuleName := range firewallRuleNames {
+		if err := nukeGceFirewallRule(ctx, client, projectID, firewallRuleName); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(firewallRuleName, err)
+		} else {
+			deletedFirewallRuleNames = append(deletedFirewallRuleNames, firewallRuleName)
+			logging.Logger.Infof("Deleted GCE firewall rule: %s", firewallRuleName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE firewall rule(s) deleted in project %s", len(deletedFirewallRuleNames), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceFirewallRule(ctx context.Context, client *compute.FirewallsClient, projectID string, firewallRuleName string) error {
+	operation, err := client.Delete(ctx, &computepb.DeleteFirewallRequest{
+		Project:  projectID,
+		Firewall: firewallRuleName,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package gcp
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceFirewallRuleResource - represents all GCE VPC firewall rules
+type GceFirewallRuleResource struct {
+	FirewallRuleNames []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (firewallRules GceFirewallRuleResource) ResourceName() string {
+	return "gcefirewallrule"
+}
+
+// ResourceIdentifiers - The names of the GCE firewall rules
+func (firewallRules GceFirewallRuleResource) ResourceIdentifiers() []string {
+	return firewallRules.FirewallRuleNames
+}
+
+func (firewallRules GceFirewallRuleResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (firewallRules GceFirewallRuleResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceFirewallRules(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
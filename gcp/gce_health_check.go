@@ -0,0 +1,280 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	legacycompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/iterator"
+)
+
+// The collections health checks live in. Target pools only support the legacy HTTP health checks, while backend
+// services use the newer ones, which can be global or regional.
+const (
+	healthChecksCollection      = "healthChecks"
+	httpHealthChecksCollection  = "httpHealthChecks"
+	httpsHealthChecksCollection = "httpsHealthChecks"
+)
+
+// The legacy health checks are deleted through global operations, which are waited on for up to about two minutes per
+// call
+const legacyHealthCheckOperationMaxWaits = 5
+
+// Health checks are identified by their location, collection and name, separated by slashes, as the legacy and newer
+// health checks don't share a namespace, e.g. global/httpHealthChecks/my-check or us-central1/healthChecks/my-check
+func gceHealthCheckIdentifier(url string) string {
+	location, collection, name := computeResourcePath(url)
+	return fmt.Sprintf("%s/%s/%s", location, collection, name)
+}
+
+func parseGceHealthCheckIdentifier(identifier string) (string, string, string, error) {
+	parts := strings.SplitN(identifier, "/", 3)
+	if len(parts) != 3 {
+		return "", "", "", errors.WithStackTrace(InvalidGceHealthCheckIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// getReferencedGceHealthChecks returns the identifiers of the health checks used by a backend service or target
+// pool. The target pools about to be nuked are left out, as their health checks are orphaned once they are gone.
+func getReferencedGceHealthChecks(ctx context.Context, projectID string, nukedTargetPoolIdentifiers []string) ([]string, error) {
+	client, err := compute.NewBackendServicesRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	var referenced []string
+	it := client.AggregatedList(ctx, &computepb.AggregatedListBackendServicesRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, backendService := range pair.Value.GetBackendServices() {
+			for _, healthCheck := range backendService.GetHealthChecks() {
+				referenced = append(referenced, gceHealthCheckIdentifier(healthCheck))
+			}
+		}
+	}
+
+	targetPools, err := getAllGceTargetPoolResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	for identifier, targetPool := range targetPools {
+		if collections.ListContainsElement(nukedTargetPoolIdentifiers, identifier) {
+			continue
+		}
+		for _, healthCheck := range targetPool.GetHealthChecks() {
+			referenced = append(referenced, gceHealthCheckIdentifier(healthCheck))
+		}
+	}
+
+	return referenced, nil
+}
+
+// getAllGceHealthCheckCreationTimes returns the creation time of all health checks in the project, legacy or not,
+// keyed by their identifier
+func getAllGceHealthCheckCreationTimes(ctx context.Context, projectID string) (map[string]string, error) {
+	creationTimes := map[string]string{}
+
+	healthChecksClient, err := compute.NewHealthChecksRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer healthChecksClient.Close()
+
+	// The aggregated list returns both the global and the regional health checks
+	it := healthChecksClient.AggregatedList(ctx, &computepb.AggregatedListHealthChecksRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, healthCheck := range pair.Value.GetHealthChecks() {
+			creationTimes[gceHealthCheckIdentifier(healthCheck.GetSelfLink())] = healthCheck.GetCreationTimestamp()
+		}
+	}
+
+	// The legacy health checks are only exposed by the discovery-based client
+	svc, err := legacycompute.NewService(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	err = svc.HttpHealthChecks.List(projectID).Pages(ctx, func(page *legacycompute.HttpHealthCheckList) error {
+		for _, healthCheck := range page.Items {
+			creationTimes[gceHealthCheckIdentifier(healthCheck.SelfLink)] = healthCheck.CreationTimestamp
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	err = svc.HttpsHealthChecks.List(projectID).Pages(ctx, func(page *legacycompute.HttpsHealthCheckList) error {
+		for _, healthCheck := range page.Items {
+			creationTimes[gceHealthCheckIdentifier(healthCheck.SelfLink)] = healthCheck.CreationTimestamp
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return creationTimes, nil
+}
+
+// Returns the location/collection/name identifiers of the health checks in the project that no backend service or
+// target pool uses, grouped by region. Global health checks are grouped under "global". The health checks of the
+// target pools in nukedTargetPoolIdentifiers count as orphaned, as these target pools are nuked first.
+func getAllGceHealthChecks(projectID string, excludeAfter time.Time, nukedTargetPoolIdentifiers []string) (map[string][]string, error) {
+	ctx := context.Background()
+	creationTimes, err := getAllGceHealthCheckCreationTimes(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	referenced, err := getReferencedGceHealthChecks(ctx, projectID, nukedTargetPoolIdentifiers)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	healthCheckIdentifiers := map[string][]string{}
+	for identifier, creationTimestamp := range creationTimes {
+		if collections.ListContainsElement(referenced, identifier) {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, creationTimestamp)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			location := strings.SplitN(identifier, "/", 2)[0]
+			healthCheckIdentifiers[location] = append(healthCheckIdentifiers[location], identifier)
+		}
+	}
+
+	return healthCheckIdentifiers, nil
+}
+
+// Deletes all health checks, legacy or not
+func nukeAllGceHealthChecks(projectID string, healthCheckIdentifiers []string) error {
+	if len(healthCheckIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE health checks to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	logging.Logger.Infof("Deleting all GCE health checks in project %s", projectID)
+	var deletedHealthCheckIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, healthCheckIdentifier := range healthCheckIdentifiers {
+		if err := nukeGceHealthCheck(ctx, projectID, healthCheckIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(healthCheckIdentifier, err)
+		} else {
+			deletedHealthCheckIdentifiers = append(deletedHealthCheckIdentifiers, healthCheckIdentifier)
+			logging.Logger.Infof("Deleted GCE health check: %s", healthCheckIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE health check(s) deleted in project %s", len(deletedHealthCheckIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceHealthCheck(ctx context.Context, projectID string, healthCheckIdentifier string) error {
+	location, collection, name, err := parseGceHealthCheckIdentifier(healthCheckIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteGceHealthCheck(ctx, projectID, location, collection, name); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// deleteGceHealthCheck deletes a health check through the client of its collection, and waits for the deletion to
+// be done
+func deleteGceHealthCheck(ctx context.Context, projectID string, location string, collection string, name string) error {
+	var operation *compute.Operation
+	switch {
+	case collection == healthChecksCollection && location == globalRegion:
+		client, err := compute.NewHealthChecksRESTClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		if operation, err = client.Delete(ctx, &computepb.DeleteHealthCheckRequest{Project: projectID, HealthCheck: name}); err != nil {
+			return err
+		}
+	case collection == healthChecksCollection:
+		client, err := compute.NewRegionHealthChecksRESTClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		if operation, err = client.Delete(ctx, &computepb.DeleteRegionHealthCheckRequest{Project: projectID, Region: location, HealthCheck: name}); err != nil {
+			return err
+		}
+	case collection == httpHealthChecksCollection || collection == httpsHealthChecksCollection:
+		return deleteLegacyGceHealthCheck(ctx, projectID, collection, name)
+	default:
+		return InvalidGceHealthCheckIdentifierError{Identifier: fmt.Sprintf("%s/%s/%s", location, collection, name)}
+	}
+	return operation.Wait(ctx)
+}
+
+// deleteLegacyGceHealthCheck deletes a legacy HTTP or HTTPS health check through the discovery-based client, which is
+// the only one exposing them, and waits for the deletion to be done
+func deleteLegacyGceHealthCheck(ctx context.Context, projectID string, collection string, name string) error {
+	svc, err := legacycompute.NewService(ctx)
+	if err != nil {
+		return err
+	}
+
+	var operation *legacycompute.Operation
+	if collection == httpHealthChecksCollection {
+		operation, err = svc.HttpHealthChecks.Delete(projectID, name).Context(ctx).Do()
+	} else {
+		operation, err = svc.HttpsHealthChecks.Delete(projectID, name).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < legacyHealthCheckOperationMaxWaits && operation.Status != "DONE"; i++ {
+		if operation, err = svc.GlobalOperations.Wait(projectID, operation.Name).Context(ctx).Do(); err != nil {
+			return err
+		}
+	}
+	if operation.Status != "DONE" {
+		return GceOperationNotDoneError{OperationName: operation.Name}
+	}
+	if operation.Error != nil && len(operation.Error.Errors) > 0 {
+		var messages []string
+		for _, operationError := range operation.Error.Errors {
+			messages = append(messages, operationError.Message)
+		}
+		return GceOperationError{OperationName: operation.Name, Messages: messages}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package gcp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceHealthCheckResource - represents all GCE health checks, legacy or not, that no backend service or target pool
+// uses
+type GceHealthCheckResource struct {
+	HealthCheckIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (healthChecks GceHealthCheckResource) ResourceName() string {
+	return "gcehealthcheck"
+}
+
+// ResourceIdentifiers - The location/collection/name identifiers of the GCE health checks
+func (healthChecks GceHealthCheckResource) ResourceIdentifiers() []string {
+	return healthChecks.HealthCheckIdentifiers
+}
+
+func (healthChecks GceHealthCheckResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (healthChecks GceHealthCheckResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceHealthChecks(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceHealthCheckIdentifierError - returned when a GCE health check identifier isn't of the form
+// location/collection/name, or names an unknown collection
+type InvalidGceHealthCheckIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceHealthCheckIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE health check identifier %s, expected <location>/<collection>/<name>", e.Identifier)
+}
+
+// GceOperationError - returned when a GCE operation waited on through the discovery-based client failed
+type GceOperationError struct {
+	OperationName string
+	Messages      []string
+}
+
+func (e GceOperationError) Error() string {
+	return fmt.Sprintf("GCE operation %s failed: %s", e.OperationName, strings.Join(e.Messages, "; "))
+}
+
+// GceOperationNotDoneError - returned when a GCE operation waited on through the discovery-based client isn't done
+// in time
+type GceOperationNotDoneError struct {
+	OperationName string
+}
+
+func (e GceOperationNotDoneError) Error() string {
+	return fmt.Sprintf("Timed out waiting for GCE operation %s to be done", e.OperationName)
+}
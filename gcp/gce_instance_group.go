@@ -0,0 +1,171 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Managed instance groups are identified by their location and name, separated by a slash, as their names are only
+// unique within a zone or region, e.g. us-central1-a/my-group for a zonal group or us-central1/my-group for a
+// regional one
+func gceInstanceGroupIdentifier(url string) string {
+	location, _, name := computeResourcePath(url)
+	return fmt.Sprintf("%s/%s", location, name)
+}
+
+func parseGceInstanceGroupIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", "", errors.WithStackTrace(InvalidGceInstanceGroupIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], nil
+}
+
+// isZone checks if the location is a zone, e.g. us-central1-a, rather than a region
+func isZone(location string) bool {
+	return regionOfLocation(location) != location
+}
+
+// gceInstanceGroupTemplates returns the URLs of the instance templates a managed instance group creates its instances
+// from, including the ones of the versions of a canary or rolling update
+func gceInstanceGroupTemplates(instanceGroup *computepb.InstanceGroupManager) []string {
+	var templates []string
+	if instanceGroup.GetInstanceTemplate() != "" {
+		templates = append(templates, instanceGroup.GetInstanceTemplate())
+	}
+	for _, version := range instanceGroup.GetVersions() {
+		if version.GetInstanceTemplate() != "" {
+			templates = append(templates, version.GetInstanceTemplate())
+		}
+	}
+	return templates
+}
+
+// getAllGceInstanceGroupResources returns all managed instance groups in the project, zonal and regional, keyed by
+// their identifier
+func getAllGceInstanceGroupResources(ctx context.Context, projectID string) (map[string]*computepb.InstanceGroupManager, error) {
+	client, err := compute.NewInstanceGroupManagersRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	// The aggregated list returns both the zonal and the regional groups
+	instanceGroups := map[string]*computepb.InstanceGroupManager{}
+	it := client.AggregatedList(ctx, &computepb.AggregatedListInstanceGroupManagersRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, instanceGroup := range pair.Value.GetInstanceGroupManagers() {
+			instanceGroups[gceInstanceGroupIdentifier(instanceGroup.GetSelfLink())] = instanceGroup
+		}
+	}
+	return instanceGroups, nil
+}
+
+// Returns the location/name identifiers of the managed instance groups in the project, grouped by region. Zonal groups
+// are grouped under the region of their zone, so that excluding a region excludes all groups in it.
+func getAllGceInstanceGroups(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	instanceGroups, err := getAllGceInstanceGroupResources(context.Background(), projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	instanceGroupIdentifiers := map[string][]string{}
+	for identifier, instanceGroup := range instanceGroups {
+		createdAt, err := time.Parse(time.RFC3339, instanceGroup.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			location, _, _ := computeResourcePath(instanceGroup.GetSelfLink())
+			region := regionOfLocation(location)
+			instanceGroupIdentifiers[region] = append(instanceGroupIdentifiers[region], identifier)
+		}
+	}
+
+	return instanceGroupIdentifiers, nil
+}
+
+// Deletes all managed instance groups, along with their instances. Each deletion is waited for, so that the instance
+// templates of the groups can be deleted afterwards.
+func nukeAllGceInstanceGroups(projectID string, instanceGroupIdentifiers []string) error {
+	if len(instanceGroupIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE managed instance groups to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewInstanceGroupManagersRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	regionClient, err := compute.NewRegionInstanceGroupManagersRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer regionClient.Close()
+
+	logging.Logger.Infof("Deleting all GCE managed instance groups in project %s", projectID)
+	var deletedInstanceGroupIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, instanceGroupIdentifier := range instanceGroupIdentifiers {
+		if err := nukeGceInstanceGroup(ctx, client, regionClient, projectID, instanceGroupIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(instanceGroupIdentifier, err)
+		} else {
+			deletedInstanceGroupIdentifiers = append(deletedInstanceGroupIdentifiers, instanceGroupIdentifier)
+			logging.Logger.Infof("Deleted GCE managed instance group: %s", instanceGroupIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE managed instance group(s) deleted in project %s", len(deletedInstanceGroupIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceInstanceGroup(ctx context.Context, client *compute.InstanceGroupManagersClient, regionClient *compute.RegionInstanceGroupManagersClient, projectID string, instanceGroupIdentifier string) error {
+	location, name, err := parseGceInstanceGroupIdentifier(instanceGroupIdentifier)
+	if err != nil {
+		return err
+	}
+
+	var operation *compute.Operation
+	if isZone(location) {
+		operation, err = client.Delete(ctx, &computepb.DeleteInstanceGroupManagerRequest{
+			Project:              projectID,
+			Zone:                 location,
+			InstanceGroupManager: name,
+		})
+	} else {
+		operation, err = regionClient.Delete(ctx, &computepb.DeleteRegionInstanceGroupManagerRequest{
+			Project:              projectID,
+			Region:               location,
+			InstanceGroupManager: name,
+		})
+	}
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
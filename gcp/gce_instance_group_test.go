@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGceInstanceGroupIdentifier(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-central1-a/my-group", gceInstanceGroupIdentifier("https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instanceGroupManagers/my-group"))
+	assert.Equal(t, "us-central1/my-group", gceInstanceGroupIdentifier("https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/instanceGroupManagers/my-group"))
+
+	location, name, err := parseGceInstanceGroupIdentifier("us-central1-a/my-group")
+	require.NoError(t, err)
+	assert.Equal(t, "us-central1-a", location)
+	assert.Equal(t, "my-group", name)
+	assert.True(t, isZone(location))
+	assert.False(t, isZone("us-central1"))
+
+	_, _, err = parseGceInstanceGroupIdentifier("my-group")
+	assert.Equal(t, InvalidGceInstanceGroupIdentifierError{Identifier: "my-group"}, errors.Unwrap(err))
+}
+
+func TestGetReferencedGceInstanceTemplates(t *testing.T) {
+	t.Parallel()
+
+	template := "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/my-template"
+	canaryTemplate := "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/instanceTemplates/my-canary"
+	otherTemplate := "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/other-template"
+	instanceGroups := map[string]*computepb.InstanceGroupManager{
+		"us-central1-a/my-group": {
+			InstanceTemplate: &template,
+			Versions: []*computepb.InstanceGroupManagerVersion{
+				{InstanceTemplate: &template},
+				{InstanceTemplate: &canaryTemplate},
+			},
+		},
+		"us-east1/other-group": {InstanceTemplate: &otherTemplate},
+	}
+
+	referenced := getReferencedGceInstanceTemplates(instanceGroups, nil)
+	assert.ElementsMatch(t, []string{"global/my-template", "global/my-template", "us-central1/my-canary", "global/other-template"}, referenced)
+
+	// The templates of the groups about to be nuked can be deleted once the groups are gone
+	referenced = getReferencedGceInstanceTemplates(instanceGroups, []string{"us-central1-a/my-group"})
+	assert.Equal(t, []string{"global/other-template"}, referenced)
+}
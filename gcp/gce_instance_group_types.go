@@ -0,0 +1,46 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceInstanceGroupResource - represents all GCE managed instance groups, zonal and regional
+type GceInstanceGroupResource struct {
+	InstanceGroupIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (instanceGroups GceInstanceGroupResource) ResourceName() string {
+	return "gceinstancegroup"
+}
+
+// ResourceIdentifiers - The location/name identifiers of the GCE managed instance groups
+func (instanceGroups GceInstanceGroupResource) ResourceIdentifiers() []string {
+	return instanceGroups.InstanceGroupIdentifiers
+}
+
+func (instanceGroups GceInstanceGroupResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 20
+}
+
+// Nuke - nuke 'em all!!!
+func (instanceGroups GceInstanceGroupResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceInstanceGroups(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceInstanceGroupIdentifierError - returned when a GCE managed instance group identifier isn't of the form
+// location/name
+type InvalidGceInstanceGroupIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceInstanceGroupIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE managed instance group identifier %s, expected <location>/<name>", e.Identifier)
+}
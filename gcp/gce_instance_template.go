@@ -0,0 +1,173 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Instance templates are identified by their location and name, separated by a slash, as regional templates only have
+// unique names within their region, e.g. global/my-template or us-central1/my-template
+func gceInstanceTemplateIdentifier(url string) string {
+	location, _, name := computeResourcePath(url)
+	return fmt.Sprintf("%s/%s", location, name)
+}
+
+func parseGceInstanceTemplateIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", "", errors.WithStackTrace(InvalidGceInstanceTemplateIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], nil
+}
+
+// getReferencedGceInstanceTemplates returns the identifiers of the instance templates used by a managed instance
+// group. The groups about to be nuked are left out, as their templates can be deleted once they are gone.
+func getReferencedGceInstanceTemplates(instanceGroups map[string]*computepb.InstanceGroupManager, nukedInstanceGroupIdentifiers []string) []string {
+	var referenced []string
+	for identifier, instanceGroup := range instanceGroups {
+		if collections.ListContainsElement(nukedInstanceGroupIdentifiers, identifier) {
+			continue
+		}
+		for _, template := range gceInstanceGroupTemplates(instanceGroup) {
+			referenced = append(referenced, gceInstanceTemplateIdentifier(template))
+		}
+	}
+	return referenced
+}
+
+// getAllGceInstanceTemplateResources returns all instance templates in the project, global and regional
+func getAllGceInstanceTemplateResources(ctx context.Context, projectID string) ([]*computepb.InstanceTemplate, error) {
+	client, err := compute.NewInstanceTemplatesRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	// The aggregated list returns both the global and the regional templates
+	var templates []*computepb.InstanceTemplate
+	it := client.AggregatedList(ctx, &computepb.AggregatedListInstanceTemplatesRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		templates = append(templates, pair.Value.GetInstanceTemplates()...)
+	}
+	return templates, nil
+}
+
+// Returns the location/name identifiers of the instance templates in the project that no managed instance group uses,
+// grouped by region. Global templates are grouped under the global region. The templates of the groups in
+// nukedInstanceGroupIdentifiers count as unused, as these groups are nuked first.
+func getAllGceInstanceTemplates(projectID string, excludeAfter time.Time, nukedInstanceGroupIdentifiers []string) (map[string][]string, error) {
+	ctx := context.Background()
+	templates, err := getAllGceInstanceTemplateResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	instanceGroups, err := getAllGceInstanceGroupResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	referenced := getReferencedGceInstanceTemplates(instanceGroups, nukedInstanceGroupIdentifiers)
+
+	templateIdentifiers := map[string][]string{}
+	for _, template := range templates {
+		identifier := gceInstanceTemplateIdentifier(template.GetSelfLink())
+		if collections.ListContainsElement(referenced, identifier) {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, template.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			location, _, _ := computeResourcePath(template.GetSelfLink())
+			templateIdentifiers[location] = append(templateIdentifiers[location], identifier)
+		}
+	}
+
+	return templateIdentifiers, nil
+}
+
+// Deletes all instance templates. The instances created from them are left alone.
+func nukeAllGceInstanceTemplates(projectID string, templateIdentifiers []string) error {
+	if len(templateIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE instance templates to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewInstanceTemplatesRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	regionClient, err := compute.NewRegionInstanceTemplatesRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer regionClient.Close()
+
+	logging.Logger.Infof("Deleting all GCE instance templates in project %s", projectID)
+	var deletedTemplateIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, templateIdentifier := range templateIdentifiers {
+		if err := nukeGceInstanceTemplate(ctx, client, regionClient, projectID, templateIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(templateIdentifier, err)
+		} else {
+			deletedTemplateIdentifiers = append(deletedTemplateIdentifiers, templateIdentifier)
+			logging.Logger.Infof("Deleted GCE instance template: %s", templateIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE instance template(s) deleted in project %s", len(deletedTemplateIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceInstanceTemplate(ctx context.Context, client *compute.InstanceTemplatesClient, regionClient *compute.RegionInstanceTemplatesClient, projectID string, templateIdentifier string) error {
+	location, name, err := parseGceInstanceTemplateIdentifier(templateIdentifier)
+	if err != nil {
+		return err
+	}
+
+	var operation *compute.Operation
+	if location == globalRegion {
+		operation, err = client.Delete(ctx, &computepb.DeleteInstanceTemplateRequest{
+			Project:          projectID,
+			InstanceTemplate: name,
+		})
+	} else {
+		operation, err = regionClient.Delete(ctx, &computepb.DeleteRegionInstanceTemplateRequest{
+			Project:          projectID,
+			Region:           location,
+			InstanceTemplate: name,
+		})
+	}
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
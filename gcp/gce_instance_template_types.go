@@ -0,0 +1,47 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceInstanceTemplateResource - represents all GCE instance templates, global and regional, that no managed instance
+// group uses
+type GceInstanceTemplateResource struct {
+	TemplateIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (templates GceInstanceTemplateResource) ResourceName() string {
+	return "gceinstancetemplate"
+}
+
+// ResourceIdentifiers - The location/name identifiers of the GCE instance templates
+func (templates GceInstanceTemplateResource) ResourceIdentifiers() []string {
+	return templates.TemplateIdentifiers
+}
+
+func (templates GceInstanceTemplateResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (templates GceInstanceTemplateResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceInstanceTemplates(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceInstanceTemplateIdentifierError - returned when a GCE instance template identifier isn't of the form
+// location/name
+type InvalidGceInstanceTemplateIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceInstanceTemplateIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE instance template identifier %s, expected <location>/<name>", e.Identifier)
+}
@@ -0,0 +1,155 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// The network every project is created with, left alone unless explicitly included, along with its subnets and
+// firewall rules
+const defaultGceNetwork = "default"
+
+// gceNetworkName returns the name of a VPC network from its URL. Networks are global, so their names are unique within
+// the project.
+func gceNetworkName(url string) string {
+	_, _, name := computeResourcePath(url)
+	return name
+}
+
+// getAllGceNetworkResources returns all VPC networks in the project, keyed by their name
+func getAllGceNetworkResources(ctx context.Context, projectID string) (map[string]*computepb.Network, error) {
+	client, err := compute.NewNetworksRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	networks := map[string]*computepb.Network{}
+	it := client.List(ctx, &computepb.ListNetworksRequest{Project: projectID})
+	for {
+		network, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		networks[network.GetName()] = network
+	}
+	return networks, nil
+}
+
+// getBlockedGceNetworks returns the names of the networks that still hold firewall rules or subnets that aren't about
+// to be nuked, and so can't be deleted. The subnets of auto mode networks don't count, as they go away along with the
+// network.
+func getBlockedGceNetworks(networks map[string]*computepb.Network, firewallRules []*computepb.Firewall, subnetworks []*computepb.Subnetwork, nukedFirewallRuleNames []string, nukedSubnetworkIdentifiers []string) []string {
+	var blocked []string
+	for _, firewallRule := range firewallRules {
+		if !collections.ListContainsElement(nukedFirewallRuleNames, firewallRule.GetName()) {
+			blocked = append(blocked, gceNetworkName(firewallRule.GetNetwork()))
+		}
+	}
+	for _, subnetwork := range subnetworks {
+		networkName := gceNetworkName(subnetwork.GetNetwork())
+		if networks[networkName].GetAutoCreateSubnetworks() {
+			continue
+		}
+		if !collections.ListContainsElement(nukedSubnetworkIdentifiers, gceSubnetworkIdentifier(subnetwork.GetSelfLink())) {
+			blocked = append(blocked, networkName)
+		}
+	}
+	return blocked
+}
+
+// Returns the names of the VPC networks in the project, grouped under the global region. The default network is only
+// included when includeDefault is set. The networks that still hold firewall rules or subnets other than the ones in
+// nukedFirewallRuleNames and nukedSubnetworkIdentifiers are left out, as these are nuked first.
+func getAllGceNetworks(projectID string, excludeAfter time.Time, includeDefault bool, nukedFirewallRuleNames []string, nukedSubnetworkIdentifiers []string) (map[string][]string, error) {
+	ctx := context.Background()
+	networks, err := getAllGceNetworkResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	firewallRules, err := getAllGceFirewallRuleResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	subnetworks, err := getAllGceSubnetworkResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	blocked := getBlockedGceNetworks(networks, firewallRules, subnetworks, nukedFirewallRuleNames, nukedSubnetworkIdentifiers)
+
+	networkNames := map[string][]string{}
+	for name, network := range networks {
+		if (name == defaultGceNetwork && !includeDefault) || collections.ListContainsElement(blocked, name) {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, network.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			networkNames[globalRegion] = append(networkNames[globalRegion], name)
+		}
+	}
+
+	return networkNames, nil
+}
+
+// Deletes all VPC networks, along with the subnets of the auto mode ones
+func nukeAllGceNetworks(projectID string, networkNames []string) error {
+	if len(networkNames) == 0 {
+		logging.Logger.Infof("No GCE VPC networks to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewNetworksRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GCE VPC networks in project %s", projectID)
+	var deletedNetworkNames []string
+	var nukeErrors NukeErrors
+
+	for _, networkName := range networkNames {
+		if err := nukeGceNetwork(ctx, client, projectID, networkName); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(networkName, err)
+		} else {
+			deletedNetworkNames = append(deletedNetworkNames, networkName)
+			logging.Logger.Infof("Deleted GCE VPC network: %s", networkName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE VPC network(s) deleted in project %s", len(deletedNetworkNames), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceNetwork(ctx context.Context, client *compute.NetworksClient, projectID string, networkName string) error {
+	operation, err := client.Delete(ctx, &computepb.DeleteNetworkRequest{
+		Project: projectID,
+		Network: networkName,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package gcp
+
+import (
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGceSubnetworkIdentifier(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-central1/my-subnet", gceSubnetworkIdentifier("https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/subnetworks/my-subnet"))
+	assert.Equal(t, "my-network", gceNetworkName("https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network"))
+
+	region, name, err := parseGceSubnetworkIdentifier("us-central1/my-subnet")
+	require.NoError(t, err)
+	assert.Equal(t, "us-central1", region)
+	assert.Equal(t, "my-subnet", name)
+
+	_, _, err = parseGceSubnetworkIdentifier("my-subnet")
+	assert.Equal(t, InvalidGceSubnetworkIdentifierError{Identifier: "my-subnet"}, errors.Unwrap(err))
+}
+
+func TestGetBlockedGceNetworks(t *testing.T) {
+	t.Parallel()
+
+	networkURL := func(name string) *string {
+		url := "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/" + name
+		return &url
+	}
+	subnetworkURL := func(name string) *string {
+		url := "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/subnetworks/" + name
+		return &url
+	}
+	name := func(name string) *string {
+		return &name
+	}
+	autoMode := true
+
+	networks := map[string]*computepb.Network{
+		"custom": {Name: name("custom")},
+		"auto":   {Name: name("auto"), AutoCreateSubnetworks: &autoMode},
+		"kept":   {Name: name("kept")},
+	}
+	firewallRules := []*computepb.Firewall{
+		{Name: name("custom-allow-ssh"), Network: networkURL("custom")},
+		{Name: name("kept-allow-ssh"), Network: networkURL("kept")},
+	}
+	subnetworks := []*computepb.Subnetwork{
+		{SelfLink: subnetworkURL("custom-subnet"), Network: networkURL("custom")},
+		{SelfLink: subnetworkURL("auto"), Network: networkURL("auto")},
+		{SelfLink: subnetworkURL("kept-subnet"), Network: networkURL("kept")},
+	}
+
+	// The networks holding firewall rules or subnets left alone can't be deleted, except for the subnets of auto mode
+	// networks, which go away along with them
+	blocked := getBlockedGceNetworks(networks, firewallRules, subnetworks, []string{"custom-allow-ssh"}, []string{"us-central1/custom-subnet"})
+	assert.ElementsMatch(t, []string{"kept", "kept"}, blocked)
+
+	blocked = getBlockedGceNetworks(networks, firewallRules, subnetworks, nil, []string{"us-central1/custom-subnet", "us-central1/kept-subnet"})
+	assert.ElementsMatch(t, []string{"custom", "kept"}, blocked)
+}
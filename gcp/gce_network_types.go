@@ -0,0 +1,34 @@
+package gcp
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceNetworkResource - represents all GCE VPC networks left empty once their firewall rules and subnets are nuked
+type GceNetworkResource struct {
+	NetworkNames []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (networks GceNetworkResource) ResourceName() string {
+	return "gcenetwork"
+}
+
+// ResourceIdentifiers - The names of the GCE VPC networks
+func (networks GceNetworkResource) ResourceIdentifiers() []string {
+	return networks.NetworkNames
+}
+
+func (networks GceNetworkResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (networks GceNetworkResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceNetworks(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
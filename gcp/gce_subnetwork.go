@@ -0,0 +1,141 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Subnets are identified by their region and name, separated by a slash, as subnet names are only unique within a
+// region, e.g. us-central1/my-subnet
+func gceSubnetworkIdentifier(url string) string {
+	region, _, name := computeResourcePath(url)
+	return fmt.Sprintf("%s/%s", region, name)
+}
+
+func parseGceSubnetworkIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", "", errors.WithStackTrace(InvalidGceSubnetworkIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], nil
+}
+
+// getAllGceSubnetworkResources returns all subnets in the project, in all regions
+func getAllGceSubnetworkResources(ctx context.Context, projectID string) ([]*computepb.Subnetwork, error) {
+	client, err := compute.NewSubnetworksRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	var subnetworks []*computepb.Subnetwork
+	it := client.AggregatedList(ctx, &computepb.AggregatedListSubnetworksRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		subnetworks = append(subnetworks, pair.Value.GetSubnetworks()...)
+	}
+	return subnetworks, nil
+}
+
+// Returns the region/name identifiers of the subnets of the custom mode VPC networks in the project, grouped by
+// region. The subnets of auto mode networks can't be deleted on their own, and go away along with their network. The
+// subnets of the default network are only included when includeDefault is set.
+func getAllGceSubnetworks(projectID string, excludeAfter time.Time, includeDefault bool) (map[string][]string, error) {
+	ctx := context.Background()
+	subnetworks, err := getAllGceSubnetworkResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	networks, err := getAllGceNetworkResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	subnetworkIdentifiers := map[string][]string{}
+	for _, subnetwork := range subnetworks {
+		networkName := gceNetworkName(subnetwork.GetNetwork())
+		if networks[networkName].GetAutoCreateSubnetworks() || (networkName == defaultGceNetwork && !includeDefault) {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, subnetwork.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			region, _, _ := computeResourcePath(subnetwork.GetSelfLink())
+			subnetworkIdentifiers[region] = append(subnetworkIdentifiers[region], gceSubnetworkIdentifier(subnetwork.GetSelfLink()))
+		}
+	}
+
+	return subnetworkIdentifiers, nil
+}
+
+// Deletes all subnets
+func nukeAllGceSubnetworks(projectID string, subnetworkIdentifiers []string) error {
+	if len(subnetworkIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE subnets to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewSubnetworksRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GCE subnets in project %s", projectID)
+	var deletedSubnetworkIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, subnetworkIdentifier := range subnetworkIdentifiers {
+		if err := nukeGceSubnetwork(ctx, client, projectID, subnetworkIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(subnetworkIdentifier, err)
+		} else {
+			deletedSubnetworkIdentifiers = append(deletedSubnetworkIdentifiers, subnetworkIdentifier)
+			logging.Logger.Infof("Deleted GCE subnet: %s", subnetworkIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE subnet(s) deleted in project %s", len(deletedSubnetworkIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceSubnetwork(ctx context.Context, client *compute.SubnetworksClient, projectID string, subnetworkIdentifier string) error {
+	region, name, err := parseGceSubnetworkIdentifier(subnetworkIdentifier)
+	if err != nil {
+		return err
+	}
+
+	operation, err := client.Delete(ctx, &computepb.DeleteSubnetworkRequest{
+		Project:    projectID,
+		Region:     region,
+		Subnetwork: name,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
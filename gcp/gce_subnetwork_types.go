@@ -0,0 +1,45 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceSubnetworkResource - represents all GCE subnets of the custom mode VPC networks
+type GceSubnetworkResource struct {
+	SubnetworkIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (subnetworks GceSubnetworkResource) ResourceName() string {
+	return "gcesubnetwork"
+}
+
+// ResourceIdentifiers - The region/name identifiers of the GCE subnets
+func (subnetworks GceSubnetworkResource) ResourceIdentifiers() []string {
+	return subnetworks.SubnetworkIdentifiers
+}
+
+func (subnetworks GceSubnetworkResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (subnetworks GceSubnetworkResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceSubnetworks(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceSubnetworkIdentifierError - returned when a GCE subnet identifier isn't of the form region/name
+type InvalidGceSubnetworkIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceSubnetworkIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE subnet identifier %s, expected <region>/<name>", e.Identifier)
+}
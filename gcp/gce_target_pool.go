@@ -0,0 +1,196 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// computeResourcePath returns the location, collection and name of a compute resource from its URL, e.g.
+// https://www.googleapis.com/compute/v1/projects/<project>/regions/<region>/targetPools/<name>. Global resources
+// have "global" as their location, and zonal resources their zone.
+func computeResourcePath(url string) (string, string, string) {
+	index := strings.Index(url, "projects/")
+	if index < 0 {
+		return "", "", ""
+	}
+	// <project>/regions/<region>/<collection>/<name>, <project>/zones/<zone>/<collection>/<name> or
+	// <project>/global/<collection>/<name>
+	parts := strings.Split(url[index+len("projects/"):], "/")
+	if len(parts) == 5 && (parts[1] == "regions" || parts[1] == "zones") {
+		return parts[2], parts[3], parts[4]
+	}
+	if len(parts) == 4 && parts[1] == globalRegion {
+		return globalRegion, parts[2], parts[3]
+	}
+	return "", "", ""
+}
+
+// Target pools are identified by their region and name, separated by a slash, as target pool names are only unique
+// within a region
+func gceTargetPoolIdentifier(url string) string {
+	region, _, name := computeResourcePath(url)
+	return fmt.Sprintf("%s/%s", region, name)
+}
+
+func parseGceTargetPoolIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", "", errors.WithStackTrace(InvalidGceTargetPoolIdentifierError{Identifier: identifier})
+	}
+	return parts[0], parts[1], nil
+}
+
+// getAllGceTargetPoolResources returns all target pools in the project, keyed by their identifier
+func getAllGceTargetPoolResources(ctx context.Context, projectID string) (map[string]*computepb.TargetPool, error) {
+	client, err := compute.NewTargetPoolsRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	targetPools := map[string]*computepb.TargetPool{}
+	it := client.AggregatedList(ctx, &computepb.AggregatedListTargetPoolsRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, targetPool := range pair.Value.GetTargetPools() {
+			targetPools[gceTargetPoolIdentifier(targetPool.GetSelfLink())] = targetPool
+		}
+	}
+	return targetPools, nil
+}
+
+// getAttachedGceTargetPools returns the identifiers of the target pools that a forwarding rule sends traffic to,
+// either directly or as the backup pool of another target pool
+func getAttachedGceTargetPools(ctx context.Context, projectID string, targetPools map[string]*computepb.TargetPool) ([]string, error) {
+	client, err := compute.NewForwardingRulesRESTClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	var attached []string
+	it := client.AggregatedList(ctx, &computepb.AggregatedListForwardingRulesRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, forwardingRule := range pair.Value.GetForwardingRules() {
+			if _, collection, _ := computeResourcePath(forwardingRule.GetTarget()); collection == "targetPools" {
+				attached = append(attached, gceTargetPoolIdentifier(forwardingRule.GetTarget()))
+			}
+		}
+	}
+
+	for _, targetPool := range targetPools {
+		if targetPool.GetBackupPool() != "" {
+			attached = append(attached, gceTargetPoolIdentifier(targetPool.GetBackupPool()))
+		}
+	}
+	return attached, nil
+}
+
+// Returns the region/name identifiers of the target pools in the project that no forwarding rule sends traffic to,
+// grouped by region
+func getAllGceTargetPools(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	targetPools, err := getAllGceTargetPoolResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	attached, err := getAttachedGceTargetPools(ctx, projectID, targetPools)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	targetPoolIdentifiers := map[string][]string{}
+	for identifier, targetPool := range targetPools {
+		if collections.ListContainsElement(attached, identifier) {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, targetPool.GetCreationTimestamp())
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			region, _, _ := computeResourcePath(targetPool.GetSelfLink())
+			targetPoolIdentifiers[region] = append(targetPoolIdentifiers[region], identifier)
+		}
+	}
+
+	return targetPoolIdentifiers, nil
+}
+
+// Deletes all target pools. The instances in them are left alone.
+func nukeAllGceTargetPools(projectID string, targetPoolIdentifiers []string) error {
+	if len(targetPoolIdentifiers) == 0 {
+		logging.Logger.Infof("No GCE target pools to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := compute.NewTargetPoolsRESTClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GCE target pools in project %s", projectID)
+	var deletedTargetPoolIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, targetPoolIdentifier := range targetPoolIdentifiers {
+		if err := nukeGceTargetPool(ctx, client, projectID, targetPoolIdentifier); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(targetPoolIdentifier, err)
+		} else {
+			deletedTargetPoolIdentifiers = append(deletedTargetPoolIdentifiers, targetPoolIdentifier)
+			logging.Logger.Infof("Deleted GCE target pool: %s", targetPoolIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCE target pool(s) deleted in project %s", len(deletedTargetPoolIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+func nukeGceTargetPool(ctx context.Context, client *compute.TargetPoolsClient, projectID string, targetPoolIdentifier string) error {
+	region, name, err := parseGceTargetPoolIdentifier(targetPoolIdentifier)
+	if err != nil {
+		return err
+	}
+
+	operation, err := client.Delete(ctx, &computepb.DeleteTargetPoolRequest{
+		Project:    projectID,
+		Region:     region,
+		TargetPool: name,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := operation.Wait(ctx); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	legacycompute "google.golang.org/api/compute/v1"
+)
+
+// createTestGceTargetPool creates a target pool using a legacy HTTP health check, without any forwarding rule sending
+// traffic to it. Returns the identifiers of the target pool and its health check.
+func createTestGceTargetPool(t *testing.T, projectID string, name string) (string, string) {
+	ctx := context.Background()
+	svc, err := legacycompute.NewService(ctx)
+	require.NoError(t, err)
+
+	healthCheckOperation, err := svc.HttpHealthChecks.Insert(projectID, &legacycompute.HttpHealthCheck{Name: name}).Context(ctx).Do()
+	require.NoError(t, err)
+	for healthCheckOperation.Status != "DONE" {
+		healthCheckOperation, err = svc.GlobalOperations.Wait(projectID, healthCheckOperation.Name).Context(ctx).Do()
+		require.NoError(t, err)
+	}
+	require.Nil(t, healthCheckOperation.Error)
+
+	targetPoolsClient, err := compute.NewTargetPoolsRESTClient(ctx)
+	require.NoError(t, err)
+	defer targetPoolsClient.Close()
+
+	healthCheckURL := fmt.Sprintf("projects/%s/global/httpHealthChecks/%s", projectID, name)
+	operation, err := targetPoolsClient.Insert(ctx, &computepb.InsertTargetPoolRequest{
+		Project: projectID,
+		Region:  testRegion,
+		TargetPoolResource: &computepb.TargetPool{
+			Name:         &name,
+			HealthChecks: []string{healthCheckURL},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, operation.Wait(ctx))
+
+	return testRegion + "/" + name, "global/httpHealthChecks/" + name
+}
+
+func TestComputeResourcePath(t *testing.T) {
+	t.Parallel()
+
+	location, collection, name := computeResourcePath("https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/targetPools/my-pool")
+	assert.Equal(t, []string{"us-central1", "targetPools", "my-pool"}, []string{location, collection, name})
+
+	location, collection, name = computeResourcePath("projects/my-project/global/httpHealthChecks/my-check")
+	assert.Equal(t, []string{"global", "httpHealthChecks", "my-check"}, []string{location, collection, name})
+
+	location, collection, name = computeResourcePath("https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/my-instance")
+	assert.Equal(t, []string{"us-central1-a", "instances", "my-instance"}, []string{location, collection, name})
+
+	location, collection, name = computeResourcePath("my-instance")
+	assert.Equal(t, []string{"", "", ""}, []string{location, collection, name})
+
+	assert.Equal(t, "us-central1/my-pool", gceTargetPoolIdentifier("projects/my-project/regions/us-central1/targetPools/my-pool"))
+	assert.Equal(t, "global/httpHealthChecks/my-check", gceHealthCheckIdentifier("projects/my-project/global/httpHealthChecks/my-check"))
+}
+
+func TestListGceTargetPools(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Target pool names have to be lower case
+	name := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	targetPoolIdentifier, healthCheckIdentifier := createTestGceTargetPool(t, projectID, name)
+	// clean up after this test
+	defer nukeAllGceHealthChecks(projectID, []string{healthCheckIdentifier})
+	defer nukeAllGceTargetPools(projectID, []string{targetPoolIdentifier})
+
+	targetPoolIdentifiers, err := getAllGceTargetPools(projectID, time.Now().Add(1*time.Hour*-1))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE target pools")
+	}
+
+	assert.NotContains(t, targetPoolIdentifiers[testRegion], targetPoolIdentifier)
+
+	targetPoolIdentifiers, err = getAllGceTargetPools(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE target pools")
+	}
+
+	assert.Contains(t, targetPoolIdentifiers[testRegion], targetPoolIdentifier)
+
+	// The health check is in use by the target pool, unless the target pool is nuked too
+	healthCheckIdentifiers, err := getAllGceHealthChecks(projectID, time.Now().Add(1*time.Hour), nil)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE health checks")
+	}
+
+	assert.NotContains(t, healthCheckIdentifiers[globalRegion], healthCheckIdentifier)
+
+	healthCheckIdentifiers, err = getAllGceHealthChecks(projectID, time.Now().Add(1*time.Hour), []string{targetPoolIdentifier})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE health checks")
+	}
+
+	assert.Contains(t, healthCheckIdentifiers[globalRegion], healthCheckIdentifier)
+}
+
+func TestNukeGceTargetPools(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Target pool names have to be lower case
+	name := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	targetPoolIdentifier, healthCheckIdentifier := createTestGceTargetPool(t, projectID, name)
+
+	if err := nukeAllGceTargetPools(projectID, []string{targetPoolIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+	if err := nukeAllGceHealthChecks(projectID, []string{healthCheckIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	targetPoolIdentifiers, err := getAllGceTargetPools(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE target pools")
+	}
+
+	assert.NotContains(t, targetPoolIdentifiers[testRegion], targetPoolIdentifier)
+
+	healthCheckIdentifiers, err := getAllGceHealthChecks(projectID, time.Now().Add(1*time.Hour), nil)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCE health checks")
+	}
+
+	assert.NotContains(t, healthCheckIdentifiers[globalRegion], healthCheckIdentifier)
+}
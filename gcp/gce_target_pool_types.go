@@ -0,0 +1,45 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GceTargetPoolResource - represents all GCE target pools that no forwarding rule sends traffic to
+type GceTargetPoolResource struct {
+	TargetPoolIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (targetPools GceTargetPoolResource) ResourceName() string {
+	return "gcetargetpool"
+}
+
+// ResourceIdentifiers - The region/name pairs of the GCE target pools
+func (targetPools GceTargetPoolResource) ResourceIdentifiers() []string {
+	return targetPools.TargetPoolIdentifiers
+}
+
+func (targetPools GceTargetPoolResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (targetPools GceTargetPoolResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGceTargetPools(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGceTargetPoolIdentifierError - returned when a GCE target pool identifier isn't a region/name pair
+type InvalidGceTargetPoolIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGceTargetPoolIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GCE target pool identifier %s, expected <region>/<name>", e.Identifier)
+}
@@ -0,0 +1,246 @@
+package gcp
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/progress"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GCP reports locations in upper case for some APIs (e.g. "US-CENTRAL1" for GCS buckets), so compare them in lower case
+func normalizeRegion(region string) string {
+	return strings.ToLower(region)
+}
+
+func split(identifiers []string, limit int) [][]string {
+	if limit < 0 {
+		limit = -1 * limit
+	} else if limit == 0 {
+		return [][]string{identifiers}
+	}
+
+	var chunk []string
+	chunks := make([][]string, 0, len(identifiers)/limit+1)
+	for len(identifiers) >= limit {
+		chunk, identifiers = identifiers[:limit], identifiers[limit:]
+		chunks = append(chunks, chunk)
+	}
+	if len(identifiers) > 0 {
+		chunks = append(chunks, identifiers[:len(identifiers)])
+	}
+
+	return chunks
+}
+
+// addResources adds the resources found per region to the project, skipping the excluded regions
+func (project *GcpProjectResources) addResources(identifiersByRegion map[string][]string, excludedRegions []string, newResource func(identifiers []string) GcpResource) {
+	for region, identifiers := range identifiersByRegion {
+		if collections.ListContainsElement(excludedRegions, region) {
+			continue
+		}
+		resourcesInRegion := project.Resources[region]
+		resourcesInRegion.Resources = append(resourcesInRegion.Resources, newResource(identifiers))
+		project.Resources[region] = resourcesInRegion
+	}
+}
+
+// resourceIdentifiers returns the identifiers of the resources of the given type found in the project, across all
+// regions
+func (project *GcpProjectResources) resourceIdentifiers(resourceName string) []string {
+	var identifiers []string
+	for _, resourcesInRegion := range project.Resources {
+		for _, resource := range resourcesInRegion.Resources {
+			if resource.ResourceName() == resourceName {
+				identifiers = append(identifiers, resource.ResourceIdentifiers()...)
+			}
+		}
+	}
+	return identifiers
+}
+
+// GetAllResources - Lists all gcp resources in each of the projects, grouped by project and region. Projects without
+// any resources are left out. Persistent disks that are attached to an instance are only included when
+// options.IncludeAttachedDisks is set.
+func GetAllResources(projectIDs []string, excludedRegions []string, excludeAfter time.Time, resourceTypes []string, excludeResourceTypes []string, options ResourceOptions) (*GcpResources, error) {
+	resources := GcpResources{
+		Projects: make(map[string]GcpProjectResources),
+	}
+
+	var normalizedExcludedRegions []string
+	for _, region := range excludedRegions {
+		logging.Logger.Infoln("Skipping region: " + region)
+		normalizedExcludedRegions = append(normalizedExcludedRegions, normalizeRegion(region))
+	}
+
+	var listedProjectIDs []string
+	for _, projectID := range projectIDs {
+		if collections.ListContainsElement(listedProjectIDs, projectID) {
+			continue
+		}
+		listedProjectIDs = append(listedProjectIDs, projectID)
+
+		logging.Logger.Infoln("Checking project: " + projectID)
+		project, err := getAllProjectResources(projectID, normalizedExcludedRegions, excludeAfter, resourceTypes, excludeResourceTypes, options)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if len(project.Resources) > 0 {
+			resources.Projects[projectID] = *project
+		}
+	}
+
+	return &resources, nil
+}
+
+// getAllProjectResources - Lists all gcp resources in a single project, grouped by region
+func getAllProjectResources(projectID string, normalizedExcludedRegions []string, excludeAfter time.Time, resourceTypes []string, excludeResourceTypes []string, options ResourceOptions) (*GcpProjectResources, error) {
+	project := GcpProjectResources{
+		Resources: make(map[string]GcpRegionResource),
+	}
+
+	// The GCP APIs list resources across the whole project, so we list each resource type once and group the results
+	// by region afterwards
+	for _, resourceType := range selectedResourceTypes(resourceTypes, excludeResourceTypes) {
+		identifiers, err := resourceType.getAll(projectID, &project, excludeAfter, options)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		project.addResources(identifiers, normalizedExcludedRegions, resourceType.newResource)
+	}
+
+	return &project, nil
+}
+
+// selectedResourceTypes - Returns the registered resource types selected with --resource-type and
+// --exclude-resource-type, in the order they are listed and nuked in
+func selectedResourceTypes(resourceTypes []string, excludeResourceTypes []string) []gcpResourceType {
+	var selected []gcpResourceType
+	for _, resourceType := range registeredResourceTypes {
+		if IsNukeable(resourceType.resource.ResourceName(), resourceTypes, excludeResourceTypes) {
+			selected = append(selected, resourceType)
+		}
+	}
+	return selected
+}
+
+// ListResourceTypes - Returns list of resources which can be passed to --resource-type
+func ListResourceTypes() []string {
+	var resourceTypes []string
+	for _, resourceType := range registeredResourceTypes {
+		resourceTypes = append(resourceTypes, resourceType.resource.ResourceName())
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+// IsValidResourceType - Checks if a resourceType is valid or not
+func IsValidResourceType(resourceType string, allResourceTypes []string) bool {
+	return collections.ListContainsElement(allResourceTypes, resourceType)
+}
+
+// IsNukeable - Checks if we should nuke a resource or not. Resource types in excludeResourceTypes are never nuked,
+// even when they are selected in resourceTypes or with "all".
+func IsNukeable(resourceType string, resourceTypes []string, excludeResourceTypes []string) bool {
+	if collections.ListContainsElement(excludeResourceTypes, resourceType) {
+		return false
+	}
+	if len(resourceTypes) == 0 ||
+		collections.ListContainsElement(resourceTypes, "all") ||
+		collections.ListContainsElement(resourceTypes, resourceType) {
+		return true
+	}
+	return false
+}
+
+// NukeAllResources - Nukes all gcp resources in each of the projects. A failure doesn't stop the other resources from
+// being nuked; the outcome is reported per resource, and the projects where some resources couldn't be nuked are
+// returned as an error.
+func NukeAllResources(resources *GcpResources, reporter *progress.Reporter) (*NukeReport, error) {
+	var projectIDs []string
+	total := 0
+	for projectID, project := range resources.Projects {
+		projectIDs = append(projectIDs, projectID)
+		for _, resourcesInRegion := range project.Resources {
+			for _, resources := range resourcesInRegion.Resources {
+				total += len(resources.ResourceIdentifiers())
+			}
+		}
+	}
+	sort.Strings(projectIDs)
+	reporter.Start(total)
+
+	nukeReport := &NukeReport{}
+	var failedProjectIDs []string
+	for _, projectID := range projectIDs {
+		logging.Logger.Infoln("Nuking project: " + projectID)
+
+		project := resources.Projects[projectID]
+		if failures := nukeAllProjectResources(projectID, &project, nukeReport, reporter); failures > 0 {
+			logging.Logger.Errorf("[Failed] project %s: %d resource(s) couldn't be nuked", projectID, failures)
+			failedProjectIDs = append(failedProjectIDs, projectID)
+			continue
+		}
+		logging.Logger.Infof("[OK] project %s nuked", projectID)
+	}
+
+	if len(failedProjectIDs) > 0 {
+		return nukeReport, errors.WithStackTrace(ProjectsNotNukedError{ProjectIDs: failedProjectIDs})
+	}
+
+	return nukeReport, nil
+}
+
+// regionsInNukeOrder - Returns the regions of the project in the order they are nuked in: sorted, with the global
+// region last, since global resources, e.g. instance templates or health checks, can't be deleted while the regional
+// resources using them are still around
+func regionsInNukeOrder(project *GcpProjectResources) []string {
+	var regions []string
+	for region := range project.Resources {
+		if region != globalRegion {
+			regions = append(regions, region)
+		}
+	}
+	sort.Strings(regions)
+	if _, hasGlobal := project.Resources[globalRegion]; hasGlobal {
+		regions = append(regions, globalRegion)
+	}
+	return regions
+}
+
+// nukeAllProjectResources - Nukes all gcp resources in a single project, recording the outcome in the report. Returns
+// how many resources couldn't be nuked.
+func nukeAllProjectResources(projectID string, project *GcpProjectResources, nukeReport *NukeReport, reporter *progress.Reporter) int {
+	failures := 0
+	for _, region := range regionsInNukeOrder(project) {
+		resourcesInRegion := project.Resources[region]
+		logging.Logger.Infoln("Nuking region: " + region)
+
+		for _, resources := range resourcesInRegion.Resources {
+			length := len(resources.ResourceIdentifiers())
+
+			// Split api calls into batches
+			logging.Logger.Infof("Terminating %d resources in batches", length)
+			batches := split(resources.ResourceIdentifiers(), resources.MaxBatchSize())
+
+			for i := 0; i < len(batches); i++ {
+				batch := batches[i]
+				err := resources.Nuke(projectID, batch)
+				batchFailures := nukeReport.addBatch(projectID, region, resources.ResourceName(), batch, nukeOutcome(resources), err)
+				failures += batchFailures
+				reporter.Advance(resources.ResourceName(), region, len(batch), batchFailures)
+
+				if i != len(batches)-1 {
+					logging.Logger.Info("Sleeping for 10 seconds before processing next batch...")
+					time.Sleep(10 * time.Second)
+				}
+			}
+		}
+	}
+
+	return failures
+}
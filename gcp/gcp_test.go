@@ -0,0 +1,255 @@
+package gcp
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		limit    int
+		array    []string
+		expected [][]string
+	}{
+		{2, []string{"a", "b", "c", "d"}, [][]string{{"a", "b"}, {"c", "d"}}},
+		{3, []string{"a", "b", "c", "d"}, [][]string{{"a", "b", "c"}, {"d"}}},
+		{2, []string{"a", "b", "c"}, [][]string{{"a", "b"}, {"c"}}},
+		{5, []string{"a", "b", "c"}, [][]string{{"a", "b", "c"}}},
+		{-2, []string{"a", "b", "c"}, [][]string{{"a", "b"}, {"c"}}},
+		{0, []string{"a", "b", "c"}, [][]string{{"a", "b", "c"}}},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, split(testCase.array, testCase.limit))
+	}
+}
+
+func TestNormalizeRegion(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-central1", normalizeRegion("US-CENTRAL1"))
+	assert.Equal(t, "us-central1", normalizeRegion("us-central1"))
+	assert.Equal(t, "eu", normalizeRegion("EU"))
+}
+
+// fakeResource records the projects it was nuked in, failing for the projects listed in failIn, and for the
+// identifiers listed in failFor
+type fakeResource struct {
+	failIn      []string
+	failFor     []string
+	nukedIn     *[]string
+	identifiers []string
+}
+
+func (resource fakeResource) ResourceName() string {
+	return "fake"
+}
+
+func (resource fakeResource) ResourceIdentifiers() []string {
+	return resource.identifiers
+}
+
+func (resource fakeResource) MaxBatchSize() int {
+	return 10
+}
+
+func (resource fakeResource) Nuke(projectID string, identifiers []string) error {
+	for _, failingProjectID := range resource.failIn {
+		if projectID == failingProjectID {
+			return fmt.Errorf("failed to nuke %s", projectID)
+		}
+	}
+	*resource.nukedIn = append(*resource.nukedIn, projectID)
+
+	var nukeErrors NukeErrors
+	for _, identifier := range identifiers {
+		if collections.ListContainsElement(resource.failFor, identifier) {
+			nukeErrors.add(identifier, fmt.Errorf("failed to nuke %s", identifier))
+		}
+	}
+	return errors.WithStackTrace(nukeErrors.errorOrNil())
+}
+
+func TestNukeAllResourcesAcrossProjects(t *testing.T) {
+	t.Parallel()
+
+	var nukedIn []string
+	resource := fakeResource{failIn: []string{"project-b"}, nukedIn: &nukedIn, identifiers: []string{"a"}}
+	projectResources := GcpProjectResources{
+		Resources: map[string]GcpRegionResource{
+			"us-central1": {Resources: []GcpResource{resource}},
+		},
+	}
+	resources := GcpResources{
+		Projects: map[string]GcpProjectResources{
+			"project-a": projectResources,
+			"project-b": projectResources,
+			"project-c": projectResources,
+		},
+	}
+
+	_, err := NukeAllResources(&resources, nil)
+	require.Error(t, err)
+
+	// The failing project doesn't stop the others from being nuked
+	assert.Equal(t, []string{"project-a", "project-c"}, nukedIn)
+	assert.Equal(t, ProjectsNotNukedError{ProjectIDs: []string{"project-b"}}, errors.Unwrap(err))
+}
+
+func TestNukeAllResourcesReport(t *testing.T) {
+	t.Parallel()
+
+	var nukedIn []string
+	resources := GcpResources{
+		Projects: map[string]GcpProjectResources{
+			"project-a": {
+				Resources: map[string]GcpRegionResource{
+					"us-central1": {Resources: []GcpResource{
+						fakeResource{failFor: []string{"b"}, nukedIn: &nukedIn, identifiers: []string{"a", "b"}},
+					}},
+				},
+			},
+			"project-b": {
+				Resources: map[string]GcpRegionResource{
+					"us-central1": {Resources: []GcpResource{
+						fakeResource{nukedIn: &nukedIn, identifiers: []string{"c"}},
+					}},
+				},
+			},
+		},
+	}
+
+	nukeReport, err := NukeAllResources(&resources, nil)
+	assert.Equal(t, ProjectsNotNukedError{ProjectIDs: []string{"project-a"}}, errors.Unwrap(err))
+
+	// A resource that couldn't be nuked doesn't stop the other resources of the batch from being nuked
+	assert.Equal(t, []NukeResult{
+		{ProjectID: "project-a", Region: "us-central1", ResourceType: "fake", Identifier: "a", Outcome: NukeOutcomeNuked},
+		{ProjectID: "project-a", Region: "us-central1", ResourceType: "fake", Identifier: "b", Outcome: NukeOutcomeFailed, Err: fmt.Errorf("failed to nuke b")},
+		{ProjectID: "project-b", Region: "us-central1", ResourceType: "fake", Identifier: "c", Outcome: NukeOutcomeNuked},
+	}, nukeReport.Results)
+	assert.Equal(t, []NukeResult{
+		{ProjectID: "project-a", Region: "us-central1", ResourceType: "fake", Identifier: "b", Outcome: NukeOutcomeFailed, Err: fmt.Errorf("failed to nuke b")},
+	}, nukeReport.Failures())
+}
+
+func TestNukeReportBatchError(t *testing.T) {
+	t.Parallel()
+
+	// Any error other than NukeErrors means none of the resources of the batch could be nuked
+	nukeReport := &NukeReport{}
+	batchErr := fmt.Errorf("failed to create client")
+	assert.Equal(t, 2, nukeReport.addBatch("project-a", "global", "fake", []string{"a", "b"}, NukeOutcomeNuked, errors.WithStackTrace(batchErr)))
+	assert.Len(t, nukeReport.Failures(), 2)
+
+	assert.Equal(t, 0, nukeReport.addBatch("project-a", "global", "fake", []string{"c"}, NukeOutcomeNuked, nil))
+	assert.Len(t, nukeReport.Results, 3)
+	assert.Equal(t, 2, nukeReport.Count(NukeOutcomeFailed))
+	assert.Equal(t, 1, nukeReport.Count(NukeOutcomeNuked))
+}
+
+func TestNukeOutcome(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, NukeOutcomeNuked, nukeOutcome(GcsBucketResource{}))
+	// Cloud KMS keys can't be deleted, so they aren't counted as nuked
+	assert.Equal(t, NukeOutcomeNeutralized, nukeOutcome(CloudKmsKeyResource{}))
+}
+
+func TestNukeErrors(t *testing.T) {
+	t.Parallel()
+
+	var nukeErrors NukeErrors
+	assert.NoError(t, nukeErrors.errorOrNil())
+
+	nukeErrors.add("b", fmt.Errorf("in use"))
+	nukeErrors.add("a", fmt.Errorf("not found"))
+	assert.EqualError(t, nukeErrors.errorOrNil(), "Failed to nuke 2 resource(s): a: not found; b: in use")
+}
+
+func TestRegisteredResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	// Each resource type is registered once, and its resources are of the same type
+	var names []string
+	for _, resourceType := range registeredResourceTypes {
+		name := resourceType.resource.ResourceName()
+		assert.NotContains(t, names, name)
+		names = append(names, name)
+		assert.Equal(t, name, resourceType.newResource([]string{"a"}).ResourceName())
+	}
+	assert.ElementsMatch(t, names, ListResourceTypes())
+}
+
+func TestListResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	// As listed by --list-resource-types
+	resourceTypes := ListResourceTypes()
+	assert.True(t, sort.StringsAreSorted(resourceTypes))
+	assert.Contains(t, resourceTypes, GcsBucketResource{}.ResourceName())
+	assert.Contains(t, resourceTypes, GkeClusterResource{}.ResourceName())
+	assert.True(t, IsValidResourceType(GcsBucketResource{}.ResourceName(), resourceTypes))
+	assert.False(t, IsValidResourceType("ec2", resourceTypes))
+}
+
+func TestSelectedResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	names := func(resourceTypes []gcpResourceType) []string {
+		var names []string
+		for _, resourceType := range resourceTypes {
+			names = append(names, resourceType.resource.ResourceName())
+		}
+		return names
+	}
+	bucket := GcsBucketResource{}.ResourceName()
+	cluster := GkeClusterResource{}.ResourceName()
+
+	// All the resource types are listed by default, and with --resource-type all
+	assert.Equal(t, ListResourceTypes(), sortedCopy(names(selectedResourceTypes(nil, nil))))
+	assert.Equal(t, ListResourceTypes(), sortedCopy(names(selectedResourceTypes([]string{"all"}, nil))))
+
+	// Only the ones given to --resource-type, in the order they are nuked in
+	assert.Equal(t, []string{cluster, bucket}, names(selectedResourceTypes([]string{bucket, cluster}, nil)))
+
+	// All but the ones given to --exclude-resource-type
+	excluded := names(selectedResourceTypes(nil, []string{bucket}))
+	assert.NotContains(t, excluded, bucket)
+	assert.Contains(t, excluded, cluster)
+	assert.Len(t, excluded, len(ListResourceTypes())-1)
+	assert.Empty(t, selectedResourceTypes([]string{bucket}, []string{bucket}))
+}
+
+// sortedCopy - Returns a sorted copy of the strings
+func sortedCopy(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func TestRegionsInNukeOrder(t *testing.T) {
+	t.Parallel()
+
+	project := GcpProjectResources{
+		Resources: map[string]GcpRegionResource{
+			"global":       {},
+			"us-east1":     {},
+			"europe-west1": {},
+			"us-central1":  {},
+		},
+	}
+	// The global resources go last, once the regional resources using them are gone
+	assert.Equal(t, []string{"europe-west1", "us-central1", "us-east1", "global"}, regionsInNukeOrder(&project))
+
+	project = GcpProjectResources{Resources: map[string]GcpRegionResource{"us-central1": {}}}
+	assert.Equal(t, []string{"us-central1"}, regionsInNukeOrder(&project))
+}
@@ -0,0 +1,104 @@
+package gcp
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// Returns the names of all GCS buckets in the project, grouped by location
+func getAllGcsBuckets(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	bucketNames := map[string][]string{}
+	it := client.Buckets(ctx, projectID)
+	for {
+		bucket, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(bucket.Created) {
+			location := normalizeRegion(bucket.Location)
+			bucketNames[location] = append(bucketNames[location], bucket.Name)
+		}
+	}
+
+	return bucketNames, nil
+}
+
+// emptyGcsBucket deletes all objects in the bucket, including the noncurrent versions of versioned objects, as a
+// bucket can only be deleted when it is empty. Errors are returned as is, so that callers can check for
+// storage.ErrBucketNotExist.
+func emptyGcsBucket(ctx context.Context, bucket *storage.BucketHandle) error {
+	it := bucket.Objects(ctx, &storage.Query{Versions: true})
+	for {
+		object, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = bucket.Object(object.Name).Generation(object.Generation).Delete(ctx)
+		if err != nil && err != storage.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
+// Deletes all GCS buckets, along with all the objects in them
+func nukeAllGcsBuckets(projectID string, bucketNames []string) error {
+	if len(bucketNames) == 0 {
+		logging.Logger.Infof("No GCS buckets to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GCS buckets in project %s", projectID)
+	var deletedBucketNames []string
+	var nukeErrors NukeErrors
+
+	for _, bucketName := range bucketNames {
+		bucket := client.Bucket(bucketName)
+
+		err := emptyGcsBucket(ctx, bucket)
+		if err == nil {
+			err = bucket.Delete(ctx)
+		}
+
+		if err != nil {
+			if err == storage.ErrBucketNotExist {
+				logging.Logger.Infof("GCS bucket %s has already been deleted", bucketName)
+			} else {
+				logging.Logger.Errorf("[Failed] %s", err)
+				nukeErrors.add(bucketName, err)
+			}
+		} else {
+			deletedBucketNames = append(deletedBucketNames, bucketName)
+			logging.Logger.Infof("Deleted GCS bucket: %s", bucketName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GCS bucket(s) deleted in project %s", len(deletedBucketNames), projectID)
+	return nukeErrors.errorOrNil()
+}
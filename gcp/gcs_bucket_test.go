@@ -0,0 +1,85 @@
+package gcp
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegion = "us-central1"
+
+// createTestGcsBucket creates a versioned bucket holding an object with a noncurrent version, so that nuking it
+// exercises emptying the bucket
+func createTestGcsBucket(t *testing.T, projectID string, name string) {
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	bucket := client.Bucket(name)
+	err = bucket.Create(ctx, projectID, &storage.BucketAttrs{
+		Location:          testRegion,
+		VersioningEnabled: true,
+	})
+	require.NoError(t, err)
+
+	for _, content := range []string{"v1", "v2"} {
+		writer := bucket.Object("test-object").NewWriter(ctx)
+		_, err = writer.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+}
+
+func TestListGcsBuckets(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Bucket names have to be lower case
+	bucketName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	createTestGcsBucket(t, projectID, bucketName)
+	// clean up after this test
+	defer nukeAllGcsBuckets(projectID, []string{bucketName})
+
+	bucketNames, err := getAllGcsBuckets(projectID, time.Now().Add(1*time.Hour*-1))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCS buckets")
+	}
+
+	assert.NotContains(t, bucketNames[testRegion], bucketName)
+
+	bucketNames, err = getAllGcsBuckets(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCS buckets")
+	}
+
+	assert.Contains(t, bucketNames[testRegion], bucketName)
+}
+
+func TestNukeGcsBuckets(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Bucket names have to be lower case
+	bucketName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	createTestGcsBucket(t, projectID, bucketName)
+
+	if err := nukeAllGcsBuckets(projectID, []string{bucketName}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	bucketNames, err := getAllGcsBuckets(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GCS buckets")
+	}
+
+	assert.NotContains(t, bucketNames[testRegion], bucketName)
+}
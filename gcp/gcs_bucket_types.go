@@ -0,0 +1,34 @@
+package gcp
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GcsBucketResource - represents all GCS buckets
+type GcsBucketResource struct {
+	BucketNames []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (buckets GcsBucketResource) ResourceName() string {
+	return "gcsbucket"
+}
+
+// ResourceIdentifiers - The names of the GCS buckets
+func (buckets GcsBucketResource) ResourceIdentifiers() []string {
+	return buckets.BucketNames
+}
+
+func (buckets GcsBucketResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (buckets GcsBucketResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGcsBuckets(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	container "cloud.google.com/go/container/apiv1"
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How long to wait for GKE clusters to finish deleting before giving up
+const gkeClusterDeleteMaxAttempts = 60
+const gkeClusterDeleteRetryInterval = 15 * time.Second
+
+// GKE clusters are either regional (e.g. "us-central1") or zonal (e.g. "us-central1-a"). Zonal clusters are grouped
+// under the region of their zone, so that excluding a region excludes all clusters in it.
+func regionOfLocation(location string) string {
+	parts := strings.Split(location, "-")
+	if len(parts) == 3 && len(parts[2]) == 1 {
+		return strings.Join(parts[:2], "-")
+	}
+	return location
+}
+
+// GKE clusters are identified by their location and name, separated by a slash, as cluster names are only unique
+// within a location
+func gkeClusterIdentifier(cluster *containerpb.Cluster) string {
+	return fmt.Sprintf("%s/%s", cluster.Location, cluster.Name)
+}
+
+func gkeClusterResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", errors.WithStackTrace(InvalidGkeClusterIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/clusters/%s", projectID, parts[0], parts[1]), nil
+}
+
+// Returns the location/name identifiers of all GKE clusters in the project, grouped by region
+func getAllGkeClusters(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	ctx := context.Background()
+	client, err := container.NewClusterManagerClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	// The "-" location lists the clusters in all regions and zones at once
+	result, err := client.ListClusters(ctx, &containerpb.ListClustersRequest{
+		Parent: fmt.Sprintf("projects/%s/locations/-", projectID),
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	for _, zone := range result.MissingZones {
+		logging.Logger.Warnf("Unable to list GKE clusters in zone %s, it is not reachable", zone)
+	}
+
+	clusterIdentifiers := map[string][]string{}
+	for _, cluster := range result.Clusters {
+		if cluster.Status == containerpb.Cluster_STOPPING {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, cluster.CreateTime)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if excludeAfter.After(createdAt) {
+			region := regionOfLocation(cluster.Location)
+			clusterIdentifiers[region] = append(clusterIdentifiers[region], gkeClusterIdentifier(cluster))
+		}
+	}
+
+	return clusterIdentifiers, nil
+}
+
+// waitUntilGkeOperationDone polls the operation until it is done, as the GKE API has no waiters
+func waitUntilGkeOperationDone(ctx context.Context, client *container.ClusterManagerClient, projectID string, operation *containerpb.Operation, location string) error {
+	operationName := fmt.Sprintf("projects/%s/locations/%s/operations/%s", projectID, location, operation.Name)
+	for i := 0; i < gkeClusterDeleteMaxAttempts; i++ {
+		if operation.Status == containerpb.Operation_DONE {
+			return nil
+		}
+		time.Sleep(gkeClusterDeleteRetryInterval)
+
+		var err error
+		operation, err = client.GetOperation(ctx, &containerpb.GetOperationRequest{Name: operationName})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return errors.WithStackTrace(GkeOperationNotDoneError{OperationName: operationName})
+}
+
+// Deletes all GKE clusters
+func nukeAllGkeClusters(projectID string, clusterIdentifiers []string) error {
+	if len(clusterIdentifiers) == 0 {
+		logging.Logger.Infof("No GKE clusters to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := container.NewClusterManagerClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all GKE clusters in project %s", projectID)
+
+	// Deleting a cluster takes several minutes, so start deleting the whole batch before waiting on any of them
+	operations := map[string]*containerpb.Operation{}
+	var nukeErrors NukeErrors
+	for _, clusterIdentifier := range clusterIdentifiers {
+		name, err := gkeClusterResourceName(projectID, clusterIdentifier)
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(clusterIdentifier, err)
+			continue
+		}
+
+		operation, err := client.DeleteCluster(ctx, &containerpb.DeleteClusterRequest{Name: name})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(clusterIdentifier, err)
+			continue
+		}
+		operations[clusterIdentifier] = operation
+	}
+
+	var deletedClusterIdentifiers []string
+	for clusterIdentifier, operation := range operations {
+		location := strings.SplitN(clusterIdentifier, "/", 2)[0]
+		if err := waitUntilGkeOperationDone(ctx, client, projectID, operation, location); err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(clusterIdentifier, err)
+		} else {
+			deletedClusterIdentifiers = append(deletedClusterIdentifiers, clusterIdentifier)
+			logging.Logger.Infof("Deleted GKE cluster: %s", clusterIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d GKE cluster(s) deleted in project %s", len(deletedClusterIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
@@ -0,0 +1,103 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	container "cloud.google.com/go/container/apiv1"
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testZone = "us-central1-a"
+
+// createTestGkeCluster creates a zonal cluster with a single node, and waits for it to be running
+func createTestGkeCluster(t *testing.T, projectID string, name string) string {
+	ctx := context.Background()
+	client, err := container.NewClusterManagerClient(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	operation, err := client.CreateCluster(ctx, &containerpb.CreateClusterRequest{
+		Parent: fmt.Sprintf("projects/%s/locations/%s", projectID, testZone),
+		Cluster: &containerpb.Cluster{
+			Name:             name,
+			InitialNodeCount: 1,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, waitUntilGkeOperationDone(ctx, client, projectID, operation, testZone))
+
+	return fmt.Sprintf("%s/%s", testZone, name)
+}
+
+func TestListGkeClusters(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Cluster names have to be lower case
+	clusterName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	clusterIdentifier := createTestGkeCluster(t, projectID, clusterName)
+	// clean up after this test
+	defer nukeAllGkeClusters(projectID, []string{clusterIdentifier})
+
+	clusterIdentifiers, err := getAllGkeClusters(projectID, time.Now().Add(1*time.Hour*-1))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GKE clusters")
+	}
+
+	assert.NotContains(t, clusterIdentifiers[testRegion], clusterIdentifier)
+
+	clusterIdentifiers, err = getAllGkeClusters(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GKE clusters")
+	}
+
+	assert.Contains(t, clusterIdentifiers[testRegion], clusterIdentifier)
+}
+
+func TestNukeGkeClusters(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Cluster names have to be lower case
+	clusterName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	clusterIdentifier := createTestGkeCluster(t, projectID, clusterName)
+
+	if err := nukeAllGkeClusters(projectID, []string{clusterIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	clusterIdentifiers, err := getAllGkeClusters(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of GKE clusters")
+	}
+
+	assert.NotContains(t, clusterIdentifiers[testRegion], clusterIdentifier)
+}
+
+func TestRegionOfLocation(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-central1", regionOfLocation("us-central1-a"))
+	assert.Equal(t, "us-central1", regionOfLocation("us-central1"))
+	assert.Equal(t, "northamerica-northeast1", regionOfLocation("northamerica-northeast1-b"))
+}
+
+func TestParseGkeClusterIdentifier(t *testing.T) {
+	t.Parallel()
+
+	name, err := gkeClusterResourceName("my-project", "us-central1-a/my-cluster")
+	require.NoError(t, err)
+	assert.Equal(t, "projects/my-project/locations/us-central1-a/clusters/my-cluster", name)
+
+	_, err = gkeClusterResourceName("my-project", "my-cluster")
+	assert.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// GkeClusterResource - represents all GKE clusters
+type GkeClusterResource struct {
+	ClusterIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (clusters GkeClusterResource) ResourceName() string {
+	return "gkecluster"
+}
+
+// ResourceIdentifiers - The location/name pairs of the GKE clusters
+func (clusters GkeClusterResource) ResourceIdentifiers() []string {
+	return clusters.ClusterIdentifiers
+}
+
+func (clusters GkeClusterResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters GkeClusterResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllGkeClusters(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidGkeClusterIdentifierError - returned when a GKE cluster identifier isn't a location/name pair
+type InvalidGkeClusterIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidGkeClusterIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid GKE cluster identifier %s, expected <location>/<name>", e.Identifier)
+}
+
+// GkeOperationNotDoneError - returned when a GKE operation is still running after waiting for it
+type GkeOperationNotDoneError struct {
+	OperationName string
+}
+
+func (e GkeOperationNotDoneError) Error() string {
+	return fmt.Sprintf("Timed out waiting for GKE operation %s to be done", e.OperationName)
+}
@@ -0,0 +1,91 @@
+package gcp
+
+import (
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The outcomes of nuking a resource
+const (
+	// The resource was deleted
+	NukeOutcomeNuked = "nuked"
+	// The resource can't be deleted, and was made unusable instead, e.g. Cloud KMS keys
+	NukeOutcomeNeutralized = "neutralized"
+	// The resource couldn't be nuked
+	NukeOutcomeFailed = "failed"
+)
+
+// neutralizingResource - implemented by the resource types whose resources can't be deleted, and are neutralized when
+// nuked instead
+type neutralizingResource interface {
+	neutralizes()
+}
+
+// nukeOutcome - Returns the outcome of nuking the resources of the given type successfully
+func nukeOutcome(resources GcpResource) string {
+	if _, isNeutralizing := resources.(neutralizingResource); isNeutralizing {
+		return NukeOutcomeNeutralized
+	}
+	return NukeOutcomeNuked
+}
+
+// NukeResult - the outcome of nuking a single resource
+type NukeResult struct {
+	ProjectID    string
+	Region       string
+	ResourceType string
+	Identifier   string
+	// One of the NukeOutcome constants
+	Outcome string
+	// Why the resource couldn't be nuked. Nil when it was nuked.
+	Err error
+}
+
+// NukeReport - the outcome of nuking each resource
+type NukeReport struct {
+	Results []NukeResult
+}
+
+// Count - Returns how many resources had the given outcome
+func (nukeReport *NukeReport) Count(outcome string) int {
+	count := 0
+	for _, result := range nukeReport.Results {
+		if result.Outcome == outcome {
+			count++
+		}
+	}
+	return count
+}
+
+// Failures - Returns the results of the resources that couldn't be nuked
+func (nukeReport *NukeReport) Failures() []NukeResult {
+	var failures []NukeResult
+	for _, result := range nukeReport.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// addBatch records the outcome of nuking a batch of resources of the same type, as returned by Nuke. NukeErrors tell
+// which resources couldn't be nuked; any other error means none of them could. The others get the given outcome.
+// Returns how many resources couldn't be nuked.
+func (nukeReport *NukeReport) addBatch(projectID string, region string, resourceType string, identifiers []string, outcome string, err error) int {
+	nukeErrors, isNukeErrors := errors.Unwrap(err).(NukeErrors)
+
+	failures := 0
+	for _, identifier := range identifiers {
+		result := NukeResult{ProjectID: projectID, Region: region, ResourceType: resourceType, Identifier: identifier, Outcome: outcome}
+		if isNukeErrors {
+			result.Err = nukeErrors.Errors[identifier]
+		} else {
+			result.Err = err
+		}
+		if result.Err != nil {
+			result.Outcome = NukeOutcomeFailed
+			failures++
+		}
+		nukeReport.Results = append(nukeReport.Results, result)
+	}
+	return failures
+}
@@ -0,0 +1,43 @@
+package gcp
+
+import (
+	"regexp"
+)
+
+// ResourceOptions - the settings that change which gcp resources are found, on top of the age and resource type
+// filters
+type ResourceOptions struct {
+	// Also include persistent disks that are attached to an instance. By default only unattached disks are included.
+	IncludeAttachedDisks bool
+	// Which Cloud DNS managed zones to include, by name
+	ManagedZoneNames NameFilter
+	// Also include the default VPC network, along with its subnets and firewall rules. By default they are left alone.
+	IncludeDefaultNetwork bool
+	// How many of the most recently uploaded tagged images to keep for each GCR image name, whatever their age
+	KeepLatestGcrImages int
+}
+
+// NameFilter - regular expressions on the names of resources. A name matches when it matches any of the include
+// expressions, or when there are none, and none of the exclude expressions.
+type NameFilter struct {
+	Include []*regexp.Regexp
+	Exclude []*regexp.Regexp
+}
+
+// Matches - Checks if the name passes the filter
+func (filter NameFilter) Matches(name string) bool {
+	for _, exclude := range filter.Exclude {
+		if exclude.MatchString(name) {
+			return false
+		}
+	}
+	if len(filter.Include) == 0 {
+		return true
+	}
+	for _, include := range filter.Include {
+		if include.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package gcp
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, NameFilter{}.Matches("anything"))
+
+	filter := NameFilter{
+		Include: []*regexp.Regexp{regexp.MustCompile(`^test-`), regexp.MustCompile(`-ci$`)},
+		Exclude: []*regexp.Regexp{regexp.MustCompile(`keep`)},
+	}
+	assert.True(t, filter.Matches("test-zone"))
+	assert.True(t, filter.Matches("zone-ci"))
+	assert.False(t, filter.Matches("prod-zone"))
+	assert.False(t, filter.Matches("test-keep-zone"))
+
+	excludeOnly := NameFilter{Exclude: []*regexp.Regexp{regexp.MustCompile(`^prod`)}}
+	assert.True(t, excludeOnly.Matches("test-zone"))
+	assert.False(t, excludeOnly.Matches("prod-zone"))
+}
@@ -0,0 +1,211 @@
+package gcp
+
+import (
+	"time"
+)
+
+// gcpResourceType - a gcp resource type, along with how to find its resources in a project
+type gcpResourceType struct {
+	// The resource, without any identifiers, e.g. for its name
+	resource GcpResource
+	// Returns the identifiers of the resources to nuke in the project, grouped by region. The resources of the types
+	// registered before this one have already been added to the project.
+	getAll func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error)
+	// Returns the resource holding the given identifiers
+	newResource func(identifiers []string) GcpResource
+}
+
+// registeredResourceTypes - all gcp resource types, in the order they are listed and nuked in
+var registeredResourceTypes = []gcpResourceType{
+	{
+		resource: GkeClusterResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGkeClusters(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GkeClusterResource{ClusterIdentifiers: identifiers}
+		},
+	},
+	// Nuked after GKE clusters, since the disks of their nodes and persistent volumes go away along with them
+	{
+		resource: GceDiskResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGceDisks(projectID, excludeAfter, options.IncludeAttachedDisks)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceDiskResource{DiskIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: GceTargetPoolResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGceTargetPools(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceTargetPoolResource{TargetPoolIdentifiers: identifiers}
+		},
+	},
+	// Nuked after target pools, since the health checks of the target pools nuked above are orphaned once they are gone
+	{
+		resource: GceHealthCheckResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			nukedTargetPoolIdentifiers := project.resourceIdentifiers(GceTargetPoolResource{}.ResourceName())
+			return getAllGceHealthChecks(projectID, excludeAfter, nukedTargetPoolIdentifiers)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceHealthCheckResource{HealthCheckIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: GceInstanceGroupResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGceInstanceGroups(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceInstanceGroupResource{InstanceGroupIdentifiers: identifiers}
+		},
+	},
+	// Nuked after managed instance groups, since a template can't be deleted while a group uses it
+	{
+		resource: GceInstanceTemplateResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			nukedInstanceGroupIdentifiers := project.resourceIdentifiers(GceInstanceGroupResource{}.ResourceName())
+			return getAllGceInstanceTemplates(projectID, excludeAfter, nukedInstanceGroupIdentifiers)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceInstanceTemplateResource{TemplateIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: GceAddressResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGceAddresses(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceAddressResource{AddressIdentifiers: identifiers}
+		},
+	},
+	// Firewall rules and subnets are nuked before the networks holding them, since a network can't be deleted while it
+	// holds any. Subnets are regional, and so nuked before the global firewall rules and networks.
+	{
+		resource: GceFirewallRuleResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGceFirewallRules(projectID, excludeAfter, options.IncludeDefaultNetwork)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceFirewallRuleResource{FirewallRuleNames: identifiers}
+		},
+	},
+	{
+		resource: GceSubnetworkResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGceSubnetworks(projectID, excludeAfter, options.IncludeDefaultNetwork)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceSubnetworkResource{SubnetworkIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: GceNetworkResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			nukedFirewallRuleNames := project.resourceIdentifiers(GceFirewallRuleResource{}.ResourceName())
+			nukedSubnetworkIdentifiers := project.resourceIdentifiers(GceSubnetworkResource{}.ResourceName())
+			return getAllGceNetworks(projectID, excludeAfter, options.IncludeDefaultNetwork, nukedFirewallRuleNames, nukedSubnetworkIdentifiers)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GceNetworkResource{NetworkNames: identifiers}
+		},
+	},
+	{
+		resource: FilestoreInstanceResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllFilestoreInstances(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return FilestoreInstanceResource{InstanceIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: SpannerInstanceResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllSpannerInstances(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return SpannerInstanceResource{InstanceNames: identifiers}
+		},
+	},
+	// The databases of the Spanner instances nuked above go away along with them, so only the other ones are listed
+	{
+		resource: SpannerDatabaseResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			nukedInstanceNames := project.resourceIdentifiers(SpannerInstanceResource{}.ResourceName())
+			return getAllSpannerDatabases(projectID, excludeAfter, nukedInstanceNames)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return SpannerDatabaseResource{DatabaseIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: CloudSqlResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllCloudSqlInstances(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return CloudSqlResource{InstanceNames: identifiers}
+		},
+	},
+	{
+		resource: GcfResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGcfs(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GcfResource{FunctionIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: GcsBucketResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGcsBuckets(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GcsBucketResource{BucketNames: identifiers}
+		},
+	},
+	{
+		resource: CloudDnsZoneResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllCloudDnsZones(projectID, excludeAfter, options.ManagedZoneNames)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return CloudDnsZoneResource{ZoneNames: identifiers}
+		},
+	},
+	{
+		resource: ArtifactRegistryRepositoryResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllArtifactRegistryRepositories(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return ArtifactRegistryRepositoryResource{RepositoryIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: GcrImageResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllGcrImages(projectID, excludeAfter, options.KeepLatestGcrImages)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return GcrImageResource{ImageIdentifiers: identifiers}
+		},
+	},
+	{
+		resource: CloudKmsKeyResource{},
+		getAll: func(projectID string, project *GcpProjectResources, excludeAfter time.Time, options ResourceOptions) (map[string][]string, error) {
+			return getAllCloudKmsKeys(projectID, excludeAfter)
+		},
+		newResource: func(identifiers []string) GcpResource {
+			return CloudKmsKeyResource{KeyIdentifiers: identifiers}
+		},
+	},
+}
@@ -0,0 +1,49 @@
+package gcp
+
+// selectedResources - the resources of a type that are left after deselecting some of them
+type selectedResources struct {
+	GcpResource
+	identifiers []string
+}
+
+// ResourceIdentifiers - The identifiers that are still selected
+func (resources selectedResources) ResourceIdentifiers() []string {
+	return resources.identifiers
+}
+
+// FilterResources - Returns the resources for which keep returns true, e.g. to leave out the resources deselected
+// before nuking. Resource types without any resource left are dropped, as are regions and projects without any
+// resource type left.
+func FilterResources(resources *GcpResources, keep func(projectID string, region string, resourceType string, identifier string) bool) *GcpResources {
+	filtered := GcpResources{Projects: map[string]GcpProjectResources{}}
+
+	for projectID, project := range resources.Projects {
+		filteredProject := GcpProjectResources{Resources: map[string]GcpRegionResource{}}
+		for region, resourcesInRegion := range project.Resources {
+			filteredRegion := GcpRegionResource{}
+			for _, resource := range resourcesInRegion.Resources {
+				var identifiers []string
+				for _, identifier := range resource.ResourceIdentifiers() {
+					if keep(projectID, region, resource.ResourceName(), identifier) {
+						identifiers = append(identifiers, identifier)
+					}
+				}
+				if len(identifiers) == 0 {
+					continue
+				}
+				if len(identifiers) < len(resource.ResourceIdentifiers()) {
+					resource = selectedResources{GcpResource: resource, identifiers: identifiers}
+				}
+				filteredRegion.Resources = append(filteredRegion.Resources, resource)
+			}
+			if len(filteredRegion.Resources) > 0 {
+				filteredProject.Resources[region] = filteredRegion
+			}
+		}
+		if len(filteredProject.Resources) > 0 {
+			filtered.Projects[projectID] = filteredProject
+		}
+	}
+
+	return &filtered
+}
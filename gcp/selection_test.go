@@ -0,0 +1,40 @@
+package gcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterResources(t *testing.T) {
+	t.Parallel()
+
+	resources := &GcpResources{
+		Projects: map[string]GcpProjectResources{
+			"project-a": {
+				Resources: map[string]GcpRegionResource{
+					"us-central1":  {Resources: []GcpResource{GcsBucketResource{BucketNames: []string{"bucket-1", "bucket-2"}}}},
+					"europe-west1": {Resources: []GcpResource{GcsBucketResource{BucketNames: []string{"bucket-3"}}}},
+				},
+			},
+			"project-b": {
+				Resources: map[string]GcpRegionResource{
+					"us-central1": {Resources: []GcpResource{GcsBucketResource{BucketNames: []string{"bucket-4"}}}},
+				},
+			},
+		},
+	}
+
+	filtered := FilterResources(resources, func(projectID string, region string, resourceType string, identifier string) bool {
+		return projectID == "project-a" && identifier != "bucket-2" && identifier != "bucket-3"
+	})
+
+	require.Len(t, filtered.Projects, 1)
+	project := filtered.Projects["project-a"]
+	require.Len(t, project.Resources, 1)
+	selected := project.Resources["us-central1"].Resources
+	require.Len(t, selected, 1)
+	assert.Equal(t, GcsBucketResource{}.ResourceName(), selected[0].ResourceName())
+	assert.Equal(t, []string{"bucket-1"}, selected[0].ResourceIdentifiers())
+}
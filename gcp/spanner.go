@@ -0,0 +1,202 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instance "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/collections"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"google.golang.org/api/iterator"
+)
+
+// regionOfSpannerConfig returns the region of a Spanner instance, from its configuration, e.g.
+// projects/<project>/instanceConfigs/regional-us-central1. Multi-region configurations, e.g. nam3, are returned as
+// they are.
+func regionOfSpannerConfig(config string) string {
+	return strings.TrimPrefix(path.Base(config), "regional-")
+}
+
+// Spanner databases are identified by their instance and name, separated by a slash, as database names are only
+// unique within an instance
+func spannerDatabaseIdentifier(databaseResourceName string) string {
+	// projects/<project>/instances/<instance>/databases/<database>
+	parts := strings.Split(databaseResourceName, "/")
+	if len(parts) != 6 {
+		return databaseResourceName
+	}
+	return fmt.Sprintf("%s/%s", parts[3], parts[5])
+}
+
+func spannerDatabaseResourceName(projectID string, identifier string) (string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 {
+		return "", errors.WithStackTrace(InvalidSpannerDatabaseIdentifierError{Identifier: identifier})
+	}
+	return fmt.Sprintf("projects/%s/instances/%s/databases/%s", projectID, parts[0], parts[1]), nil
+}
+
+func getAllSpannerInstanceResources(ctx context.Context, projectID string) ([]*instancepb.Instance, error) {
+	client, err := instance.NewInstanceAdminClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	var instances []*instancepb.Instance
+	it := client.ListInstances(ctx, &instancepb.ListInstancesRequest{Parent: "projects/" + projectID})
+	for {
+		spannerInstance, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		instances = append(instances, spannerInstance)
+	}
+	return instances, nil
+}
+
+// Returns the names of all Spanner instances in the project, grouped by region
+func getAllSpannerInstances(projectID string, excludeAfter time.Time) (map[string][]string, error) {
+	instances, err := getAllSpannerInstanceResources(context.Background(), projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	instanceNames := map[string][]string{}
+	for _, spannerInstance := range instances {
+		if excludeAfter.After(spannerInstance.GetCreateTime().AsTime()) {
+			region := regionOfSpannerConfig(spannerInstance.GetConfig())
+			instanceNames[region] = append(instanceNames[region], path.Base(spannerInstance.GetName()))
+		}
+	}
+
+	return instanceNames, nil
+}
+
+// Returns the instance/name identifiers of all Spanner databases in the project, grouped by the region of their
+// instance. The databases of the instances in nukedInstanceNames are left out, as they go away along with their
+// instance, and so are the databases with drop protection enabled.
+func getAllSpannerDatabases(projectID string, excludeAfter time.Time, nukedInstanceNames []string) (map[string][]string, error) {
+	ctx := context.Background()
+	instances, err := getAllSpannerInstanceResources(ctx, projectID)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	client, err := database.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	databaseIdentifiers := map[string][]string{}
+	for _, spannerInstance := range instances {
+		if collections.ListContainsElement(nukedInstanceNames, path.Base(spannerInstance.GetName())) {
+			continue
+		}
+
+		region := regionOfSpannerConfig(spannerInstance.GetConfig())
+		it := client.ListDatabases(ctx, &databasepb.ListDatabasesRequest{Parent: spannerInstance.GetName()})
+		for {
+			spannerDatabase, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			if spannerDatabase.GetEnableDropProtection() {
+				continue
+			}
+
+			if excludeAfter.After(spannerDatabase.GetCreateTime().AsTime()) {
+				databaseIdentifiers[region] = append(databaseIdentifiers[region], spannerDatabaseIdentifier(spannerDatabase.GetName()))
+			}
+		}
+	}
+
+	return databaseIdentifiers, nil
+}
+
+// Deletes all Spanner instances, along with all the databases in them
+func nukeAllSpannerInstances(projectID string, instanceNames []string) error {
+	if len(instanceNames) == 0 {
+		logging.Logger.Infof("No Spanner instances to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := instance.NewInstanceAdminClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all Spanner instances in project %s", projectID)
+	var deletedInstanceNames []string
+	var nukeErrors NukeErrors
+
+	for _, instanceName := range instanceNames {
+		err := client.DeleteInstance(ctx, &instancepb.DeleteInstanceRequest{
+			Name: fmt.Sprintf("projects/%s/instances/%s", projectID, instanceName),
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(instanceName, err)
+		} else {
+			deletedInstanceNames = append(deletedInstanceNames, instanceName)
+			logging.Logger.Infof("Deleted Spanner instance: %s", instanceName)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Spanner instance(s) deleted in project %s", len(deletedInstanceNames), projectID)
+	return nukeErrors.errorOrNil()
+}
+
+// Drops all Spanner databases
+func nukeAllSpannerDatabases(projectID string, databaseIdentifiers []string) error {
+	if len(databaseIdentifiers) == 0 {
+		logging.Logger.Infof("No Spanner databases to nuke in project %s", projectID)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, err := database.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer client.Close()
+
+	logging.Logger.Infof("Deleting all Spanner databases in project %s", projectID)
+	var deletedDatabaseIdentifiers []string
+	var nukeErrors NukeErrors
+
+	for _, databaseIdentifier := range databaseIdentifiers {
+		name, err := spannerDatabaseResourceName(projectID, databaseIdentifier)
+		if err == nil {
+			err = client.DropDatabase(ctx, &databasepb.DropDatabaseRequest{Database: name})
+		}
+
+		if err != nil {
+			logging.Logger.Errorf("[Failed] %s", err)
+			nukeErrors.add(databaseIdentifier, err)
+		} else {
+			deletedDatabaseIdentifiers = append(deletedDatabaseIdentifiers, databaseIdentifier)
+			logging.Logger.Infof("Deleted Spanner database: %s", databaseIdentifier)
+		}
+	}
+
+	logging.Logger.Infof("[OK] %d Spanner database(s) deleted in project %s", len(deletedDatabaseIdentifiers), projectID)
+	return nukeErrors.errorOrNil()
+}
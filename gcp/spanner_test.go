@@ -0,0 +1,148 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instance "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestSpannerInstance creates the smallest possible regional Spanner instance, with a single database in it
+func createTestSpannerInstance(t *testing.T, projectID string, name string, databaseName string) {
+	ctx := context.Background()
+	instanceClient, err := instance.NewInstanceAdminClient(ctx)
+	require.NoError(t, err)
+	defer instanceClient.Close()
+
+	instanceOperation, err := instanceClient.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
+		Parent:     "projects/" + projectID,
+		InstanceId: name,
+		Instance: &instancepb.Instance{
+			Config:          fmt.Sprintf("projects/%s/instanceConfigs/regional-%s", projectID, testRegion),
+			DisplayName:     name,
+			ProcessingUnits: 100,
+		},
+	})
+	require.NoError(t, err)
+	_, err = instanceOperation.Wait(ctx)
+	require.NoError(t, err)
+
+	databaseClient, err := database.NewDatabaseAdminClient(ctx)
+	require.NoError(t, err)
+	defer databaseClient.Close()
+
+	databaseOperation, err := databaseClient.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
+		Parent:          fmt.Sprintf("projects/%s/instances/%s", projectID, name),
+		CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", databaseName),
+	})
+	require.NoError(t, err)
+	_, err = databaseOperation.Wait(ctx)
+	require.NoError(t, err)
+}
+
+func TestRegionOfSpannerConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "us-central1", regionOfSpannerConfig("projects/my-project/instanceConfigs/regional-us-central1"))
+	assert.Equal(t, "nam3", regionOfSpannerConfig("projects/my-project/instanceConfigs/nam3"))
+}
+
+func TestSpannerDatabaseIdentifier(t *testing.T) {
+	t.Parallel()
+
+	identifier := spannerDatabaseIdentifier("projects/my-project/instances/my-instance/databases/my-database")
+	assert.Equal(t, "my-instance/my-database", identifier)
+
+	name, err := spannerDatabaseResourceName("my-project", identifier)
+	require.NoError(t, err)
+	assert.Equal(t, "projects/my-project/instances/my-instance/databases/my-database", name)
+
+	_, err = spannerDatabaseResourceName("my-project", "my-database")
+	assert.Equal(t, InvalidSpannerDatabaseIdentifierError{Identifier: "my-database"}, errors.Unwrap(err))
+}
+
+func TestListSpannerInstancesAndDatabases(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Spanner instance and database names must be lower case
+	instanceName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	databaseName := "cloud_nuke_test_" + strings.ToLower(util.UniqueID())
+	createTestSpannerInstance(t, projectID, instanceName, databaseName)
+	// clean up after this test
+	defer nukeAllSpannerInstances(projectID, []string{instanceName})
+
+	instanceNames, err := getAllSpannerInstances(projectID, time.Now().Add(1*time.Hour*-1))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Spanner instances")
+	}
+
+	assert.NotContains(t, instanceNames[testRegion], instanceName)
+
+	instanceNames, err = getAllSpannerInstances(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Spanner instances")
+	}
+
+	assert.Contains(t, instanceNames[testRegion], instanceName)
+
+	databaseIdentifier := fmt.Sprintf("%s/%s", instanceName, databaseName)
+	databaseIdentifiers, err := getAllSpannerDatabases(projectID, time.Now().Add(1*time.Hour), nil)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Spanner databases")
+	}
+
+	assert.Contains(t, databaseIdentifiers[testRegion], databaseIdentifier)
+
+	// The databases of the instances being nuked aren't listed
+	databaseIdentifiers, err = getAllSpannerDatabases(projectID, time.Now().Add(1*time.Hour), []string{instanceName})
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Spanner databases")
+	}
+
+	assert.NotContains(t, databaseIdentifiers[testRegion], databaseIdentifier)
+}
+
+func TestNukeSpannerInstancesAndDatabases(t *testing.T) {
+	t.Parallel()
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	// Spanner instance and database names must be lower case
+	instanceName := "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
+	databaseName := "cloud_nuke_test_" + strings.ToLower(util.UniqueID())
+	createTestSpannerInstance(t, projectID, instanceName, databaseName)
+
+	databaseIdentifier := fmt.Sprintf("%s/%s", instanceName, databaseName)
+	if err := nukeAllSpannerDatabases(projectID, []string{databaseIdentifier}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	databaseIdentifiers, err := getAllSpannerDatabases(projectID, time.Now().Add(1*time.Hour), nil)
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Spanner databases")
+	}
+
+	assert.NotContains(t, databaseIdentifiers[testRegion], databaseIdentifier)
+
+	if err := nukeAllSpannerInstances(projectID, []string{instanceName}); err != nil {
+		assert.Fail(t, errors.WithStackTrace(err).Error())
+	}
+
+	instanceNames, err := getAllSpannerInstances(projectID, time.Now().Add(1*time.Hour))
+	if err != nil {
+		assert.Fail(t, "Unable to fetch list of Spanner instances")
+	}
+
+	assert.NotContains(t, instanceNames[testRegion], instanceName)
+}
@@ -0,0 +1,74 @@
+package gcp
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// SpannerInstanceResource - represents all Spanner instances
+type SpannerInstanceResource struct {
+	InstanceNames []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (instances SpannerInstanceResource) ResourceName() string {
+	return "spannerinstance"
+}
+
+// ResourceIdentifiers - The names of the Spanner instances
+func (instances SpannerInstanceResource) ResourceIdentifiers() []string {
+	return instances.InstanceNames
+}
+
+func (instances SpannerInstanceResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (instances SpannerInstanceResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllSpannerInstances(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// SpannerDatabaseResource - represents all Spanner databases, in the instances that aren't nuked
+type SpannerDatabaseResource struct {
+	DatabaseIdentifiers []string
+}
+
+// ResourceName - the simple name of the gcp resource
+func (databases SpannerDatabaseResource) ResourceName() string {
+	return "spannerdatabase"
+}
+
+// ResourceIdentifiers - The instance/name pairs of the Spanner databases
+func (databases SpannerDatabaseResource) ResourceIdentifiers() []string {
+	return databases.DatabaseIdentifiers
+}
+
+func (databases SpannerDatabaseResource) MaxBatchSize() int {
+	// Tentative batch size to ensure GCP doesn't throttle
+	return 50
+}
+
+// Nuke - nuke 'em all!!!
+func (databases SpannerDatabaseResource) Nuke(projectID string, identifiers []string) error {
+	if err := nukeAllSpannerDatabases(projectID, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// InvalidSpannerDatabaseIdentifierError - returned when a Spanner database identifier isn't an instance/name pair
+type InvalidSpannerDatabaseIdentifierError struct {
+	Identifier string
+}
+
+func (e InvalidSpannerDatabaseIdentifierError) Error() string {
+	return fmt.Sprintf("Invalid Spanner database identifier %s, expected <instance>/<name>", e.Identifier)
+}
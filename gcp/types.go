@@ -0,0 +1,21 @@
+package gcp
+
+// GcpResources - the resources found in each project, keyed by project id
+type GcpResources struct {
+	Projects map[string]GcpProjectResources
+}
+
+type GcpProjectResources struct {
+	Resources map[string]GcpRegionResource
+}
+
+type GcpResource interface {
+	ResourceName() string
+	ResourceIdentifiers() []string
+	MaxBatchSize() int
+	Nuke(projectID string, identifiers []string) error
+}
+
+type GcpRegionResource struct {
+	Resources []GcpResource
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	awslambda "github.com/aws/aws-lambda-go/lambda"
+	"github.com/gruntwork-io/cloud-nuke/lambda"
+)
+
+// VERSION - Set at build time
+var VERSION string
+
+// The entrypoint of cloud-nuke deployed as a Lambda function, e.g. run on a schedule
+func main() {
+	awslambda.Start(lambda.NewHandler(VERSION))
+}
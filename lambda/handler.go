@@ -0,0 +1,204 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/cloud-nuke/commands"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Lambda functions can only write to /tmp
+const scratchDir = "/tmp"
+
+// reportUploadMargin - the time kept before the deadline of the invocation to upload the report
+const reportUploadMargin = 30 * time.Second
+
+// Settings - the settings of a run from Lambda, read from the environment variables of the function
+type Settings struct {
+	// Path to a config file, e.g. bundled along with the function
+	ConfigPath           string
+	ResourceTypes        []string
+	ExcludeResourceTypes []string
+	ExcludeRegions       []string
+	OlderThan            string
+	NewerThan            string
+	// The ARNs of the roles to assume to nuke several accounts
+	RoleArns []string
+	// The S3 bucket to write the report of each run to, under the given key prefix
+	ReportBucket string
+	ReportPrefix string
+}
+
+// Result - what a run from Lambda returns
+type Result struct {
+	// The S3 URL of the report. Empty when there was nothing to nuke.
+	ReportLocation string `json:"report_location,omitempty"`
+}
+
+// SettingsFromEnv - Reads the settings of a run from the environment variables of the function. Lists are comma
+// separated.
+func SettingsFromEnv(getenv func(string) string) (Settings, error) {
+	list := func(name string) []string {
+		var values []string
+		for _, value := range strings.Split(getenv(name), ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		return values
+	}
+
+	settings := Settings{
+		ConfigPath:           getenv("CLOUD_NUKE_CONFIG"),
+		ResourceTypes:        list("CLOUD_NUKE_RESOURCE_TYPES"),
+		ExcludeResourceTypes: list("CLOUD_NUKE_EXCLUDE_RESOURCE_TYPES"),
+		ExcludeRegions:       list("CLOUD_NUKE_EXCLUDE_REGIONS"),
+		OlderThan:            getenv("CLOUD_NUKE_OLDER_THAN"),
+		NewerThan:            getenv("CLOUD_NUKE_NEWER_THAN"),
+		RoleArns:             list("CLOUD_NUKE_ROLE_ARNS"),
+		ReportBucket:         getenv("CLOUD_NUKE_REPORT_BUCKET"),
+		ReportPrefix:         getenv("CLOUD_NUKE_REPORT_PREFIX"),
+	}
+	if settings.ReportBucket == "" {
+		return Settings{}, MissingSettingError{Name: "CLOUD_NUKE_REPORT_BUCKET"}
+	}
+	return settings, nil
+}
+
+// cliArgs - Returns the arguments running cloud-nuke aws with the given settings, in force mode since nobody is there
+// to confirm. The report is written to reportPath. No --max-duration is passed when maxDuration is zero.
+func cliArgs(settings Settings, reportPath string, maxDuration time.Duration) []string {
+	args := []string{
+		"cloud-nuke", "aws",
+		"--force",
+		"--auto-tune",
+		"--output-json", reportPath,
+		"--throughput-file", filepath.Join(scratchDir, "cloud-nuke-throughput.json"),
+	}
+	if settings.ConfigPath != "" {
+		args = append(args, "--config", settings.ConfigPath)
+	}
+	repeated := []struct {
+		flagName string
+		values   []string
+	}{
+		{"resource-type", settings.ResourceTypes},
+		{"exclude-resource-type", settings.ExcludeResourceTypes},
+		{"exclude-region", settings.ExcludeRegions},
+		{"role-arn", settings.RoleArns},
+	}
+	for _, flag := range repeated {
+		for _, value := range flag.values {
+			args = append(args, "--"+flag.flagName, value)
+		}
+	}
+	if settings.OlderThan != "" {
+		args = append(args, "--older-than", settings.OlderThan)
+	}
+	if settings.NewerThan != "" {
+		args = append(args, "--newer-than", settings.NewerThan)
+	}
+	if maxDuration > 0 {
+		args = append(args, "--max-duration", maxDuration.String())
+	}
+	return args
+}
+
+// runMaxDuration - Returns how long the whole run can take, so that it stops while there is still time to upload the
+// report before the invocation is killed at its deadline
+func runMaxDuration(deadline time.Time, now time.Time) time.Duration {
+	maxDuration := deadline.Sub(now) - reportUploadMargin
+	if maxDuration < time.Second {
+		return time.Second
+	}
+	return maxDuration.Truncate(time.Second)
+}
+
+// reportKey - Returns the S3 key of the report of a run started at the given time
+func reportKey(prefix string, startedAt time.Time) string {
+	return fmt.Sprintf("%scloud-nuke-report-%s.json", prefix, startedAt.UTC().Format("20060102T150405Z"))
+}
+
+// NewHandler - Returns the Lambda handler running cloud-nuke aws with the settings read from the environment, and
+// writing the report of each run to S3
+func NewHandler(version string) func(ctx context.Context) (Result, error) {
+	return func(ctx context.Context) (Result, error) {
+		settings, err := SettingsFromEnv(os.Getenv)
+		if err != nil {
+			return Result{}, err
+		}
+
+		startedAt := time.Now()
+		reportPath := filepath.Join(scratchDir, "cloud-nuke-report-"+util.UniqueID()+".json")
+		defer os.Remove(reportPath)
+
+		var maxDuration time.Duration
+		if deadline, hasDeadline := ctx.Deadline(); hasDeadline {
+			maxDuration = runMaxDuration(deadline, startedAt)
+		}
+
+		// The report is uploaded even when the run fails, e.g. because some resources couldn't be nuked or it ran out of
+		// time, as it records what was found before then
+		app := commands.CreateCli(version)
+		runErr := app.Run(cliArgs(settings, reportPath, maxDuration))
+
+		contents, err := ioutil.ReadFile(reportPath)
+		if os.IsNotExist(err) {
+			return Result{}, errors.WithStackTrace(runErr)
+		}
+		if err != nil {
+			return Result{}, errors.WithStackTrace(err)
+		}
+
+		key := reportKey(settings.ReportPrefix, startedAt)
+		if err := uploadReport(ctx, settings.ReportBucket, key, contents); err != nil {
+			if runErr != nil {
+				logging.Logger.Errorf("Unable to write the report to S3: %v", err)
+				return Result{}, errors.WithStackTrace(runErr)
+			}
+			return Result{}, err
+		}
+		location := fmt.Sprintf("s3://%s/%s", settings.ReportBucket, key)
+		logging.Logger.Infof("Wrote the report to %s", location)
+		return Result{ReportLocation: location}, errors.WithStackTrace(runErr)
+	}
+}
+
+// uploadReport - Writes the report to S3, with the credentials of the function rather than the roles assumed to nuke
+func uploadReport(ctx context.Context, bucket string, key string, contents []byte) error {
+	session, err := session.NewSession()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	_, err = s3.New(session).PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      awsgo.String(bucket),
+		Key:         awsgo.String(key),
+		Body:        bytes.NewReader(contents),
+		ContentType: awsgo.String("application/json"),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// MissingSettingError - returned when an environment variable required to run from Lambda isn't set
+type MissingSettingError struct {
+	Name string
+}
+
+func (err MissingSettingError) Error() string {
+	return fmt.Sprintf("Environment variable %s is required to run cloud-nuke from Lambda", err.Name)
+}
@@ -0,0 +1,77 @@
+package lambda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsFromEnv(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{
+		"CLOUD_NUKE_RESOURCE_TYPES":  "ec2, ebs",
+		"CLOUD_NUKE_EXCLUDE_REGIONS": "us-west-1",
+		"CLOUD_NUKE_OLDER_THAN":      "24h",
+		"CLOUD_NUKE_REPORT_BUCKET":   "cloud-nuke-reports",
+		"CLOUD_NUKE_REPORT_PREFIX":   "sandbox/",
+	}
+	settings, err := SettingsFromEnv(func(name string) string { return env[name] })
+	require.NoError(t, err)
+	assert.Equal(t, Settings{
+		ResourceTypes:  []string{"ec2", "ebs"},
+		ExcludeRegions: []string{"us-west-1"},
+		OlderThan:      "24h",
+		ReportBucket:   "cloud-nuke-reports",
+		ReportPrefix:   "sandbox/",
+	}, settings)
+
+	_, err = SettingsFromEnv(func(name string) string { return "" })
+	assert.Equal(t, MissingSettingError{Name: "CLOUD_NUKE_REPORT_BUCKET"}, err)
+}
+
+func TestCliArgs(t *testing.T) {
+	t.Parallel()
+
+	settings := Settings{
+		ConfigPath:    "cloud-nuke.yaml",
+		ResourceTypes: []string{"ec2", "ebs"},
+		OlderThan:     "24h",
+		RoleArns:      []string{"arn:aws:iam::210987654321:role/cloud-nuke"},
+		ReportBucket:  "cloud-nuke-reports",
+	}
+	assert.Equal(t, []string{
+		"cloud-nuke", "aws",
+		"--force",
+		"--auto-tune",
+		"--output-json", "/tmp/report.json",
+		"--throughput-file", "/tmp/cloud-nuke-throughput.json",
+		"--config", "cloud-nuke.yaml",
+		"--resource-type", "ec2",
+		"--resource-type", "ebs",
+		"--role-arn", "arn:aws:iam::210987654321:role/cloud-nuke",
+		"--older-than", "24h",
+	}, cliArgs(settings, "/tmp/report.json", 0))
+
+	assert.Equal(t, []string{"--max-duration", "14m30s"}, cliArgs(settings, "/tmp/report.json", 14*time.Minute+30*time.Second)[18:])
+}
+
+func TestRunMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	assert.Equal(t, 14*time.Minute+30*time.Second, runMaxDuration(now.Add(15*time.Minute), now))
+	assert.Equal(t, 14*time.Minute+29*time.Second, runMaxDuration(now.Add(15*time.Minute-500*time.Millisecond), now))
+	// Even with no time left, there is a maximum duration, as zero would mean none
+	assert.Equal(t, time.Second, runMaxDuration(now.Add(10*time.Second), now))
+}
+
+func TestReportKey(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	assert.Equal(t, "sandbox/cloud-nuke-report-20210304T050607Z.json", reportKey("sandbox/", startedAt))
+	assert.Equal(t, "cloud-nuke-report-20210304T050607Z.json", reportKey("", startedAt))
+}
@@ -0,0 +1,110 @@
+package leaks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// How many snapshots a history keeps. The oldest ones are dropped first.
+const maxSnapshots = 100
+
+// Snapshot - the number of resources of each type found by a run
+type Snapshot struct {
+	TakenAt time.Time      `json:"taken_at"`
+	Counts  map[string]int `json:"counts"`
+}
+
+// History - the snapshots taken by previous runs, oldest first
+type History struct {
+	Snapshots []Snapshot `json:"snapshots"`
+}
+
+// Load - Reads the history stored at the given path. Returns an empty history when there is no file at that path yet.
+func Load(path string) (*History, error) {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &History{}, nil
+	}
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var history History
+	if err := json.Unmarshal(contents, &history); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &history, nil
+}
+
+// Save - Writes the history to the given path, as JSON
+func (history *History) Save(path string) error {
+	contents, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// Add - Appends a snapshot to the history, dropping the oldest snapshots when there are too many
+func (history *History) Add(snapshot Snapshot) {
+	history.Snapshots = append(history.Snapshots, snapshot)
+	if len(history.Snapshots) > maxSnapshots {
+		history.Snapshots = history.Snapshots[len(history.Snapshots)-maxSnapshots:]
+	}
+}
+
+// GrowingResourceTypes - Returns the resource types whose count grew in each of the last runs runs, i.e. over the last
+// runs+1 snapshots. Those are likely leaked by a test suite that doesn't clean up after itself. Resource types missing
+// from a snapshot are counted as 0.
+func (history *History) GrowingResourceTypes(runs int) []string {
+	if runs < 1 || len(history.Snapshots) < runs+1 {
+		return nil
+	}
+	snapshots := history.Snapshots[len(history.Snapshots)-runs-1:]
+
+	resourceTypes := map[string]bool{}
+	for _, snapshot := range snapshots {
+		for resourceType := range snapshot.Counts {
+			resourceTypes[resourceType] = true
+		}
+	}
+
+	var growing []string
+	for resourceType := range resourceTypes {
+		grew := true
+		for i := 1; i < len(snapshots); i++ {
+			if snapshots[i].Counts[resourceType] <= snapshots[i-1].Counts[resourceType] {
+				grew = false
+				break
+			}
+		}
+		if grew {
+			growing = append(growing, resourceType)
+		}
+	}
+
+	sort.Strings(growing)
+	return growing
+}
+
+// Trend - Returns the counts of the resource type over the last runs+1 snapshots, oldest first
+func (history *History) Trend(resourceType string, runs int) []int {
+	first := len(history.Snapshots) - runs - 1
+	if first < 0 {
+		first = 0
+	}
+
+	var counts []int
+	for _, snapshot := range history.Snapshots[first:] {
+		counts = append(counts, snapshot.Counts[resourceType])
+	}
+	return counts
+}
@@ -0,0 +1,71 @@
+package leaks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func historyOf(counts ...map[string]int) *History {
+	history := &History{}
+	for i, snapshotCounts := range counts {
+		history.Add(Snapshot{TakenAt: time.Unix(int64(i), 0), Counts: snapshotCounts})
+	}
+	return history
+}
+
+func TestGrowingResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	history := historyOf(
+		map[string]int{"ec2": 5, "s3": 1, "eip": 1},
+		map[string]int{"ec2": 1, "s3": 2, "eip": 2},
+		map[string]int{"ec2": 2, "s3": 3, "eip": 3, "ecr": 1},
+		map[string]int{"ec2": 3, "s3": 3, "eip": 4, "ecr": 2},
+	)
+
+	// ecr is missing from the first snapshots, so it grew from 0
+	assert.Equal(t, []string{"ec2", "ecr", "eip"}, history.GrowingResourceTypes(2))
+	assert.Equal(t, []string{"eip"}, history.GrowingResourceTypes(3))
+	assert.Empty(t, history.GrowingResourceTypes(4))
+	assert.Equal(t, []int{0, 0, 1, 2}, history.Trend("ecr", 3))
+	assert.Equal(t, []int{5, 1, 2, 3}, history.Trend("ec2", 10))
+}
+
+func TestHistoryKeepsTheLatestSnapshots(t *testing.T) {
+	t.Parallel()
+
+	history := &History{}
+	for i := 0; i < maxSnapshots+5; i++ {
+		history.Add(Snapshot{Counts: map[string]int{"ec2": i}})
+	}
+
+	require.Len(t, history.Snapshots, maxSnapshots)
+	assert.Equal(t, 5, history.Snapshots[0].Counts["ec2"])
+}
+
+func TestHistoryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "cloud-nuke-leaks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "history.json")
+
+	history, err := Load(path)
+	require.NoError(t, err)
+	assert.Empty(t, history.Snapshots)
+
+	takenAt := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	history.Add(Snapshot{TakenAt: takenAt, Counts: map[string]int{"ec2": 3}})
+	require.NoError(t, history.Save(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, history, loaded)
+}
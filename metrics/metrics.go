@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// Count - how many resources of a type were discovered in a region, and what became of them
+type Count struct {
+	ResourceType string
+	Region       string
+	Discovered   int
+	Nuked        int
+	Failed       int
+	// How long discovering the resources took, and nuking them, including the pauses between batches
+	DiscoveryTime time.Duration
+	NukeTime      time.Duration
+}
+
+// Run - the metrics of a run, emitted to the configured sinks once it is over
+type Run struct {
+	// aws, gcp or azure
+	Cloud     string
+	StartedAt time.Time
+	Duration  time.Duration
+	counts    map[Count]*Count
+	errors    map[ErrorCount]*ErrorCount
+}
+
+// ErrorCount - how many errors of a category resources of a type ran into, across regions
+type ErrorCount struct {
+	ResourceType string
+	// e.g. throttling or permission denied
+	Category string
+	Errors   int
+}
+
+// NewRun - Starts recording the metrics of a run in the given cloud
+func NewRun(cloud string) *Run {
+	return &Run{Cloud: cloud, StartedAt: time.Now(), counts: map[Count]*Count{}, errors: map[ErrorCount]*ErrorCount{}}
+}
+
+func (run *Run) count(resourceType string, region string) *Count {
+	key := Count{ResourceType: resourceType, Region: region}
+	if run.counts[key] == nil {
+		run.counts[key] = &Count{ResourceType: resourceType, Region: region}
+	}
+	return run.counts[key]
+}
+
+// AddDiscovered - Records that resources of the given type were discovered in the region, to be nuked
+func (run *Run) AddDiscovered(resourceType string, region string, discovered int) {
+	run.count(resourceType, region).Discovered += discovered
+}
+
+// AddNuked - Records what became of resources of the given type in the region, once they were processed
+func (run *Run) AddNuked(resourceType string, region string, nuked int, failed int) {
+	count := run.count(resourceType, region)
+	count.Nuked += nuked
+	count.Failed += failed
+}
+
+// AddDiscoveryTime - Records how long discovering the resources of the given type in the region took
+func (run *Run) AddDiscoveryTime(resourceType string, region string, elapsed time.Duration) {
+	run.count(resourceType, region).DiscoveryTime += elapsed
+}
+
+// AddNukeTime - Records how long nuking the resources of the given type in the region took
+func (run *Run) AddNukeTime(resourceType string, region string, elapsed time.Duration) {
+	run.count(resourceType, region).NukeTime += elapsed
+}
+
+// AddErrors - Records that resources of the given type ran into errors of the given category
+func (run *Run) AddErrors(resourceType string, category string, errors int) {
+	key := ErrorCount{ResourceType: resourceType, Category: category}
+	if run.errors[key] == nil {
+		run.errors[key] = &ErrorCount{ResourceType: resourceType, Category: category}
+	}
+	run.errors[key].Errors += errors
+}
+
+// ErrorCounts - Returns the error counts of the run, sorted by resource type, then category
+func (run *Run) ErrorCounts() []ErrorCount {
+	errorCounts := []ErrorCount{}
+	for _, errorCount := range run.errors {
+		errorCounts = append(errorCounts, *errorCount)
+	}
+	sort.Slice(errorCounts, func(i, j int) bool {
+		if errorCounts[i].ResourceType != errorCounts[j].ResourceType {
+			return errorCounts[i].ResourceType < errorCounts[j].ResourceType
+		}
+		return errorCounts[i].Category < errorCounts[j].Category
+	})
+	return errorCounts
+}
+
+// Counts - Returns the counts of the run, sorted by resource type, then region
+func (run *Run) Counts() []Count {
+	counts := []Count{}
+	for _, count := range run.counts {
+		counts = append(counts, *count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].ResourceType != counts[j].ResourceType {
+			return counts[i].ResourceType < counts[j].ResourceType
+		}
+		return counts[i].Region < counts[j].Region
+	})
+	return counts
+}
+
+// Sink - where the metrics of the runs are sent to
+type Sink interface {
+	// Name - Returns the name of the sink, for logging
+	Name() string
+	// Emit - Sends the metrics of the run
+	Emit(run *Run) error
+}
+
+// Emit - Ends the run and sends its metrics to each of the sinks. Failing to send metrics doesn't fail the run, so the
+// errors are only logged.
+func Emit(run *Run, sinks []Sink) {
+	run.Duration = time.Since(run.StartedAt)
+	for _, sink := range sinks {
+		if err := sink.Emit(run); err != nil {
+			logging.Logger.Errorf("Failed to send the metrics of the run to %s: %s", sink.Name(), err)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package metrics
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRun() *Run {
+	run := NewRun("aws")
+	run.StartedAt = time.Unix(1600000000, 0)
+	run.Duration = 90 * time.Second
+	run.AddDiscovered("ec2", "us-east-1", 3)
+	run.AddDiscovered("ebs", "us-west-2", 2)
+	run.AddNuked("ec2", "us-east-1", 2, 1)
+	return run
+}
+
+func TestRunCounts(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []Count{
+		{ResourceType: "ebs", Region: "us-west-2", Discovered: 2},
+		{ResourceType: "ec2", Region: "us-east-1", Discovered: 3, Nuked: 2, Failed: 1},
+	}, testRun().Counts())
+}
+
+func TestStatsDSinkEmit(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink := StatsDSink{Address: conn.LocalAddr().String(), Prefix: "cloud_nuke"}
+	require.NoError(t, sink.Emit(testRun()))
+
+	buffer := make([]byte, statsdMaxPacketSize)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buffer)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"cloud_nuke.aws.us-west-2.ebs.discovered:2|c",
+		"cloud_nuke.aws.us-west-2.ebs.nuked:0|c",
+		"cloud_nuke.aws.us-west-2.ebs.failed:0|c",
+		"cloud_nuke.aws.us-east-1.ec2.discovered:3|c",
+		"cloud_nuke.aws.us-east-1.ec2.nuked:2|c",
+		"cloud_nuke.aws.us-east-1.ec2.failed:1|c",
+		"cloud_nuke.aws.run_duration:90000|ms",
+	}, strings.Split(string(buffer[:n]), "\n"))
+}
+
+func TestStatsDSinkName(t *testing.T) {
+	t.Parallel()
+
+	sink := StatsDSink{Prefix: "cloud_nuke"}
+	assert.Equal(t, "cloud_nuke.gcp.europe-west1.gcs_bucket", sink.name("gcp", "europe-west1", "gcs.bucket"))
+}
+
+func TestPushgatewaySinkEmit(t *testing.T) {
+	t.Parallel()
+
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		contents, _ := ioutil.ReadAll(r.Body)
+		body = string(contents)
+	}))
+	defer server.Close()
+
+	require.NoError(t, PushgatewaySink{URL: server.URL + "/"}.Emit(testRun()))
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/metrics/job/cloud-nuke/cloud/aws", path)
+	assert.Contains(t, body, "# TYPE cloud_nuke_resources_discovered gauge\n")
+	assert.Contains(t, body, "cloud_nuke_resources_discovered{resource_type=\"ec2\",region=\"us-east-1\"} 3\n")
+	assert.Contains(t, body, "cloud_nuke_resources_nuked{resource_type=\"ec2\",region=\"us-east-1\"} 2\n")
+	assert.Contains(t, body, "cloud_nuke_resources_failed{resource_type=\"ec2\",region=\"us-east-1\"} 1\n")
+	assert.Contains(t, body, "cloud_nuke_run_duration_seconds 90\n")
+	assert.Contains(t, body, "cloud_nuke_last_run_timestamp_seconds 1600000000\n")
+}
+
+func TestPushgatewaySinkEmitRefused(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	err := PushgatewaySink{URL: server.URL}.Emit(testRun())
+	assert.Equal(t, PushgatewayError{StatusCode: http.StatusBadRequest}, errors.Unwrap(err))
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `a\"b\\c\nd`, escapeLabelValue("a\"b\\c\nd"))
+}
+
+func TestWebhookSinkEmit(t *testing.T) {
+	t.Parallel()
+
+	var contentType string
+	var summary Summary
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		json.NewDecoder(r.Body).Decode(&summary)
+	}))
+	defer server.Close()
+
+	require.NoError(t, WebhookSink{URL: server.URL, Format: WebhookJSONFormat}.Emit(testRun()))
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, Summary{
+		Cloud:               "aws",
+		StartedAt:           "2020-09-13T12:26:40Z",
+		DurationSeconds:     90,
+		Discovered:          5,
+		Nuked:               2,
+		Failed:              1,
+		FailedResourceTypes: []string{"ec2 in us-east-1"},
+	}, summary)
+}
+
+func TestWebhookSinkEmitSlack(t *testing.T) {
+	t.Parallel()
+
+	var message map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&message)
+	}))
+	defer server.Close()
+
+	require.NoError(t, WebhookSink{URL: server.URL, Format: WebhookSlackFormat}.Emit(testRun()))
+	assert.Equal(t, map[string]string{
+		"text": "*cloud-nuke aws run*: 5 resource(s) discovered, 2 nuked, 1 failed, in 1m30s\nFailed to nuke: ec2 in us-east-1",
+	}, message)
+}
+
+func TestWebhookSinkEmitRefused(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	err := WebhookSink{URL: server.URL, Format: WebhookSlackFormat}.Emit(testRun())
+	assert.Equal(t, WebhookError{StatusCode: http.StatusNotFound}, errors.Unwrap(err))
+}
+
+func TestWebhookSinkName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "webhook on hooks.slack.com", WebhookSink{URL: "https://hooks.slack.com/services/T000/B000/secret"}.Name())
+}
+
+func TestRunErrorCounts(t *testing.T) {
+	t.Parallel()
+
+	run := testRun()
+	run.AddErrors("ec2", "throttling", 2)
+	run.AddErrors("ebs", "permission denied", 1)
+	run.AddErrors("ec2", "throttling", 1)
+	assert.Equal(t, []ErrorCount{
+		{ResourceType: "ebs", Category: "permission denied", Errors: 1},
+		{ResourceType: "ec2", Category: "throttling", Errors: 3},
+	}, run.ErrorCounts())
+}
+
+func TestTelemetrySinkEmit(t *testing.T) {
+	t.Parallel()
+
+	run := testRun()
+	run.AddDiscovered("ec2", "us-west-2", 1)
+	run.AddNukeTime("ec2", "us-east-1", 30*time.Second)
+	run.AddNukeTime("ec2", "us-west-2", 15*time.Second)
+	run.AddErrors("ec2", "throttling", 2)
+
+	var payload string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contents, _ := ioutil.ReadAll(r.Body)
+		payload = string(contents)
+	}))
+	defer server.Close()
+
+	require.NoError(t, TelemetrySink{URL: server.URL, Version: "v1.2.3"}.Emit(run))
+
+	// The regions are left out, the counts and durations being summed up across them
+	var telemetry Telemetry
+	require.NoError(t, json.Unmarshal([]byte(payload), &telemetry))
+	assert.Equal(t, Telemetry{
+		Version:         "v1.2.3",
+		Cloud:           "aws",
+		DurationSeconds: 90,
+		ResourceTypes: []TelemetryResourceType{
+			{ResourceType: "ebs", Discovered: 2},
+			{ResourceType: "ec2", Discovered: 4, Nuked: 2, Failed: 1, NukeSeconds: 45},
+		},
+		Errors: []TelemetryErrors{{ResourceType: "ec2", Category: "throttling", Errors: 2}},
+	}, telemetry)
+	assert.NotContains(t, payload, "us-east-1")
+}
+
+func TestTelemetrySinkEmitLocalOnly(t *testing.T) {
+	t.Parallel()
+
+	file, err := ioutil.TempFile("", "cloud-nuke-telemetry")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	sink := TelemetrySink{Path: file.Name(), Version: "v1.2.3"}
+	require.NoError(t, sink.Emit(testRun()))
+	assert.Equal(t, "telemetry file "+file.Name(), sink.Name())
+
+	contents, err := ioutil.ReadFile(file.Name())
+	require.NoError(t, err)
+	var telemetry Telemetry
+	require.NoError(t, json.Unmarshal(contents, &telemetry))
+	assert.Equal(t, "aws", telemetry.Cloud)
+	assert.Len(t, telemetry.ResourceTypes, 2)
+	assert.Equal(t, []TelemetryErrors{}, telemetry.Errors)
+}
+
+func TestTelemetrySinkEmitRefused(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := TelemetrySink{URL: server.URL}.Emit(testRun())
+	assert.Equal(t, TelemetryError{StatusCode: http.StatusServiceUnavailable}, errors.Unwrap(err))
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// pushgatewayJob - the job the metrics are grouped under in the Pushgateway
+const pushgatewayJob = "cloud-nuke"
+
+// PushgatewaySink - pushes the metrics to a Prometheus Pushgateway, grouped by job and cloud, so each run replaces the
+// metrics of the previous run in the same cloud
+type PushgatewaySink struct {
+	// The base URL of the Pushgateway, e.g. http://pushgateway:9091
+	URL string
+}
+
+// PushgatewayError - returned when the Pushgateway refuses the metrics
+type PushgatewayError struct {
+	StatusCode int
+}
+
+func (err PushgatewayError) Error() string {
+	return fmt.Sprintf("The Pushgateway refused the metrics with status %d", err.StatusCode)
+}
+
+// Name - Returns the name of the sink, for logging
+func (sink PushgatewaySink) Name() string {
+	return "Pushgateway " + sink.URL
+}
+
+// Emit - Pushes the counts and duration of the run as gauges
+func (sink PushgatewaySink) Emit(run *Run) error {
+	pushURL := fmt.Sprintf("%s/metrics/job/%s/cloud/%s", strings.TrimSuffix(sink.URL, "/"), pushgatewayJob, url.PathEscape(run.Cloud))
+	request, err := http.NewRequest(http.MethodPut, pushURL, bytes.NewBufferString(exposition(run)))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	request.Header.Set("Content-Type", "text/plain; version=0.0.4")
+
+	client := http.Client{Timeout: 30 * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return errors.WithStackTrace(PushgatewayError{StatusCode: response.StatusCode})
+	}
+	return nil
+}
+
+// exposition - Renders the metrics of the run in the Prometheus text format. The cloud is left out of the labels, as
+// it is part of the grouping key.
+func exposition(run *Run) string {
+	var out strings.Builder
+	gauges := []struct {
+		name  string
+		help  string
+		value func(count Count) int
+	}{
+		{"cloud_nuke_resources_discovered", "Resources discovered to be nuked by the last run.", func(count Count) int { return count.Discovered }},
+		{"cloud_nuke_resources_nuked", "Resources nuked by the last run.", func(count Count) int { return count.Nuked }},
+		{"cloud_nuke_resources_failed", "Resources the last run failed to nuke.", func(count Count) int { return count.Failed }},
+	}
+	counts := run.Counts()
+	for _, gauge := range gauges {
+		fmt.Fprintf(&out, "# HELP %s %s\n# TYPE %s gauge\n", gauge.name, gauge.help, gauge.name)
+		for _, count := range counts {
+			fmt.Fprintf(&out, "%s{resource_type=\"%s\",region=\"%s\"} %d\n", gauge.name, escapeLabelValue(count.ResourceType), escapeLabelValue(count.Region), gauge.value(count))
+		}
+	}
+	fmt.Fprintf(&out, "# HELP cloud_nuke_run_duration_seconds Duration of the last run.\n# TYPE cloud_nuke_run_duration_seconds gauge\n")
+	fmt.Fprintf(&out, "cloud_nuke_run_duration_seconds %g\n", run.Duration.Seconds())
+	fmt.Fprintf(&out, "# HELP cloud_nuke_last_run_timestamp_seconds When the last run started.\n# TYPE cloud_nuke_last_run_timestamp_seconds gauge\n")
+	fmt.Fprintf(&out, "cloud_nuke_last_run_timestamp_seconds %d\n", run.StartedAt.Unix())
+	return out.String()
+}
+
+// escapeLabelValue - Escapes the characters with a meaning in the label values of the Prometheus text format
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
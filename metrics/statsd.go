@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// statsdMaxPacketSize - the largest UDP payload sent at once, which fits in the usual MTU of 1500 bytes
+const statsdMaxPacketSize = 1400
+
+// StatsDSink - sends the metrics to a StatsD server over UDP. StatsD has no labels, so the cloud, region and resource
+// type are part of the metric names, e.g. cloud_nuke.aws.us-east-1.ec2.nuked.
+type StatsDSink struct {
+	// The host:port of the StatsD server
+	Address string
+	// Prepended to the name of all metrics
+	Prefix string
+}
+
+// Name - Returns the name of the sink, for logging
+func (sink StatsDSink) Name() string {
+	return "StatsD server " + sink.Address
+}
+
+// Emit - Sends the counts of the run as counters, and its duration as a timer
+func (sink StatsDSink) Emit(run *Run) error {
+	conn, err := net.Dial("udp", sink.Address)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer conn.Close()
+
+	var packet bytes.Buffer
+	for _, line := range sink.lines(run) {
+		if packet.Len() > 0 && packet.Len()+len(line)+1 > statsdMaxPacketSize {
+			if _, err := conn.Write(packet.Bytes()); err != nil {
+				return errors.WithStackTrace(err)
+			}
+			packet.Reset()
+		}
+		if packet.Len() > 0 {
+			packet.WriteString("\n")
+		}
+		packet.WriteString(line)
+	}
+	if _, err := conn.Write(packet.Bytes()); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// lines - Returns the metrics of the run, in the StatsD line protocol
+func (sink StatsDSink) lines(run *Run) []string {
+	var lines []string
+	for _, count := range run.Counts() {
+		name := sink.name(run.Cloud, count.Region, count.ResourceType)
+		lines = append(lines,
+			fmt.Sprintf("%s.discovered:%d|c", name, count.Discovered),
+			fmt.Sprintf("%s.nuked:%d|c", name, count.Nuked),
+			fmt.Sprintf("%s.failed:%d|c", name, count.Failed),
+		)
+	}
+	lines = append(lines, fmt.Sprintf("%s.run_duration:%d|ms", sink.name(run.Cloud), int64(run.Duration/time.Millisecond)))
+	return lines
+}
+
+// name - Joins the parts of a metric name, replacing the characters StatsD gives a meaning to
+func (sink StatsDSink) name(parts ...string) string {
+	replacer := strings.NewReplacer(".", "_", ":", "_", "|", "_", "@", "_", "\n", "_")
+	sanitized := []string{sink.Prefix}
+	for _, part := range parts {
+		sanitized = append(sanitized, replacer.Replace(part))
+	}
+	return strings.Join(sanitized, ".")
+}
@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// TelemetrySink - reports anonymized, aggregate data about the run once it is over, to help the maintainers find out
+// which resource types fail the most. Only opted into explicitly. The payload is either posted to URL, or, in the
+// local-only mode, written to the file at Path without being sent anywhere.
+type TelemetrySink struct {
+	URL  string
+	Path string
+	// The version of cloud-nuke making the run
+	Version string
+}
+
+// TelemetryError - returned when the telemetry endpoint refuses the payload
+type TelemetryError struct {
+	StatusCode int
+}
+
+func (err TelemetryError) Error() string {
+	return fmt.Sprintf("The telemetry endpoint refused the payload with status %d", err.StatusCode)
+}
+
+// TelemetryResourceType - what became of the resources of a type, across regions
+type TelemetryResourceType struct {
+	ResourceType     string  `json:"resource_type"`
+	Discovered       int     `json:"discovered"`
+	Nuked            int     `json:"nuked"`
+	Failed           int     `json:"failed"`
+	DiscoverySeconds float64 `json:"discovery_seconds"`
+	NukeSeconds      float64 `json:"nuke_seconds"`
+}
+
+// TelemetryErrors - how many errors of a category the resources of a type ran into, across regions
+type TelemetryErrors struct {
+	ResourceType string `json:"resource_type"`
+	Category     string `json:"category"`
+	Errors       int    `json:"errors"`
+}
+
+// Telemetry - the telemetry payload of a run. It only holds resource types, error categories, counts and durations:
+// never the identifiers of the resources, the regions, nor the account, subscription or project ids.
+type Telemetry struct {
+	Version         string                  `json:"version"`
+	Cloud           string                  `json:"cloud"`
+	DurationSeconds float64                 `json:"duration_seconds"`
+	ResourceTypes   []TelemetryResourceType `json:"resource_types"`
+	Errors          []TelemetryErrors       `json:"errors"`
+}
+
+// anonymize - Returns the telemetry payload of the run, with the counts and durations of each resource type summed up
+// across regions
+func anonymize(run *Run, version string) Telemetry {
+	telemetry := Telemetry{
+		Version:         version,
+		Cloud:           run.Cloud,
+		DurationSeconds: run.Duration.Seconds(),
+		ResourceTypes:   []TelemetryResourceType{},
+		Errors:          []TelemetryErrors{},
+	}
+
+	resourceTypes := map[string]*TelemetryResourceType{}
+	for _, count := range run.Counts() {
+		resourceType := resourceTypes[count.ResourceType]
+		if resourceType == nil {
+			resourceType = &TelemetryResourceType{ResourceType: count.ResourceType}
+			resourceTypes[count.ResourceType] = resourceType
+		}
+		resourceType.Discovered += count.Discovered
+		resourceType.Nuked += count.Nuked
+		resourceType.Failed += count.Failed
+		resourceType.DiscoverySeconds += count.DiscoveryTime.Seconds()
+		resourceType.NukeSeconds += count.NukeTime.Seconds()
+	}
+	for _, resourceType := range resourceTypes {
+		telemetry.ResourceTypes = append(telemetry.ResourceTypes, *resourceType)
+	}
+	sort.Slice(telemetry.ResourceTypes, func(i, j int) bool {
+		return telemetry.ResourceTypes[i].ResourceType < telemetry.ResourceTypes[j].ResourceType
+	})
+
+	for _, errorCount := range run.ErrorCounts() {
+		telemetry.Errors = append(telemetry.Errors, TelemetryErrors(errorCount))
+	}
+	return telemetry
+}
+
+// Name - Returns the name of the sink, for logging
+func (sink TelemetrySink) Name() string {
+	if sink.Path != "" {
+		return "telemetry file " + sink.Path
+	}
+	telemetryURL, err := url.Parse(sink.URL)
+	if err != nil {
+		return "telemetry endpoint"
+	}
+	return "telemetry endpoint on " + telemetryURL.Host
+}
+
+// Emit - Posts the telemetry payload of the run, or writes it to the file of the local-only mode
+func (sink TelemetrySink) Emit(run *Run) error {
+	contents, err := json.MarshalIndent(anonymize(run, sink.Version), "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if sink.Path != "" {
+		if err := ioutil.WriteFile(sink.Path, contents, 0644); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		return nil
+	}
+
+	client := http.Client{Timeout: 30 * time.Second}
+	response, err := client.Post(sink.URL, "application/json", bytes.NewBuffer(contents))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return errors.WithStackTrace(TelemetryError{StatusCode: response.StatusCode})
+	}
+	return nil
+}
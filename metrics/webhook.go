@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// WebhookFormat - the payload posted to a webhook
+type WebhookFormat string
+
+const (
+	// WebhookJSONFormat - the summary of the run as a JSON object, for tools to consume
+	WebhookJSONFormat WebhookFormat = "json"
+	// WebhookSlackFormat - the summary of the run as a Slack message, for Slack incoming webhooks
+	WebhookSlackFormat WebhookFormat = "slack"
+)
+
+// WebhookFormats - the supported webhook formats
+var WebhookFormats = []WebhookFormat{WebhookJSONFormat, WebhookSlackFormat}
+
+// WebhookSink - posts a summary of the run to a webhook once it is over, e.g. so that a team channel sees the outcome
+// of nightly cleanups without parsing logs
+type WebhookSink struct {
+	URL    string
+	Format WebhookFormat
+}
+
+// WebhookError - returned when the webhook refuses the summary
+type WebhookError struct {
+	StatusCode int
+}
+
+func (err WebhookError) Error() string {
+	return fmt.Sprintf("The webhook refused the summary of the run with status %d", err.StatusCode)
+}
+
+// Summary - the summary of a run, as posted in the JSON format
+type Summary struct {
+	Cloud           string  `json:"cloud"`
+	StartedAt       string  `json:"started_at"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Discovered      int     `json:"discovered"`
+	Nuked           int     `json:"nuked"`
+	Failed          int     `json:"failed"`
+	// The resource types that failed to be nuked in some region, e.g. ec2 in us-east-1
+	FailedResourceTypes []string `json:"failed_resource_types"`
+}
+
+// summarize - Returns the summary of the run
+func summarize(run *Run) Summary {
+	summary := Summary{
+		Cloud:               run.Cloud,
+		StartedAt:           run.StartedAt.UTC().Format(time.RFC3339),
+		DurationSeconds:     run.Duration.Seconds(),
+		FailedResourceTypes: []string{},
+	}
+	for _, count := range run.Counts() {
+		summary.Discovered += count.Discovered
+		summary.Nuked += count.Nuked
+		summary.Failed += count.Failed
+		if count.Failed > 0 {
+			summary.FailedResourceTypes = append(summary.FailedResourceTypes, fmt.Sprintf("%s in %s", count.ResourceType, count.Region))
+		}
+	}
+	return summary
+}
+
+// slackMessage - Renders the summary as the text of a Slack message
+func (summary Summary) slackMessage() string {
+	text := fmt.Sprintf("*cloud-nuke %s run*: %d resource(s) discovered, %d nuked, %d failed, in %s",
+		summary.Cloud, summary.Discovered, summary.Nuked, summary.Failed, time.Duration(summary.DurationSeconds*float64(time.Second)).Round(time.Second))
+	if len(summary.FailedResourceTypes) > 0 {
+		text += "\nFailed to nuke: " + strings.Join(summary.FailedResourceTypes, ", ")
+	}
+	return text
+}
+
+// Name - Returns the name of the sink, for logging. Only the host of the URL is logged, as the URLs of webhooks, e.g.
+// the ones of Slack, usually hold a secret.
+func (sink WebhookSink) Name() string {
+	webhookURL, err := url.Parse(sink.URL)
+	if err != nil {
+		return "webhook"
+	}
+	return "webhook on " + webhookURL.Host
+}
+
+// Emit - Posts the summary of the run in the format of the sink
+func (sink WebhookSink) Emit(run *Run) error {
+	summary := summarize(run)
+	var payload interface{} = summary
+	if sink.Format == WebhookSlackFormat {
+		payload = map[string]string{"text": summary.slackMessage()}
+	}
+	contents, err := json.Marshal(payload)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	client := http.Client{Timeout: 30 * time.Second}
+	response, err := client.Post(sink.URL, "application/json", bytes.NewBuffer(contents))
+	if err != nil {
+		// Leave the URL, and the secret it may hold, out of the error
+		if urlErr, isURLErr := err.(*url.Error); isURLErr {
+			err = urlErr.Err
+		}
+		return errors.WithStackTrace(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return errors.WithStackTrace(WebhookError{StatusCode: response.StatusCode})
+	}
+	return nil
+}
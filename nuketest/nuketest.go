@@ -0,0 +1,67 @@
+// Package nuketest contains helpers to use cloud-nuke from Terratest suites. Tag every resource a test creates with a
+// unique test run ID, then defer one of these helpers so that only what the test itself created gets nuked, even if
+// the test fails halfway through and never gets to run its own cleanup.
+//
+//	uniqueID := random.UniqueId()
+//	defer nuketest.NukeTaggedResourcesOnFailure(t, "TestRunId", uniqueID)
+package nuketest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// NukeTaggedResources nukes all resources, across all enabled regions and supported resource types, that are tagged
+// with the given key and test run ID, and waits until they are deleted. The test fails if anything goes wrong.
+func NukeTaggedResources(t *testing.T, tagKey string, testRunID string) {
+	if err := NukeTaggedResourcesE(t, tagKey, testRunID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// NukeTaggedResourcesE nukes all resources, across all enabled regions and supported resource types, that are tagged
+// with the given key and test run ID, and waits until they are deleted.
+func NukeTaggedResourcesE(t *testing.T, tagKey string, testRunID string) error {
+	regions, err := aws.GetEnabledRegions()
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	account, err := aws.GetAllResourcesWithTag(regions, tagKey, testRunID)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if len(account.Resources) == 0 {
+		logging.Logger.Infof("No resources tagged with %s=%s left behind by %s", tagKey, testRunID, t.Name())
+		return nil
+	}
+
+	for region, resourcesInRegion := range account.Resources {
+		for _, resources := range resourcesInRegion.Resources {
+			for _, identifier := range resources.ResourceIdentifiers() {
+				logging.Logger.Infof("* %s-%s-%s\n", resources.ResourceName(), identifier, region)
+			}
+		}
+	}
+
+	// Wait for the deletions to complete, so that the test doesn't move on, or exit, while resources it created are
+	// still being torn down
+	return aws.NukeAllResources(context.Background(), account, regions, true, aws.NukeTimeouts{}, aws.FailurePolicies{}, nil)
+}
+
+// NukeTaggedResourcesOnFailure behaves like NukeTaggedResources, but only nukes when the test has failed. Successful
+// runs are expected to clean up after themselves (e.g. through terraform destroy), so this only kicks in to catch what
+// a failed run left behind.
+func NukeTaggedResourcesOnFailure(t *testing.T, tagKey string, testRunID string) {
+	if !t.Failed() {
+		return
+	}
+
+	logging.Logger.Infof("%s failed, nuking resources tagged with %s=%s", t.Name(), tagKey, testRunID)
+	NukeTaggedResources(t, tagKey, testRunID)
+}
@@ -0,0 +1,209 @@
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// Format - how the progress of a run is reported
+type Format string
+
+const (
+	// TextFormat - progress is logged along with the other log lines
+	TextFormat Format = "text"
+	// JSONFormat - progress is written as a JSON object per line, for tools to follow the run
+	JSONFormat Format = "json"
+)
+
+// Formats - the supported progress formats
+var Formats = []Format{TextFormat, JSONFormat}
+
+// Update - how far along the nuking is, as written in the JSON format after each batch
+type Update struct {
+	// The resource type and region of the batch just processed
+	ResourceType string `json:"resource_type"`
+	Region       string `json:"region"`
+	// How many resources have been processed so far, and how many of them couldn't be nuked
+	Nuked  int `json:"nuked"`
+	Failed int `json:"failed"`
+	Total  int `json:"total"`
+	// Time elapsed since the nuking started, and estimated time remaining, in seconds
+	ElapsedSeconds   int64 `json:"elapsed_seconds"`
+	RemainingSeconds int64 `json:"remaining_seconds"`
+}
+
+// Reporter - reports the progress of the nuking, along with an estimated time remaining. A nil reporter reports
+// nothing.
+type Reporter struct {
+	format Format
+	// Where the JSON format is written to
+	out io.Writer
+	now func() time.Time
+
+	mutex     sync.Mutex
+	startedAt time.Time
+	// When the last batch was processed, or the nuking started
+	advancedAt time.Time
+	total      int
+	nuked      int
+	failed     int
+	counts     map[Count]*Count
+	// Notified of the batches processed through AdvanceBatch
+	batchListener BatchListener
+}
+
+// BatchListener - notified of the identifiers of each batch of resources processed, e.g. to record which resources
+// were nuked
+type BatchListener func(resourceType string, region string, identifiers []string, failed bool)
+
+// Count - how many resources of a type have been processed in a region, and how many of them couldn't be nuked
+type Count struct {
+	ResourceType string
+	Region       string
+	Processed    int
+	Failed       int
+	// The time spent processing them, including the pauses between batches
+	Elapsed time.Duration
+}
+
+// NewReporter - Returns a reporter in the given format. The JSON format is written to out.
+func NewReporter(format Format, out io.Writer) *Reporter {
+	return &Reporter{format: format, out: out, now: time.Now, counts: map[Count]*Count{}}
+}
+
+// Start - Starts reporting the progress of the nuking of the given number of resources
+func (reporter *Reporter) Start(total int) {
+	if reporter == nil {
+		return
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	reporter.startedAt = reporter.now()
+	reporter.advancedAt = reporter.startedAt
+	reporter.total = total
+	reporter.nuked = 0
+	reporter.failed = 0
+	reporter.counts = map[Count]*Count{}
+}
+
+// Advance - Reports that a batch of resources of the given type and region has been processed, failures included
+func (reporter *Reporter) Advance(resourceType string, region string, processed int, failed int) {
+	if reporter == nil {
+		return
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	reporter.nuked += processed
+	reporter.failed += failed
+	key := Count{ResourceType: resourceType, Region: region}
+	if reporter.counts[key] == nil {
+		reporter.counts[key] = &Count{ResourceType: resourceType, Region: region}
+	}
+	reporter.counts[key].Processed += processed
+	reporter.counts[key].Failed += failed
+	advancedAt := reporter.now()
+	reporter.counts[key].Elapsed += advancedAt.Sub(reporter.advancedAt)
+	reporter.advancedAt = advancedAt
+	update := reporter.update(resourceType, region)
+
+	if reporter.format == JSONFormat {
+		contents, err := json.Marshal(update)
+		if err != nil {
+			logging.Logger.Errorf("Failed to report progress: %s", err)
+			return
+		}
+		fmt.Fprintln(reporter.out, string(contents))
+		return
+	}
+	logging.Logger.Infoln(update.String())
+}
+
+// OnBatch - Registers the listener notified of each batch processed through AdvanceBatch
+func (reporter *Reporter) OnBatch(listener BatchListener) {
+	if reporter == nil {
+		return
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	reporter.batchListener = listener
+}
+
+// AdvanceBatch - Reports that the batch of resources with the given identifiers has been processed, and either nuked
+// or failed altogether
+func (reporter *Reporter) AdvanceBatch(resourceType string, region string, identifiers []string, failed bool) {
+	if reporter == nil {
+		return
+	}
+	failures := 0
+	if failed {
+		failures = len(identifiers)
+	}
+	reporter.Advance(resourceType, region, len(identifiers), failures)
+
+	reporter.mutex.Lock()
+	listener := reporter.batchListener
+	reporter.mutex.Unlock()
+	if listener != nil {
+		listener(resourceType, region, identifiers, failed)
+	}
+}
+
+// Counts - Returns how many resources have been processed so far per resource type and region, sorted by resource
+// type, then region
+func (reporter *Reporter) Counts() []Count {
+	if reporter == nil {
+		return nil
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	counts := []Count{}
+	for _, count := range reporter.counts {
+		counts = append(counts, *count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].ResourceType != counts[j].ResourceType {
+			return counts[i].ResourceType < counts[j].ResourceType
+		}
+		return counts[i].Region < counts[j].Region
+	})
+	return counts
+}
+
+// update - Returns the progress so far. The time remaining is extrapolated from the pace of the resources processed.
+func (reporter *Reporter) update(resourceType string, region string) Update {
+	elapsed := reporter.now().Sub(reporter.startedAt)
+	var remaining time.Duration
+	if reporter.nuked > 0 && reporter.nuked < reporter.total {
+		remaining = time.Duration(float64(elapsed) / float64(reporter.nuked) * float64(reporter.total-reporter.nuked))
+	}
+	return Update{
+		ResourceType:     resourceType,
+		Region:           region,
+		Nuked:            reporter.nuked,
+		Failed:           reporter.failed,
+		Total:            reporter.total,
+		ElapsedSeconds:   int64(elapsed.Round(time.Second) / time.Second),
+		RemainingSeconds: int64(remaining.Round(time.Second) / time.Second),
+	}
+}
+
+// String - Renders the update on a single line, e.g. Nuked 40 of 120 resources (ec2, us-east-1), about 2m30s remaining
+func (update Update) String() string {
+	line := fmt.Sprintf("Nuked %d of %d resources (%s, %s)", update.Nuked, update.Total, update.ResourceType, update.Region)
+	if update.Failed > 0 {
+		line += fmt.Sprintf(", %d failed", update.Failed)
+	}
+	if update.Nuked < update.Total {
+		line += fmt.Sprintf(", about %s remaining", time.Duration(update.RemainingSeconds)*time.Second)
+	}
+	return line
+}
@@ -0,0 +1,117 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock - a clock that only moves forward when told to
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func TestReporterJSON(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	clock := &fakeClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	reporter := NewReporter(JSONFormat, &out)
+	reporter.now = clock.Now
+
+	reporter.Start(10)
+	clock.now = clock.now.Add(30 * time.Second)
+	reporter.Advance("ec2", "us-east-1", 2, 0)
+	clock.now = clock.now.Add(90 * time.Second)
+	reporter.Advance("ebs", "us-west-2", 8, 1)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var update Update
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &update))
+	assert.Equal(t, Update{ResourceType: "ec2", Region: "us-east-1", Nuked: 2, Total: 10, ElapsedSeconds: 30, RemainingSeconds: 120}, update)
+
+	update = Update{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &update))
+	assert.Equal(t, Update{ResourceType: "ebs", Region: "us-west-2", Nuked: 10, Failed: 1, Total: 10, ElapsedSeconds: 120}, update)
+}
+
+func TestUpdateString(t *testing.T) {
+	t.Parallel()
+
+	update := Update{ResourceType: "ec2", Region: "us-east-1", Nuked: 40, Total: 120, RemainingSeconds: 150}
+	assert.Equal(t, "Nuked 40 of 120 resources (ec2, us-east-1), about 2m30s remaining", update.String())
+
+	update = Update{ResourceType: "gcsbucket", Region: "global", Nuked: 120, Failed: 2, Total: 120}
+	assert.Equal(t, "Nuked 120 of 120 resources (gcsbucket, global), 2 failed", update.String())
+}
+
+func TestNilReporter(t *testing.T) {
+	t.Parallel()
+
+	var reporter *Reporter
+	reporter.Start(1)
+	reporter.Advance("ec2", "us-east-1", 1, 0)
+	reporter.OnBatch(func(string, string, []string, bool) {})
+	reporter.AdvanceBatch("ec2", "us-east-1", []string{"i-123"}, false)
+}
+
+func TestReporterCounts(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	reporter := NewReporter(JSONFormat, &bytes.Buffer{})
+	reporter.now = clock.Now
+
+	reporter.Start(6)
+	clock.now = clock.now.Add(10 * time.Second)
+	reporter.Advance("ec2", "us-west-2", 1, 0)
+	clock.now = clock.now.Add(20 * time.Second)
+	reporter.Advance("ebs", "us-east-1", 2, 1)
+	clock.now = clock.now.Add(5 * time.Second)
+	reporter.Advance("ec2", "us-west-2", 3, 0)
+
+	assert.Equal(t, []Count{
+		{ResourceType: "ebs", Region: "us-east-1", Processed: 2, Failed: 1, Elapsed: 20 * time.Second},
+		{ResourceType: "ec2", Region: "us-west-2", Processed: 4, Elapsed: 15 * time.Second},
+	}, reporter.Counts())
+}
+
+func TestReporterBatchListener(t *testing.T) {
+	t.Parallel()
+
+	reporter := NewReporter(JSONFormat, &bytes.Buffer{})
+	var batches []string
+	reporter.OnBatch(func(resourceType string, region string, identifiers []string, failed bool) {
+		batches = append(batches, fmt.Sprintf("%s %s %s %t", resourceType, region, strings.Join(identifiers, ","), failed))
+	})
+
+	reporter.Start(3)
+	reporter.AdvanceBatch("ec2", "us-east-1", []string{"i-123", "i-456"}, false)
+	reporter.AdvanceBatch("ebs", "us-west-2", []string{"vol-123"}, true)
+
+	assert.Equal(t, []string{"ec2 us-east-1 i-123,i-456 false", "ebs us-west-2 vol-123 true"}, batches)
+	assert.Equal(t, []Count{
+		{ResourceType: "ebs", Region: "us-west-2", Processed: 1, Failed: 1},
+		{ResourceType: "ec2", Region: "us-east-1", Processed: 2},
+	}, removeElapsed(reporter.Counts()))
+}
+
+// removeElapsed - Returns the counts without the time spent on them, which depends on the clock
+func removeElapsed(counts []Count) []Count {
+	for i := range counts {
+		counts[i].Elapsed = 0
+	}
+	return counts
+}
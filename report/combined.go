@@ -0,0 +1,62 @@
+package report
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// AccountOutcome - what a run over several accounts found in one of them
+type AccountOutcome struct {
+	// The role assumed to reach the account
+	RoleArn string `json:"role_arn"`
+	// Left out when the role couldn't be assumed, or the run failed before looking up the account
+	Account   *Account   `json:"account,omitempty"`
+	Resources []Resource `json:"resources"`
+	// Why the run failed in the account. Left out when it didn't.
+	Error string `json:"error,omitempty"`
+}
+
+// CombinedReport - the resources found by a run over several accounts, as written with --output-json
+type CombinedReport struct {
+	Cloud       string           `json:"cloud"`
+	GeneratedAt string           `json:"generated_at"`
+	Accounts    []AccountOutcome `json:"accounts"`
+}
+
+// NewCombined - Returns an empty combined report for the given cloud
+func NewCombined(cloud string) *CombinedReport {
+	return &CombinedReport{
+		Cloud:       cloud,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Accounts:    []AccountOutcome{},
+	}
+}
+
+// AddAccount - Adds the outcome of the run in the account reached through the given role. The report is nil when the
+// run failed before finding any resources, and err is nil when the run didn't fail.
+func (combined *CombinedReport) AddAccount(roleArn string, report *Report, err error) {
+	outcome := AccountOutcome{RoleArn: roleArn, Resources: []Resource{}}
+	if report != nil {
+		outcome.Account = report.Account
+		outcome.Resources = report.Resources
+	}
+	if err != nil {
+		outcome.Error = err.Error()
+	}
+	combined.Accounts = append(combined.Accounts, outcome)
+}
+
+// WriteJSON - Writes the combined report to the file at the given path, as JSON
+func (combined *CombinedReport) WriteJSON(path string) error {
+	contents, err := json.MarshalIndent(combined, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombinedReport(t *testing.T) {
+	t.Parallel()
+
+	accountReport := New("aws")
+	accountReport.Account = &Account{ID: "123456789012", Alias: "sandbox-1"}
+	accountReport.AddResource("ec2", "i-123", "us-east-1", time.Time{})
+
+	combined := NewCombined("aws")
+	combined.AddAccount("arn:aws:iam::123456789012:role/nuke", accountReport, nil)
+	combined.AddAccount("arn:aws:iam::210987654321:role/nuke", nil, fmt.Errorf("access denied"))
+
+	file, err := ioutil.TempFile("", "cloud-nuke-combined-*.json")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+	require.NoError(t, combined.WriteJSON(file.Name()))
+
+	contents, err := ioutil.ReadFile(file.Name())
+	require.NoError(t, err)
+	var read CombinedReport
+	require.NoError(t, json.Unmarshal(contents, &read))
+
+	assert.Equal(t, "aws", read.Cloud)
+	assert.Equal(t, []AccountOutcome{
+		{
+			RoleArn:   "arn:aws:iam::123456789012:role/nuke",
+			Account:   &Account{ID: "123456789012", Alias: "sandbox-1"},
+			Resources: []Resource{{ResourceType: "ec2", Identifier: "i-123", Region: "us-east-1"}},
+		},
+		{
+			RoleArn:   "arn:aws:iam::210987654321:role/nuke",
+			Resources: []Resource{},
+			Error:     "access denied",
+		},
+	}, read.Accounts)
+}
@@ -0,0 +1,135 @@
+package report
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The team the resources that don't carry the team tag are reported under
+const UntaggedTeam = "(untagged)"
+
+// OwnedResource - a resource, along with the values of its owner and team tags
+type OwnedResource struct {
+	ResourceType string `json:"resource_type"`
+	Identifier   string `json:"identifier"`
+	Region       string `json:"region"`
+	Owner        string `json:"owner,omitempty"`
+	Team         string `json:"team,omitempty"`
+}
+
+// TeamOwnership - what a team owns
+type TeamOwnership struct {
+	Team string `json:"team"`
+	// The distinct values of the owner tag among the resources of the team
+	Owners         []string       `json:"owners"`
+	ResourceCounts map[string]int `json:"resource_counts"`
+	TotalResources int            `json:"total_resources"`
+	// The month-to-date cost of the team, in USD. Left out when costs weren't looked up.
+	MonthToDateCost *float64 `json:"month_to_date_cost,omitempty"`
+}
+
+// OwnershipReport - the resources found by a run, broken down by the team owning them
+type OwnershipReport struct {
+	Cloud       string `json:"cloud"`
+	GeneratedAt string `json:"generated_at"`
+	// Left out when the account is unknown
+	Account     *Account        `json:"account,omitempty"`
+	OwnerTagKey string          `json:"owner_tag_key"`
+	TeamTagKey  string          `json:"team_tag_key"`
+	Teams       []TeamOwnership `json:"teams"`
+	Resources   []OwnedResource `json:"resources"`
+}
+
+// NewOwnership - Returns the ownership report of the given resources. The costs, when not nil, are indexed by the
+// value of the team tag, with the cost of the untagged resources under the empty value. Teams are sorted by the
+// number of resources they own, most first.
+func NewOwnership(cloud string, ownerTagKey string, teamTagKey string, resources []OwnedResource, costs map[string]float64) *OwnershipReport {
+	teams := map[string]*TeamOwnership{}
+	owners := map[string]map[string]bool{}
+
+	addTeam := func(name string) *TeamOwnership {
+		if team, ok := teams[name]; ok {
+			return team
+		}
+		team := &TeamOwnership{Team: name, Owners: []string{}, ResourceCounts: map[string]int{}}
+		teams[name] = team
+		owners[name] = map[string]bool{}
+		return team
+	}
+
+	for _, resource := range resources {
+		name := resource.Team
+		if name == "" {
+			name = UntaggedTeam
+		}
+		team := addTeam(name)
+		team.ResourceCounts[resource.ResourceType]++
+		team.TotalResources++
+		if resource.Owner != "" && !owners[name][resource.Owner] {
+			owners[name][resource.Owner] = true
+			team.Owners = append(team.Owners, resource.Owner)
+		}
+	}
+
+	// Teams can accrue costs without owning any of the resources found, e.g. through resource types cloud-nuke
+	// doesn't support
+	if costs != nil {
+		for name, cost := range costs {
+			if name == "" {
+				name = UntaggedTeam
+			}
+			cost := cost
+			team := addTeam(name)
+			if team.MonthToDateCost != nil {
+				cost += *team.MonthToDateCost
+			}
+			team.MonthToDateCost = &cost
+		}
+		for _, team := range teams {
+			if team.MonthToDateCost == nil {
+				zero := 0.0
+				team.MonthToDateCost = &zero
+			}
+		}
+	}
+
+	report := &OwnershipReport{
+		Cloud:       cloud,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		OwnerTagKey: ownerTagKey,
+		TeamTagKey:  teamTagKey,
+		Teams:       []TeamOwnership{},
+		Resources:   resources,
+	}
+	if report.Resources == nil {
+		report.Resources = []OwnedResource{}
+	}
+	for _, team := range teams {
+		sort.Strings(team.Owners)
+		report.Teams = append(report.Teams, *team)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool {
+		if report.Teams[i].TotalResources != report.Teams[j].TotalResources {
+			return report.Teams[i].TotalResources > report.Teams[j].TotalResources
+		}
+		return report.Teams[i].Team < report.Teams[j].Team
+	})
+
+	return report
+}
+
+// WriteJSON - Writes the ownership report to the file at the given path, as JSON
+func (report *OwnershipReport) WriteJSON(path string) error {
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOwnedResources = []OwnedResource{
+	{ResourceType: "ec2", Identifier: "i-1", Region: "us-east-1", Owner: "alice", Team: "platform"},
+	{ResourceType: "ec2", Identifier: "i-2", Region: "us-east-1", Owner: "bob", Team: "platform"},
+	{ResourceType: "ebs", Identifier: "vol-1", Region: "eu-west-1", Owner: "alice", Team: "platform"},
+	{ResourceType: "ec2", Identifier: "i-3", Region: "us-east-1", Owner: "carol", Team: "data"},
+	{ResourceType: "vpc", Identifier: "vpc-1", Region: "us-east-1", Owner: "dave"},
+}
+
+func TestNewOwnership(t *testing.T) {
+	t.Parallel()
+
+	ownership := NewOwnership("aws", "owner", "team", testOwnedResources, nil)
+
+	assert.Equal(t, "owner", ownership.OwnerTagKey)
+	assert.Equal(t, "team", ownership.TeamTagKey)
+	assert.Equal(t, testOwnedResources, ownership.Resources)
+	assert.Equal(t, []TeamOwnership{
+		{Team: "platform", Owners: []string{"alice", "bob"}, ResourceCounts: map[string]int{"ec2": 2, "ebs": 1}, TotalResources: 3},
+		{Team: UntaggedTeam, Owners: []string{"dave"}, ResourceCounts: map[string]int{"vpc": 1}, TotalResources: 1},
+		{Team: "data", Owners: []string{"carol"}, ResourceCounts: map[string]int{"ec2": 1}, TotalResources: 1},
+	}, ownership.Teams)
+}
+
+func TestNewOwnershipWithCosts(t *testing.T) {
+	t.Parallel()
+
+	costs := map[string]float64{"platform": 120.5, "": 10, "security": 3.25}
+	ownership := NewOwnership("aws", "owner", "team", testOwnedResources, costs)
+
+	costOf := map[string]float64{}
+	for _, team := range ownership.Teams {
+		require.NotNil(t, team.MonthToDateCost, team.Team)
+		costOf[team.Team] = *team.MonthToDateCost
+	}
+	assert.Equal(t, map[string]float64{"platform": 120.5, UntaggedTeam: 10, "data": 0, "security": 3.25}, costOf)
+
+	// Teams that only accrue costs don't own any of the resources found, so they come last
+	assert.Equal(t, "security", ownership.Teams[3].Team)
+	assert.Equal(t, 0, ownership.Teams[3].TotalResources)
+}
+
+func TestNewOwnershipWithoutResources(t *testing.T) {
+	t.Parallel()
+
+	ownership := NewOwnership("aws", "owner", "team", nil, nil)
+	assert.Equal(t, []TeamOwnership{}, ownership.Teams)
+	assert.Equal(t, []OwnedResource{}, ownership.Resources)
+}
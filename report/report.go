@@ -0,0 +1,157 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// Resource - a resource found by a run
+type Resource struct {
+	// The subscription or project the resource belongs to, in the plans of Azure and GCP runs, which can span several
+	// of them. Left out of AWS reports, whose resources all belong to the account of the report.
+	Scope        string `json:"scope,omitempty"`
+	ResourceType string `json:"resource_type"`
+	Identifier   string `json:"identifier"`
+	Region       string `json:"region"`
+	// When the resource was created, in RFC3339. Left out when the resource type doesn't expose it.
+	CreatedAt string `json:"created_at,omitempty"`
+	// How far the nuking of the resource got, as recorded in the state file of a run. Left out of the other reports.
+	Status string `json:"status,omitempty"`
+}
+
+// SkippedResource - a resource found by a run but left alone, along with why, e.g. an EC2 instance with termination
+// protection enabled
+type SkippedResource struct {
+	ResourceType string `json:"resource_type"`
+	Identifier   string `json:"identifier"`
+	Region       string `json:"region"`
+	Reason       string `json:"reason"`
+}
+
+// Account - the account a run was made in, so the reports of many accounts can be told apart at a glance. Only the id
+// is always known.
+type Account struct {
+	ID     string `json:"id"`
+	Alias  string `json:"alias,omitempty"`
+	Email  string `json:"email,omitempty"`
+	OUPath string `json:"ou_path,omitempty"`
+}
+
+// Summary - Renders the account on a single line, e.g. 123456789012 (sandbox-42, sandbox-42@example.com, Root/Sandboxes)
+func (account Account) Summary() string {
+	var details []string
+	for _, detail := range []string{account.Alias, account.Email, account.OUPath} {
+		if detail != "" {
+			details = append(details, detail)
+		}
+	}
+	if len(details) == 0 {
+		return account.ID
+	}
+	return fmt.Sprintf("%s (%s)", account.ID, strings.Join(details, ", "))
+}
+
+// ManualAction - a commitment to physical hardware found by a run, which can't be nuked and needs someone to act on it,
+// e.g. a Snowball job
+type ManualAction struct {
+	Kind       string `json:"kind"`
+	Identifier string `json:"identifier"`
+	Region     string `json:"region"`
+	State      string `json:"state"`
+	Action     string `json:"action"`
+}
+
+// Report - the resources found by a run, as written with --output-json
+type Report struct {
+	Cloud       string `json:"cloud"`
+	GeneratedAt string `json:"generated_at"`
+	// Left out when the account is unknown
+	Account   *Account   `json:"account,omitempty"`
+	Resources []Resource `json:"resources"`
+	// Left out when there are none
+	Skipped []SkippedResource `json:"skipped,omitempty"`
+	// Left out when there are none
+	ManualActions []ManualAction `json:"manual_actions,omitempty"`
+}
+
+// New - Returns an empty report for the given cloud
+func New(cloud string) *Report {
+	return &Report{
+		Cloud:       cloud,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Resources:   []Resource{},
+	}
+}
+
+// AddResource - Adds a resource to the report. The creation time is left out when zero.
+func (report *Report) AddResource(resourceType string, identifier string, region string, createdAt time.Time) {
+	resource := Resource{
+		ResourceType: resourceType,
+		Identifier:   identifier,
+		Region:       region,
+	}
+	if !createdAt.IsZero() {
+		resource.CreatedAt = createdAt.UTC().Format(time.RFC3339)
+	}
+	report.Resources = append(report.Resources, resource)
+}
+
+// AddSkipped - Adds a resource left alone to the report, along with why
+func (report *Report) AddSkipped(resourceType string, identifier string, region string, reason string) {
+	report.Skipped = append(report.Skipped, SkippedResource{
+		ResourceType: resourceType,
+		Identifier:   identifier,
+		Region:       region,
+		Reason:       reason,
+	})
+}
+
+// WriteJSON - Writes the report to the file at the given path, as JSON
+func (report *Report) WriteJSON(path string) error {
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// ReadJSON - Reads back a report written with WriteJSON, e.g. a plan to nuke
+func ReadJSON(path string) (*Report, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	var report Report
+	if err := json.Unmarshal(contents, &report); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return &report, nil
+}
+
+// FormatAge - Renders an age in a human friendly way, e.g. 3d4h, 5h12m or 7m
+func FormatAge(age time.Duration) string {
+	if age < 0 {
+		age = 0
+	}
+
+	days := int(age.Hours()) / 24
+	hours := int(age.Hours()) % 24
+	minutes := int(age.Minutes()) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
@@ -0,0 +1,90 @@
+package report
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatAge(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "3d4h", FormatAge(76*time.Hour+30*time.Minute))
+	assert.Equal(t, "1d0h", FormatAge(24*time.Hour))
+	assert.Equal(t, "5h12m", FormatAge(5*time.Hour+12*time.Minute+30*time.Second))
+	assert.Equal(t, "7m", FormatAge(7*time.Minute+59*time.Second))
+	assert.Equal(t, "0m", FormatAge(-1*time.Minute))
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 3, 4, 5, 6, 7, 8, time.FixedZone("CET", 3600))
+	runReport := New("aws")
+	runReport.Account = &Account{ID: "123456789012", Alias: "sandbox-42"}
+	runReport.AddResource("ec2", "i-123", "us-east-1", createdAt)
+	runReport.AddResource("vpc", "vpc-123", "us-east-1", time.Time{})
+	runReport.AddSkipped("ec2", "i-456", "us-east-1", "termination protection is enabled")
+
+	file, err := ioutil.TempFile("", "cloud-nuke-report-*.json")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	require.NoError(t, runReport.WriteJSON(file.Name()))
+
+	contents, err := ioutil.ReadFile(file.Name())
+	require.NoError(t, err)
+
+	var written Report
+	require.NoError(t, json.Unmarshal(contents, &written))
+	assert.Equal(t, "aws", written.Cloud)
+	assert.Equal(t, &Account{ID: "123456789012", Alias: "sandbox-42"}, written.Account)
+	assert.Equal(t, []Resource{
+		{ResourceType: "ec2", Identifier: "i-123", Region: "us-east-1", CreatedAt: "2020-03-04T04:06:07Z"},
+		{ResourceType: "vpc", Identifier: "vpc-123", Region: "us-east-1"},
+	}, written.Resources)
+	assert.Equal(t, []SkippedResource{
+		{ResourceType: "ec2", Identifier: "i-456", Region: "us-east-1", Reason: "termination protection is enabled"},
+	}, written.Skipped)
+}
+
+func TestReadJSON(t *testing.T) {
+	t.Parallel()
+
+	runReport := New("aws")
+	runReport.Account = &Account{ID: "123456789012"}
+	runReport.AddResource("ec2", "i-123", "us-east-1", time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC))
+
+	file, err := ioutil.TempFile("", "cloud-nuke-report-*.json")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	require.NoError(t, runReport.WriteJSON(file.Name()))
+
+	read, err := ReadJSON(file.Name())
+	require.NoError(t, err)
+	assert.Equal(t, runReport, read)
+
+	require.NoError(t, ioutil.WriteFile(file.Name(), []byte("not json"), 0644))
+	_, err = ReadJSON(file.Name())
+	assert.Error(t, err)
+}
+
+func TestAccountSummary(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "123456789012", Account{ID: "123456789012"}.Summary())
+	assert.Equal(t, "123456789012 (sandbox-42)", Account{ID: "123456789012", Alias: "sandbox-42"}.Summary())
+	assert.Equal(
+		t,
+		"123456789012 (sandbox-42, sandbox-42@example.com, Root/Sandboxes)",
+		Account{ID: "123456789012", Alias: "sandbox-42", Email: "sandbox-42@example.com", OUPath: "Root/Sandboxes"}.Summary(),
+	)
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// SharedResource - a resource about to be nuked, along with who it's shared with
+type SharedResource struct {
+	ResourceType string   `json:"resource_type"`
+	Identifier   string   `json:"identifier"`
+	Region       string   `json:"region"`
+	AccountIds   []string `json:"account_ids"`
+	Public       bool     `json:"public"`
+}
+
+// SharingReport - the resources about to be nuked that other accounts have access to, and lose it along with them
+type SharingReport struct {
+	Cloud       string `json:"cloud"`
+	GeneratedAt string `json:"generated_at"`
+	// Left out when the account is unknown
+	Account *Account `json:"account,omitempty"`
+	// The accounts the resources are shared with, each with the number of resources shared with it
+	ConsumingAccounts map[string]int   `json:"consuming_accounts"`
+	Resources         []SharedResource `json:"resources"`
+}
+
+// NewSharing - Returns the sharing report of the given resources
+func NewSharing(cloud string, resources []SharedResource) *SharingReport {
+	report := &SharingReport{
+		Cloud:             cloud,
+		GeneratedAt:       time.Now().UTC().Format(time.RFC3339),
+		ConsumingAccounts: map[string]int{},
+		Resources:         resources,
+	}
+	if report.Resources == nil {
+		report.Resources = []SharedResource{}
+	}
+	for i, resource := range report.Resources {
+		if resource.AccountIds == nil {
+			report.Resources[i].AccountIds = []string{}
+		}
+		for _, accountId := range resource.AccountIds {
+			report.ConsumingAccounts[accountId]++
+		}
+	}
+	return report
+}
+
+// WriteJSON - Writes the sharing report to the file at the given path, as JSON
+func (report *SharingReport) WriteJSON(path string) error {
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
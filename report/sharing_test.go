@@ -0,0 +1,29 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSharing(t *testing.T) {
+	t.Parallel()
+
+	sharing := NewSharing("aws", []SharedResource{
+		{ResourceType: "ami", Identifier: "ami-1", Region: "us-east-1", AccountIds: []string{"111111111111", "222222222222"}},
+		{ResourceType: "snap", Identifier: "snap-1", Region: "us-east-1", AccountIds: []string{"111111111111"}},
+		{ResourceType: "ami", Identifier: "ami-2", Region: "eu-west-1", Public: true},
+	})
+
+	assert.Equal(t, map[string]int{"111111111111": 2, "222222222222": 1}, sharing.ConsumingAccounts)
+	// Public resources are written with an empty list of accounts rather than null
+	assert.Equal(t, []string{}, sharing.Resources[2].AccountIds)
+}
+
+func TestNewSharingWithoutResources(t *testing.T) {
+	t.Parallel()
+
+	sharing := NewSharing("aws", nil)
+	assert.Equal(t, []SharedResource{}, sharing.Resources)
+	assert.Empty(t, sharing.ConsumingAccounts)
+}
@@ -0,0 +1,40 @@
+package report
+
+// The statuses of the resources in the state file of a run, which records how far the nuking got so that an
+// interrupted run can be resumed
+const (
+	// Not nuked yet
+	StatusPending = "pending"
+	// Nuked
+	StatusNuked = "nuked"
+	// Couldn't be nuked, e.g. because of throttling or a timeout. Retried when resuming the run.
+	StatusFailed = "failed"
+	// Left alone, e.g. because it no longer existed or wasn't selected
+	StatusSkipped = "skipped"
+)
+
+// SetStatus - Sets the status of the resources of the given type and region with the given identifiers
+func (report *Report) SetStatus(resourceType string, region string, identifiers []string, status string) {
+	selected := map[string]bool{}
+	for _, identifier := range identifiers {
+		selected[identifier] = true
+	}
+	for i, resource := range report.Resources {
+		if resource.ResourceType == resourceType && resource.Region == region && selected[resource.Identifier] {
+			report.Resources[i].Status = status
+		}
+	}
+}
+
+// Remaining - Returns the resources of the state file of a run that are still to be nuked when resuming it, i.e. the
+// ones that are neither nuked nor skipped
+func (report *Report) Remaining() *Report {
+	remaining := *report
+	remaining.Resources = []Resource{}
+	for _, resource := range report.Resources {
+		if resource.Status != StatusNuked && resource.Status != StatusSkipped {
+			remaining.Resources = append(remaining.Resources, resource)
+		}
+	}
+	return &remaining
+}
@@ -0,0 +1,36 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateRemaining(t *testing.T) {
+	t.Parallel()
+
+	state := New("aws")
+	state.Account = &Account{ID: "123456789012"}
+	state.AddResource("ec2", "i-123", "us-east-1", time.Time{})
+	state.AddResource("ec2", "i-456", "us-east-1", time.Time{})
+	state.AddResource("ec2", "i-123", "us-west-2", time.Time{})
+	state.AddResource("ebs", "vol-123", "us-east-1", time.Time{})
+	state.AddResource("vpc", "vpc-123", "us-east-1", time.Time{})
+	state.SetStatus("ec2", "us-east-1", []string{"i-123", "i-456"}, StatusPending)
+	state.SetStatus("ec2", "us-east-1", []string{"i-123"}, StatusNuked)
+	state.SetStatus("ec2", "us-west-2", []string{"i-123"}, StatusFailed)
+	state.SetStatus("ebs", "us-east-1", []string{"vol-123"}, StatusSkipped)
+
+	assert.Equal(t, StatusNuked, state.Resources[0].Status)
+	assert.Equal(t, StatusPending, state.Resources[1].Status)
+
+	remaining := state.Remaining()
+	assert.Equal(t, state.Account, remaining.Account)
+	assert.Equal(t, []Resource{
+		{ResourceType: "ec2", Identifier: "i-456", Region: "us-east-1", Status: StatusPending},
+		{ResourceType: "ec2", Identifier: "i-123", Region: "us-west-2", Status: StatusFailed},
+		{ResourceType: "vpc", Identifier: "vpc-123", Region: "us-east-1"},
+	}, remaining.Resources)
+	assert.Len(t, state.Resources, 5)
+}
@@ -0,0 +1,111 @@
+package throughput
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/gruntwork-cli/errors"
+)
+
+// The weight of the latest run in the rates, so that they follow changes in throughput, e.g. from API throttling,
+// without being thrown off by a single unusual run
+const latestRunWeight = 0.3
+
+// Rate - how long nuking a resource of a type took in previous runs
+type Rate struct {
+	SecondsPerResource float64 `json:"seconds_per_resource"`
+	// How many runs the rate was measured over
+	Runs int `json:"runs"`
+}
+
+// History - the rates measured by previous runs, per cloud, then resource type
+type History struct {
+	Clouds map[string]map[string]Rate `json:"clouds"`
+}
+
+// NewHistory - Returns a history without any rates
+func NewHistory() *History {
+	return &History{Clouds: map[string]map[string]Rate{}}
+}
+
+// DefaultPath - Returns where the history is stored unless configured otherwise, in the home directory of the user
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return filepath.Join(home, ".cloud-nuke", "throughput.json"), nil
+}
+
+// Load - Reads the history stored at the given path. Returns an empty history when there is no file at that path yet.
+func Load(path string) (*History, error) {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NewHistory(), nil
+	}
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var history History
+	if err := json.Unmarshal(contents, &history); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if history.Clouds == nil {
+		history.Clouds = map[string]map[string]Rate{}
+	}
+	return &history, nil
+}
+
+// Save - Writes the history to the given path, as JSON, creating its directory if needed
+func (history *History) Save(path string) error {
+	contents, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// Record - Updates the rate of the resource type with the time a run took to nuke the given number of resources
+func (history *History) Record(cloud string, resourceType string, resources int, elapsed time.Duration) {
+	if resources <= 0 {
+		return
+	}
+	if history.Clouds[cloud] == nil {
+		history.Clouds[cloud] = map[string]Rate{}
+	}
+
+	secondsPerResource := elapsed.Seconds() / float64(resources)
+	rate, known := history.Clouds[cloud][resourceType]
+	if known {
+		secondsPerResource = (1-latestRunWeight)*rate.SecondsPerResource + latestRunWeight*secondsPerResource
+	}
+	history.Clouds[cloud][resourceType] = Rate{SecondsPerResource: secondsPerResource, Runs: rate.Runs + 1}
+}
+
+// Estimate - Returns how long nuking the given number of resources of each type should take, based on previous runs.
+// The resource types never nuked before can't be estimated, so they are left out of the estimate and returned sorted.
+func (history *History) Estimate(cloud string, counts map[string]int) (time.Duration, []string) {
+	var seconds float64
+	var unknown []string
+	for resourceType, count := range counts {
+		rate, known := history.Clouds[cloud][resourceType]
+		if !known {
+			unknown = append(unknown, resourceType)
+			continue
+		}
+		seconds += rate.SecondsPerResource * float64(count)
+	}
+	sort.Strings(unknown)
+	return time.Duration(seconds * float64(time.Second)), unknown
+}
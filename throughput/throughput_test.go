@@ -0,0 +1,63 @@
+package throughput
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	history := NewHistory()
+	history.Record("aws", "ec2", 10, 100*time.Second)
+	assert.Equal(t, Rate{SecondsPerResource: 10, Runs: 1}, history.Clouds["aws"]["ec2"])
+
+	// Later runs are averaged in, the latest one weighing the least
+	history.Record("aws", "ec2", 5, 100*time.Second)
+	assert.InDelta(t, 13, history.Clouds["aws"]["ec2"].SecondsPerResource, 0.001)
+	assert.Equal(t, 2, history.Clouds["aws"]["ec2"].Runs)
+
+	// Nothing is learnt from a run that didn't nuke any resource of the type
+	history.Record("aws", "ebs", 0, time.Minute)
+	_, known := history.Clouds["aws"]["ebs"]
+	assert.False(t, known)
+}
+
+func TestEstimate(t *testing.T) {
+	t.Parallel()
+
+	history := NewHistory()
+	history.Record("aws", "ec2", 1, 30*time.Second)
+	history.Record("aws", "ebs", 1, 6*time.Second)
+	history.Record("gcp", "gcsbucket", 1, time.Hour)
+
+	estimate, unknown := history.Estimate("aws", map[string]int{"ec2": 4, "ebs": 10, "s3": 2, "ami": 1})
+	assert.Equal(t, 3*time.Minute, estimate)
+	assert.Equal(t, []string{"ami", "s3"}, unknown)
+}
+
+func TestHistoryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "cloud-nuke-throughput")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nested", "throughput.json")
+
+	history, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, NewHistory(), history)
+
+	history.Record("azure", "vm", 2, time.Minute)
+	require.NoError(t, history.Save(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, history, loaded)
+}
@@ -0,0 +1,97 @@
+package tuning
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// How many regions are discovered at the same time per CPU. Discovery mostly waits on the AWS APIs, so it goes well
+// beyond one per CPU.
+const regionsPerCPU = 4
+
+// How much memory discovering a region takes at most, mostly for the SDK clients and the identifiers listed
+const memoryPerRegion = 128 * 1024 * 1024
+
+// MaxConcurrency - the most regions discovered at the same time, so that the AWS APIs aren't throttled
+const MaxConcurrency = 16
+
+// Above this, a cgroup v1 memory limit means that there is no limit
+const unlimitedMemory = 1 << 50
+
+// Resources - what the machine, or container, running cloud-nuke has available
+type Resources struct {
+	OS   string
+	Arch string
+	// The CPUs that goroutines run on at the same time, as set by GOMAXPROCS
+	CPUs int
+	// The memory limit, in bytes. Zero when it isn't known.
+	MemoryBytes int64
+}
+
+func (resources Resources) String() string {
+	memory := "unknown memory"
+	if resources.MemoryBytes > 0 {
+		memory = fmt.Sprintf("%d MiB of memory", resources.MemoryBytes/(1024*1024))
+	}
+	return fmt.Sprintf("%s/%s, %d CPUs, %s", resources.OS, resources.Arch, resources.CPUs, memory)
+}
+
+// Detect - Returns what the current process has available. The memory limit is read from Lambda's environment, then
+// from cgroups, so that it's the one of the Lambda function or Fargate task rather than of the host.
+func Detect() Resources {
+	return Resources{
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		CPUs:        runtime.GOMAXPROCS(0),
+		MemoryBytes: memoryLimit(os.Getenv, ioutil.ReadFile),
+	}
+}
+
+// The files holding the memory limit of the container, for cgroup v2 then v1
+var memoryLimitFiles = []string{
+	"/sys/fs/cgroup/memory.max",
+	"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+}
+
+// memoryLimit - Returns the memory limit in bytes, or zero when there is none, or it can't be read
+func memoryLimit(getenv func(string) string, readFile func(string) ([]byte, error)) int64 {
+	if megabytes, err := strconv.ParseInt(getenv("AWS_LAMBDA_FUNCTION_MEMORY_SIZE"), 10, 64); err == nil && megabytes > 0 {
+		return megabytes * 1024 * 1024
+	}
+
+	for _, path := range memoryLimitFiles {
+		contents, err := readFile(path)
+		if err != nil {
+			continue
+		}
+		// cgroup v2 holds "max" when there is no limit
+		limit, err := strconv.ParseInt(strings.TrimSpace(string(contents)), 10, 64)
+		if err != nil || limit <= 0 || limit >= unlimitedMemory {
+			return 0
+		}
+		return limit
+	}
+	return 0
+}
+
+// Concurrency - Returns how many regions to discover at the same time with the given resources, between 1 and
+// MaxConcurrency
+func Concurrency(resources Resources) int {
+	concurrency := resources.CPUs * regionsPerCPU
+	if resources.MemoryBytes > 0 {
+		if byMemory := int(resources.MemoryBytes / memoryPerRegion); byMemory < concurrency {
+			concurrency = byMemory
+		}
+	}
+	if concurrency > MaxConcurrency {
+		return MaxConcurrency
+	}
+	if concurrency < 1 {
+		return 1
+	}
+	return concurrency
+}
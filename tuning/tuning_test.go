@@ -0,0 +1,52 @@
+package tuning
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mebibyte = 1024 * 1024
+
+func TestConcurrency(t *testing.T) {
+	t.Parallel()
+
+	// A laptop, with plenty of memory
+	assert.Equal(t, 8, Concurrency(Resources{CPUs: 2, MemoryBytes: 16 * 1024 * mebibyte}))
+	// Capped so that the AWS APIs aren't throttled
+	assert.Equal(t, MaxConcurrency, Concurrency(Resources{CPUs: 16}))
+	// A small Lambda function, limited by its memory
+	assert.Equal(t, 4, Concurrency(Resources{CPUs: 2, MemoryBytes: 512 * mebibyte}))
+	// Never below one region at a time
+	assert.Equal(t, 1, Concurrency(Resources{CPUs: 1, MemoryBytes: 64 * mebibyte}))
+}
+
+func TestMemoryLimit(t *testing.T) {
+	t.Parallel()
+
+	noEnv := func(string) string { return "" }
+	files := func(contents map[string]string) func(string) ([]byte, error) {
+		return func(path string) ([]byte, error) {
+			content, exists := contents[path]
+			if !exists {
+				return nil, os.ErrNotExist
+			}
+			return []byte(content), nil
+		}
+	}
+
+	lambdaEnv := func(name string) string {
+		if name == "AWS_LAMBDA_FUNCTION_MEMORY_SIZE" {
+			return "256"
+		}
+		return ""
+	}
+	assert.Equal(t, int64(256*mebibyte), memoryLimit(lambdaEnv, files(nil)))
+
+	assert.Equal(t, int64(512*mebibyte), memoryLimit(noEnv, files(map[string]string{"/sys/fs/cgroup/memory.max": "536870912\n"})))
+	assert.Equal(t, int64(0), memoryLimit(noEnv, files(map[string]string{"/sys/fs/cgroup/memory.max": "max\n"})))
+	assert.Equal(t, int64(1024*mebibyte), memoryLimit(noEnv, files(map[string]string{"/sys/fs/cgroup/memory/memory.limit_in_bytes": "1073741824\n"})))
+	assert.Equal(t, int64(0), memoryLimit(noEnv, files(map[string]string{"/sys/fs/cgroup/memory/memory.limit_in_bytes": "9223372036854771712\n"})))
+	assert.Equal(t, int64(0), memoryLimit(noEnv, files(nil)))
+}